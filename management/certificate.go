@@ -0,0 +1,110 @@
+package management
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CertificateInfo describes an X.509 certificate, such as the signing
+// certificate of a SAML identity provider.
+type CertificateInfo struct {
+	// The distinguished name of the subject.
+	Subject *string `json:"subject,omitempty"`
+
+	// The distinguished name of the issuer.
+	Issuer *string `json:"issuer,omitempty"`
+
+	// The serial number in hexadecimal.
+	SerialNumber *string `json:"serial_number,omitempty"`
+
+	// The algorithm of the public key, for example "RSA".
+	PublicKeyAlgorithm *string `json:"public_key_algorithm,omitempty"`
+
+	// The algorithm the certificate was signed with, for example "SHA256-RSA".
+	SignatureAlgorithm *string `json:"signature_algorithm,omitempty"`
+
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	NotAfter  *time.Time `json:"not_after,omitempty"`
+
+	// The SHA-1 thumbprint in lowercase hexadecimal, as used by SAML
+	// connections to identify trusted certificates.
+	ThumbprintSHA1 *string `json:"thumbprint_sha1,omitempty"`
+
+	// The SHA-256 thumbprint in lowercase hexadecimal.
+	ThumbprintSHA256 *string `json:"thumbprint_sha256,omitempty"`
+
+	// The PEM encoded certificate.
+	PEM *string `json:"pem,omitempty"`
+}
+
+// ParseCertificate parses a certificate given either in PEM form or as base64
+// encoded DER, as found in SAML metadata. Base64 encoded PEM, as used by the
+// signingCert option of SAML connections, is accepted too. Whitespace is
+// ignored.
+func ParseCertificate(data string) (*CertificateInfo, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(strings.TrimSpace(data))); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data), ""))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode the certificate: %w", err)
+		}
+		der = decoded
+		if block, _ := pem.Decode(decoded); block != nil {
+			der = block.Bytes
+		}
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate: %w", err)
+	}
+
+	return newCertificateInfo(certificate), nil
+}
+
+func newCertificateInfo(certificate *x509.Certificate) *CertificateInfo {
+	sha1Sum := sha1.Sum(certificate.Raw)
+	sha256Sum := sha256.Sum256(certificate.Raw)
+	encoded := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate.Raw}))
+	subject := certificate.Subject.String()
+	issuer := certificate.Issuer.String()
+	serialNumber := certificate.SerialNumber.Text(16)
+	publicKeyAlgorithm := certificate.PublicKeyAlgorithm.String()
+	signatureAlgorithm := certificate.SignatureAlgorithm.String()
+	thumbprintSHA1 := hex.EncodeToString(sha1Sum[:])
+	thumbprintSHA256 := hex.EncodeToString(sha256Sum[:])
+
+	return &CertificateInfo{
+		Subject:            &subject,
+		Issuer:             &issuer,
+		SerialNumber:       &serialNumber,
+		PublicKeyAlgorithm: &publicKeyAlgorithm,
+		SignatureAlgorithm: &signatureAlgorithm,
+		NotBefore:          &certificate.NotBefore,
+		NotAfter:           &certificate.NotAfter,
+		ThumbprintSHA1:     &thumbprintSHA1,
+		ThumbprintSHA256:   &thumbprintSHA256,
+		PEM:                &encoded,
+	}
+}
+
+// Expired reports whether the certificate is no longer valid at the given
+// time.
+func (c *CertificateInfo) Expired(at time.Time) bool {
+	return c.NotAfter != nil && at.After(*c.NotAfter)
+}
+
+// ExpiresWithin reports whether the certificate will no longer be valid
+// within the given window from the given time.
+func (c *CertificateInfo) ExpiresWithin(window time.Duration, at time.Time) bool {
+	return c.NotAfter != nil && at.Add(window).After(*c.NotAfter)
+}
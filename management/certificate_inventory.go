@@ -0,0 +1,228 @@
+package management
+
+import (
+	"bytes"
+	"encoding/xml"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// Sources of the certificates in a CertificateInventory.
+const (
+	CertificateSourceConnection = "connection"
+	CertificateSourceSigningKey = "signing_key"
+)
+
+// CertificateInventory lists the certificates used by the SAML, ADFS and
+// PingFederate connections of a tenant, as well as its signing keys.
+//
+// Its String method returns the inventory as JSON, so it can be written out
+// by scheduled jobs as is.
+type CertificateInventory struct {
+	// When the inventory was taken.
+	GeneratedAt *time.Time `json:"generated_at,omitempty"`
+
+	// Certificates that expire before GeneratedAt plus this window are
+	// flagged as expiring.
+	ExpiryWindow *string `json:"expiry_window,omitempty"`
+
+	Certificates []*CertificateInventoryItem `json:"certificates"`
+}
+
+// CertificateInventoryItem is a certificate found while taking a
+// CertificateInventory.
+type CertificateInventoryItem struct {
+	// Where the certificate was found, either CertificateSourceConnection or
+	// CertificateSourceSigningKey.
+	Source *string `json:"source,omitempty"`
+
+	// The connection the certificate belongs to.
+	ConnectionID       *string `json:"connection_id,omitempty"`
+	ConnectionName     *string `json:"connection_name,omitempty"`
+	ConnectionStrategy *string `json:"connection_strategy,omitempty"`
+
+	// The option of the connection holding the certificate, for example
+	// "signingCert".
+	Field *string `json:"field,omitempty"`
+
+	// The key id of the signing key the certificate belongs to.
+	KID *string `json:"kid,omitempty"`
+
+	// The state of the signing key: "current", "next", "previous" or
+	// "revoked".
+	KeyState *string `json:"key_state,omitempty"`
+
+	Certificate *CertificateInfo `json:"certificate,omitempty"`
+
+	// Why the certificate could not be parsed, if it couldn't.
+	Error *string `json:"error,omitempty"`
+
+	// Whether the certificate has expired, or expires within the window.
+	Expired  *bool `json:"expired"`
+	Expiring *bool `json:"expiring"`
+}
+
+// NeedsAttention returns the certificates that have expired, expire within the
+// window or could not be parsed. Revoked signing keys are left out.
+func (i *CertificateInventory) NeedsAttention() []*CertificateInventoryItem {
+	var items []*CertificateInventoryItem
+	for _, item := range i.Certificates {
+		if item.GetKeyState() == "revoked" {
+			continue
+		}
+		if item.GetExpired() || item.GetExpiring() || item.GetError() != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// certificateConnectionStrategies are the strategies of the connections that
+// hold certificates.
+var certificateConnectionStrategies = []string{
+	ConnectionStrategySAML,
+	ConnectionStrategyADFS,
+	ConnectionStrategyPingFederate,
+}
+
+// CertificateInventory walks the SAML, ADFS and PingFederate connections and
+// the signing keys of the tenant, and reports on every certificate found.
+// Certificates that expire within the given window are flagged.
+func (m *Management) CertificateInventory(window time.Duration, opts ...RequestOption) (*CertificateInventory, error) {
+	now := time.Now().UTC()
+	expiryWindow := window.String()
+	inventory := &CertificateInventory{
+		GeneratedAt:  &now,
+		ExpiryWindow: &expiryWindow,
+		Certificates: []*CertificateInventoryItem{},
+	}
+
+	for _, strategy := range certificateConnectionStrategies {
+		for page := 0; ; page++ {
+			l, err := m.Connection.List(withOptions(opts, Parameter("strategy", strategy), Page(page))...)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range l.Connections {
+				inventory.addConnection(c, now, window)
+			}
+			if !l.HasNext() {
+				break
+			}
+		}
+	}
+
+	keys, err := m.SigningKey.List(opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		item := &CertificateInventoryItem{
+			Source:   authok.String(CertificateSourceSigningKey),
+			KID:      key.KID,
+			KeyState: authok.String(signingKeyState(key)),
+		}
+		inventory.add(item, key.GetCert(), now, window)
+	}
+
+	return inventory, nil
+}
+
+func (i *CertificateInventory) addConnection(c *Connection, now time.Time, window time.Duration) {
+	type field struct {
+		name string
+		data string
+	}
+
+	var fields []field
+	switch options := c.Options.(type) {
+	case *ConnectionOptionsSAML:
+		fields = []field{
+			{"cert", options.GetCert()},
+			{"signingCert", options.GetSigningCert()},
+			{"signing_key.cert", options.SigningKey.GetCert()},
+		}
+	case *ConnectionOptionsPingFederate:
+		fields = []field{
+			{"cert", options.GetCert()},
+			{"signingCert", options.GetSigningCert()},
+		}
+	case *ConnectionOptionsADFS:
+		for _, certificate := range x509CertificatesInXML(options.GetFedMetadataXML()) {
+			fields = append(fields, field{"fedMetadataXml", certificate})
+		}
+	}
+
+	// The same certificate is often found in more than one option.
+	seen := map[string]bool{}
+	for _, f := range fields {
+		if f.data == "" {
+			continue
+		}
+		if certificate, err := ParseCertificate(f.data); err == nil {
+			if seen[certificate.GetThumbprintSHA1()] {
+				continue
+			}
+			seen[certificate.GetThumbprintSHA1()] = true
+		}
+		i.add(&CertificateInventoryItem{
+			Source:             authok.String(CertificateSourceConnection),
+			ConnectionID:       c.ID,
+			ConnectionName:     c.Name,
+			ConnectionStrategy: c.Strategy,
+			Field:              authok.String(f.name),
+		}, f.data, now, window)
+	}
+}
+
+func (i *CertificateInventory) add(item *CertificateInventoryItem, data string, now time.Time, window time.Duration) {
+	certificate, err := ParseCertificate(data)
+	if err != nil {
+		item.Error = authok.String(err.Error())
+	} else {
+		expired := certificate.Expired(now)
+		expiring := !expired && certificate.ExpiresWithin(window, now)
+		item.Certificate = certificate
+		item.Expired = &expired
+		item.Expiring = &expiring
+	}
+	i.Certificates = append(i.Certificates, item)
+}
+
+func signingKeyState(key *SigningKey) string {
+	switch {
+	case key.GetRevoked():
+		return "revoked"
+	case key.GetCurrent():
+		return "current"
+	case key.GetNext():
+		return "next"
+	case key.GetPrevious():
+		return "previous"
+	default:
+		return ""
+	}
+}
+
+// x509CertificatesInXML returns the content of every X509Certificate element
+// found in an XML document, such as WS-Federation metadata.
+func x509CertificatesInXML(data string) []string {
+	var certificates []string
+	decoder := xml.NewDecoder(bytes.NewBufferString(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return certificates
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "X509Certificate" {
+			continue
+		}
+		var certificate string
+		if err := decoder.DecodeElement(&certificate, &start); err != nil {
+			return certificates
+		}
+		certificates = append(certificates, certificate)
+	}
+}
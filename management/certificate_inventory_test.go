@@ -0,0 +1,86 @@
+package management
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagement_CertificateInventory(t *testing.T) {
+	now := time.Now()
+	valid := newTestCertificate(t, "valid", now.Add(-time.Hour), now.Add(365*24*time.Hour), nil)
+	expiring := newTestCertificate(t, "expiring", now.Add(-time.Hour), now.Add(7*24*time.Hour), nil)
+	expired := newTestCertificate(t, "expired", now.Add(-48*time.Hour), now.Add(-24*time.Hour), nil)
+
+	toPEM := func(der []byte) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	}
+
+	api, m := startFakeAPI(t)
+	api.put("/connections/con_saml", map[string]interface{}{
+		"id":       "con_saml",
+		"name":     "acme-saml",
+		"strategy": "samlp",
+		"options": map[string]interface{}{
+			"cert":        toPEM(expiring),
+			"signingCert": base64.StdEncoding.EncodeToString([]byte(toPEM(expiring))),
+		},
+	})
+	api.put("/connections/con_adfs", map[string]interface{}{
+		"id":       "con_adfs",
+		"name":     "acme-adfs",
+		"strategy": "adfs",
+		"options": map[string]interface{}{
+			"fedMetadataXml": `<EntityDescriptor><RoleDescriptor><KeyDescriptor><KeyInfo><X509Data><X509Certificate>` +
+				base64.StdEncoding.EncodeToString(valid) +
+				`</X509Certificate></X509Data></KeyInfo></KeyDescriptor></RoleDescriptor></EntityDescriptor>`,
+		},
+	})
+	api.put("/connections/con_ping", map[string]interface{}{
+		"id":       "con_ping",
+		"name":     "acme-ping",
+		"strategy": "pingfederate",
+		"options":  map[string]interface{}{"cert": "garbage"},
+	})
+	api.put("/connections/con_db", map[string]interface{}{"id": "con_db", "name": "db", "strategy": "authok"})
+	api.put("/keys/signing/current", map[string]interface{}{"kid": "current", "current": true, "cert": toPEM(valid)})
+	api.put("/keys/signing/revoked", map[string]interface{}{"kid": "revoked", "revoked": true, "cert": toPEM(expired)})
+
+	inventory, err := m.CertificateInventory(30 * 24 * time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, "720h0m0s", inventory.GetExpiryWindow())
+	require.Len(t, inventory.Certificates, 5)
+
+	saml := inventory.Certificates[0]
+	assert.Equal(t, CertificateSourceConnection, saml.GetSource())
+	assert.Equal(t, "acme-saml", saml.GetConnectionName())
+	assert.Equal(t, "cert", saml.GetField())
+	assert.Equal(t, "CN=expiring", saml.Certificate.GetSubject())
+	assert.True(t, saml.GetExpiring())
+	assert.False(t, saml.GetExpired())
+
+	adfs := inventory.Certificates[1]
+	assert.Equal(t, "fedMetadataXml", adfs.GetField())
+	assert.Equal(t, "CN=valid", adfs.Certificate.GetSubject())
+	assert.False(t, adfs.GetExpiring())
+
+	ping := inventory.Certificates[2]
+	assert.Equal(t, "acme-ping", ping.GetConnectionName())
+	assert.Contains(t, ping.GetError(), "failed to decode the certificate")
+
+	assert.Equal(t, "current", inventory.Certificates[3].GetKeyState())
+	assert.Equal(t, "revoked", inventory.Certificates[4].GetKeyState())
+	assert.True(t, inventory.Certificates[4].GetExpired())
+
+	assert.Equal(t, []*CertificateInventoryItem{saml, ping}, inventory.NeedsAttention())
+
+	var report map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(inventory.String()), &report))
+	assert.Len(t, report["certificates"], 5)
+}
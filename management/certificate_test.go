@@ -0,0 +1,88 @@
+package management
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCertificate returns a self-signed certificate valid between the
+// given times, DER encoded.
+func newTestCertificate(t *testing.T, commonName string, notBefore, notAfter time.Time, key crypto.Signer) []byte {
+	t.Helper()
+
+	if key == nil {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		key = rsaKey
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	return der
+}
+
+func TestParseCertificate(t *testing.T) {
+	notBefore := time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+	notAfter := notBefore.Add(48 * time.Hour)
+	der := newTestCertificate(t, "idp.example.com", notBefore, notAfter, nil)
+	sum := sha1.Sum(der)
+
+	for name, data := range map[string]string{
+		"PEM":        string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"Base64":     "\n  " + base64.StdEncoding.EncodeToString(der)[:40] + "\n  " + base64.StdEncoding.EncodeToString(der)[40:] + "\n",
+		"Base64 PEM": base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	} {
+		t.Run(name, func(t *testing.T) {
+			certificate, err := ParseCertificate(data)
+			require.NoError(t, err)
+
+			assert.Equal(t, "CN=idp.example.com", certificate.GetSubject())
+			assert.Equal(t, "2a", certificate.GetSerialNumber())
+			assert.Equal(t, "RSA", certificate.GetPublicKeyAlgorithm())
+			assert.Equal(t, hex.EncodeToString(sum[:]), certificate.GetThumbprintSHA1())
+			assert.Len(t, certificate.GetThumbprintSHA256(), 64)
+			assert.Equal(t, notAfter, certificate.GetNotAfter().UTC())
+
+			assert.False(t, certificate.Expired(time.Now()))
+			assert.True(t, certificate.Expired(notAfter.Add(time.Second)))
+			assert.False(t, certificate.ExpiresWithin(24*time.Hour, time.Now()))
+			assert.True(t, certificate.ExpiresWithin(72*time.Hour, time.Now()))
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := ParseCertificate("not a certificate")
+		assert.Error(t, err)
+	})
+}
+
+func newTestECDSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return key
+}
@@ -0,0 +1,438 @@
+package management
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/joho/godotenv"
+
+	"github.com/authok/authok-go"
+)
+
+// CustomScriptNames are the custom scripts a database connection can have.
+var CustomScriptNames = []string{
+	"login",
+	"get_user",
+	"create",
+	"verify",
+	"change_password",
+	"delete",
+}
+
+// CustomScriptsConfigurationFile is the dotenv file holding the configuration
+// variables in a custom scripts directory.
+const CustomScriptsConfigurationFile = "configuration.env"
+
+// Kinds of CustomScriptChange.
+const (
+	CustomScriptAdded    = "added"
+	CustomScriptRemoved  = "removed"
+	CustomScriptModified = "modified"
+)
+
+// CustomScripts are the custom scripts and configuration variables of a
+// database connection, as kept in a directory with a file per script, such as
+// "login.js", and the configuration in CustomScriptsConfigurationFile.
+type CustomScripts struct {
+	// The scripts by name, one of CustomScriptNames.
+	Scripts *map[string]string `json:"customScripts,omitempty"`
+
+	// Configuration variables that can be used in the scripts. When nil, the
+	// configuration is left as it is, which allows keeping secrets out of the
+	// directory.
+	Configuration *map[string]string `json:"configuration,omitempty"`
+}
+
+// CustomScriptChange is a difference between the custom scripts of a
+// directory and those of a connection.
+type CustomScriptChange struct {
+	// The name of the script, or "configuration.{key}" for configuration
+	// variables.
+	Name *string `json:"name,omitempty"`
+
+	// One of CustomScriptAdded, CustomScriptRemoved or CustomScriptModified.
+	Change *string `json:"change,omitempty"`
+
+	// A unified diff of the script. Configuration values are left out, as
+	// they often hold secrets.
+	UnifiedDiff *string `json:"diff,omitempty"`
+}
+
+// ReadCustomScripts reads the custom scripts and configuration of a database
+// connection from a directory. Files other than scripts and the configuration
+// are ignored, but scripts with a name not in CustomScriptNames are an error.
+func ReadCustomScripts(dir string) (*CustomScripts, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the custom scripts: %w", err)
+	}
+
+	scripts := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".js" {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ".js")
+		if !isCustomScriptName(name) {
+			return nil, fmt.Errorf("unknown custom script %q, expected one of: %s", entry.Name(), strings.Join(CustomScriptNames, ", "))
+		}
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the custom scripts: %w", err)
+		}
+		scripts[name] = string(b)
+	}
+
+	s := &CustomScripts{Scripts: &scripts}
+
+	configuration, err := godotenv.Read(filepath.Join(dir, CustomScriptsConfigurationFile))
+	switch {
+	case err == nil:
+		s.Configuration = &configuration
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("failed to read the custom scripts configuration: %w", err)
+	}
+
+	return s, nil
+}
+
+// Write writes the custom scripts and configuration to a directory, creating
+// it if needed. Scripts in the directory that are not in s are removed, so
+// the directory ends up matching s. The configuration file is readable by its
+// owner only.
+func (s *CustomScripts) Write(dir string) error {
+	if err := s.validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to write the custom scripts: %w", err)
+	}
+
+	scripts := s.GetScripts()
+	for _, name := range CustomScriptNames {
+		path := filepath.Join(dir, name+".js")
+		script, ok := scripts[name]
+		if !ok {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("failed to write the custom scripts: %w", err)
+			}
+			continue
+		}
+		if err := os.WriteFile(path, []byte(script), 0o644); err != nil {
+			return fmt.Errorf("failed to write the custom scripts: %w", err)
+		}
+	}
+
+	if s.Configuration == nil {
+		return nil
+	}
+	configuration, err := godotenv.Marshal(s.GetConfiguration())
+	if err != nil {
+		return fmt.Errorf("failed to write the custom scripts configuration: %w", err)
+	}
+	path := filepath.Join(dir, CustomScriptsConfigurationFile)
+	if err := os.WriteFile(path, []byte(configuration+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write the custom scripts configuration: %w", err)
+	}
+	return nil
+}
+
+// Changes returns the changes needed to turn other into s, one per script and
+// configuration variable. The configuration is only compared when s has one.
+func (s *CustomScripts) Changes(other *CustomScripts) []*CustomScriptChange {
+	var changes []*CustomScriptChange
+
+	have, want := other.GetScripts(), s.GetScripts()
+	for _, name := range CustomScriptNames {
+		before, hadScript := have[name]
+		after, hasScript := want[name]
+		change := customScriptChange(hadScript, hasScript, before == after)
+		if change == "" {
+			continue
+		}
+		diff := unifiedDiff("a/"+name+".js", "b/"+name+".js", diffLines(before), diffLines(after))
+		changes = append(changes, &CustomScriptChange{
+			Name:        authok.String(name),
+			Change:      authok.String(change),
+			UnifiedDiff: authok.String(diff),
+		})
+	}
+
+	if s.Configuration == nil {
+		return changes
+	}
+	haveConfiguration, wantConfiguration := other.GetConfiguration(), s.GetConfiguration()
+	keys := map[string]bool{}
+	for key := range haveConfiguration {
+		keys[key] = true
+	}
+	for key := range wantConfiguration {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	for _, key := range sorted {
+		before, hadKey := haveConfiguration[key]
+		after, hasKey := wantConfiguration[key]
+		if change := customScriptChange(hadKey, hasKey, before == after); change != "" {
+			changes = append(changes, &CustomScriptChange{
+				Name:   authok.String("configuration." + key),
+				Change: authok.String(change),
+			})
+		}
+	}
+
+	return changes
+}
+
+// Apply sets the custom scripts and configuration on the options of a
+// database connection. The configuration is only set when s has one.
+func (s *CustomScripts) Apply(options *ConnectionOptions) {
+	options.CustomScripts = clonePointerFunc(s.Scripts, cloneMap[string, string])
+	if s.Configuration != nil {
+		options.Configuration = clonePointerFunc(s.Configuration, cloneMap[string, string])
+	}
+}
+
+func (s *CustomScripts) validate() error {
+	for name := range s.GetScripts() {
+		if !isCustomScriptName(name) {
+			return fmt.Errorf("unknown custom script %q, expected one of: %s", name, strings.Join(CustomScriptNames, ", "))
+		}
+	}
+	return nil
+}
+
+func customScriptChange(had, has, same bool) string {
+	switch {
+	case had && !has:
+		return CustomScriptRemoved
+	case !had && has:
+		return CustomScriptAdded
+	case had && has && !same:
+		return CustomScriptModified
+	default:
+		return ""
+	}
+}
+
+// diffLines splits a script into lines for diffing, each ending in a newline.
+func diffLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if last := len(lines) - 1; lines[last] == "" {
+		lines = lines[:last]
+	} else {
+		lines[last] += "\n"
+	}
+	return lines
+}
+
+// unifiedDiff returns a unified diff of two lists of lines, each ending with
+// a newline, with three lines of context. It is empty when they are equal.
+func unifiedDiff(fromFile, toFile string, a, b []string) string {
+	const context = 3
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and
+	// b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Walk the common subsequence, listing removed lines before added ones
+	// between two common lines.
+	var lines, removed, added []string
+	flush := func() {
+		lines = append(append(lines, removed...), added...)
+		removed, added = nil, nil
+	}
+	for i, j := 0, 0; i < len(a) || j < len(b); {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			lines = append(lines, " "+a[i])
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			removed = append(removed, "-"+a[i])
+			i++
+		default:
+			added = append(added, "+"+b[j])
+			j++
+		}
+	}
+	flush()
+
+	var diff strings.Builder
+	fromLine, toLine := 0, 0
+	for start := 0; start < len(lines); {
+		first := start
+		for first < len(lines) && lines[first][0] == ' ' {
+			first++
+		}
+		if first == len(lines) {
+			break
+		}
+		last := first
+		for k := first; k < len(lines) && k-last <= 2*context+1; k++ {
+			if lines[k][0] != ' ' {
+				last = k
+			}
+		}
+
+		from, to := first-context, last+context+1
+		if from < start {
+			from = start
+		}
+		if to > len(lines) {
+			to = len(lines)
+		}
+		fromLine += from - start
+		toLine += from - start
+
+		fromCount, toCount := 0, 0
+		for _, line := range lines[from:to] {
+			if line[0] != '+' {
+				fromCount++
+			}
+			if line[0] != '-' {
+				toCount++
+			}
+		}
+		if diff.Len() == 0 {
+			fmt.Fprintf(&diff, "--- %s\n+++ %s\n", fromFile, toFile)
+		}
+		fmt.Fprintf(&diff, "@@ -%s +%s @@\n", unifiedDiffRange(fromLine, fromCount), unifiedDiffRange(toLine, toCount))
+		for _, line := range lines[from:to] {
+			diff.WriteString(line)
+		}
+
+		fromLine += fromCount
+		toLine += toCount
+		start = to
+	}
+	return diff.String()
+}
+
+// unifiedDiffRange formats the range of a hunk, given the number of lines
+// before it.
+func unifiedDiffRange(before, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprint(before + 1)
+	default:
+		return fmt.Sprintf("%d,%d", before+1, count)
+	}
+}
+
+func isCustomScriptName(name string) bool {
+	for _, n := range CustomScriptNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// customScriptsOptions returns the options of a database connection, or an
+// error if the connection isn't one.
+func customScriptsOptions(c *Connection) (*ConnectionOptions, error) {
+	options, ok := c.Options.(*ConnectionOptions)
+	if c.GetStrategy() != ConnectionStrategyAuthok || !ok {
+		return nil, &managementError{400, "Bad Request", fmt.Sprintf("Connection %q is not a database connection", c.GetID())}
+	}
+	return options, nil
+}
+
+// CustomScripts reads the custom scripts and configuration of a database
+// connection.
+func (m *ConnectionManager) CustomScripts(id string, opts ...RequestOption) (*CustomScripts, error) {
+	c, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	options, err := customScriptsOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	return &CustomScripts{
+		Scripts:       options.CustomScripts,
+		Configuration: options.Configuration,
+	}, nil
+}
+
+// DiffCustomScripts compares the custom scripts in a directory with those of
+// a database connection, without changing anything.
+func (m *ConnectionManager) DiffCustomScripts(id, dir string, opts ...RequestOption) ([]*CustomScriptChange, error) {
+	local, err := ReadCustomScripts(dir)
+	if err != nil {
+		return nil, err
+	}
+	remote, err := m.CustomScripts(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return local.Changes(remote), nil
+}
+
+// SyncCustomScripts updates the custom scripts and configuration of a
+// database connection to match a directory, and returns the changes made. If
+// nothing changed no update is made. The query parameters set by opts only
+// apply to reading the connection.
+func (m *ConnectionManager) SyncCustomScripts(id, dir string, opts ...RequestOption) ([]*CustomScriptChange, error) {
+	local, err := ReadCustomScripts(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+	after := before.Clone()
+	options, err := customScriptsOptions(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := local.Changes(&CustomScripts{Scripts: options.CustomScripts, Configuration: options.Configuration})
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	local.Apply(options)
+	if err := m.UpdateChanged(id, before, after, withoutQuery(opts)); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// ExportCustomScripts writes the custom scripts and configuration of a
+// database connection to a directory.
+func (m *ConnectionManager) ExportCustomScripts(id, dir string, opts ...RequestOption) error {
+	s, err := m.CustomScripts(id, opts...)
+	if err != nil {
+		return err
+	}
+	return s.Write(dir)
+}
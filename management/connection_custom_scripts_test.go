@@ -0,0 +1,197 @@
+package management
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCustomScriptsDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestReadCustomScripts(t *testing.T) {
+	dir := writeCustomScriptsDir(t, map[string]string{
+		"login.js":          "function login() {}\n",
+		"get_user.js":       "function getUser() {}\n",
+		"README.md":         "# Scripts\n",
+		"configuration.env": "API_URL=https://api.example.com\nAPI_KEY=\"secret\"\n",
+	})
+
+	s, err := ReadCustomScripts(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"login":    "function login() {}\n",
+		"get_user": "function getUser() {}\n",
+	}, s.GetScripts())
+	assert.Equal(t, map[string]string{
+		"API_URL": "https://api.example.com",
+		"API_KEY": "secret",
+	}, s.GetConfiguration())
+
+	t.Run("Rejects unknown scripts", func(t *testing.T) {
+		dir := writeCustomScriptsDir(t, map[string]string{"signup.js": ""})
+		_, err := ReadCustomScripts(dir)
+		assert.EqualError(t, err, `unknown custom script "signup.js", expected one of: login, get_user, create, verify, change_password, delete`)
+	})
+
+	t.Run("Leaves the configuration out when there is no file", func(t *testing.T) {
+		dir := writeCustomScriptsDir(t, map[string]string{"login.js": ""})
+		s, err := ReadCustomScripts(dir)
+		require.NoError(t, err)
+		assert.Nil(t, s.Configuration)
+	})
+}
+
+func TestCustomScripts_Write(t *testing.T) {
+	dir := writeCustomScriptsDir(t, map[string]string{
+		"delete.js": "function remove() {}\n",
+		"notes.txt": "keep me",
+	})
+
+	s := &CustomScripts{
+		Scripts:       &map[string]string{"login": "function login() {}\n"},
+		Configuration: &map[string]string{"API_KEY": "secret value"},
+	}
+	require.NoError(t, s.Write(dir))
+
+	read, err := ReadCustomScripts(dir)
+	require.NoError(t, err)
+	assert.Equal(t, s, read)
+	assert.FileExists(t, filepath.Join(dir, "notes.txt"))
+	assert.NoFileExists(t, filepath.Join(dir, "delete.js"))
+
+	info, err := os.Stat(filepath.Join(dir, CustomScriptsConfigurationFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	err = (&CustomScripts{Scripts: &map[string]string{"signup": ""}}).Write(dir)
+	assert.Error(t, err)
+}
+
+func TestCustomScripts_Changes(t *testing.T) {
+	remote := &CustomScripts{
+		Scripts: &map[string]string{
+			"login":  "function login() {\n  return 1;\n}\n",
+			"delete": "function remove() {}\n",
+		},
+		Configuration: &map[string]string{"API_KEY": "old", "UNUSED": "x"},
+	}
+	local := &CustomScripts{
+		Scripts: &map[string]string{
+			"login":  "function login() {\n  return 2;\n}\n",
+			"create": "function create() {}\n",
+		},
+		Configuration: &map[string]string{"API_KEY": "new", "API_URL": "https://api.example.com"},
+	}
+
+	var changes []string
+	for _, change := range local.Changes(remote) {
+		changes = append(changes, change.GetName()+" "+change.GetChange())
+	}
+	assert.Equal(t, []string{
+		"login modified",
+		"create added",
+		"delete removed",
+		"configuration.API_KEY modified",
+		"configuration.API_URL added",
+		"configuration.UNUSED removed",
+	}, changes)
+
+	assert.Equal(t, `--- a/login.js
++++ b/login.js
+@@ -1,3 +1,3 @@
+ function login() {
+-  return 1;
++  return 2;
+ }
+`, local.Changes(remote)[0].GetUnifiedDiff())
+
+	local.Configuration = nil
+	assert.Len(t, local.Changes(remote), 3)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	lines := func(s string) []string {
+		return diffLines(strings.ReplaceAll(s, " ", "\n"))
+	}
+
+	assert.Empty(t, unifiedDiff("a", "b", lines("1 2"), lines("1 2")))
+	assert.Equal(t, "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+1\n+2\n", unifiedDiff("a", "b", nil, lines("1 2")))
+	assert.Equal(t, "--- a\n+++ b\n@@ -1 +0,0 @@\n-1\n", unifiedDiff("a", "b", lines("1"), nil))
+	assert.Equal(t, `--- a
++++ b
+@@ -1,4 +1,4 @@
+-1
++one
+ 2
+ 3
+ 4
+@@ -9,4 +9,4 @@
+ 9
+ 10
+ 11
+-12
++twelve
+`, unifiedDiff("a", "b", lines("1 2 3 4 5 6 7 8 9 10 11 12"), lines("one 2 3 4 5 6 7 8 9 10 11 twelve")))
+}
+
+func TestConnectionManager_SyncCustomScripts(t *testing.T) {
+	api, m := startFakeAPI(t)
+	api.put("/connections/con_1", map[string]interface{}{
+		"id":       "con_1",
+		"name":     "db",
+		"strategy": "authok",
+		"options": map[string]interface{}{
+			"brute_force_protection": true,
+			"customScripts":          map[string]interface{}{"login": "old"},
+			"configuration":          map[string]interface{}{"API_KEY": "secret"},
+		},
+	})
+
+	dir := writeCustomScriptsDir(t, map[string]string{
+		"login.js":    "new",
+		"get_user.js": "function getUser() {}",
+	})
+
+	changes, err := m.Connection.DiffCustomScripts("con_1", dir)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, []string{"GET /connections/con_1"}, api.requests())
+
+	changes, err = m.Connection.SyncCustomScripts("con_1", dir, IncludeFields("id", "options"))
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "id,options", api.queries("GET /connections/con_1")[1].Get("fields"))
+	require.Len(t, api.queries("PATCH /connections/con_1"), 1)
+	assert.Empty(t, api.queries("PATCH /connections/con_1")[0])
+	assert.Equal(t, []map[string]interface{}{{
+		"options": map[string]interface{}{
+			"brute_force_protection": true,
+			"customScripts":          map[string]interface{}{"login": "new", "get_user": "function getUser() {}"},
+			"configuration":          map[string]interface{}{"API_KEY": "secret"},
+		},
+	}}, api.bodies("PATCH /connections/con_1"))
+
+	changes, err = m.Connection.SyncCustomScripts("con_1", dir)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Len(t, api.bodies("PATCH /connections/con_1"), 1)
+
+	export := t.TempDir()
+	require.NoError(t, m.Connection.ExportCustomScripts("con_1", export))
+	exported, err := ReadCustomScripts(export)
+	require.NoError(t, err)
+	assert.Equal(t, "new", exported.GetScripts()["login"])
+	assert.Equal(t, map[string]string{"API_KEY": "secret"}, exported.GetConfiguration())
+}
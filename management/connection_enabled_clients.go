@@ -0,0 +1,187 @@
+package management
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// enabledClientsMaxAttempts is how many times the enabled clients of a
+// connection are read, changed and written before giving up on concurrent
+// writers.
+const enabledClientsMaxAttempts = 5
+
+// EnabledClientsChange is a change to the clients enabled on a connection, as
+// applied in bulk by ConnectionManager.UpdateEnabledClients.
+type EnabledClientsChange struct {
+	ConnectionID *string `json:"connection_id,omitempty"`
+
+	// The ids of the clients to enable and disable.
+	Enable  *[]string `json:"enable,omitempty"`
+	Disable *[]string `json:"disable,omitempty"`
+
+	// Why the change could not be applied, if it couldn't.
+	Error *string `json:"error,omitempty"`
+}
+
+// EnableClients enables clients on a connection, leaving the other enabled
+// clients as they are.
+//
+// Only the enabled clients are written, and they are read back afterwards to
+// verify the change was not overwritten by a concurrent writer. If it was, or
+// if the update conflicts, the change is retried from a fresh read.
+//
+// Use UpdateEnabledClients to pass request options.
+func (m *ConnectionManager) EnableClients(id string, clientIDs ...string) error {
+	return m.updateEnabledClients(id, clientIDs, nil, nil)
+}
+
+// DisableClients disables clients on a connection, leaving the other enabled
+// clients as they are. Concurrent writers are handled as in EnableClients.
+//
+// Use UpdateEnabledClients to pass request options.
+func (m *ConnectionManager) DisableClients(id string, clientIDs ...string) error {
+	return m.updateEnabledClients(id, nil, clientIDs, nil)
+}
+
+// UpdateEnabledClients applies changes to the clients enabled on many
+// connections, as EnableClients and DisableClients do. A failed change does
+// not stop the others; its Error is set and an error is returned once all
+// changes have been tried.
+//
+// The query parameters set by opts only apply to reading the connections.
+func (m *ConnectionManager) UpdateEnabledClients(changes []*EnabledClientsChange, opts ...RequestOption) error {
+	failed := 0
+	for _, change := range changes {
+		change.Error = nil
+		err := m.updateEnabledClients(change.GetConnectionID(), change.GetEnable(), change.GetDisable(), opts)
+		if err != nil {
+			failed++
+			message := err.Error()
+			change.Error = &message
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to update the enabled clients of %d of %d connections", failed, len(changes))
+	}
+	return nil
+}
+
+func (m *ConnectionManager) updateEnabledClients(id string, enable, disable []string, opts []RequestOption) error {
+	for attempt := 1; ; attempt++ {
+		c, err := m.Read(id, opts...)
+		if err != nil {
+			return err
+		}
+
+		enabledClients, changed := changeEnabledClients(c.GetEnabledClients(), enable, disable)
+		if !changed {
+			return nil
+		}
+
+		err = m.Update(id, &Connection{EnabledClients: &enabledClients}, withoutQuery(opts))
+		if err != nil {
+			if mErr, ok := err.(Error); ok && mErr.Status() == http.StatusConflict && attempt < enabledClientsMaxAttempts {
+				continue
+			}
+			return err
+		}
+
+		verified, err := m.Read(id, opts...)
+		if err != nil {
+			return err
+		}
+		if _, changed := changeEnabledClients(verified.GetEnabledClients(), enable, disable); !changed {
+			return nil
+		}
+		if attempt >= enabledClientsMaxAttempts {
+			return &managementError{
+				StatusCode: http.StatusConflict,
+				Err:        http.StatusText(http.StatusConflict),
+				Message:    fmt.Sprintf("enabled clients of connection %q were modified concurrently", id),
+			}
+		}
+	}
+}
+
+// changeEnabledClients returns the enabled clients with the given clients
+// enabled and disabled, and whether that changes anything. The order of the
+// clients already enabled is kept.
+func changeEnabledClients(enabledClients, enable, disable []string) ([]string, bool) {
+	disabled := map[string]bool{}
+	for _, id := range disable {
+		disabled[id] = true
+	}
+
+	changed := false
+	seen := map[string]bool{}
+	result := []string{}
+	for _, id := range enabledClients {
+		if disabled[id] {
+			changed = true
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	for _, id := range enable {
+		if seen[id] || disabled[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+		changed = true
+	}
+	return result, changed
+}
+
+// ClientsForConnection returns the clients enabled on a connection.
+func (m *ConnectionManager) ClientsForConnection(id string, opts ...RequestOption) ([]*Client, error) {
+	c, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := map[string]bool{}
+	for _, clientID := range c.GetEnabledClients() {
+		enabled[clientID] = true
+	}
+
+	var clients []*Client
+	for page := 0; len(enabled) > 0; page++ {
+		l, err := m.Management.Client.List(withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, client := range l.Clients {
+			if enabled[client.GetClientID()] {
+				clients = append(clients, client)
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+	}
+	return clients, nil
+}
+
+// ConnectionsForClient returns the connections a client is enabled on.
+func (m *ConnectionManager) ConnectionsForClient(clientID string, opts ...RequestOption) ([]*Connection, error) {
+	var connections []*Connection
+	for page := 0; ; page++ {
+		l, err := m.List(withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range l.Connections {
+			for _, id := range c.GetEnabledClients() {
+				if id == clientID {
+					connections = append(connections, c)
+					break
+				}
+			}
+		}
+		if !l.HasNext() {
+			return connections, nil
+		}
+	}
+}
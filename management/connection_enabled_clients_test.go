@@ -0,0 +1,116 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenEnabledClients stores the clients app_1, app_2 and app_3, and the
+// connections con_1 with app_1 enabled and con_2 with app_1 and app_2.
+func givenEnabledClients(api *fakeAPI) {
+	for _, id := range []string{"app_1", "app_2", "app_3"} {
+		api.put("/clients/"+id, &Client{ClientID: authok.String(id)})
+	}
+	api.put("/connections/con_1", &Connection{ID: authok.String("con_1"), Strategy: authok.String("authok"), EnabledClients: &[]string{"app_1"}})
+	api.put("/connections/con_2", &Connection{ID: authok.String("con_2"), Strategy: authok.String("authok"), EnabledClients: &[]string{"app_1", "app_2"}})
+}
+
+// loseEnabledClientsWrites acknowledges the given number of updates of
+// connections without applying them, as if a concurrent writer overwrote
+// them.
+func loseEnabledClientsWrites(api *fakeAPI, times int) {
+	api.handle("PATCH /connections/*", func(w http.ResponseWriter, r *http.Request) {
+		if times == 0 {
+			api.serve(w, r)
+			return
+		}
+		times--
+		writeFakeAPIJSON(w, http.StatusOK, api.get(r.URL.Path))
+	})
+}
+
+func enabledClientsOf(api *fakeAPI, id string) []string {
+	return fakeAPIResourceAs[Connection](api, "/connections/"+id).GetEnabledClients()
+}
+
+func TestConnectionManager_EnableClients(t *testing.T) {
+	t.Run("Enables and disables clients", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenEnabledClients(api)
+
+		require.NoError(t, m.Connection.EnableClients("con_1", "app_2", "app_1"))
+		assert.Equal(t, []string{"app_1", "app_2"}, enabledClientsOf(api, "con_1"))
+
+		require.NoError(t, m.Connection.DisableClients("con_1", "app_1", "app_3"))
+		assert.Equal(t, []string{"app_2"}, enabledClientsOf(api, "con_1"))
+		assert.Len(t, api.queries("PATCH /connections/con_1"), 2)
+
+		require.NoError(t, m.Connection.EnableClients("con_1", "app_2"))
+		assert.Len(t, api.queries("PATCH /connections/con_1"), 2)
+	})
+
+	t.Run("Retries writes that were overwritten or conflicted", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenEnabledClients(api)
+		loseEnabledClientsWrites(api, 1)
+		api.fail("PATCH /connections/*", http.StatusConflict, 1)
+
+		require.NoError(t, m.Connection.EnableClients("con_1", "app_3"))
+		assert.Equal(t, []string{"app_1", "app_3"}, enabledClientsOf(api, "con_1"))
+		assert.Len(t, api.queries("PATCH /connections/con_1"), 3)
+	})
+
+	t.Run("Gives up after too many attempts", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenEnabledClients(api)
+		loseEnabledClientsWrites(api, enabledClientsMaxAttempts)
+
+		err := m.Connection.EnableClients("con_1", "app_3")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusConflict, err.(Error).Status())
+	})
+
+	t.Run("Applies bulk changes", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenEnabledClients(api)
+		api.fail("PATCH /connections/con_1", http.StatusConflict, 0)
+
+		changes := []*EnabledClientsChange{
+			{ConnectionID: authok.String("con_1"), Enable: &[]string{"app_3"}},
+			{ConnectionID: authok.String("con_2"), Enable: &[]string{"app_3"}, Disable: &[]string{"app_1"}},
+		}
+		err := m.Connection.UpdateEnabledClients(changes, IncludeFields("id", "enabled_clients"))
+		assert.EqualError(t, err, "failed to update the enabled clients of 1 of 2 connections")
+		assert.NotEmpty(t, changes[0].GetError())
+		assert.Empty(t, changes[1].GetError())
+		assert.Equal(t, []string{"app_1"}, enabledClientsOf(api, "con_1"))
+		assert.Equal(t, []string{"app_2", "app_3"}, enabledClientsOf(api, "con_2"))
+
+		assert.Equal(t, "id,enabled_clients", api.queries("GET /connections/con_2")[0].Get("fields"))
+		assert.Empty(t, api.queries("PATCH /connections/con_2")[0])
+	})
+}
+
+func TestConnectionManager_ClientsForConnection(t *testing.T) {
+	api, m := startFakeAPI(t)
+	givenEnabledClients(api)
+	api.pageSize = 1
+
+	clients, err := m.Connection.ClientsForConnection("con_2")
+	require.NoError(t, err)
+	var ids []string
+	for _, c := range clients {
+		ids = append(ids, c.GetClientID())
+	}
+	assert.Equal(t, []string{"app_1", "app_2"}, ids)
+
+	connections, err := m.Connection.ConnectionsForClient("app_2")
+	require.NoError(t, err)
+	require.Len(t, connections, 1)
+	assert.Equal(t, "con_2", connections[0].GetID())
+}
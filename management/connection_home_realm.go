@@ -0,0 +1,272 @@
+package management
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// HomeRealmMatch is a connection an email address belongs to.
+type HomeRealmMatch struct {
+	Connection *Connection `json:"connection,omitempty"`
+
+	// The realm or domain alias of the connection that matched, for example
+	// "example.com" or "*.example.com".
+	Domain *string `json:"domain,omitempty"`
+
+	// True if the connection is enabled for the organization the discovery
+	// was set up for.
+	Organization *bool `json:"organization,omitempty"`
+}
+
+// HomeRealmDiscovery finds the enterprise connection an email address belongs
+// to, from the realms and domain aliases of the connections of the tenant.
+//
+// The connections are indexed when the HomeRealmDiscovery is created, and can
+// be refreshed on demand with Refresh or periodically with Start.
+type HomeRealmDiscovery struct {
+	m            *Management
+	organization string
+	opts         []RequestOption
+
+	mu    sync.RWMutex
+	index []*homeRealmEntry
+	err   error
+}
+
+type homeRealmEntry struct {
+	pattern      string
+	wildcard     bool
+	connection   *Connection
+	organization bool
+}
+
+// HomeRealmDiscovery indexes the connections of the tenant for home realm
+// discovery. When an organization id is given, the connections enabled for
+// that organization take priority over the others.
+func (m *ConnectionManager) HomeRealmDiscovery(organization string, opts ...RequestOption) (*HomeRealmDiscovery, error) {
+	d := &HomeRealmDiscovery{
+		m:            m.Management,
+		organization: organization,
+		opts:         opts,
+	}
+	if err := d.Refresh(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Refresh rebuilds the index from the connections of the tenant. On failure
+// the previous index is kept.
+func (d *HomeRealmDiscovery) Refresh() error {
+	index, err := d.build()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.err = err
+	if err == nil {
+		d.index = index
+	}
+	return err
+}
+
+// Start refreshes the index every interval in the background, until the
+// context is done. Failed refreshes are reported by Err.
+func (d *HomeRealmDiscovery) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_ = d.Refresh()
+			}
+		}
+	}()
+}
+
+// Err returns the error of the last refresh, if it failed.
+func (d *HomeRealmDiscovery) Err() error {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.err
+}
+
+// Discover returns the connection the email address belongs to, or nil if
+// none matches.
+//
+// Exact matches on a realm or domain alias take priority over subdomains, so
+// "eu.example.com" falls back to a connection for "example.com" unless a
+// connection is set up for it. Wildcards such as "*.example.com" only match
+// subdomains, and are used last. Connections enabled for the organization
+// take priority over all others.
+func (d *HomeRealmDiscovery) Discover(email string) *HomeRealmMatch {
+	matches := d.Matches(email)
+	if len(matches) == 0 {
+		return nil
+	}
+	return matches[0]
+}
+
+// Matches returns every connection the email address belongs to, the best
+// match first.
+func (d *HomeRealmDiscovery) Matches(email string) []*HomeRealmMatch {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return nil
+	}
+	domain := normalizeDomain(email[at+1:])
+	if domain == "" {
+		return nil
+	}
+
+	d.mu.RLock()
+	index := d.index
+	d.mu.RUnlock()
+
+	type candidate struct {
+		entry *homeRealmEntry
+		rank  int
+	}
+	var candidates []candidate
+	seen := map[string]bool{}
+	for _, entry := range index {
+		rank, ok := entry.match(domain)
+		if !ok {
+			continue
+		}
+		candidates = append(candidates, candidate{entry, rank})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.entry.organization != b.entry.organization {
+			return a.entry.organization
+		}
+		if a.rank != b.rank {
+			return a.rank > b.rank
+		}
+		if a.entry.connection.GetIsDomainConnection() != b.entry.connection.GetIsDomainConnection() {
+			return a.entry.connection.GetIsDomainConnection()
+		}
+		return a.entry.connection.GetName() < b.entry.connection.GetName()
+	})
+
+	var matches []*HomeRealmMatch
+	for _, c := range candidates {
+		if seen[c.entry.connection.GetID()] {
+			continue
+		}
+		seen[c.entry.connection.GetID()] = true
+		pattern := c.entry.pattern
+		if c.entry.wildcard {
+			pattern = "*." + pattern
+		}
+		matches = append(matches, &HomeRealmMatch{
+			Connection:   c.entry.connection,
+			Domain:       authok.String(pattern),
+			Organization: authok.Bool(c.entry.organization),
+		})
+	}
+	return matches
+}
+
+// match reports whether the domain matches the entry, and how specific the
+// match is. Exact matches rank above subdomain matches, which rank above
+// wildcards; longer patterns rank above shorter ones.
+func (e *homeRealmEntry) match(domain string) (int, bool) {
+	switch {
+	case !e.wildcard && domain == e.pattern:
+		return 2 << 16, true
+	case strings.HasSuffix(domain, "."+e.pattern):
+		if e.wildcard {
+			return len(e.pattern), true
+		}
+		return 1<<16 + len(e.pattern), true
+	default:
+		return 0, false
+	}
+}
+
+func (d *HomeRealmDiscovery) build() ([]*homeRealmEntry, error) {
+	enabled := map[string]bool{}
+	if d.organization != "" {
+		for page := 0; ; page++ {
+			l, err := d.m.Organization.Connections(d.organization, withOptions(d.opts, Page(page))...)
+			if err != nil {
+				return nil, err
+			}
+			for _, c := range l.OrganizationConnections {
+				enabled[c.GetConnectionID()] = true
+			}
+			if !l.HasNext() {
+				break
+			}
+		}
+	}
+
+	var index []*homeRealmEntry
+	for page := 0; ; page++ {
+		l, err := d.m.Connection.List(withOptions(d.opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range l.Connections {
+			for _, domain := range homeRealmDomains(c) {
+				entry := &homeRealmEntry{
+					pattern:      domain,
+					connection:   c,
+					organization: enabled[c.GetID()],
+				}
+				if strings.HasPrefix(domain, "*.") {
+					entry.pattern = domain[2:]
+					entry.wildcard = true
+				}
+				index = append(index, entry)
+			}
+		}
+		if !l.HasNext() {
+			break
+		}
+	}
+	return index, nil
+}
+
+// homeRealmDomains returns the normalized realms, tenant domain and domain
+// aliases of a connection. Realms which are not domain names, such as the
+// name of a database connection, are left out.
+func homeRealmDomains(c *Connection) []string {
+	var domains []string
+	for _, realm := range c.GetRealms() {
+		if strings.Contains(realm, ".") {
+			domains = append(domains, realm)
+		}
+	}
+	if options, ok := c.Options.(interface{ GetTenantDomain() string }); ok && options.GetTenantDomain() != "" {
+		domains = append(domains, options.GetTenantDomain())
+	}
+	if options, ok := c.Options.(interface{ GetDomainAliases() []string }); ok {
+		domains = append(domains, options.GetDomainAliases()...)
+	}
+
+	var normalized []string
+	seen := map[string]bool{}
+	for _, domain := range domains {
+		domain = normalizeDomain(domain)
+		if domain == "" || domain == "*." || seen[domain] {
+			continue
+		}
+		seen[domain] = true
+		normalized = append(normalized, domain)
+	}
+	return normalized
+}
+
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+}
@@ -0,0 +1,120 @@
+package management
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// givenHomeRealmConnections stores the connections used to discover home
+// realms, along with the organization org_1 which has con_org enabled.
+func givenHomeRealmConnections(api *fakeAPI) {
+	for _, connection := range homeRealmTestConnections() {
+		api.put("/connections/"+connection["id"].(string), connection)
+	}
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	api.put("/organizations/org_1/enabled_connections/con_org", map[string]interface{}{"connection_id": "con_org"})
+}
+
+func homeRealmTestConnections() []map[string]interface{} {
+	return []map[string]interface{}{
+		{
+			"id":       "con_db",
+			"name":     "Username-Password",
+			"strategy": "authok",
+			"realms":   []string{"Username-Password"},
+		},
+		{
+			"id":       "con_saml",
+			"name":     "acme-saml",
+			"strategy": "samlp",
+			"options":  map[string]interface{}{"domain_aliases": []string{"Acme.com", "*.acme.io"}},
+		},
+		{
+			"id":       "con_waad",
+			"name":     "acme-eu",
+			"strategy": "waad",
+			"options":  map[string]interface{}{"tenant_domain": "eu.acme.com"},
+		},
+		{
+			"id":                   "con_google",
+			"name":                 "acme-google",
+			"strategy":             "google-apps",
+			"is_domain_connection": true,
+			"realms":               []string{"acme.io"},
+		},
+		{
+			"id":       "con_org",
+			"name":     "acme-okta",
+			"strategy": "okta",
+			"options":  map[string]interface{}{"domain_aliases": []string{"acme.com"}},
+		},
+	}
+}
+
+func TestConnectionManager_HomeRealmDiscovery(t *testing.T) {
+	api, m := startFakeAPI(t)
+	givenHomeRealmConnections(api)
+
+	t.Run("Matches exact domains, subdomains and wildcards", func(t *testing.T) {
+		d, err := m.Connection.HomeRealmDiscovery("")
+		require.NoError(t, err)
+
+		for email, expected := range map[string]string{
+			"jane@ACME.com":        "acme-okta",
+			"jane@eu.acme.com":     "acme-eu",
+			"jane@dev.eu.acme.com": "acme-eu",
+			"jane@us.acme.com":     "acme-okta",
+			"jane@acme.io":         "acme-google",
+			"jane@dev.acme.io":     "acme-google",
+		} {
+			match := d.Discover(email)
+			if assert.NotNil(t, match, email) {
+				assert.Equal(t, expected, match.Connection.GetName(), email)
+			}
+		}
+
+		assert.Nil(t, d.Discover("jane@example.com"))
+		assert.Nil(t, d.Discover("not an email"))
+
+		matches := d.Matches("jane@dev.acme.io")
+		require.Len(t, matches, 2)
+		assert.Equal(t, "acme.io", matches[0].GetDomain())
+		assert.Equal(t, "*.acme.io", matches[1].GetDomain())
+	})
+
+	t.Run("Prefers connections enabled for the organization", func(t *testing.T) {
+		d, err := m.Connection.HomeRealmDiscovery("org_1")
+		require.NoError(t, err)
+
+		match := d.Discover("jane@eu.acme.com")
+		require.NotNil(t, match)
+		assert.Equal(t, "acme-okta", match.Connection.GetName())
+		assert.True(t, match.GetOrganization())
+	})
+
+	t.Run("Refreshes in the background", func(t *testing.T) {
+		d, err := m.Connection.HomeRealmDiscovery("")
+		require.NoError(t, err)
+		assert.Nil(t, d.Discover("jane@example.com"))
+
+		api.put("/connections/con_example", map[string]interface{}{
+			"id":       "con_example",
+			"name":     "example",
+			"strategy": "adfs",
+			"options":  map[string]interface{}{"domain_aliases": []string{"example.com"}},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		d.Start(ctx, time.Millisecond)
+
+		assert.Eventually(t, func() bool {
+			return d.Discover("jane@example.com") != nil
+		}, time.Second, time.Millisecond)
+		assert.NoError(t, d.Err())
+	})
+}
@@ -0,0 +1,235 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+// OIDCDiscoveryDocument holds the OpenID Provider metadata published by an
+// identity provider at its discovery endpoint.
+//
+// See: https://openid.net/specs/openid-connect-discovery-1_0.html#ProviderMetadata
+type OIDCDiscoveryDocument struct {
+	// The URL of the discovery document, as it was fetched.
+	DiscoveryURL *string `json:"-"`
+
+	Issuer                           *string   `json:"issuer,omitempty"`
+	AuthorizationEndpoint            *string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint                    *string   `json:"token_endpoint,omitempty"`
+	UserInfoEndpoint                 *string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                          *string   `json:"jwks_uri,omitempty"`
+	EndSessionEndpoint               *string   `json:"end_session_endpoint,omitempty"`
+	ResponseTypesSupported           *[]string `json:"response_types_supported,omitempty"`
+	ScopesSupported                  *[]string `json:"scopes_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported *[]string `json:"id_token_signing_alg_values_supported,omitempty"`
+}
+
+// DiscoverOIDC fetches and validates the discovery document of the given
+// issuer using httpClient, or http.DefaultClient if nil. The issuer can also be
+// given as the full URL of the discovery document.
+//
+// An error is returned if the document can't be fetched or lacks any of the
+// endpoints needed to configure a connection. Problems that don't prevent the
+// connection from working, such as an issuer that doesn't match the one that
+// was asked for, are returned as warnings.
+func DiscoverOIDC(ctx context.Context, httpClient *http.Client, issuer string) (*OIDCDiscoveryDocument, []string, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	discoveryURL := oidcDiscoveryURL(issuer)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create the discovery request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch the discovery document: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to fetch the discovery document from %s: %s", discoveryURL, response.Status)
+	}
+
+	var document OIDCDiscoveryDocument
+	if err := json.NewDecoder(response.Body).Decode(&document); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode the discovery document: %w", err)
+	}
+	document.DiscoveryURL = &discoveryURL
+
+	warnings, err := document.validate(strings.TrimSuffix(issuer, oidcDiscoveryPath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &document, warnings, nil
+}
+
+func oidcDiscoveryURL(issuer string) string {
+	if strings.HasSuffix(issuer, oidcDiscoveryPath) {
+		return issuer
+	}
+	return strings.TrimSuffix(issuer, "/") + oidcDiscoveryPath
+}
+
+// validate checks that the document holds everything a connection needs and
+// returns warnings about anything that looks off.
+func (d *OIDCDiscoveryDocument) validate(issuer string) ([]string, error) {
+	required := map[string]string{
+		"issuer":                 d.GetIssuer(),
+		"authorization_endpoint": d.GetAuthorizationEndpoint(),
+		"jwks_uri":               d.GetJWKSURI(),
+	}
+	for _, field := range []string{"issuer", "authorization_endpoint", "jwks_uri"} {
+		value := required[field]
+		if value == "" {
+			return nil, fmt.Errorf("the discovery document is missing the %s", field)
+		}
+		if u, err := url.Parse(value); err != nil || !u.IsAbs() {
+			return nil, fmt.Errorf("the discovery document has an invalid %s: %q", field, value)
+		}
+	}
+
+	var warnings []string
+
+	if strings.TrimSuffix(d.GetIssuer(), "/") != strings.TrimSuffix(issuer, "/") {
+		warnings = append(warnings, fmt.Sprintf(
+			"the issuer %q in the discovery document does not match the expected issuer %q",
+			d.GetIssuer(),
+			issuer,
+		))
+	}
+
+	if d.GetTokenEndpoint() == "" {
+		warnings = append(warnings, "the discovery document has no token_endpoint, only the front channel flow can be used")
+	}
+
+	if d.GetUserInfoEndpoint() == "" {
+		warnings = append(warnings, "the discovery document has no userinfo_endpoint")
+	}
+
+	if responseTypes := d.GetResponseTypesSupported(); len(responseTypes) > 0 {
+		supported := false
+		for _, responseType := range responseTypes {
+			if responseType == "code" || responseType == "id_token" {
+				supported = true
+			}
+		}
+		if !supported {
+			warnings = append(warnings, fmt.Sprintf(
+				"none of the supported response types %q can be used, either \"code\" or \"id_token\" is required",
+				responseTypes,
+			))
+		}
+	}
+
+	for _, endpoint := range []string{d.GetAuthorizationEndpoint(), d.GetTokenEndpoint(), d.GetUserInfoEndpoint(), d.GetJWKSURI()} {
+		if endpoint != "" && !strings.HasPrefix(endpoint, "https://") {
+			warnings = append(warnings, fmt.Sprintf("the endpoint %q does not use https", endpoint))
+		}
+	}
+
+	return warnings, nil
+}
+
+// ApplyDiscovery sets the issuer and endpoints of the connection from the
+// given discovery document. Endpoints the document does not provide, such as
+// the optional token and userinfo endpoints, and other options are left
+// untouched.
+func (c *ConnectionOptionsOIDC) ApplyDiscovery(d *OIDCDiscoveryDocument) {
+	setIfPresent(&c.DiscoveryURL, d.DiscoveryURL)
+	setIfPresent(&c.Issuer, d.Issuer)
+	setIfPresent(&c.AuthorizationEndpoint, d.AuthorizationEndpoint)
+	setIfPresent(&c.TokenEndpoint, d.TokenEndpoint)
+	setIfPresent(&c.UserInfoEndpoint, d.UserInfoEndpoint)
+	setIfPresent(&c.JWKSURI, d.JWKSURI)
+}
+
+// ApplyDiscovery sets the issuer and endpoints of the connection from the
+// given discovery document. Endpoints the document does not provide and
+// other options are left untouched.
+func (c *ConnectionOptionsOkta) ApplyDiscovery(d *OIDCDiscoveryDocument) {
+	setIfPresent(&c.Issuer, d.Issuer)
+	setIfPresent(&c.AuthorizationEndpoint, d.AuthorizationEndpoint)
+	setIfPresent(&c.TokenEndpoint, d.TokenEndpoint)
+	setIfPresent(&c.UserInfoEndpoint, d.UserInfoEndpoint)
+	setIfPresent(&c.JWKSURI, d.JWKSURI)
+}
+
+func setIfPresent(dst **string, value *string) {
+	if value != nil {
+		*dst = value
+	}
+}
+
+// RefreshOIDCEndpoints fetches the discovery document of an oidc or okta
+// connection and updates the connection's issuer and endpoints to match it.
+//
+// The discovery document is fetched from the connection's discovery URL or
+// issuer, or for okta connections, from its domain. Only the options are
+// updated, and only if something changed. The query parameters set by opts
+// only apply to reading the connection. Any warnings raised while validating
+// the discovery document are returned.
+func (m *ConnectionManager) RefreshOIDCEndpoints(id string, httpClient *http.Client, opts ...RequestOption) ([]string, error) {
+	before, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	after := before.Clone()
+
+	var issuer string
+	switch options := after.Options.(type) {
+	case *ConnectionOptionsOIDC:
+		issuer = options.GetDiscoveryURL()
+		if issuer == "" {
+			issuer = options.GetIssuer()
+		}
+	case *ConnectionOptionsOkta:
+		issuer = options.GetIssuer()
+		if issuer == "" && options.GetDomain() != "" {
+			issuer = "https://" + options.GetDomain()
+		}
+	default:
+		return nil, &managementError{400, "Bad Request", fmt.Sprintf("Connection %q is not an oidc or okta connection", id)}
+	}
+	if issuer == "" {
+		return nil, &managementError{400, "Bad Request", fmt.Sprintf("Connection %q has no issuer to discover", id)}
+	}
+
+	document, warnings, err := DiscoverOIDC(requestContext(opts), httpClient, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	switch options := after.Options.(type) {
+	case *ConnectionOptionsOIDC:
+		options.ApplyDiscovery(document)
+	case *ConnectionOptionsOkta:
+		options.ApplyDiscovery(document)
+	}
+
+	if err := m.UpdateChanged(id, before, after, withoutQuery(opts)); err != nil {
+		return nil, err
+	}
+
+	return warnings, nil
+}
+
+// requestContext returns the context set on a request by the given options.
+func requestContext(opts []RequestOption) context.Context {
+	request, _ := http.NewRequest(http.MethodGet, "/", nil)
+	for _, option := range opts {
+		option.apply(request)
+	}
+	return request.Context()
+}
@@ -0,0 +1,190 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func newDiscoveryTestServer(t *testing.T, document func(issuer string) map[string]interface{}) *httptest.Server {
+	t.Helper()
+
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(document(server.URL))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func validDiscoveryDocument(issuer string) map[string]interface{} {
+	return map[string]interface{}{
+		"issuer":                   issuer,
+		"authorization_endpoint":   issuer + "/authorize",
+		"token_endpoint":           issuer + "/token",
+		"userinfo_endpoint":        issuer + "/userinfo",
+		"jwks_uri":                 issuer + "/.well-known/jwks.json",
+		"response_types_supported": []string{"code", "id_token", "code id_token"},
+	}
+}
+
+func TestDiscoverOIDC(t *testing.T) {
+	t.Run("Fetches a valid document", func(t *testing.T) {
+		server := newDiscoveryTestServer(t, validDiscoveryDocument)
+
+		document, warnings, err := DiscoverOIDC(context.Background(), server.Client(), server.URL+"/")
+		require.NoError(t, err)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, server.URL+"/.well-known/openid-configuration", document.GetDiscoveryURL())
+		assert.Equal(t, server.URL+"/authorize", document.GetAuthorizationEndpoint())
+		assert.Equal(t, server.URL+"/.well-known/jwks.json", document.GetJWKSURI())
+	})
+
+	t.Run("Warns about a mismatched issuer and response types", func(t *testing.T) {
+		server := newDiscoveryTestServer(t, func(issuer string) map[string]interface{} {
+			document := validDiscoveryDocument(issuer)
+			document["issuer"] = "https://other.example.com"
+			document["response_types_supported"] = []string{"token"}
+			return document
+		})
+
+		_, warnings, err := DiscoverOIDC(context.Background(), server.Client(), server.URL+"/.well-known/openid-configuration")
+		require.NoError(t, err)
+
+		require.Len(t, warnings, 2)
+		assert.Contains(t, warnings[0], "does not match the expected issuer")
+		assert.Contains(t, warnings[1], "response types")
+	})
+
+	t.Run("Fails when required endpoints are missing", func(t *testing.T) {
+		server := newDiscoveryTestServer(t, func(issuer string) map[string]interface{} {
+			document := validDiscoveryDocument(issuer)
+			delete(document, "jwks_uri")
+			return document
+		})
+
+		_, _, err := DiscoverOIDC(context.Background(), server.Client(), server.URL)
+		assert.EqualError(t, err, "the discovery document is missing the jwks_uri")
+	})
+
+	t.Run("Fails when the document can't be found", func(t *testing.T) {
+		server := newDiscoveryTestServer(t, validDiscoveryDocument)
+
+		_, _, err := DiscoverOIDC(context.Background(), server.Client(), server.URL+"/tenant")
+		assert.Error(t, err)
+	})
+}
+
+func TestConnectionOptionsOIDC_ApplyDiscovery(t *testing.T) {
+	options := &ConnectionOptionsOIDC{ClientID: authok.String("client-id")}
+	options.ApplyDiscovery(&OIDCDiscoveryDocument{
+		DiscoveryURL:          authok.String("https://idp.example.com/.well-known/openid-configuration"),
+		Issuer:                authok.String("https://idp.example.com"),
+		AuthorizationEndpoint: authok.String("https://idp.example.com/authorize"),
+		TokenEndpoint:         authok.String("https://idp.example.com/token"),
+		UserInfoEndpoint:      authok.String("https://idp.example.com/userinfo"),
+		JWKSURI:               authok.String("https://idp.example.com/jwks"),
+	})
+
+	assert.Equal(t, "client-id", options.GetClientID())
+	assert.Equal(t, "https://idp.example.com/.well-known/openid-configuration", options.GetDiscoveryURL())
+	assert.Equal(t, "https://idp.example.com", options.GetIssuer())
+	assert.Equal(t, "https://idp.example.com/token", options.GetTokenEndpoint())
+	assert.Equal(t, "https://idp.example.com/jwks", options.GetJWKSURI())
+}
+
+func TestConnectionOptionsOIDC_ApplyPartialDiscovery(t *testing.T) {
+	options := &ConnectionOptionsOIDC{
+		Issuer:           authok.String("https://old.example.com"),
+		TokenEndpoint:    authok.String("https://old.example.com/token"),
+		UserInfoEndpoint: authok.String("https://old.example.com/userinfo"),
+	}
+	options.ApplyDiscovery(&OIDCDiscoveryDocument{
+		Issuer:                authok.String("https://idp.example.com"),
+		AuthorizationEndpoint: authok.String("https://idp.example.com/authorize"),
+		JWKSURI:               authok.String("https://idp.example.com/jwks"),
+	})
+
+	assert.Equal(t, "https://idp.example.com", options.GetIssuer())
+	assert.Equal(t, "https://idp.example.com/authorize", options.GetAuthorizationEndpoint())
+	assert.Equal(t, "https://old.example.com/token", options.GetTokenEndpoint())
+	assert.Equal(t, "https://old.example.com/userinfo", options.GetUserInfoEndpoint())
+
+	okta := &ConnectionOptionsOkta{TokenEndpoint: authok.String("https://old.example.com/token")}
+	okta.ApplyDiscovery(&OIDCDiscoveryDocument{Issuer: authok.String("https://idp.example.com")})
+	assert.Equal(t, "https://old.example.com/token", okta.GetTokenEndpoint())
+}
+
+func TestConnectionManager_RefreshOIDCEndpoints(t *testing.T) {
+	t.Run("Updates the endpoints", func(t *testing.T) {
+		idp := newDiscoveryTestServer(t, validDiscoveryDocument)
+		api, m := startFakeAPI(t)
+		givenAnOktaConnection(api, idp.URL)
+
+		warnings, err := m.Connection.RefreshOIDCEndpoints("con_123", idp.Client(), IncludeFields("id", "options"))
+		require.NoError(t, err)
+
+		assert.Empty(t, warnings)
+		assert.Equal(t, []map[string]interface{}{{
+			"options": map[string]interface{}{
+				"client_id":              "client-id",
+				"issuer":                 idp.URL,
+				"authorization_endpoint": idp.URL + "/authorize",
+				"token_endpoint":         idp.URL + "/token",
+				"userinfo_endpoint":      idp.URL + "/userinfo",
+				"jwks_uri":               idp.URL + "/.well-known/jwks.json",
+			},
+		}}, api.bodies("PATCH /connections/con_123"))
+		assert.Equal(t, "id,options", api.queries("GET /connections/con_123")[0].Get("fields"))
+		assert.Empty(t, api.queries("PATCH /connections/con_123")[0])
+	})
+
+	t.Run("Keeps the endpoints missing from the document", func(t *testing.T) {
+		idp := newDiscoveryTestServer(t, func(issuer string) map[string]interface{} {
+			document := validDiscoveryDocument(issuer)
+			delete(document, "token_endpoint")
+			delete(document, "userinfo_endpoint")
+			return document
+		})
+		api, m := startFakeAPI(t)
+		givenAnOktaConnection(api, idp.URL)
+		options := api.get("/connections/con_123")["options"].(map[string]interface{})
+		options["token_endpoint"] = idp.URL + "/oauth/token"
+
+		warnings, err := m.Connection.RefreshOIDCEndpoints("con_123", idp.Client())
+		require.NoError(t, err)
+
+		assert.Contains(t, warnings, "the discovery document has no userinfo_endpoint")
+		options = api.get("/connections/con_123")["options"].(map[string]interface{})
+		assert.Equal(t, idp.URL+"/oauth/token", options["token_endpoint"])
+		assert.Nil(t, options["userinfo_endpoint"])
+		assert.Equal(t, idp.URL+"/authorize", options["authorization_endpoint"])
+	})
+}
+
+// givenAnOktaConnection stores the okta connection con_123 of the identity
+// provider at issuer, with an outdated authorization endpoint.
+func givenAnOktaConnection(api *fakeAPI, issuer string) {
+	api.put("/connections/con_123", map[string]interface{}{
+		"id":       "con_123",
+		"strategy": "okta",
+		"options": map[string]interface{}{
+			"client_id":              "client-id",
+			"issuer":                 issuer,
+			"authorization_endpoint": issuer + "/old/authorize",
+		},
+	})
+}
@@ -0,0 +1,234 @@
+package management
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ConnectionOptionsMFA holds the multifactor authentication options of a
+// database connection.
+type ConnectionOptionsMFA struct {
+	// Whether multifactor authentication is enabled for the connection.
+	Active *bool `json:"active,omitempty"`
+
+	// Whether the enrollment settings are returned to users who have not
+	// enrolled yet.
+	ReturnEnrollSettings *bool `json:"return_enroll_settings,omitempty"`
+}
+
+// ConnectionOptionsValidation holds the validation options of a database
+// connection.
+type ConnectionOptionsValidation struct {
+	Username *ConnectionOptionsUsernameValidation `json:"username,omitempty"`
+}
+
+// ConnectionOptionsUsernameValidation limits the length of usernames.
+type ConnectionOptionsUsernameValidation struct {
+	Min *int `json:"min,omitempty"`
+	Max *int `json:"max,omitempty"`
+}
+
+// UpstreamParam is a parameter passed on to the identity provider when users
+// log in. Its value is either static or taken from a parameter of the
+// authorization request.
+type UpstreamParam struct {
+	// The parameter of the authorization request to pass on, one of
+	// UpstreamParamAliases.
+	Alias *string `json:"alias,omitempty"`
+
+	// A static value to pass on.
+	Value *string `json:"value,omitempty"`
+}
+
+// ConnectionOptionsAuthParams holds the parameters of the authorization
+// requests of passwordless connections.
+type ConnectionOptionsAuthParams struct {
+	Scope        *string `json:"scope,omitempty"`
+	ResponseType *string `json:"response_type,omitempty"`
+}
+
+// UpstreamParamAliases are the authorization request parameters that can be
+// passed on to the identity provider.
+var UpstreamParamAliases = []string{
+	"acr_values",
+	"audience",
+	"client_id",
+	"display",
+	"id_token_hint",
+	"login_hint",
+	"max_age",
+	"prompt",
+	"resource",
+	"response_mode",
+	"response_type",
+	"ui_locales",
+}
+
+// The bounds of the username length of database connections.
+const (
+	usernameMinLength = 1
+	usernameMaxLength = 128
+)
+
+// Validate checks the username length limits.
+func (v *ConnectionOptionsValidation) Validate() error {
+	if v == nil || v.Username == nil {
+		return nil
+	}
+	minLength, maxLength := v.Username.Min, v.Username.Max
+	if minLength != nil && (*minLength < usernameMinLength || *minLength > usernameMaxLength) {
+		return fmt.Errorf("the minimum username length must be between %d and %d", usernameMinLength, usernameMaxLength)
+	}
+	if maxLength != nil && (*maxLength < usernameMinLength || *maxLength > usernameMaxLength) {
+		return fmt.Errorf("the maximum username length must be between %d and %d", usernameMinLength, usernameMaxLength)
+	}
+	if minLength != nil && maxLength != nil && *minLength > *maxLength {
+		return fmt.Errorf("the minimum username length %d is greater than the maximum %d", *minLength, *maxLength)
+	}
+	return nil
+}
+
+// Validate checks that the upstream param has either a known alias or a
+// value.
+func (p *UpstreamParam) Validate() error {
+	switch {
+	case p == nil:
+		return fmt.Errorf("an upstream param requires either an alias or a value")
+	case p.Alias != nil && p.Value != nil:
+		return fmt.Errorf("an upstream param cannot have both an alias and a value")
+	case p.Alias != nil:
+		for _, alias := range UpstreamParamAliases {
+			if p.GetAlias() == alias {
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown upstream param alias %q, expected one of: %s", p.GetAlias(), strings.Join(UpstreamParamAliases, ", "))
+	case p.Value != nil:
+		return nil
+	default:
+		return fmt.Errorf("an upstream param requires either an alias or a value")
+	}
+}
+
+// MFASettings returns the typed multifactor authentication options.
+func (c *ConnectionOptions) MFASettings() (*ConnectionOptionsMFA, error) {
+	var mfa *ConnectionOptionsMFA
+	if err := convertJSON(c.MFA, &mfa); err != nil {
+		return nil, fmt.Errorf("failed to read the mfa options: %w", err)
+	}
+	return mfa, nil
+}
+
+// SetMFASettings replaces the multifactor authentication options.
+func (c *ConnectionOptions) SetMFASettings(mfa *ConnectionOptionsMFA) error {
+	c.MFA = nil
+	if err := convertJSON(mfa, &c.MFA); err != nil {
+		return fmt.Errorf("failed to set the mfa options: %w", err)
+	}
+	return nil
+}
+
+// ValidationSettings returns the typed validation options.
+func (c *ConnectionOptions) ValidationSettings() (*ConnectionOptionsValidation, error) {
+	var v *ConnectionOptionsValidation
+	if err := convertJSON(c.Validation, &v); err != nil {
+		return nil, fmt.Errorf("failed to read the validation options: %w", err)
+	}
+	return v, nil
+}
+
+// SetValidationSettings validates and replaces the validation options.
+func (c *ConnectionOptions) SetValidationSettings(v *ConnectionOptionsValidation) error {
+	if err := v.Validate(); err != nil {
+		return err
+	}
+	c.Validation = nil
+	if err := convertJSON(v, &c.Validation); err != nil {
+		return fmt.Errorf("failed to set the validation options: %w", err)
+	}
+	return nil
+}
+
+// UpstreamParamSettings returns the typed upstream params.
+func (c *ConnectionOptions) UpstreamParamSettings() (map[string]*UpstreamParam, error) {
+	return ParseUpstreamParams(c.UpstreamParams)
+}
+
+// SetUpstreamParamSettings validates and replaces the upstream params.
+func (c *ConnectionOptions) SetUpstreamParamSettings(params map[string]*UpstreamParam) (err error) {
+	c.UpstreamParams, err = UpstreamParamsMap(params)
+	return err
+}
+
+// AuthParamsSettings returns the typed authorization parameters.
+func (c *ConnectionOptionsEmail) AuthParamsSettings() (*ConnectionOptionsAuthParams, error) {
+	return parseAuthParams(c.AuthParams)
+}
+
+// SetAuthParamsSettings replaces the authorization parameters.
+func (c *ConnectionOptionsEmail) SetAuthParamsSettings(p *ConnectionOptionsAuthParams) (err error) {
+	c.AuthParams, err = authParamsMap(p)
+	return err
+}
+
+// AuthParamsSettings returns the typed authorization parameters.
+func (c *ConnectionOptionsSMS) AuthParamsSettings() (*ConnectionOptionsAuthParams, error) {
+	return parseAuthParams(c.AuthParams)
+}
+
+// SetAuthParamsSettings replaces the authorization parameters.
+func (c *ConnectionOptionsSMS) SetAuthParamsSettings(p *ConnectionOptionsAuthParams) (err error) {
+	c.AuthParams, err = authParamsMap(p)
+	return err
+}
+
+// ParseUpstreamParams converts the UpstreamParams option of a connection, of
+// any strategy, to typed upstream params.
+func ParseUpstreamParams(params map[string]interface{}) (map[string]*UpstreamParam, error) {
+	var typed map[string]*UpstreamParam
+	if err := convertJSON(params, &typed); err != nil {
+		return nil, fmt.Errorf("failed to read the upstream params: %w", err)
+	}
+	return typed, nil
+}
+
+// UpstreamParamsMap validates typed upstream params and converts them to the
+// form of the UpstreamParams option of a connection.
+func UpstreamParamsMap(params map[string]*UpstreamParam) (map[string]interface{}, error) {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := params[name].Validate(); err != nil {
+			return nil, fmt.Errorf("invalid upstream param %q: %w", name, err)
+		}
+	}
+
+	var m map[string]interface{}
+	if err := convertJSON(params, &m); err != nil {
+		return nil, fmt.Errorf("failed to set the upstream params: %w", err)
+	}
+	return m, nil
+}
+
+func parseAuthParams(params interface{}) (*ConnectionOptionsAuthParams, error) {
+	var typed *ConnectionOptionsAuthParams
+	if err := convertJSON(params, &typed); err != nil {
+		return nil, fmt.Errorf("failed to read the auth params: %w", err)
+	}
+	return typed, nil
+}
+
+func authParamsMap(p *ConnectionOptionsAuthParams) (interface{}, error) {
+	if p == nil {
+		return nil, nil
+	}
+	var m map[string]interface{}
+	if err := convertJSON(p, &m); err != nil {
+		return nil, fmt.Errorf("failed to set the auth params: %w", err)
+	}
+	return m, nil
+}
@@ -0,0 +1,74 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func TestConnectionOptions_TypedSettings(t *testing.T) {
+	var options ConnectionOptions
+	err := json.Unmarshal([]byte(`{
+		"mfa": {"active": true, "return_enroll_settings": false},
+		"validation": {"username": {"min": 3, "max": 20}},
+		"upstream_params": {"screen_name": {"alias": "login_hint"}, "tenant": {"value": "acme"}}
+	}`), &options)
+	require.NoError(t, err)
+
+	mfa, err := options.MFASettings()
+	require.NoError(t, err)
+	assert.True(t, mfa.GetActive())
+	assert.False(t, mfa.GetReturnEnrollSettings())
+
+	validation, err := options.ValidationSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 3, validation.Username.GetMin())
+	assert.Equal(t, 20, validation.Username.GetMax())
+
+	params, err := options.UpstreamParamSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "login_hint", params["screen_name"].GetAlias())
+	assert.Equal(t, "acme", params["tenant"].GetValue())
+
+	require.NoError(t, options.SetMFASettings(&ConnectionOptionsMFA{Active: authok.Bool(false)}))
+	assert.Equal(t, map[string]interface{}{"active": false}, options.MFA)
+
+	validation.Username.Max = authok.Int(2)
+	assert.EqualError(t, options.SetValidationSettings(validation), "the minimum username length 3 is greater than the maximum 2")
+	validation.Username.Max = authok.Int(200)
+	assert.EqualError(t, options.SetValidationSettings(validation), "the maximum username length must be between 1 and 128")
+	validation.Username.Max = authok.Int(15)
+	require.NoError(t, options.SetValidationSettings(validation))
+	assert.Equal(t, map[string]interface{}{"username": map[string]interface{}{"min": float64(3), "max": float64(15)}}, options.Validation)
+
+	params["screen_name"].Alias = authok.String("login-hint")
+	assert.EqualError(t, options.SetUpstreamParamSettings(params), `invalid upstream param "screen_name": unknown upstream param alias "login-hint", expected one of: acr_values, audience, client_id, display, id_token_hint, login_hint, max_age, prompt, resource, response_mode, response_type, ui_locales`)
+	params["screen_name"].Alias = authok.String("login_hint")
+	params["tenant"].Alias = authok.String("audience")
+	assert.EqualError(t, options.SetUpstreamParamSettings(params), `invalid upstream param "tenant": an upstream param cannot have both an alias and a value`)
+	params["tenant"].Alias = nil
+	require.NoError(t, options.SetUpstreamParamSettings(params))
+	assert.Equal(t, map[string]interface{}{
+		"screen_name": map[string]interface{}{"alias": "login_hint"},
+		"tenant":      map[string]interface{}{"value": "acme"},
+	}, options.UpstreamParams)
+}
+
+func TestConnectionOptionsSMS_AuthParamsSettings(t *testing.T) {
+	options := &ConnectionOptionsSMS{AuthParams: map[string]string{"scope": "openid profile"}}
+
+	params, err := options.AuthParamsSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "openid profile", params.GetScope())
+
+	params.ResponseType = authok.String("code")
+	require.NoError(t, options.SetAuthParamsSettings(params))
+	assert.Equal(t, map[string]interface{}{"scope": "openid profile", "response_type": "code"}, options.AuthParams)
+
+	require.NoError(t, options.SetAuthParamsSettings(nil))
+	assert.Nil(t, options.AuthParams)
+}
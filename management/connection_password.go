@@ -0,0 +1,331 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/authok/authok-go"
+)
+
+// Password strength levels of database connections.
+const (
+	PasswordPolicyNone      = "none"
+	PasswordPolicyLow       = "low"
+	PasswordPolicyFair      = "fair"
+	PasswordPolicyGood      = "good"
+	PasswordPolicyExcellent = "excellent"
+)
+
+// Codes of the reasons a password is rejected.
+const (
+	PasswordViolationTooShort            = "too_short"
+	PasswordViolationCharacterTypes      = "character_types"
+	PasswordViolationIdenticalCharacters = "identical_characters"
+	PasswordViolationDictionary          = "dictionary"
+	PasswordViolationPersonalInfo        = "personal_info"
+)
+
+// Types of characters counted by the password strength levels.
+const (
+	PasswordCharacterLower   = "lower"
+	PasswordCharacterUpper   = "upper"
+	PasswordCharacterNumber  = "number"
+	PasswordCharacterSpecial = "special"
+)
+
+// PasswordSettings are the password options of a database connection.
+type PasswordSettings struct {
+	// Password strength level, one of PasswordPolicyNone, PasswordPolicyLow,
+	// PasswordPolicyFair, PasswordPolicyGood or PasswordPolicyExcellent.
+	Policy *string `json:"passwordPolicy,omitempty"`
+
+	History           *PasswordHistory           `json:"password_history,omitempty"`
+	NoPersonalInfo    *PasswordNoPersonalInfo    `json:"password_no_personal_info,omitempty"`
+	Dictionary        *PasswordDictionary        `json:"password_dictionary,omitempty"`
+	ComplexityOptions *PasswordComplexityOptions `json:"password_complexity_options,omitempty"`
+}
+
+// PasswordHistory prevents users from reusing their previous passwords.
+type PasswordHistory struct {
+	Enable *bool `json:"enable,omitempty"`
+
+	// The number of previous passwords to remember.
+	Size *int `json:"size,omitempty"`
+}
+
+// PasswordNoPersonalInfo prevents users from using their name, username or
+// email address as part of their password.
+type PasswordNoPersonalInfo struct {
+	Enable *bool `json:"enable,omitempty"`
+}
+
+// PasswordDictionary prevents users from using common passwords.
+type PasswordDictionary struct {
+	Enable *bool `json:"enable,omitempty"`
+
+	// Additional words that are not allowed in passwords.
+	Dictionary *[]string `json:"dictionary,omitempty"`
+}
+
+// PasswordComplexityOptions holds the password complexity options.
+type PasswordComplexityOptions struct {
+	// The minimum length of passwords, overriding the length required by the
+	// strength level.
+	MinLength *int `json:"min_length,omitempty"`
+}
+
+// PasswordViolation is a reason a password is rejected.
+type PasswordViolation struct {
+	// One of PasswordViolationTooShort, PasswordViolationCharacterTypes,
+	// PasswordViolationIdenticalCharacters, PasswordViolationDictionary or
+	// PasswordViolationPersonalInfo.
+	Code *string `json:"code,omitempty"`
+
+	// A message that can be shown to the user.
+	Message *string `json:"message,omitempty"`
+
+	// The minimum length, for PasswordViolationTooShort.
+	MinLength *int `json:"min_length,omitempty"`
+
+	// The number of character types required and the types that are
+	// missing, for PasswordViolationCharacterTypes.
+	RequiredCharacterTypes *int      `json:"required_character_types,omitempty"`
+	MissingCharacterTypes  *[]string `json:"missing_character_types,omitempty"`
+
+	// The profile attribute found in the password, for
+	// PasswordViolationPersonalInfo.
+	Field *string `json:"field,omitempty"`
+}
+
+// PasswordSettings returns the typed password options of the connection.
+func (c *ConnectionOptions) PasswordSettings() (*PasswordSettings, error) {
+	var s *PasswordSettings
+	if err := convertJSON(c, &s); err != nil {
+		return nil, fmt.Errorf("failed to read the password settings: %w", err)
+	}
+	return s, nil
+}
+
+// SetPasswordSettings replaces the password options of the connection.
+func (c *ConnectionOptions) SetPasswordSettings(s *PasswordSettings) error {
+	if s == nil {
+		s = &PasswordSettings{}
+	}
+	c.PasswordPolicy = s.Policy
+	for _, option := range []struct {
+		from interface{}
+		to   *map[string]interface{}
+	}{
+		{s.History, &c.PasswordHistory},
+		{s.NoPersonalInfo, &c.PasswordNoPersonalInfo},
+		{s.Dictionary, &c.PasswordDictionary},
+		{s.ComplexityOptions, &c.PasswordComplexityOptions},
+	} {
+		*option.to = nil
+		if err := convertJSON(option.from, option.to); err != nil {
+			return fmt.Errorf("failed to set the password settings: %w", err)
+		}
+	}
+	return nil
+}
+
+// convertJSON converts from one type to another through their JSON encoding.
+func convertJSON(from, to interface{}) error {
+	b, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, to)
+}
+
+// passwordStrength describes a password strength level.
+type passwordStrength struct {
+	minLength           int
+	characterTypes      []string
+	minCharacterTypes   int
+	noIdenticalInARow   bool
+	characterTypesLabel string
+}
+
+var passwordStrengths = map[string]passwordStrength{
+	PasswordPolicyNone: {minLength: 1},
+	PasswordPolicyLow:  {minLength: 6},
+	PasswordPolicyFair: {
+		minLength:           8,
+		characterTypes:      []string{PasswordCharacterLower, PasswordCharacterUpper, PasswordCharacterNumber},
+		minCharacterTypes:   3,
+		characterTypesLabel: "lower case letters, upper case letters and numbers",
+	},
+	PasswordPolicyGood: {
+		minLength:           8,
+		characterTypes:      []string{PasswordCharacterLower, PasswordCharacterUpper, PasswordCharacterNumber, PasswordCharacterSpecial},
+		minCharacterTypes:   3,
+		characterTypesLabel: "at least 3 of lower case letters, upper case letters, numbers and special characters",
+	},
+	PasswordPolicyExcellent: {
+		minLength:           10,
+		characterTypes:      []string{PasswordCharacterLower, PasswordCharacterUpper, PasswordCharacterNumber, PasswordCharacterSpecial},
+		minCharacterTypes:   3,
+		noIdenticalInARow:   true,
+		characterTypesLabel: "at least 3 of lower case letters, upper case letters, numbers and special characters",
+	},
+}
+
+// commonPasswords is a short list of the most common passwords, rejected when
+// the dictionary check is enabled. The server checks against a far longer
+// list, so passwords accepted here may still be rejected by the API.
+var commonPasswords = []string{
+	"123456", "12345678", "123456789", "1234567890", "password", "password1",
+	"qwerty", "qwerty123", "abc123", "111111", "123123", "letmein", "welcome",
+	"iloveyou", "admin", "monkey", "dragon", "football", "baseball", "sunshine",
+	"princess", "passw0rd", "master", "trustno1", "000000",
+}
+
+// Evaluate checks a candidate password against the password settings, and
+// returns the reasons it is rejected, if any. The user, which may be nil, is
+// used for the personal info check.
+//
+// The password history cannot be checked locally, as previous passwords are
+// only known to the server.
+func (s *PasswordSettings) Evaluate(password string, user *User) []*PasswordViolation {
+	var violations []*PasswordViolation
+
+	strength, ok := passwordStrengths[s.GetPolicy()]
+	if !ok {
+		strength = passwordStrengths[PasswordPolicyNone]
+	}
+
+	minLength := strength.minLength
+	if s.ComplexityOptions.GetMinLength() > 0 {
+		minLength = s.ComplexityOptions.GetMinLength()
+	}
+	if length := len([]rune(password)); length < minLength {
+		violations = append(violations, &PasswordViolation{
+			Code:      authok.String(PasswordViolationTooShort),
+			Message:   authok.String(fmt.Sprintf("Password must be at least %d characters long", minLength)),
+			MinLength: authok.Int(minLength),
+		})
+	}
+
+	if strength.minCharacterTypes > 0 {
+		present := passwordCharacterTypes(password)
+		var missing []string
+		for _, characterType := range strength.characterTypes {
+			if !present[characterType] {
+				missing = append(missing, characterType)
+			}
+		}
+		if len(strength.characterTypes)-len(missing) < strength.minCharacterTypes {
+			violations = append(violations, &PasswordViolation{
+				Code:                   authok.String(PasswordViolationCharacterTypes),
+				Message:                authok.String("Password must contain " + strength.characterTypesLabel),
+				RequiredCharacterTypes: authok.Int(strength.minCharacterTypes),
+				MissingCharacterTypes:  &missing,
+			})
+		}
+	}
+
+	if strength.noIdenticalInARow && hasIdenticalCharactersInARow(password, 3) {
+		violations = append(violations, &PasswordViolation{
+			Code:    authok.String(PasswordViolationIdenticalCharacters),
+			Message: authok.String("Password must not contain more than 2 identical characters in a row"),
+		})
+	}
+
+	if s.Dictionary.GetEnable() {
+		lower := strings.ToLower(password)
+		for _, word := range append(commonPasswords, s.Dictionary.GetDictionary()...) {
+			if lower == strings.ToLower(word) {
+				violations = append(violations, &PasswordViolation{
+					Code:    authok.String(PasswordViolationDictionary),
+					Message: authok.String("Password is too common"),
+				})
+				break
+			}
+		}
+	}
+
+	if s.NoPersonalInfo.GetEnable() && user != nil {
+		if field := personalInfoInPassword(password, user); field != "" {
+			violations = append(violations, &PasswordViolation{
+				Code:    authok.String(PasswordViolationPersonalInfo),
+				Message: authok.String("Password must not contain personal information"),
+				Field:   authok.String(field),
+			})
+		}
+	}
+
+	return violations
+}
+
+func passwordCharacterTypes(password string) map[string]bool {
+	present := map[string]bool{}
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			present[PasswordCharacterLower] = true
+		case unicode.IsUpper(r):
+			present[PasswordCharacterUpper] = true
+		case unicode.IsDigit(r):
+			present[PasswordCharacterNumber] = true
+		case !unicode.IsSpace(r):
+			present[PasswordCharacterSpecial] = true
+		}
+	}
+	return present
+}
+
+func hasIdenticalCharactersInARow(password string, n int) bool {
+	var previous rune
+	count := 0
+	for _, r := range password {
+		if count > 0 && r == previous {
+			count++
+		} else {
+			count = 1
+		}
+		if count >= n {
+			return true
+		}
+		previous = r
+	}
+	return false
+}
+
+// personalInfoInPassword returns the profile attribute of the user found in
+// the password, or "" if there is none.
+func personalInfoInPassword(password string, user *User) string {
+	type field struct {
+		name  string
+		value string
+	}
+	fields := []field{
+		{"name", user.GetName()},
+		{"given_name", user.GetGivenName()},
+		{"family_name", user.GetFamilyName()},
+		{"username", user.GetUsername()},
+		{"nickname", user.GetNickname()},
+		{"email", strings.SplitN(user.GetEmail(), "@", 2)[0]},
+	}
+	if user.UserMetadata != nil {
+		for _, key := range []string{"name", "first", "last"} {
+			if value, ok := (*user.UserMetadata)[key].(string); ok {
+				fields = append(fields, field{"user_metadata." + key, value})
+			}
+		}
+	}
+
+	lower := strings.ToLower(password)
+	for _, field := range fields {
+		value := strings.ToLower(strings.TrimSpace(field.value))
+		if len([]rune(value)) < 3 {
+			continue
+		}
+		if strings.Contains(lower, value) {
+			return field.name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package management
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func TestConnectionOptions_PasswordSettings(t *testing.T) {
+	var options ConnectionOptions
+	err := json.Unmarshal([]byte(`{
+		"passwordPolicy": "good",
+		"password_history": {"enable": true, "size": 5},
+		"password_dictionary": {"enable": true, "dictionary": ["acme"]},
+		"password_complexity_options": {"min_length": 12}
+	}`), &options)
+	require.NoError(t, err)
+
+	settings, err := options.PasswordSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "good", settings.GetPolicy())
+	assert.Equal(t, 5, settings.History.GetSize())
+	assert.Equal(t, []string{"acme"}, settings.Dictionary.GetDictionary())
+	assert.Equal(t, 12, settings.ComplexityOptions.GetMinLength())
+	assert.Nil(t, settings.NoPersonalInfo)
+
+	settings.NoPersonalInfo = &PasswordNoPersonalInfo{Enable: authok.Bool(true)}
+	settings.History = nil
+	require.NoError(t, options.SetPasswordSettings(settings))
+	assert.Equal(t, map[string]interface{}{"enable": true}, options.PasswordNoPersonalInfo)
+	assert.Nil(t, options.PasswordHistory)
+	assert.Equal(t, map[string]interface{}{"min_length": float64(12)}, options.PasswordComplexityOptions)
+}
+
+func TestPasswordSettings_Evaluate(t *testing.T) {
+	codes := func(violations []*PasswordViolation) []string {
+		var codes []string
+		for _, v := range violations {
+			codes = append(codes, v.GetCode())
+		}
+		return codes
+	}
+
+	var testCases = []struct {
+		name     string
+		settings *PasswordSettings
+		password string
+		expected []string
+	}{
+		{"None accepts any password", &PasswordSettings{}, "a", nil},
+		{"None rejects empty passwords", &PasswordSettings{}, "", []string{PasswordViolationTooShort}},
+		{"Low requires 6 characters", &PasswordSettings{Policy: authok.String(PasswordPolicyLow)}, "abcde", []string{PasswordViolationTooShort}},
+		{"Fair requires lower, upper and numbers", &PasswordSettings{Policy: authok.String(PasswordPolicyFair)}, "abcdefg!", []string{PasswordViolationCharacterTypes}},
+		{"Fair accepts lower, upper and numbers", &PasswordSettings{Policy: authok.String(PasswordPolicyFair)}, "abcdEF12", nil},
+		{"Good accepts 3 of 4 types", &PasswordSettings{Policy: authok.String(PasswordPolicyGood)}, "abcdef1!", nil},
+		{"Good rejects 2 of 4 types", &PasswordSettings{Policy: authok.String(PasswordPolicyGood)}, "abcdef12", []string{PasswordViolationCharacterTypes}},
+		{"Excellent rejects identical characters", &PasswordSettings{Policy: authok.String(PasswordPolicyExcellent)}, "aaabcD1!xyz", []string{PasswordViolationIdenticalCharacters}},
+		{
+			"Minimum length overrides the level",
+			&PasswordSettings{Policy: authok.String(PasswordPolicyLow), ComplexityOptions: &PasswordComplexityOptions{MinLength: authok.Int(10)}},
+			"abcdefgh",
+			[]string{PasswordViolationTooShort},
+		},
+		{
+			"Dictionary rejects common passwords",
+			&PasswordSettings{Dictionary: &PasswordDictionary{Enable: authok.Bool(true)}},
+			"Password1",
+			[]string{PasswordViolationDictionary},
+		},
+		{
+			"Dictionary rejects custom words",
+			&PasswordSettings{Dictionary: &PasswordDictionary{Enable: authok.Bool(true), Dictionary: &[]string{"acme"}}},
+			"ACME",
+			[]string{PasswordViolationDictionary},
+		},
+		{
+			"Personal info rejects the username",
+			&PasswordSettings{NoPersonalInfo: &PasswordNoPersonalInfo{Enable: authok.Bool(true)}},
+			"xxJaneDoe99",
+			[]string{PasswordViolationPersonalInfo},
+		},
+	}
+
+	user := &User{
+		Username:     authok.String("janedoe"),
+		Email:        authok.String("jane.d@example.com"),
+		UserMetadata: &map[string]interface{}{"first": "Jane"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, codes(testCase.settings.Evaluate(testCase.password, user)))
+		})
+	}
+
+	t.Run("Reports the details of a violation", func(t *testing.T) {
+		violations := (&PasswordSettings{Policy: authok.String(PasswordPolicyFair)}).Evaluate("abc", nil)
+		require.Len(t, violations, 2)
+		assert.Equal(t, 8, violations[0].GetMinLength())
+		assert.Equal(t, []string{PasswordCharacterUpper, PasswordCharacterNumber}, violations[1].GetMissingCharacterTypes())
+		assert.NotEmpty(t, violations[1].GetMessage())
+
+		violations = (&PasswordSettings{NoPersonalInfo: &PasswordNoPersonalInfo{Enable: authok.Bool(true)}}).Evaluate("jane2024", user)
+		require.Len(t, violations, 1)
+		assert.Equal(t, "user_metadata.first", violations[0].GetField())
+	})
+}
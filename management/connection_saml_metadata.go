@@ -0,0 +1,350 @@
+package management
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SAML bindings supported by SAML connections.
+const (
+	SAMLBindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	SAMLBindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+)
+
+// Bindings in order of preference.
+var samlBindings = []string{SAMLBindingHTTPRedirect, SAMLBindingHTTPPost}
+
+// Signature and digest algorithms supported by SAML connections, keyed by
+// their XML Signature identifier.
+var (
+	samlSignatureAlgorithms = map[string]string{
+		"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256": "rsa-sha256",
+		"http://www.w3.org/2000/09/xmldsig#rsa-sha1":        "rsa-sha1",
+	}
+	samlDigestAlgorithms = map[string]string{
+		"http://www.w3.org/2001/04/xmlenc#sha256": "sha256",
+		"http://www.w3.org/2000/09/xmldsig#sha1":  "sha1",
+	}
+)
+
+// samlCertificateExpiryWarning is how long before a signing certificate
+// expires a warning is raised.
+const samlCertificateExpiryWarning = 30 * 24 * time.Hour
+
+// SAMLMetadata holds the settings of a SAML identity provider read from its
+// metadata.
+type SAMLMetadata struct {
+	// The entity ID of the identity provider.
+	EntityID *string `json:"entity_id,omitempty"`
+
+	// The single sign-on endpoint and the binding used to reach it.
+	SignInEndpoint *string `json:"sign_in_endpoint,omitempty"`
+	SignInBinding  *string `json:"sign_in_binding,omitempty"`
+
+	// The single logout endpoint and the binding used to reach it, if any.
+	SignOutEndpoint *string `json:"sign_out_endpoint,omitempty"`
+	SignOutBinding  *string `json:"sign_out_binding,omitempty"`
+
+	// The preferred signature and digest algorithms, among those supported.
+	SignatureAlgorithm *string `json:"signature_algorithm,omitempty"`
+	DigestAlgorithm    *string `json:"digest_algorithm,omitempty"`
+
+	// The certificates the identity provider signs its responses with.
+	SigningCertificates []*CertificateInfo `json:"signing_certificates,omitempty"`
+
+	// Problems found in the metadata, such as expired certificates or
+	// unsupported algorithms.
+	Warnings []string `json:"warnings,omitempty"`
+
+	// The URL the metadata was fetched from, if any.
+	MetadataURL *string `json:"metadata_url,omitempty"`
+
+	// The metadata document as it was read.
+	MetadataXML *string `json:"metadata_xml,omitempty"`
+}
+
+type samlEntitiesDescriptor struct {
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
+
+type samlEntityDescriptor struct {
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+	SigningMethods    []samlAlgorithmSupport `xml:"Extensions>SigningMethod"`
+	DigestMethods     []samlAlgorithmSupport `xml:"Extensions>DigestMethod"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors       []samlKeyDescriptor    `xml:"KeyDescriptor"`
+	SingleSignOnServices []samlEndpoint         `xml:"SingleSignOnService"`
+	SingleLogoutServices []samlEndpoint         `xml:"SingleLogoutService"`
+	SigningMethods       []samlAlgorithmSupport `xml:"Extensions>SigningMethod"`
+	DigestMethods        []samlAlgorithmSupport `xml:"Extensions>DigestMethod"`
+}
+
+type samlKeyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+type samlAlgorithmSupport struct {
+	Algorithm string `xml:"Algorithm,attr"`
+}
+
+// ParseSAMLMetadata reads the metadata of a SAML identity provider. The
+// document can either be an EntityDescriptor or an EntitiesDescriptor, in
+// which case the first entity describing an identity provider is used.
+//
+// An error is returned if the metadata has no usable sign in endpoint or no
+// valid signing certificate. Anything else that looks off is reported in the
+// Warnings of the result.
+func ParseSAMLMetadata(data []byte) (*SAMLMetadata, error) {
+	entity, err := findSAMLIdentityProvider(data)
+	if err != nil {
+		return nil, err
+	}
+
+	metadataXML := string(data)
+	metadata := &SAMLMetadata{MetadataXML: &metadataXML}
+	if entity.EntityID != "" {
+		metadata.EntityID = &entity.EntityID
+	}
+
+	idp := entity.IDPSSODescriptors[0]
+
+	signIn, ok := pickSAMLEndpoint(idp.SingleSignOnServices)
+	if !ok {
+		return nil, fmt.Errorf("the SAML metadata has no SingleSignOnService with a supported binding")
+	}
+	metadata.SignInEndpoint = &signIn.Location
+	metadata.SignInBinding = &signIn.Binding
+
+	if signOut, ok := pickSAMLEndpoint(idp.SingleLogoutServices); ok {
+		metadata.SignOutEndpoint = &signOut.Location
+		metadata.SignOutBinding = &signOut.Binding
+	} else if len(idp.SingleLogoutServices) > 0 {
+		metadata.Warnings = append(metadata.Warnings, "the SAML metadata has no SingleLogoutService with a supported binding")
+	}
+
+	metadata.readCertificates(idp.KeyDescriptors, time.Now())
+	if len(metadata.SigningCertificates) == 0 {
+		return nil, fmt.Errorf("the SAML metadata has no valid signing certificate")
+	}
+
+	metadata.SignatureAlgorithm = metadata.pickAlgorithm(
+		"signature",
+		append(entity.SigningMethods, idp.SigningMethods...),
+		samlSignatureAlgorithms,
+		"rsa-sha256",
+	)
+	metadata.DigestAlgorithm = metadata.pickAlgorithm(
+		"digest",
+		append(entity.DigestMethods, idp.DigestMethods...),
+		samlDigestAlgorithms,
+		"sha256",
+	)
+
+	return metadata, nil
+}
+
+// FetchSAMLMetadata fetches the metadata of a SAML identity provider from the
+// given URL using httpClient, or http.DefaultClient if nil, and parses it
+// with ParseSAMLMetadata.
+func FetchSAMLMetadata(ctx context.Context, httpClient *http.Client, metadataURL string) (*SAMLMetadata, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the metadata request: %w", err)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the SAML metadata: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch the SAML metadata from %s: %s", metadataURL, response.Status)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the SAML metadata: %w", err)
+	}
+
+	metadata, err := ParseSAMLMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	metadata.MetadataURL = &metadataURL
+
+	return metadata, nil
+}
+
+func findSAMLIdentityProvider(data []byte) (*samlEntityDescriptor, error) {
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to decode the SAML metadata: %w", err)
+	}
+
+	var entities []samlEntityDescriptor
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		var entity samlEntityDescriptor
+		if err := xml.Unmarshal(data, &entity); err != nil {
+			return nil, fmt.Errorf("failed to decode the SAML metadata: %w", err)
+		}
+		entities = append(entities, entity)
+	case "EntitiesDescriptor":
+		var descriptor samlEntitiesDescriptor
+		if err := xml.Unmarshal(data, &descriptor); err != nil {
+			return nil, fmt.Errorf("failed to decode the SAML metadata: %w", err)
+		}
+		entities = descriptor.EntityDescriptors
+	default:
+		return nil, fmt.Errorf("the SAML metadata has an unexpected root element %q", root.XMLName.Local)
+	}
+
+	for i := range entities {
+		if len(entities[i].IDPSSODescriptors) > 0 {
+			return &entities[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("the SAML metadata does not describe an identity provider")
+}
+
+func pickSAMLEndpoint(endpoints []samlEndpoint) (samlEndpoint, bool) {
+	for _, binding := range samlBindings {
+		for _, endpoint := range endpoints {
+			if endpoint.Binding == binding && endpoint.Location != "" {
+				return endpoint, true
+			}
+		}
+	}
+	return samlEndpoint{}, false
+}
+
+func (m *SAMLMetadata) readCertificates(keyDescriptors []samlKeyDescriptor, now time.Time) {
+	seen := make(map[string]bool)
+	for _, keyDescriptor := range keyDescriptors {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+
+		for _, data := range keyDescriptor.Certificates {
+			certificate, err := ParseCertificate(data)
+			if err != nil {
+				m.Warnings = append(m.Warnings, fmt.Sprintf("skipped an invalid signing certificate: %s", err))
+				continue
+			}
+			if seen[certificate.GetThumbprintSHA1()] {
+				continue
+			}
+			seen[certificate.GetThumbprintSHA1()] = true
+
+			switch {
+			case certificate.Expired(now):
+				m.Warnings = append(m.Warnings, fmt.Sprintf(
+					"the signing certificate %s expired on %s",
+					certificate.GetThumbprintSHA1(),
+					certificate.GetNotAfter().Format(time.RFC3339),
+				))
+			case certificate.ExpiresWithin(samlCertificateExpiryWarning, now):
+				m.Warnings = append(m.Warnings, fmt.Sprintf(
+					"the signing certificate %s expires on %s",
+					certificate.GetThumbprintSHA1(),
+					certificate.GetNotAfter().Format(time.RFC3339),
+				))
+			}
+			if certificate.GetPublicKeyAlgorithm() != "RSA" {
+				m.Warnings = append(m.Warnings, fmt.Sprintf(
+					"the signing certificate %s uses an unsupported %s key",
+					certificate.GetThumbprintSHA1(),
+					certificate.GetPublicKeyAlgorithm(),
+				))
+			}
+
+			m.SigningCertificates = append(m.SigningCertificates, certificate)
+		}
+	}
+}
+
+// pickAlgorithm returns the preferred algorithm among those advertised by the
+// identity provider that are supported, and warns about the unsupported ones.
+// If none are advertised, nil is returned and the connection default is used.
+func (m *SAMLMetadata) pickAlgorithm(kind string, advertised []samlAlgorithmSupport, supported map[string]string, preferred string) *string {
+	if len(advertised) == 0 {
+		return nil
+	}
+
+	var picked string
+	for _, algorithm := range advertised {
+		name, ok := supported[algorithm.Algorithm]
+		if !ok {
+			m.Warnings = append(m.Warnings, fmt.Sprintf("the %s algorithm %q is not supported", kind, algorithm.Algorithm))
+			continue
+		}
+		if picked == "" || name == preferred {
+			picked = name
+		}
+	}
+
+	if picked == "" {
+		m.Warnings = append(m.Warnings, fmt.Sprintf("none of the advertised %s algorithms are supported", kind))
+		return nil
+	}
+
+	return &picked
+}
+
+// ApplyMetadata configures the connection to trust the identity provider
+// described by the given metadata. The signing certificate is set to the
+// first valid certificate and all certificates are added to the thumbprints.
+// Other options are left untouched.
+func (c *ConnectionOptionsSAML) ApplyMetadata(m *SAMLMetadata) {
+	c.EntityID = m.EntityID
+	c.SignInEndpoint = m.SignInEndpoint
+	c.ProtocolBinding = m.SignInBinding
+	c.SignOutEndpoint = m.SignOutEndpoint
+	if m.SignatureAlgorithm != nil {
+		c.SignatureAlgorithm = m.SignatureAlgorithm
+	}
+	if m.DigestAlgorithm != nil {
+		c.DigestAglorithm = m.DigestAlgorithm
+	}
+
+	now := time.Now()
+	var signingCert *CertificateInfo
+	thumbprints := make([]interface{}, 0, len(m.SigningCertificates))
+	for _, certificate := range m.SigningCertificates {
+		thumbprints = append(thumbprints, certificate.GetThumbprintSHA1())
+		if signingCert == nil && !certificate.Expired(now) {
+			signingCert = certificate
+		}
+	}
+	if signingCert == nil && len(m.SigningCertificates) > 0 {
+		signingCert = m.SigningCertificates[0]
+	}
+
+	if signingCert != nil {
+		encoded := base64.StdEncoding.EncodeToString([]byte(signingCert.GetPEM()))
+		c.SigningCert = &encoded
+	}
+	c.Thumbprints = thumbprints
+}
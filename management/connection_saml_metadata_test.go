@@ -0,0 +1,205 @@
+package management
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+const testSAMLMetadata = `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" xmlns:alg="urn:oasis:names:tc:SAML:metadata:algsupport" entityID="https://idp.example.com/saml">
+  <md:Extensions>
+    <alg:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha512"/>
+    <alg:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/>
+    <alg:SigningMethod Algorithm="http://www.w3.org/2000/09/xmldsig#rsa-sha1"/>
+    <alg:SigningMethod Algorithm="http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"/>
+  </md:Extensions>
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor>
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>%s</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP" Location="https://idp.example.com/saml/soap"/>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/saml/logout"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/saml/post"/>
+    <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.example.com/saml/redirect"/>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`
+
+func newTestSAMLMetadata(t *testing.T) ([]byte, []string) {
+	t.Helper()
+
+	now := time.Now()
+	current := newTestCertificate(t, "current", now.Add(-time.Hour), now.Add(365*24*time.Hour), nil)
+	encryption := newTestCertificate(t, "encryption", now.Add(-time.Hour), now.Add(365*24*time.Hour), nil)
+	expired := newTestCertificate(t, "expired", now.Add(-48*time.Hour), now.Add(-24*time.Hour), nil)
+
+	certificates := []string{
+		base64.StdEncoding.EncodeToString(current),
+		base64.StdEncoding.EncodeToString(encryption),
+		base64.StdEncoding.EncodeToString(expired),
+	}
+
+	return []byte(fmt.Sprintf(testSAMLMetadata, certificates[0], certificates[1], certificates[2])), certificates
+}
+
+func TestParseSAMLMetadata(t *testing.T) {
+	data, certificates := newTestSAMLMetadata(t)
+
+	metadata, err := ParseSAMLMetadata(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://idp.example.com/saml", metadata.GetEntityID())
+	assert.Equal(t, "https://idp.example.com/saml/redirect", metadata.GetSignInEndpoint())
+	assert.Equal(t, SAMLBindingHTTPRedirect, metadata.GetSignInBinding())
+	assert.Equal(t, "https://idp.example.com/saml/logout", metadata.GetSignOutEndpoint())
+	assert.Equal(t, SAMLBindingHTTPPost, metadata.GetSignOutBinding())
+	assert.Equal(t, "rsa-sha256", metadata.GetSignatureAlgorithm())
+	assert.Equal(t, "sha256", metadata.GetDigestAlgorithm())
+
+	require.Len(t, metadata.SigningCertificates, 2)
+	assert.Equal(t, "CN=current", metadata.SigningCertificates[0].GetSubject())
+	assert.Equal(t, "CN=expired", metadata.SigningCertificates[1].GetSubject())
+
+	current, err := ParseCertificate(certificates[0])
+	require.NoError(t, err)
+	assert.Equal(t, current.GetThumbprintSHA1(), metadata.SigningCertificates[0].GetThumbprintSHA1())
+
+	require.Len(t, metadata.Warnings, 2)
+	assert.Contains(t, metadata.Warnings[0], "expired on")
+	assert.Contains(t, metadata.Warnings[1], `digest algorithm "http://www.w3.org/2001/04/xmlenc#sha512" is not supported`)
+
+	t.Run("Applies to the connection options", func(t *testing.T) {
+		options := &ConnectionOptionsSAML{Debug: authok.Bool(true)}
+		options.ApplyMetadata(metadata)
+
+		assert.True(t, options.GetDebug())
+		assert.Equal(t, "https://idp.example.com/saml", options.GetEntityID())
+		assert.Equal(t, "https://idp.example.com/saml/redirect", options.GetSignInEndpoint())
+		assert.Equal(t, SAMLBindingHTTPRedirect, options.GetProtocolBinding())
+		assert.Equal(t, "https://idp.example.com/saml/logout", options.GetSignOutEndpoint())
+		assert.Equal(t, "rsa-sha256", options.GetSignatureAlgorithm())
+		assert.Equal(t, "sha256", options.GetDigestAglorithm())
+		assert.Equal(t, []interface{}{
+			metadata.SigningCertificates[0].GetThumbprintSHA1(),
+			metadata.SigningCertificates[1].GetThumbprintSHA1(),
+		}, options.Thumbprints)
+
+		signingCert, err := base64.StdEncoding.DecodeString(options.GetSigningCert())
+		require.NoError(t, err)
+		assert.Equal(t, current.GetPEM(), string(signingCert))
+
+		parsed, err := ParseCertificate(options.GetSigningCert())
+		require.NoError(t, err)
+		assert.Equal(t, current.GetThumbprintSHA1(), parsed.GetThumbprintSHA1())
+	})
+}
+
+func TestParseSAMLMetadata_EntitiesDescriptor(t *testing.T) {
+	data, _ := newTestSAMLMetadata(t)
+	entity := strings.SplitN(string(data), "?>", 2)[1]
+
+	wrapped := `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata">
+  <EntityDescriptor entityID="https://sp.example.com"><SPSSODescriptor/></EntityDescriptor>` + entity + `
+</EntitiesDescriptor>`
+
+	metadata, err := ParseSAMLMetadata([]byte(wrapped))
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp.example.com/saml", metadata.GetEntityID())
+}
+
+func TestParseSAMLMetadata_Errors(t *testing.T) {
+	ecdsaCertificate := base64.StdEncoding.EncodeToString(
+		newTestCertificate(t, "ecdsa", time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour), newTestECDSAKey(t)),
+	)
+
+	var testCases = []struct {
+		name     string
+		metadata string
+		err      string
+		warning  string
+	}{
+		{
+			name:     "not xml",
+			metadata: "{}",
+			err:      "failed to decode the SAML metadata",
+		},
+		{
+			name:     "service provider",
+			metadata: `<EntityDescriptor entityID="sp"><SPSSODescriptor/></EntityDescriptor>`,
+			err:      "the SAML metadata does not describe an identity provider",
+		},
+		{
+			name: "unsupported binding",
+			metadata: `<EntityDescriptor entityID="idp"><IDPSSODescriptor>
+				<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP" Location="https://idp"/>
+			</IDPSSODescriptor></EntityDescriptor>`,
+			err: "the SAML metadata has no SingleSignOnService with a supported binding",
+		},
+		{
+			name: "no signing certificate",
+			metadata: `<EntityDescriptor entityID="idp"><IDPSSODescriptor>
+				<KeyDescriptor use="signing"><KeyInfo><X509Data><X509Certificate>bm9wZQ==</X509Certificate></X509Data></KeyInfo></KeyDescriptor>
+				<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp"/>
+			</IDPSSODescriptor></EntityDescriptor>`,
+			err: "the SAML metadata has no valid signing certificate",
+		},
+		{
+			name: "unsupported key",
+			metadata: `<EntityDescriptor entityID="idp"><IDPSSODescriptor>
+				<KeyDescriptor use="signing"><KeyInfo><X509Data><X509Certificate>` + ecdsaCertificate + `</X509Certificate></X509Data></KeyInfo></KeyDescriptor>
+				<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp"/>
+			</IDPSSODescriptor></EntityDescriptor>`,
+			warning: "uses an unsupported ECDSA key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			metadata, err := ParseSAMLMetadata([]byte(testCase.metadata))
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, metadata.Warnings, 1)
+			assert.Contains(t, metadata.Warnings[0], testCase.warning)
+		})
+	}
+}
+
+func TestFetchSAMLMetadata(t *testing.T) {
+	data, _ := newTestSAMLMetadata(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+
+	metadata, err := FetchSAMLMetadata(context.Background(), server.Client(), server.URL+"/metadata")
+	require.NoError(t, err)
+	assert.Equal(t, server.URL+"/metadata", metadata.GetMetadataURL())
+	assert.Equal(t, string(data), metadata.GetMetadataXML())
+
+	_, err = FetchSAMLMetadata(context.Background(), server.Client(), server.URL+"/missing")
+	assert.Error(t, err)
+}
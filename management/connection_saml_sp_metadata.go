@@ -0,0 +1,195 @@
+package management
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// SAML name identifier formats advertised in service provider metadata.
+const (
+	samlNameIDFormatEmail      = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	samlNameIDFormatPersistent = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	samlNameIDFormatTransient  = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+	samlProtocol               = "urn:oasis:names:tc:SAML:2.0:protocol"
+)
+
+// SAMLServiceProvider describes the Authok side of a SAML connection, as it
+// has to be configured in the identity provider.
+type SAMLServiceProvider struct {
+	// The domain the identity provider sends users back to. Either the
+	// tenant domain or a custom domain.
+	Domain *string `json:"domain,omitempty"`
+
+	// The name of the tenant, used in the entity ID.
+	Tenant *string `json:"tenant,omitempty"`
+
+	// The SAML connection.
+	Connection *Connection `json:"connection,omitempty"`
+
+	// The certificate used to sign SAML requests, in PEM form. Defaults to
+	// the signing key of the connection.
+	SigningCert *string `json:"signing_cert,omitempty"`
+}
+
+// EntityID returns the entity ID of the service provider, in the form
+// "urn:authok:{tenant}:{connection}".
+func (sp *SAMLServiceProvider) EntityID() string {
+	return fmt.Sprintf("urn:authok:%s:%s", sp.GetTenant(), sp.Connection.GetName())
+}
+
+// AssertionConsumerServiceURL returns the URL the identity provider posts
+// its responses to.
+func (sp *SAMLServiceProvider) AssertionConsumerServiceURL() string {
+	return fmt.Sprintf("https://%s/login/callback?connection=%s", sp.GetDomain(), url.QueryEscape(sp.Connection.GetName()))
+}
+
+// LogoutURL returns the URL the identity provider sends logout requests and
+// responses to.
+func (sp *SAMLServiceProvider) LogoutURL() string {
+	return fmt.Sprintf("https://%s/logout", sp.GetDomain())
+}
+
+type samlSPEntityDescriptor struct {
+	XMLName         xml.Name            `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string              `xml:"entityID,attr"`
+	SPSSODescriptor samlSPSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type samlSPSSODescriptor struct {
+	AuthnRequestsSigned        bool                    `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool                    `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string                  `xml:"protocolSupportEnumeration,attr"`
+	KeyDescriptors             []samlSPKeyDescriptor   `xml:"KeyDescriptor,omitempty"`
+	SingleLogoutServices       []samlSPEndpoint        `xml:"SingleLogoutService"`
+	NameIDFormats              []string                `xml:"NameIDFormat"`
+	AssertionConsumerServices  []samlSPIndexedEndpoint `xml:"AssertionConsumerService"`
+}
+
+type samlSPKeyDescriptor struct {
+	Use     string        `xml:"use,attr"`
+	KeyInfo samlSPKeyInfo `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+}
+
+type samlSPKeyInfo struct {
+	Certificate string `xml:"http://www.w3.org/2000/09/xmldsig# X509Data>X509Certificate"`
+}
+
+type samlSPEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+type samlSPIndexedEndpoint struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+// MetadataXML generates the service provider metadata to hand to the
+// administrator of the identity provider.
+func (sp *SAMLServiceProvider) MetadataXML() ([]byte, error) {
+	if sp.GetDomain() == "" {
+		return nil, fmt.Errorf("a domain is required to generate the SAML metadata")
+	}
+	if sp.GetTenant() == "" {
+		return nil, fmt.Errorf("a tenant is required to generate the SAML metadata")
+	}
+	if sp.Connection.GetName() == "" {
+		return nil, fmt.Errorf("a connection name is required to generate the SAML metadata")
+	}
+
+	options, ok := sp.Connection.Options.(*ConnectionOptionsSAML)
+	if sp.Connection.GetStrategy() != ConnectionStrategySAML || (!ok && sp.Connection.Options != nil) {
+		return nil, fmt.Errorf("connection %q is not a SAML connection", sp.Connection.GetName())
+	}
+
+	signingCert := sp.GetSigningCert()
+	if signingCert == "" && options != nil && options.SigningKey != nil {
+		signingCert = options.SigningKey.GetCert()
+	}
+
+	descriptor := samlSPSSODescriptor{
+		AuthnRequestsSigned:        options.GetSignSAMLRequest(),
+		WantAssertionsSigned:       true,
+		ProtocolSupportEnumeration: samlProtocol,
+		SingleLogoutServices: []samlSPEndpoint{
+			{Binding: SAMLBindingHTTPRedirect, Location: sp.LogoutURL()},
+			{Binding: SAMLBindingHTTPPost, Location: sp.LogoutURL()},
+		},
+		NameIDFormats: []string{
+			samlNameIDFormatEmail,
+			samlNameIDFormatPersistent,
+			samlNameIDFormatTransient,
+		},
+		AssertionConsumerServices: []samlSPIndexedEndpoint{
+			{Binding: SAMLBindingHTTPPost, Location: sp.AssertionConsumerServiceURL(), Index: 0, IsDefault: true},
+		},
+	}
+
+	if signingCert != "" {
+		certificate, err := ParseCertificate(signingCert)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode([]byte(certificate.GetPEM()))
+		descriptor.KeyDescriptors = []samlSPKeyDescriptor{{
+			Use:     "signing",
+			KeyInfo: samlSPKeyInfo{Certificate: base64.StdEncoding.EncodeToString(block.Bytes)},
+		}}
+	}
+
+	b, err := xml.MarshalIndent(samlSPEntityDescriptor{
+		EntityID:        sp.EntityID(),
+		SPSSODescriptor: descriptor,
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the SAML metadata: %w", err)
+	}
+
+	return append([]byte(xml.Header), b...), nil
+}
+
+// ServiceProviderMetadata generates the SAML service provider metadata of a
+// SAML connection, to hand to the administrator of the identity provider.
+//
+// The metadata uses the tenant domain, unless a custom domain is given. When
+// the connection has no signing key of its own, the current signing key of
+// the tenant is used instead.
+func (m *ConnectionManager) ServiceProviderMetadata(id string, customDomain *CustomDomain, opts ...RequestOption) ([]byte, error) {
+	c, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	domain := m.url.Host
+	tenant := strings.SplitN(domain, ".", 2)[0]
+	if customDomain.GetDomain() != "" {
+		domain = customDomain.GetDomain()
+	}
+
+	sp := &SAMLServiceProvider{
+		Domain:     &domain,
+		Tenant:     &tenant,
+		Connection: c,
+	}
+
+	if options, ok := c.Options.(*ConnectionOptionsSAML); !ok || options.SigningKey.GetCert() == "" {
+		keys, err := m.Management.SigningKey.List(opts...)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if key.GetCurrent() {
+				sp.SigningCert = key.Cert
+				break
+			}
+		}
+	}
+
+	return sp.MetadataXML()
+}
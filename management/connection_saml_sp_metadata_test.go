@@ -0,0 +1,99 @@
+package management
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func TestSAMLServiceProvider_MetadataXML(t *testing.T) {
+	der := newTestCertificate(t, "sp", time.Now().Add(-time.Hour), time.Now().Add(time.Hour), nil)
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	sp := &SAMLServiceProvider{
+		Domain: authok.String("login.example.com"),
+		Tenant: authok.String("example"),
+		Connection: &Connection{
+			Name:     authok.String("acme & co"),
+			Strategy: authok.String(ConnectionStrategySAML),
+			Options: &ConnectionOptionsSAML{
+				SignSAMLRequest: authok.Bool(true),
+				SigningKey:      &ConnectionOptionsSAMLSigningKey{Cert: &certificate},
+			},
+		},
+	}
+
+	assert.Equal(t, "urn:authok:example:acme & co", sp.EntityID())
+	assert.Equal(t, "https://login.example.com/login/callback?connection=acme+%26+co", sp.AssertionConsumerServiceURL())
+	assert.Equal(t, "https://login.example.com/logout", sp.LogoutURL())
+
+	metadata, err := sp.MetadataXML()
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(metadata), xml.Header))
+
+	var descriptor struct {
+		EntityID string `xml:"entityID,attr"`
+		SP       struct {
+			AuthnRequestsSigned bool     `xml:"AuthnRequestsSigned,attr"`
+			Certificate         string   `xml:"KeyDescriptor>KeyInfo>X509Data>X509Certificate"`
+			NameIDFormats       []string `xml:"NameIDFormat"`
+			Logout              []struct {
+				Binding  string `xml:"Binding,attr"`
+				Location string `xml:"Location,attr"`
+			} `xml:"SingleLogoutService"`
+			ACS struct {
+				Binding  string `xml:"Binding,attr"`
+				Location string `xml:"Location,attr"`
+			} `xml:"AssertionConsumerService"`
+		} `xml:"SPSSODescriptor"`
+	}
+	require.NoError(t, xml.Unmarshal(metadata, &descriptor))
+
+	assert.Equal(t, "urn:authok:example:acme & co", descriptor.EntityID)
+	assert.True(t, descriptor.SP.AuthnRequestsSigned)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(der), descriptor.SP.Certificate)
+	assert.Contains(t, descriptor.SP.NameIDFormats, "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress")
+	require.Len(t, descriptor.SP.Logout, 2)
+	assert.Equal(t, "https://login.example.com/logout", descriptor.SP.Logout[0].Location)
+	assert.Equal(t, SAMLBindingHTTPPost, descriptor.SP.ACS.Binding)
+	assert.Equal(t, sp.AssertionConsumerServiceURL(), descriptor.SP.ACS.Location)
+
+	t.Run("Requires a SAML connection", func(t *testing.T) {
+		sp := *sp
+		sp.Connection = sp.Connection.Clone()
+		sp.Connection.Strategy = authok.String(ConnectionStrategyOIDC)
+
+		_, err := sp.MetadataXML()
+		assert.EqualError(t, err, `connection "acme & co" is not a SAML connection`)
+	})
+}
+
+func TestConnectionManager_ServiceProviderMetadata(t *testing.T) {
+	der := newTestCertificate(t, "tenant", time.Now().Add(-time.Hour), time.Now().Add(time.Hour), nil)
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	api, m := startFakeAPI(t)
+	api.put("/connections/con_123", map[string]interface{}{
+		"id":       "con_123",
+		"name":     "acme",
+		"strategy": "samlp",
+		"options":  map[string]interface{}{},
+	})
+	api.put("/keys/signing/previous", map[string]interface{}{"kid": "previous", "previous": true})
+	api.put("/keys/signing/current", map[string]interface{}{"kid": "current", "current": true, "cert": certificate})
+
+	metadata, err := m.Connection.ServiceProviderMetadata("con_123", &CustomDomain{Domain: authok.String("login.acme.com")})
+	require.NoError(t, err)
+
+	assert.Contains(t, string(metadata), `entityID="urn:authok:127:acme"`)
+	assert.Contains(t, string(metadata), `Location="https://login.acme.com/login/callback?connection=acme"`)
+	assert.Contains(t, string(metadata), base64.StdEncoding.EncodeToString(der))
+}
@@ -6,7 +6,9 @@
 //go:build ignore
 // +build ignore
 
-// gen-methods generates accessor methods for structs with pointer fields.
+// gen-methods generates accessor methods for structs with pointer fields, as
+// well as Clone, Equal and Diff methods for the structs listed in
+// cloneStructs and those they refer to.
 //
 // This code has been copied from https://github.com/google/go-github, and it's subject to its licence.
 package main
@@ -18,6 +20,7 @@ import (
 	"go/ast"
 	"go/format"
 	"go/parser"
+	"go/printer"
 	"go/token"
 	"io/ioutil"
 	"log"
@@ -27,6 +30,7 @@ import (
 	"strings"
 	"text/template"
 	"time"
+	"unicode"
 )
 
 const (
@@ -46,6 +50,34 @@ var (
 		"Management",
 		".*Manager",
 	}
+	// cloneStructs lists the resources to generate Clone, Equal and Diff
+	// methods for in regex format. The structs they refer to get them too.
+	cloneStructs = []string{
+		"Action", "ActionBinding", "ActionExecution", "ActionTrigger", "ActionVersion",
+		"AuthenticationMethod",
+		"BlacklistToken",
+		"Branding", "BrandingTheme", "BrandingUniversalLogin",
+		"BreachedPasswordDetection", "BruteForceProtection", "SuspiciousIPThrottling",
+		"Client", "ClientGrant",
+		"Connection", "ConnectionOptions.*",
+		"CustomDomain",
+		"DailyStat",
+		"Email", "EmailProvider.*", "EmailTemplate",
+		"Enrollment", "CreateEnrollmentTicket", "EnrollmentTicket", "MultiFactor.*", "PhoneMessageTypes",
+		"Grant",
+		"Hook",
+		"Job", "JobError",
+		"Log", "LogStream", "LogStreamSink.*",
+		"Organization", "OrganizationConnection", "OrganizationInvitation", "OrganizationMember", "OrganizationMemberRole",
+		"Prompt",
+		"ResourceServer",
+		"Role", "Permission",
+		"Rule", "RuleConfig",
+		"SigningKey",
+		"Tenant",
+		"Ticket",
+		"User", "UserBlock", "UserEnrollment", "UserIdentityLink", "UserRecoveryCode",
+	}
 )
 
 func logf(fmt string, args ...interface{}) {
@@ -70,6 +102,16 @@ func main() {
 			Year:     time.Now().Year(),
 			Package:  pkgName,
 			Imports:  map[string]string{},
+			structs:  map[string]bool{},
+		}
+		all := map[string]*ast.StructType{}
+		for _, f := range pkg.Files {
+			if err := collectStructs(f, all); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := t.collectCloneStructs(all); err != nil {
+			log.Fatal(err)
 		}
 		for filename, f := range pkg.Files {
 			logf("Processing %v...", filename)
@@ -84,6 +126,83 @@ func main() {
 	logf("Done.")
 }
 
+// collectStructs records the exported structs which are not skipped by name.
+func collectStructs(f *ast.File, structs map[string]*ast.StructType) error {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			skip, err := skipStruct(ts.Name.String())
+			if err != nil {
+				return err
+			}
+			if !skip {
+				structs[ts.Name.String()] = st
+			}
+		}
+	}
+	return nil
+}
+
+// collectCloneStructs records the names of the structs which will have Clone,
+// Equal and Diff methods generated for them: those listed in cloneStructs and
+// the structs their fields refer to, so that these can be deep copied and
+// compared using the generated methods.
+func (t *templateData) collectCloneStructs(all map[string]*ast.StructType) error {
+	var add func(name string)
+	add = func(name string) {
+		st, ok := all[name]
+		if !ok || t.structs[name] {
+			return
+		}
+		t.structs[name] = true
+		for _, field := range st.Fields.List {
+			ast.Inspect(field.Type, func(n ast.Node) bool {
+				if ident, ok := n.(*ast.Ident); ok {
+					add(ident.Name)
+				}
+				return true
+			})
+		}
+	}
+
+	for _, pattern := range cloneStructs {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return err
+		}
+		for name := range all {
+			if re.MatchString(name) {
+				add(name)
+			}
+		}
+	}
+	return nil
+}
+
+func skipStruct(name string) (bool, error) {
+	for _, pattern := range skipStructs {
+		match, err := regexp.Match(pattern, []byte(name))
+		if err != nil {
+			return false, err
+		}
+		if match {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (t *templateData) processAST(f *ast.File) error {
 	for _, decl := range f.Decls {
 		gd, ok := decl.(*ast.GenDecl)
@@ -120,6 +239,11 @@ func (t *templateData) processAST(f *ast.File) error {
 			// Add stringer method
 			t.addStringer(ts.Name.String())
 
+			// Add Clone, Equal and Diff methods
+			if t.structs[ts.Name.String()] {
+				t.addCloneAndDiff(ts.Name.String(), st)
+			}
+
 			for _, field := range st.Fields.List {
 				if len(field.Names) == 0 {
 					continue
@@ -322,12 +446,208 @@ func (t *templateData) addSelectorExpr(x *ast.SelectorExpr, receiverType, fieldN
 	}
 }
 
+func (t *templateData) addCloneAndDiff(receiverType string, st *ast.StructType) {
+	g := &getter{
+		sortVal:      strings.ToLower(receiverType) + ".zzzz",
+		ReceiverVar:  strings.ToLower(receiverType[:1]),
+		ReceiverType: receiverType,
+		CloneAndDiff: true,
+	}
+
+	for _, field := range st.Fields.List {
+		var fieldName, path string
+		if len(field.Names) == 0 {
+			// Embedded structs are encoded inline unless they have a name.
+			fieldName = strings.TrimPrefix(typeString(field.Type), "*")
+			if skip, _ := skipStruct(fieldName); skip {
+				// Embedded clients are shared rather than copied or compared.
+				g.CloneFields = append(g.CloneFields, fmt.Sprintf("clone.%v = %v.%v", fieldName, g.ReceiverVar, fieldName))
+				continue
+			}
+			path = jsonName(field, "")
+		} else {
+			fieldName = field.Names[0].String()
+			if !field.Names[0].IsExported() {
+				logf("Field %v is unexported; skipping.", fieldName)
+				continue
+			}
+			path = jsonName(field, snakeCase(fieldName))
+		}
+
+		lhs := g.ReceiverVar + "." + fieldName
+		rhs := "other." + fieldName
+		g.CloneFields = append(g.CloneFields, fmt.Sprintf("clone.%v = %v", fieldName, t.cloneExpr(field.Type, lhs)))
+		g.DiffFields = append(g.DiffFields, t.diffStmt(field.Type, path, lhs, rhs))
+	}
+
+	t.Getters = append(t.Getters, g)
+}
+
+// cloneExpr returns an expression which evaluates to a deep copy of src.
+func (t *templateData) cloneExpr(typ ast.Expr, src string) string {
+	switch x := typ.(type) {
+	case *ast.Ident:
+		if t.structs[x.Name] {
+			return "*" + src + ".Clone()"
+		}
+		return src
+	case *ast.SelectorExpr:
+		return src
+	case *ast.InterfaceType:
+		return "cloneValue(" + src + ")"
+	case *ast.StarExpr:
+		if ident, ok := x.X.(*ast.Ident); ok && t.structs[ident.Name] {
+			return src + ".Clone()"
+		}
+		if inner := t.cloneExpr(x.X, "v"); inner != "v" {
+			return fmt.Sprintf("clonePointerFunc(%v, func(v %v) %v { return %v })", src, typeString(x.X), typeString(x.X), inner)
+		}
+		return "clonePointer(" + src + ")"
+	case *ast.ArrayType:
+		if inner := t.cloneExpr(x.Elt, "v"); inner != "v" {
+			return fmt.Sprintf("cloneSliceFunc(%v, func(v %v) %v { return %v })", src, typeString(x.Elt), typeString(x.Elt), inner)
+		}
+		return "cloneSlice(" + src + ")"
+	case *ast.MapType:
+		if inner := t.cloneExpr(x.Value, "v"); inner != "v" {
+			return fmt.Sprintf("cloneMapFunc(%v, func(v %v) %v { return %v })", src, typeString(x.Value), typeString(x.Value), inner)
+		}
+		return "cloneMap(" + src + ")"
+	default:
+		logf("cloneExpr: unknown type %T for %v; copying as is.", typ, src)
+		return src
+	}
+}
+
+// diffStmt returns a statement which appends the changes between a and b to
+// the changes slice.
+func (t *templateData) diffStmt(typ ast.Expr, path, a, b string) string {
+	fieldPath := `prefix+"` + path + `"`
+	nestedPath := `prefix+"` + path + `."`
+	if path == "" {
+		nestedPath = "prefix"
+	}
+
+	switch x := typ.(type) {
+	case *ast.Ident:
+		if t.structs[x.Name] {
+			return fmt.Sprintf("changes = append(changes, %v.diff(%v, &%v)...)", a, nestedPath, b)
+		}
+		if isComparable(x.Name) {
+			return fmt.Sprintf("changes = diffComparable(changes, %v, %v, %v)", fieldPath, a, b)
+		}
+	case *ast.StarExpr:
+		switch xx := x.X.(type) {
+		case *ast.Ident:
+			if t.structs[xx.Name] {
+				return fmt.Sprintf("changes = append(changes, %v.diff(%v, %v)...)", a, nestedPath, b)
+			}
+			if isComparable(xx.Name) {
+				return fmt.Sprintf("changes = diffPointer(changes, %v, %v, %v)", fieldPath, a, b)
+			}
+		case *ast.SelectorExpr:
+			if typeString(xx) == "time.Time" {
+				return fmt.Sprintf("changes = diffTime(changes, %v, %v, %v)", fieldPath, a, b)
+			}
+		case *ast.ArrayType:
+			return fmt.Sprintf("changes = diffSlicePointer(changes, %v, %v, %v)", fieldPath, a, b)
+		case *ast.MapType:
+			if key, ok := xx.Key.(*ast.Ident); ok && key.Name == "string" {
+				return fmt.Sprintf("changes = diffMapPointer(changes, %v, %v, %v)", fieldPath, a, b)
+			}
+		}
+	case *ast.ArrayType:
+		return fmt.Sprintf("changes = diffSlice(changes, %v, %v, %v)", fieldPath, a, b)
+	case *ast.MapType:
+		if key, ok := x.Key.(*ast.Ident); ok && key.Name == "string" {
+			return fmt.Sprintf("changes = diffMap(changes, %v, %v, %v)", fieldPath, a, b)
+		}
+	}
+
+	return fmt.Sprintf("changes = diffValue(changes, %v, %v, %v)", fieldPath, a, b)
+}
+
+func isComparable(name string) bool {
+	switch name {
+	case "string", "bool", "int", "int64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+func typeString(typ ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), typ); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// jsonName returns the name used for the field in its JSON representation.
+// Fields which are left out by their tag are usually encoded by a custom
+// marshaller, so their name is derived from the field name instead.
+func jsonName(field *ast.Field, fallback string) string {
+	if field.Tag == nil {
+		return fallback
+	}
+	tag := reflectTag(strings.Trim(field.Tag.Value, "`"), "json")
+	name := strings.Split(tag, ",")[0]
+	if name == "" || name == "-" {
+		return fallback
+	}
+	return name
+}
+
+func reflectTag(tag, key string) string {
+	for tag != "" {
+		i := strings.Index(tag, ":")
+		if i < 0 {
+			break
+		}
+		name := strings.TrimSpace(tag[:i])
+		tag = tag[i+1:]
+		if !strings.HasPrefix(tag, `"`) {
+			break
+		}
+		end := strings.Index(tag[1:], `"`)
+		if end < 0 {
+			break
+		}
+		value := tag[1 : end+1]
+		tag = tag[end+2:]
+		if name == key {
+			return value
+		}
+	}
+	return ""
+}
+
+// snakeCase converts a Go field name into its snake case form, keeping
+// initialisms together. For example "EmailVerified" becomes "email_verified"
+// and "UserID" becomes "user_id".
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || (unicode.IsUpper(runes[i-1]) && nextLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
 type templateData struct {
 	filename string
 	Year     int
 	Package  string
 	Imports  map[string]string
 	Getters  []*getter
+	structs  map[string]bool
 }
 
 type getter struct {
@@ -340,6 +660,9 @@ type getter struct {
 	NamedStruct  bool // Getter for named struct.
 	MapType      bool
 	Stringer     bool // Used for the structs String method.
+	CloneAndDiff bool // Used for the structs Clone, Equal and Diff methods.
+	CloneFields  []string
+	DiffFields   []string
 }
 
 type byName []*getter
@@ -381,6 +704,56 @@ func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() {{.FieldType}} {
 func ({{.ReceiverVar}} *{{.ReceiverType}}) String() string {
   return Stringify({{.ReceiverVar}})
 }
+{{else if .CloneAndDiff}}
+// Clone returns a deep copy of {{.ReceiverType}}.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Clone() *{{.ReceiverType}} {
+  if {{.ReceiverVar}} == nil {
+    return nil
+  }
+  clone := &{{.ReceiverType}}{}
+  {{- range .CloneFields}}
+  {{.}}
+  {{- end}}
+  return clone
+}
+
+func ({{.ReceiverVar}} *{{.ReceiverType}}) cloneAny() interface{} {
+  return {{.ReceiverVar}}.Clone()
+}
+
+// Equal reports whether {{.ReceiverType}} and other hold the same values.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Equal(other *{{.ReceiverType}}) bool {
+  return len({{.ReceiverVar}}.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between {{.ReceiverType}} and other.
+func ({{.ReceiverVar}} *{{.ReceiverType}}) Diff(other *{{.ReceiverType}}) []FieldChange {
+  return {{.ReceiverVar}}.diff("", other)
+}
+
+func ({{.ReceiverVar}} *{{.ReceiverType}}) diff(prefix string, other *{{.ReceiverType}}) (changes []FieldChange) {
+  if {{.ReceiverVar}} == nil && other == nil {
+    return nil
+  }
+  if {{.ReceiverVar}} == nil {
+    {{.ReceiverVar}} = &{{.ReceiverType}}{}
+  }
+  if other == nil {
+    other = &{{.ReceiverType}}{}
+  }
+  {{- range .DiffFields}}
+  {{.}}
+  {{- end}}
+  return changes
+}
+
+func ({{.ReceiverVar}} *{{.ReceiverType}}) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+  typed, ok := other.(*{{.ReceiverType}})
+  if !ok {
+    return nil, false
+  }
+  return {{.ReceiverVar}}.diff(prefix, typed), true
+}
 {{else}}
 // Get{{.FieldName}} returns the {{.FieldName}} field if it's non-nil, zero value otherwise.
 func ({{.ReceiverVar}} *{{.ReceiverType}}) Get{{.FieldName}}() {{.FieldType}} {
@@ -432,6 +805,28 @@ func Test{{ .ReceiverType }}_String(t *testing.T) {
     t.Errorf("failed to produce a valid json")
   }
 }
+{{else if .CloneAndDiff}}
+func Test{{ .ReceiverType }}_Clone(t *testing.T) {
+  var v *{{ .ReceiverType }}
+  if v.Clone() != nil {
+    t.Errorf("expected the clone of nil to be nil")
+  }
+  v = &{{ .ReceiverType }}{}
+  clone := v.Clone()
+  if clone == v {
+    t.Errorf("expected the clone to be a new value")
+  }
+  if !v.Equal(clone) {
+    t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+  }
+  v = &{{ .ReceiverType }}{}
+  populate(v)
+  clone = v.Clone()
+  if !v.Equal(clone) {
+    t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+  }
+  assertNotAliased(t, v, clone)
+}
 {{else}}
 func Test{{.ReceiverType}}_Get{{.FieldName}}(tt *testing.T) {
   var zeroValue {{.FieldType}}
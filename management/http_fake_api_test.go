@@ -0,0 +1,656 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAPICollection describes how the Management API serves a collection of
+// resources, by the last segment of its path.
+type fakeAPICollection struct {
+	// The key the resources are listed under. Lists under "items" have their
+	// paging information under "meta", and collections without a key are
+	// listed as plain arrays.
+	key string
+
+	// The field identifying the resources, "id" unless set.
+	id string
+
+	// The top level collection holding the resources added to the
+	// collection by id, such as the users added as members of an
+	// organization.
+	refs string
+
+	// Another field the resources can be read by.
+	alias string
+}
+
+var fakeAPICollections = map[string]fakeAPICollection{
+	"actions":                {key: "actions"},
+	"authentication-methods": {key: "authenticators"},
+	"bindings":               {key: "bindings"},
+	"client-grants":          {key: "client_grants"},
+	"clients":                {key: "items", id: "client_id"},
+	"connections":            {key: "connections"},
+	"enabled_connections":    {key: "enabled_connections", id: "connection_id"},
+	"enrollments":            {},
+	"grants":                 {key: "grants"},
+	"hooks":                  {key: "hooks"},
+	"invitations":            {key: "invitations"},
+	"logs":                   {id: "log_id"},
+	"members":                {key: "items", id: "user_id", refs: "users"},
+	"organizations":          {key: "items"},
+	"permissions":            {key: "permissions"},
+	"resource-servers":       {key: "resource_servers", alias: "identifier"},
+	"roles":                  {key: "roles", refs: "roles"},
+	"rules":                  {key: "rules"},
+	"signing":                {id: "kid"},
+	"users":                  {key: "users", id: "user_id", refs: "users"},
+}
+
+// fakeAPIFilters are the query parameters lists are filtered by, along with
+// the field they match.
+var fakeAPIFilters = map[string]string{
+	"actionName":  "name",
+	"email":       "email",
+	"name":        "name",
+	"name_filter": "name",
+	"strategy":    "strategy",
+	"user_id":     "user_id",
+}
+
+// fakeAPI is an in-memory Management API for the tests of workflows that the
+// HTTP recordings cannot cover, such as those needing failures or concurrent
+// writers.
+//
+// It keeps resources as JSON objects by path, such as "/roles/rol_1" or
+// "/organizations/org_1/members/auth0|1", and serves them the way the
+// Management API does:
+//
+//   - GET reads a resource, or lists the resources of a collection, filtered
+//     by parameters such as name_filter and paged by page and page_size;
+//   - POST creates a resource, stamping invitations with their expiry, or adds
+//     resources to a collection by id, as in {"roles": ["rol_1"]};
+//   - PATCH merges the body into a resource, metadata included;
+//   - DELETE deletes a resource and those under it, or removes resources
+//     from a collection by id, or all of them without a body.
+//
+// The roles and organizations of users are served from the users of roles
+// and the members of organizations, and their enrollments from the Guardian
+// enrollments. Signing keys can be rotated and revoked.
+//
+// Requests are answered one at a time, so handlers registered with handle
+// need no locking of their own.
+type fakeAPI struct {
+	t *testing.T
+
+	mu        sync.Mutex
+	resources map[string]*fakeAPIResource
+	handlers  []fakeAPIHandler
+	failures  []*fakeAPIFailure
+	calls     []*fakeAPICall
+	seq       int
+	nextID    int
+
+	// How many resources a list page holds at most, or 0 for as many as
+	// asked for.
+	pageSize int
+}
+
+type fakeAPIResource struct {
+	seq  int
+	data map[string]interface{}
+}
+
+type fakeAPIHandler struct {
+	pattern string
+	handler http.HandlerFunc
+}
+
+type fakeAPIFailure struct {
+	pattern   string
+	status    int
+	times     int
+	exhausted bool
+}
+
+type fakeAPICall struct {
+	method string
+	path   string
+	query  url.Values
+	body   []byte
+}
+
+// startFakeAPI starts a fakeAPI and returns it along with a Management using
+// it.
+func startFakeAPI(t *testing.T) (*fakeAPI, *Management) {
+	t.Helper()
+
+	f := &fakeAPI{t: t, resources: map[string]*fakeAPIResource{}}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	m, err := New(server.URL, WithInsecure())
+	require.NoError(t, err)
+
+	return f, m
+}
+
+// handle answers the requests matching pattern with handler instead. Patterns
+// are a method and a path, such as "GET /roles/*/users", where * stands for
+// any segment of the path and a trailing ** for any number of them. The
+// handler can call serve to answer as the fake would have.
+func (f *fakeAPI) handle(pattern string, handler http.HandlerFunc) {
+	f.handlers = append(f.handlers, fakeAPIHandler{pattern, handler})
+}
+
+// fail fails the requests matching pattern with status, the given number of
+// times or every time if times is 0.
+func (f *fakeAPI) fail(pattern string, status, times int) {
+	f.failures = append(f.failures, &fakeAPIFailure{pattern: pattern, status: status, times: times})
+}
+
+// put stores a resource at path, replacing any resource already there.
+func (f *fakeAPI) put(path string, resource interface{}) {
+	b, err := json.Marshal(resource)
+	require.NoError(f.t, err)
+	var data map[string]interface{}
+	require.NoError(f.t, json.Unmarshal(b, &data))
+
+	if existing, ok := f.resources[path]; ok {
+		existing.data = data
+		return
+	}
+	f.seq++
+	f.resources[path] = &fakeAPIResource{seq: f.seq, data: data}
+}
+
+// get returns the resource at path, or nil if there is none. Changing it
+// changes the resource.
+func (f *fakeAPI) get(path string) map[string]interface{} {
+	if resource, ok := f.resources[path]; ok {
+		return resource.data
+	}
+	return nil
+}
+
+// remove deletes the resource at path and those under it.
+func (f *fakeAPI) remove(path string) {
+	for p := range f.resources {
+		if p == path || strings.HasPrefix(p, path+"/") {
+			delete(f.resources, p)
+		}
+	}
+}
+
+// paths returns the paths of the resources stored, sorted.
+func (f *fakeAPI) paths() []string {
+	paths := []string{}
+	for path := range f.resources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// requests returns the method and path of the requests received, such as
+// "GET /roles/rol_1".
+func (f *fakeAPI) requests() []string {
+	requests := []string{}
+	for _, call := range f.calls {
+		requests = append(requests, call.method+" "+call.path)
+	}
+	return requests
+}
+
+// bodies returns the bodies of the requests received matching pattern.
+func (f *fakeAPI) bodies(pattern string) []map[string]interface{} {
+	var bodies []map[string]interface{}
+	for _, call := range f.calls {
+		if matchFakeAPIPattern(pattern, call.method, call.path) {
+			var body map[string]interface{}
+			require.NoError(f.t, json.Unmarshal(call.body, &body))
+			bodies = append(bodies, body)
+		}
+	}
+	return bodies
+}
+
+// queries returns the query parameters of the requests received matching
+// pattern.
+func (f *fakeAPI) queries(pattern string) []url.Values {
+	var queries []url.Values
+	for _, call := range f.calls {
+		if matchFakeAPIPattern(pattern, call.method, call.path) {
+			queries = append(queries, call.query)
+		}
+	}
+	return queries
+}
+
+// forget forgets the requests received so far.
+func (f *fakeAPI) forget() {
+	f.calls = nil
+}
+
+// fakeAPIResourceAs returns the resource at path decoded as a T, or nil if
+// there is none.
+func fakeAPIResourceAs[T any](f *fakeAPI, path string) *T {
+	data := f.get(path)
+	if data == nil {
+		return nil
+	}
+	b, err := json.Marshal(data)
+	require.NoError(f.t, err)
+	var v T
+	require.NoError(f.t, json.Unmarshal(b, &v))
+	return &v
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	r.URL.Path = strings.TrimPrefix(r.URL.Path, "/api/v1")
+	r.URL.RawPath = strings.TrimPrefix(r.URL.RawPath, "/api/v1")
+	body, err := io.ReadAll(r.Body)
+	require.NoError(f.t, err)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	f.calls = append(f.calls, &fakeAPICall{r.Method, r.URL.Path, r.URL.Query(), body})
+
+	for _, failure := range f.failures {
+		if !failure.exhausted && matchFakeAPIPattern(failure.pattern, r.Method, r.URL.Path) {
+			if failure.times--; failure.times == 0 {
+				failure.exhausted = true
+			}
+			writeFakeAPIError(w, failure.status, http.StatusText(failure.status))
+			return
+		}
+	}
+	for _, h := range f.handlers {
+		if matchFakeAPIPattern(h.pattern, r.Method, r.URL.Path) {
+			h.handler(w, r)
+			return
+		}
+	}
+	f.serve(w, r)
+}
+
+// serve answers a request as described on fakeAPI.
+func (f *fakeAPI) serve(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	name := segments[len(segments)-1]
+	collection, isCollection := fakeAPICollections[name]
+	body := map[string]interface{}{}
+	if b := f.calls[len(f.calls)-1].body; len(b) > 0 {
+		require.NoError(f.t, json.Unmarshal(b, &body))
+	}
+
+	if isCollection && len(segments) > 2 && f.get(parentPath(path)) == nil {
+		writeFakeAPIError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	if f.serveView(w, r, segments, body) || f.serveSigningKeyAction(w, r, segments) {
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && isCollection:
+		f.writeList(w, r, collection, f.children(path))
+	case r.Method == http.MethodGet:
+		resource := f.find(path)
+		if resource == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		writeFakeAPIJSON(w, http.StatusOK, resource)
+	case r.Method == http.MethodPost && isCollection:
+		if ids, ok := body[name].([]interface{}); ok && len(body) == 1 {
+			for _, id := range ids {
+				f.add(path, collection, id)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		id, _ := body[collection.idField()].(string)
+		if id == "" {
+			f.nextID++
+			id = fmt.Sprintf("id_%d", f.nextID)
+			body[collection.idField()] = id
+		}
+		if f.get(path+"/"+id) != nil {
+			writeFakeAPIError(w, http.StatusConflict, "Already exists")
+			return
+		}
+		if name == "invitations" {
+			stampFakeAPIInvitation(body)
+		}
+		f.put(path+"/"+id, body)
+		writeFakeAPIJSON(w, http.StatusCreated, body)
+	case r.Method == http.MethodPatch:
+		resource := f.find(path)
+		if resource == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		for key, value := range body {
+			patch, isObject := value.(map[string]interface{})
+			existing, wasObject := resource[key].(map[string]interface{})
+			switch {
+			case value == nil:
+				delete(resource, key)
+			case isObject && wasObject && strings.HasSuffix(key, "_metadata"):
+				for k, v := range patch {
+					if v == nil {
+						delete(existing, k)
+					} else {
+						existing[k] = v
+					}
+				}
+			default:
+				resource[key] = value
+			}
+		}
+		writeFakeAPIJSON(w, http.StatusOK, resource)
+	case r.Method == http.MethodDelete && isCollection:
+		if ids, ok := body[name].([]interface{}); ok {
+			for _, id := range ids {
+				f.remove(path + "/" + collection.idOf(id))
+			}
+		} else {
+			for _, child := range f.children(path) {
+				f.remove(path + "/" + collection.idOf(child))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		if f.get(path) == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		f.remove(path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Errorf("the fake Management API cannot answer %s %s", r.Method, path)
+		writeFakeAPIError(w, http.StatusNotImplemented, "Not Implemented")
+	}
+}
+
+// serveView answers the requests for collections derived from others.
+func (f *fakeAPI) serveView(w http.ResponseWriter, r *http.Request, segments []string, body map[string]interface{}) bool {
+	switch {
+	case len(segments) == 1 && segments[0] == "users-by-email" && r.Method == http.MethodGet:
+		f.writeList(w, r, fakeAPICollection{}, f.children("/users"))
+	case len(segments) == 3 && segments[0] == "users" && segments[2] == "organizations" && r.Method == http.MethodGet:
+		var organizations []map[string]interface{}
+		for _, organization := range f.children("/organizations") {
+			if f.get("/organizations/"+organization["id"].(string)+"/members/"+segments[1]) != nil {
+				organizations = append(organizations, organization)
+			}
+		}
+		f.writeList(w, r, fakeAPICollections["organizations"], organizations)
+	case len(segments) == 3 && segments[0] == "users" && segments[2] == "roles":
+		switch r.Method {
+		case http.MethodGet:
+			var roles []map[string]interface{}
+			for _, role := range f.children("/roles") {
+				if f.get("/roles/"+role["id"].(string)+"/users/"+segments[1]) != nil {
+					roles = append(roles, role)
+				}
+			}
+			f.writeList(w, r, fakeAPICollections["roles"], roles)
+		case http.MethodPost, http.MethodDelete:
+			ids, _ := body["roles"].([]interface{})
+			for _, id := range ids {
+				if r.Method == http.MethodPost {
+					f.add("/roles/"+id.(string)+"/users", fakeAPICollections["users"], segments[1])
+				} else {
+					f.remove("/roles/" + id.(string) + "/users/" + segments[1])
+				}
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			return false
+		}
+	case len(segments) == 3 && segments[0] == "users" && segments[2] == "enrollments" && r.Method == http.MethodGet:
+		var enrollments []map[string]interface{}
+		for _, enrollment := range f.children("/guardian/enrollments") {
+			if enrollment["user_id"] == segments[1] {
+				enrollments = append(enrollments, enrollment)
+			}
+		}
+		f.writeList(w, r, fakeAPICollection{}, enrollments)
+	default:
+		return false
+	}
+	return true
+}
+
+// serveSigningKeyAction answers the requests rotating and revoking signing
+// keys. Rotating makes the next key current, the current key previous and
+// adds a new next key.
+func (f *fakeAPI) serveSigningKeyAction(w http.ResponseWriter, r *http.Request, segments []string) bool {
+	now := time.Now().Format(time.RFC3339Nano)
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/keys/signing/rotate":
+		for _, key := range f.children("/keys/signing") {
+			switch {
+			case key["current"] == true:
+				delete(key, "current")
+				key["previous"], key["current_until"] = true, now
+			case key["next"] == true:
+				delete(key, "next")
+				key["current"], key["current_since"] = true, now
+			case key["previous"] == true:
+				delete(key, "previous")
+			}
+		}
+		f.nextID++
+		kid := fmt.Sprintf("id_%d", f.nextID)
+		f.put("/keys/signing/"+kid, map[string]interface{}{"kid": kid, "next": true})
+		writeFakeAPIJSON(w, http.StatusCreated, map[string]interface{}{"kid": kid})
+	case r.Method == http.MethodPut && len(segments) == 4 && segments[0] == "keys" && segments[3] == "revoke":
+		key := f.get("/keys/signing/" + segments[2])
+		if key == nil {
+			writeFakeAPIError(w, http.StatusNotFound, "Not Found")
+			return true
+		}
+		key["revoked"], key["revoked_at"] = true, now
+		writeFakeAPIJSON(w, http.StatusOK, key)
+	default:
+		return false
+	}
+	return true
+}
+
+// find returns the resource at path, looking it up by its alias too.
+func (f *fakeAPI) find(path string) map[string]interface{} {
+	if resource := f.get(path); resource != nil {
+		return resource
+	}
+	parent := parentPath(path)
+	segments := strings.Split(parent, "/")
+	alias := fakeAPICollections[segments[len(segments)-1]].alias
+	if alias == "" {
+		return nil
+	}
+	for _, resource := range f.children(parent) {
+		if resource[alias] == path[len(parent)+1:] {
+			return resource
+		}
+	}
+	return nil
+}
+
+// children returns the resources right under path, in the order they were
+// stored.
+func (f *fakeAPI) children(path string) []map[string]interface{} {
+	var resources []*fakeAPIResource
+	for p, resource := range f.resources {
+		if strings.HasPrefix(p, path+"/") && !strings.Contains(p[len(path)+1:], "/") {
+			resources = append(resources, resource)
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].seq < resources[j].seq })
+
+	children := []map[string]interface{}{}
+	for _, resource := range resources {
+		children = append(children, resource.data)
+	}
+	return children
+}
+
+// add adds a resource to the collection at path, given by id or as a whole.
+func (f *fakeAPI) add(path string, collection fakeAPICollection, resource interface{}) {
+	id := collection.idOf(resource)
+	if _, ok := resource.(string); ok {
+		resource = map[string]interface{}{collection.idField(): id}
+		if referenced := f.get("/" + collection.refs + "/" + id); collection.refs != "" && referenced != nil {
+			resource = referenced
+		}
+	}
+	f.put(path+"/"+id, resource)
+}
+
+func (f *fakeAPI) writeList(w http.ResponseWriter, r *http.Request, collection fakeAPICollection, resources []map[string]interface{}) {
+	query := r.URL.Query()
+	for parameter, field := range fakeAPIFilters {
+		values, ok := query[parameter]
+		if !ok {
+			continue
+		}
+		var filtered []map[string]interface{}
+		for _, resource := range resources {
+			for _, value := range values {
+				if strings.EqualFold(fmt.Sprint(resource[field]), value) {
+					filtered = append(filtered, resource)
+					break
+				}
+			}
+		}
+		resources = filtered
+	}
+
+	total := len(resources)
+	page, _ := strconv.Atoi(query.Get("page"))
+	perPage, _ := strconv.Atoi(query.Get("page_size"))
+	if f.pageSize > 0 && (perPage == 0 || perPage > f.pageSize) {
+		perPage = f.pageSize
+	}
+	start := 0
+	if perPage > 0 {
+		start = page * perPage
+		if start > len(resources) {
+			start = len(resources)
+		}
+		resources = resources[start:]
+		if len(resources) > perPage {
+			resources = resources[:perPage]
+		}
+	} else {
+		perPage = total
+	}
+	if resources == nil {
+		resources = []map[string]interface{}{}
+	}
+
+	meta := map[string]interface{}{"start": start, "limit": perPage, "length": len(resources), "total": total}
+	switch collection.key {
+	case "":
+		writeFakeAPIJSON(w, http.StatusOK, resources)
+	case "items":
+		writeFakeAPIJSON(w, http.StatusOK, map[string]interface{}{"items": resources, "meta": meta})
+	default:
+		meta[collection.key] = resources
+		writeFakeAPIJSON(w, http.StatusOK, meta)
+	}
+}
+
+func (c fakeAPICollection) idField() string {
+	if c.id == "" {
+		return "id"
+	}
+	return c.id
+}
+
+// idOf returns the id of a resource, given by id or as a whole. Permissions
+// have no id of their own and are identified by resource server and name, the
+// former escaped so as not to add segments to their path.
+func (c fakeAPICollection) idOf(resource interface{}) string {
+	switch r := resource.(type) {
+	case string:
+		return r
+	case map[string]interface{}:
+		if name, ok := r["permission_name"].(string); ok {
+			return url.PathEscape(fmt.Sprint(r["resource_server_identifier"])) + " " + name
+		}
+		return fmt.Sprint(r[c.idField()])
+	}
+	return fmt.Sprint(resource)
+}
+
+// stampFakeAPIInvitation sets when an invitation was created and when it
+// expires, 7 days later unless its ttl_sec says otherwise.
+func stampFakeAPIInvitation(invitation map[string]interface{}) {
+	ttl := 7 * 24 * time.Hour
+	if seconds, ok := invitation["ttl_sec"].(float64); ok && seconds > 0 {
+		ttl = time.Duration(seconds) * time.Second
+	}
+	now := time.Now().UTC()
+	invitation["created_at"] = now.Format(time.RFC3339Nano)
+	invitation["expires_at"] = now.Add(ttl).Format(time.RFC3339Nano)
+}
+
+func parentPath(path string) string {
+	return path[:strings.LastIndex(path, "/")]
+}
+
+// matchFakeAPIPattern reports whether a request matches a pattern of handle.
+func matchFakeAPIPattern(pattern, method, path string) bool {
+	patternMethod, patternPath, _ := strings.Cut(pattern, " ")
+	if patternMethod != method {
+		return false
+	}
+	want := strings.Split(strings.Trim(patternPath, "/"), "/")
+	have := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range want {
+		if segment == "**" && i == len(want)-1 {
+			return len(have) > i
+		}
+		if i >= len(have) || (segment != "*" && segment != have[i]) {
+			return false
+		}
+	}
+	return len(want) == len(have)
+}
+
+func writeFakeAPIJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeFakeAPIError writes an error in the form returned by the Management
+// API.
+func writeFakeAPIError(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"statusCode": status,
+		"error":      http.StatusText(status),
+		"message":    message,
+	})
+}
@@ -100,6 +100,80 @@ func (a *Action) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of Action.
+func (a *Action) Clone() *Action {
+	if a == nil {
+		return nil
+	}
+	clone := &Action{}
+	clone.ID = clonePointer(a.ID)
+	clone.Name = clonePointer(a.Name)
+	clone.SupportedTriggers = cloneSliceFunc(a.SupportedTriggers, func(v ActionTrigger) ActionTrigger { return *v.Clone() })
+	clone.Code = clonePointer(a.Code)
+	clone.Dependencies = clonePointerFunc(a.Dependencies, func(v []ActionDependency) []ActionDependency {
+		return cloneSliceFunc(v, func(v ActionDependency) ActionDependency { return *v.Clone() })
+	})
+	clone.Runtime = clonePointer(a.Runtime)
+	clone.Secrets = clonePointerFunc(a.Secrets, func(v []ActionSecret) []ActionSecret {
+		return cloneSliceFunc(v, func(v ActionSecret) ActionSecret { return *v.Clone() })
+	})
+	clone.DeployedVersion = a.DeployedVersion.Clone()
+	clone.Status = clonePointer(a.Status)
+	clone.AllChangesDeployed = a.AllChangesDeployed
+	clone.BuiltAt = clonePointer(a.BuiltAt)
+	clone.CreatedAt = clonePointer(a.CreatedAt)
+	clone.UpdatedAt = clonePointer(a.UpdatedAt)
+	return clone
+}
+
+func (a *Action) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether Action and other hold the same values.
+func (a *Action) Equal(other *Action) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Action and other.
+func (a *Action) Diff(other *Action) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *Action) diff(prefix string, other *Action) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &Action{}
+	}
+	if other == nil {
+		other = &Action{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", a.Name, other.Name)
+	changes = diffSlice(changes, prefix+"supported_triggers", a.SupportedTriggers, other.SupportedTriggers)
+	changes = diffPointer(changes, prefix+"code", a.Code, other.Code)
+	changes = diffSlicePointer(changes, prefix+"dependencies", a.Dependencies, other.Dependencies)
+	changes = diffPointer(changes, prefix+"runtime", a.Runtime, other.Runtime)
+	changes = diffSlicePointer(changes, prefix+"secrets", a.Secrets, other.Secrets)
+	changes = append(changes, a.DeployedVersion.diff(prefix+"deployed_version.", other.DeployedVersion)...)
+	changes = diffPointer(changes, prefix+"status", a.Status, other.Status)
+	changes = diffComparable(changes, prefix+"all_changes_deployed", a.AllChangesDeployed, other.AllChangesDeployed)
+	changes = diffTime(changes, prefix+"built_at", a.BuiltAt, other.BuiltAt)
+	changes = diffTime(changes, prefix+"created_at", a.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"updated_at", a.UpdatedAt, other.UpdatedAt)
+	return changes
+}
+
+func (a *Action) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Action)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetAction returns the Action field.
 func (a *ActionBinding) GetAction() *Action {
 	if a == nil {
@@ -161,6 +235,66 @@ func (a *ActionBinding) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionBinding.
+func (a *ActionBinding) Clone() *ActionBinding {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionBinding{}
+	clone.ID = clonePointer(a.ID)
+	clone.TriggerID = clonePointer(a.TriggerID)
+	clone.DisplayName = clonePointer(a.DisplayName)
+	clone.Ref = a.Ref.Clone()
+	clone.Action = a.Action.Clone()
+	clone.Secrets = cloneSliceFunc(a.Secrets, func(v *ActionSecret) *ActionSecret { return v.Clone() })
+	clone.CreatedAt = clonePointer(a.CreatedAt)
+	clone.UpdatedAt = clonePointer(a.UpdatedAt)
+	return clone
+}
+
+func (a *ActionBinding) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionBinding and other hold the same values.
+func (a *ActionBinding) Equal(other *ActionBinding) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionBinding and other.
+func (a *ActionBinding) Diff(other *ActionBinding) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionBinding) diff(prefix string, other *ActionBinding) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionBinding{}
+	}
+	if other == nil {
+		other = &ActionBinding{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"trigger_id", a.TriggerID, other.TriggerID)
+	changes = diffPointer(changes, prefix+"display_name", a.DisplayName, other.DisplayName)
+	changes = append(changes, a.Ref.diff(prefix+"ref.", other.Ref)...)
+	changes = append(changes, a.Action.diff(prefix+"action.", other.Action)...)
+	changes = diffSlice(changes, prefix+"secrets", a.Secrets, other.Secrets)
+	changes = diffTime(changes, prefix+"created_at", a.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"updated_at", a.UpdatedAt, other.UpdatedAt)
+	return changes
+}
+
+func (a *ActionBinding) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionBinding)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of ActionBindingList.
 func (a *ActionBindingList) String() string {
 	return Stringify(a)
@@ -187,6 +321,54 @@ func (a *ActionBindingReference) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionBindingReference.
+func (a *ActionBindingReference) Clone() *ActionBindingReference {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionBindingReference{}
+	clone.Type = clonePointer(a.Type)
+	clone.Value = clonePointer(a.Value)
+	return clone
+}
+
+func (a *ActionBindingReference) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionBindingReference and other hold the same values.
+func (a *ActionBindingReference) Equal(other *ActionBindingReference) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionBindingReference and other.
+func (a *ActionBindingReference) Diff(other *ActionBindingReference) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionBindingReference) diff(prefix string, other *ActionBindingReference) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionBindingReference{}
+	}
+	if other == nil {
+		other = &ActionBindingReference{}
+	}
+	changes = diffPointer(changes, prefix+"type", a.Type, other.Type)
+	changes = diffPointer(changes, prefix+"value", a.Value, other.Value)
+	return changes
+}
+
+func (a *ActionBindingReference) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionBindingReference)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetName returns the Name field if it's non-nil, zero value otherwise.
 func (a *ActionDependency) GetName() string {
 	if a == nil || a.Name == nil {
@@ -216,6 +398,56 @@ func (a *ActionDependency) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionDependency.
+func (a *ActionDependency) Clone() *ActionDependency {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionDependency{}
+	clone.Name = clonePointer(a.Name)
+	clone.Version = clonePointer(a.Version)
+	clone.RegistryURL = clonePointer(a.RegistryURL)
+	return clone
+}
+
+func (a *ActionDependency) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionDependency and other hold the same values.
+func (a *ActionDependency) Equal(other *ActionDependency) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionDependency and other.
+func (a *ActionDependency) Diff(other *ActionDependency) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionDependency) diff(prefix string, other *ActionDependency) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionDependency{}
+	}
+	if other == nil {
+		other = &ActionDependency{}
+	}
+	changes = diffPointer(changes, prefix+"name", a.Name, other.Name)
+	changes = diffPointer(changes, prefix+"version", a.Version, other.Version)
+	changes = diffPointer(changes, prefix+"registry_url", a.RegistryURL, other.RegistryURL)
+	return changes
+}
+
+func (a *ActionDependency) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionDependency)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
 func (a *ActionExecution) GetCreatedAt() time.Time {
 	if a == nil || a.CreatedAt == nil {
@@ -261,6 +493,62 @@ func (a *ActionExecution) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionExecution.
+func (a *ActionExecution) Clone() *ActionExecution {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionExecution{}
+	clone.ID = clonePointer(a.ID)
+	clone.TriggerID = clonePointer(a.TriggerID)
+	clone.Status = clonePointer(a.Status)
+	clone.Results = cloneSliceFunc(a.Results, func(v *ActionExecutionResult) *ActionExecutionResult { return v.Clone() })
+	clone.CreatedAt = clonePointer(a.CreatedAt)
+	clone.UpdatedAt = clonePointer(a.UpdatedAt)
+	return clone
+}
+
+func (a *ActionExecution) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionExecution and other hold the same values.
+func (a *ActionExecution) Equal(other *ActionExecution) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionExecution and other.
+func (a *ActionExecution) Diff(other *ActionExecution) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionExecution) diff(prefix string, other *ActionExecution) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionExecution{}
+	}
+	if other == nil {
+		other = &ActionExecution{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"trigger_id", a.TriggerID, other.TriggerID)
+	changes = diffPointer(changes, prefix+"status", a.Status, other.Status)
+	changes = diffSlice(changes, prefix+"results", a.Results, other.Results)
+	changes = diffTime(changes, prefix+"created_at", a.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"updated_at", a.UpdatedAt, other.UpdatedAt)
+	return changes
+}
+
+func (a *ActionExecution) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionExecution)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetActionName returns the ActionName field if it's non-nil, zero value otherwise.
 func (a *ActionExecutionResult) GetActionName() string {
 	if a == nil || a.ActionName == nil {
@@ -290,6 +578,58 @@ func (a *ActionExecutionResult) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionExecutionResult.
+func (a *ActionExecutionResult) Clone() *ActionExecutionResult {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionExecutionResult{}
+	clone.ActionName = clonePointer(a.ActionName)
+	clone.Error = cloneMapFunc(a.Error, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.StartedAt = clonePointer(a.StartedAt)
+	clone.EndedAt = clonePointer(a.EndedAt)
+	return clone
+}
+
+func (a *ActionExecutionResult) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionExecutionResult and other hold the same values.
+func (a *ActionExecutionResult) Equal(other *ActionExecutionResult) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionExecutionResult and other.
+func (a *ActionExecutionResult) Diff(other *ActionExecutionResult) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionExecutionResult) diff(prefix string, other *ActionExecutionResult) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionExecutionResult{}
+	}
+	if other == nil {
+		other = &ActionExecutionResult{}
+	}
+	changes = diffPointer(changes, prefix+"action_name", a.ActionName, other.ActionName)
+	changes = diffMap(changes, prefix+"error", a.Error, other.Error)
+	changes = diffTime(changes, prefix+"started_at", a.StartedAt, other.StartedAt)
+	changes = diffTime(changes, prefix+"ended_at", a.EndedAt, other.EndedAt)
+	return changes
+}
+
+func (a *ActionExecutionResult) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionExecutionResult)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of ActionList.
 func (a *ActionList) String() string {
 	return Stringify(a)
@@ -350,6 +690,56 @@ func (a *ActionSecret) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionSecret.
+func (a *ActionSecret) Clone() *ActionSecret {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionSecret{}
+	clone.Name = clonePointer(a.Name)
+	clone.Value = clonePointer(a.Value)
+	clone.UpdatedAt = clonePointer(a.UpdatedAt)
+	return clone
+}
+
+func (a *ActionSecret) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionSecret and other hold the same values.
+func (a *ActionSecret) Equal(other *ActionSecret) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionSecret and other.
+func (a *ActionSecret) Diff(other *ActionSecret) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionSecret) diff(prefix string, other *ActionSecret) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionSecret{}
+	}
+	if other == nil {
+		other = &ActionSecret{}
+	}
+	changes = diffPointer(changes, prefix+"name", a.Name, other.Name)
+	changes = diffPointer(changes, prefix+"value", a.Value, other.Value)
+	changes = diffTime(changes, prefix+"updated_at", a.UpdatedAt, other.UpdatedAt)
+	return changes
+}
+
+func (a *ActionSecret) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionSecret)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (a *ActionTrigger) GetID() string {
 	if a == nil || a.ID == nil {
@@ -379,6 +769,56 @@ func (a *ActionTrigger) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionTrigger.
+func (a *ActionTrigger) Clone() *ActionTrigger {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionTrigger{}
+	clone.ID = clonePointer(a.ID)
+	clone.Version = clonePointer(a.Version)
+	clone.Status = clonePointer(a.Status)
+	return clone
+}
+
+func (a *ActionTrigger) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionTrigger and other hold the same values.
+func (a *ActionTrigger) Equal(other *ActionTrigger) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionTrigger and other.
+func (a *ActionTrigger) Diff(other *ActionTrigger) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionTrigger) diff(prefix string, other *ActionTrigger) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionTrigger{}
+	}
+	if other == nil {
+		other = &ActionTrigger{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"version", a.Version, other.Version)
+	changes = diffPointer(changes, prefix+"status", a.Status, other.Status)
+	return changes
+}
+
+func (a *ActionTrigger) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionTrigger)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of ActionTriggerList.
 func (a *ActionTriggerList) String() string {
 	return Stringify(a)
@@ -445,6 +885,72 @@ func (a *ActionVersion) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionVersion.
+func (a *ActionVersion) Clone() *ActionVersion {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionVersion{}
+	clone.ID = clonePointer(a.ID)
+	clone.Code = clonePointer(a.Code)
+	clone.Dependencies = cloneSliceFunc(a.Dependencies, func(v *ActionDependency) *ActionDependency { return v.Clone() })
+	clone.Deployed = a.Deployed
+	clone.Status = clonePointer(a.Status)
+	clone.Number = a.Number
+	clone.Errors = cloneSliceFunc(a.Errors, func(v *ActionVersionError) *ActionVersionError { return v.Clone() })
+	clone.Action = a.Action.Clone()
+	clone.BuiltAt = clonePointer(a.BuiltAt)
+	clone.CreatedAt = clonePointer(a.CreatedAt)
+	clone.UpdatedAt = clonePointer(a.UpdatedAt)
+	return clone
+}
+
+func (a *ActionVersion) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionVersion and other hold the same values.
+func (a *ActionVersion) Equal(other *ActionVersion) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionVersion and other.
+func (a *ActionVersion) Diff(other *ActionVersion) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionVersion) diff(prefix string, other *ActionVersion) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionVersion{}
+	}
+	if other == nil {
+		other = &ActionVersion{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"code", a.Code, other.Code)
+	changes = diffSlice(changes, prefix+"dependencies", a.Dependencies, other.Dependencies)
+	changes = diffComparable(changes, prefix+"deployed", a.Deployed, other.Deployed)
+	changes = diffPointer(changes, prefix+"status", a.Status, other.Status)
+	changes = diffComparable(changes, prefix+"number", a.Number, other.Number)
+	changes = diffSlice(changes, prefix+"errors", a.Errors, other.Errors)
+	changes = append(changes, a.Action.diff(prefix+"action.", other.Action)...)
+	changes = diffTime(changes, prefix+"built_at", a.BuiltAt, other.BuiltAt)
+	changes = diffTime(changes, prefix+"created_at", a.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"updated_at", a.UpdatedAt, other.UpdatedAt)
+	return changes
+}
+
+func (a *ActionVersion) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionVersion)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // GetID returns the ID field if it's non-nil, zero value otherwise.
 func (a *ActionVersionError) GetID() string {
 	if a == nil || a.ID == nil {
@@ -474,6 +980,56 @@ func (a *ActionVersionError) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of ActionVersionError.
+func (a *ActionVersionError) Clone() *ActionVersionError {
+	if a == nil {
+		return nil
+	}
+	clone := &ActionVersionError{}
+	clone.ID = clonePointer(a.ID)
+	clone.Message = clonePointer(a.Message)
+	clone.URL = clonePointer(a.URL)
+	return clone
+}
+
+func (a *ActionVersionError) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether ActionVersionError and other hold the same values.
+func (a *ActionVersionError) Equal(other *ActionVersionError) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ActionVersionError and other.
+func (a *ActionVersionError) Diff(other *ActionVersionError) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *ActionVersionError) diff(prefix string, other *ActionVersionError) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &ActionVersionError{}
+	}
+	if other == nil {
+		other = &ActionVersionError{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"msg", a.Message, other.Message)
+	changes = diffPointer(changes, prefix+"url", a.URL, other.URL)
+	return changes
+}
+
+func (a *ActionVersionError) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ActionVersionError)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of ActionVersionList.
 func (a *ActionVersionList) String() string {
 	return Stringify(a)
@@ -612,6 +1168,84 @@ func (a *AuthenticationMethod) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of AuthenticationMethod.
+func (a *AuthenticationMethod) Clone() *AuthenticationMethod {
+	if a == nil {
+		return nil
+	}
+	clone := &AuthenticationMethod{}
+	clone.ID = clonePointer(a.ID)
+	clone.Type = clonePointer(a.Type)
+	clone.Confirmed = clonePointer(a.Confirmed)
+	clone.Name = clonePointer(a.Name)
+	clone.LinkID = clonePointer(a.LinkID)
+	clone.PhoneNumber = clonePointer(a.PhoneNumber)
+	clone.Email = clonePointer(a.Email)
+	clone.KeyID = clonePointer(a.KeyID)
+	clone.PublicKey = clonePointer(a.PublicKey)
+	clone.CreatedAt = clonePointer(a.CreatedAt)
+	clone.EnrolledAt = clonePointer(a.EnrolledAt)
+	clone.LastAuthedAt = clonePointer(a.LastAuthedAt)
+	clone.TOTPSecret = clonePointer(a.TOTPSecret)
+	clone.PreferredAuthenticationMethod = clonePointer(a.PreferredAuthenticationMethod)
+	clone.RelyingPartyIdentifier = clonePointer(a.RelyingPartyIdentifier)
+	clone.AuthenticationMethods = clonePointerFunc(a.AuthenticationMethods, func(v []AuthenticationMethodReference) []AuthenticationMethodReference {
+		return cloneSliceFunc(v, func(v AuthenticationMethodReference) AuthenticationMethodReference { return *v.Clone() })
+	})
+	return clone
+}
+
+func (a *AuthenticationMethod) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether AuthenticationMethod and other hold the same values.
+func (a *AuthenticationMethod) Equal(other *AuthenticationMethod) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between AuthenticationMethod and other.
+func (a *AuthenticationMethod) Diff(other *AuthenticationMethod) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *AuthenticationMethod) diff(prefix string, other *AuthenticationMethod) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &AuthenticationMethod{}
+	}
+	if other == nil {
+		other = &AuthenticationMethod{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"type", a.Type, other.Type)
+	changes = diffPointer(changes, prefix+"confirmed", a.Confirmed, other.Confirmed)
+	changes = diffPointer(changes, prefix+"name", a.Name, other.Name)
+	changes = diffPointer(changes, prefix+"link_id", a.LinkID, other.LinkID)
+	changes = diffPointer(changes, prefix+"phone_number", a.PhoneNumber, other.PhoneNumber)
+	changes = diffPointer(changes, prefix+"email", a.Email, other.Email)
+	changes = diffPointer(changes, prefix+"key_id", a.KeyID, other.KeyID)
+	changes = diffPointer(changes, prefix+"public_key", a.PublicKey, other.PublicKey)
+	changes = diffTime(changes, prefix+"created_at", a.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"enrolled_at", a.EnrolledAt, other.EnrolledAt)
+	changes = diffTime(changes, prefix+"last_auth_at", a.LastAuthedAt, other.LastAuthedAt)
+	changes = diffPointer(changes, prefix+"totp_secret", a.TOTPSecret, other.TOTPSecret)
+	changes = diffPointer(changes, prefix+"preferred_authentication_method", a.PreferredAuthenticationMethod, other.PreferredAuthenticationMethod)
+	changes = diffPointer(changes, prefix+"relying_party_identifier", a.RelyingPartyIdentifier, other.RelyingPartyIdentifier)
+	changes = diffSlicePointer(changes, prefix+"authentication_methods", a.AuthenticationMethods, other.AuthenticationMethods)
+	return changes
+}
+
+func (a *AuthenticationMethod) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*AuthenticationMethod)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of AuthenticationMethodList.
 func (a *AuthenticationMethodList) String() string {
 	return Stringify(a)
@@ -638,25 +1272,121 @@ func (a *AuthenticationMethodReference) String() string {
 	return Stringify(a)
 }
 
+// Clone returns a deep copy of AuthenticationMethodReference.
+func (a *AuthenticationMethodReference) Clone() *AuthenticationMethodReference {
+	if a == nil {
+		return nil
+	}
+	clone := &AuthenticationMethodReference{}
+	clone.ID = clonePointer(a.ID)
+	clone.Type = clonePointer(a.Type)
+	return clone
+}
+
+func (a *AuthenticationMethodReference) cloneAny() interface{} {
+	return a.Clone()
+}
+
+// Equal reports whether AuthenticationMethodReference and other hold the same values.
+func (a *AuthenticationMethodReference) Equal(other *AuthenticationMethodReference) bool {
+	return len(a.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between AuthenticationMethodReference and other.
+func (a *AuthenticationMethodReference) Diff(other *AuthenticationMethodReference) []FieldChange {
+	return a.diff("", other)
+}
+
+func (a *AuthenticationMethodReference) diff(prefix string, other *AuthenticationMethodReference) (changes []FieldChange) {
+	if a == nil && other == nil {
+		return nil
+	}
+	if a == nil {
+		a = &AuthenticationMethodReference{}
+	}
+	if other == nil {
+		other = &AuthenticationMethodReference{}
+	}
+	changes = diffPointer(changes, prefix+"id", a.ID, other.ID)
+	changes = diffPointer(changes, prefix+"type", a.Type, other.Type)
+	return changes
+}
+
+func (a *AuthenticationMethodReference) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*AuthenticationMethodReference)
+	if !ok {
+		return nil, false
+	}
+	return a.diff(prefix, typed), true
+}
+
 // String returns a string representation of BlacklistToken.
 func (b *BlacklistToken) String() string {
 	return Stringify(b)
 }
 
-// GetColors returns the Colors field.
-func (b *Branding) GetColors() *BrandingColors {
+// Clone returns a deep copy of BlacklistToken.
+func (b *BlacklistToken) Clone() *BlacklistToken {
 	if b == nil {
 		return nil
 	}
-	return b.Colors
+	clone := &BlacklistToken{}
+	clone.Audience = b.Audience
+	clone.JTI = b.JTI
+	return clone
 }
 
-// GetFaviconURL returns the FaviconURL field if it's non-nil, zero value otherwise.
-func (b *Branding) GetFaviconURL() string {
-	if b == nil || b.FaviconURL == nil {
-		return ""
-	}
-	return *b.FaviconURL
+func (b *BlacklistToken) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BlacklistToken and other hold the same values.
+func (b *BlacklistToken) Equal(other *BlacklistToken) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BlacklistToken and other.
+func (b *BlacklistToken) Diff(other *BlacklistToken) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BlacklistToken) diff(prefix string, other *BlacklistToken) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BlacklistToken{}
+	}
+	if other == nil {
+		other = &BlacklistToken{}
+	}
+	changes = diffComparable(changes, prefix+"aud", b.Audience, other.Audience)
+	changes = diffComparable(changes, prefix+"jti", b.JTI, other.JTI)
+	return changes
+}
+
+func (b *BlacklistToken) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BlacklistToken)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
+// GetColors returns the Colors field.
+func (b *Branding) GetColors() *BrandingColors {
+	if b == nil {
+		return nil
+	}
+	return b.Colors
+}
+
+// GetFaviconURL returns the FaviconURL field if it's non-nil, zero value otherwise.
+func (b *Branding) GetFaviconURL() string {
+	if b == nil || b.FaviconURL == nil {
+		return ""
+	}
+	return *b.FaviconURL
 }
 
 // GetFont returns the Font field.
@@ -680,6 +1410,58 @@ func (b *Branding) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of Branding.
+func (b *Branding) Clone() *Branding {
+	if b == nil {
+		return nil
+	}
+	clone := &Branding{}
+	clone.Colors = b.Colors.Clone()
+	clone.FaviconURL = clonePointer(b.FaviconURL)
+	clone.LogoURL = clonePointer(b.LogoURL)
+	clone.Font = b.Font.Clone()
+	return clone
+}
+
+func (b *Branding) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether Branding and other hold the same values.
+func (b *Branding) Equal(other *Branding) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Branding and other.
+func (b *Branding) Diff(other *Branding) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *Branding) diff(prefix string, other *Branding) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &Branding{}
+	}
+	if other == nil {
+		other = &Branding{}
+	}
+	changes = append(changes, b.Colors.diff(prefix+"colors.", other.Colors)...)
+	changes = diffPointer(changes, prefix+"favicon_url", b.FaviconURL, other.FaviconURL)
+	changes = diffPointer(changes, prefix+"logo_url", b.LogoURL, other.LogoURL)
+	changes = append(changes, b.Font.diff(prefix+"font.", other.Font)...)
+	return changes
+}
+
+func (b *Branding) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Branding)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // GetPageBackground returns the PageBackground field if it's non-nil, zero value otherwise.
 func (b *BrandingColors) GetPageBackground() string {
 	if b == nil || b.PageBackground == nil {
@@ -709,6 +1491,56 @@ func (b *BrandingColors) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of BrandingColors.
+func (b *BrandingColors) Clone() *BrandingColors {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingColors{}
+	clone.Primary = clonePointer(b.Primary)
+	clone.PageBackground = clonePointer(b.PageBackground)
+	clone.PageBackgroundGradient = b.PageBackgroundGradient.Clone()
+	return clone
+}
+
+func (b *BrandingColors) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BrandingColors and other hold the same values.
+func (b *BrandingColors) Equal(other *BrandingColors) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BrandingColors and other.
+func (b *BrandingColors) Diff(other *BrandingColors) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingColors) diff(prefix string, other *BrandingColors) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BrandingColors{}
+	}
+	if other == nil {
+		other = &BrandingColors{}
+	}
+	changes = diffPointer(changes, prefix+"primary", b.Primary, other.Primary)
+	changes = diffPointer(changes, prefix+"page_background", b.PageBackground, other.PageBackground)
+	changes = append(changes, b.PageBackgroundGradient.diff(prefix+"page_background_gradient.", other.PageBackgroundGradient)...)
+	return changes
+}
+
+func (b *BrandingColors) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingColors)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // GetURL returns the URL field if it's non-nil, zero value otherwise.
 func (b *BrandingFont) GetURL() string {
 	if b == nil || b.URL == nil {
@@ -722,6 +1554,52 @@ func (b *BrandingFont) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of BrandingFont.
+func (b *BrandingFont) Clone() *BrandingFont {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingFont{}
+	clone.URL = clonePointer(b.URL)
+	return clone
+}
+
+func (b *BrandingFont) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BrandingFont and other hold the same values.
+func (b *BrandingFont) Equal(other *BrandingFont) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BrandingFont and other.
+func (b *BrandingFont) Diff(other *BrandingFont) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingFont) diff(prefix string, other *BrandingFont) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BrandingFont{}
+	}
+	if other == nil {
+		other = &BrandingFont{}
+	}
+	changes = diffPointer(changes, prefix+"url", b.URL, other.URL)
+	return changes
+}
+
+func (b *BrandingFont) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingFont)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // GetAngleDegree returns the AngleDegree field if it's non-nil, zero value otherwise.
 func (b *BrandingPageBackgroundGradient) GetAngleDegree() int {
 	if b == nil || b.AngleDegree == nil {
@@ -759,6 +1637,58 @@ func (b *BrandingPageBackgroundGradient) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of BrandingPageBackgroundGradient.
+func (b *BrandingPageBackgroundGradient) Clone() *BrandingPageBackgroundGradient {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingPageBackgroundGradient{}
+	clone.Type = clonePointer(b.Type)
+	clone.Start = clonePointer(b.Start)
+	clone.End = clonePointer(b.End)
+	clone.AngleDegree = clonePointer(b.AngleDegree)
+	return clone
+}
+
+func (b *BrandingPageBackgroundGradient) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BrandingPageBackgroundGradient and other hold the same values.
+func (b *BrandingPageBackgroundGradient) Equal(other *BrandingPageBackgroundGradient) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BrandingPageBackgroundGradient and other.
+func (b *BrandingPageBackgroundGradient) Diff(other *BrandingPageBackgroundGradient) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingPageBackgroundGradient) diff(prefix string, other *BrandingPageBackgroundGradient) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BrandingPageBackgroundGradient{}
+	}
+	if other == nil {
+		other = &BrandingPageBackgroundGradient{}
+	}
+	changes = diffPointer(changes, prefix+"type", b.Type, other.Type)
+	changes = diffPointer(changes, prefix+"start", b.Start, other.Start)
+	changes = diffPointer(changes, prefix+"end", b.End, other.End)
+	changes = diffPointer(changes, prefix+"angle_deg", b.AngleDegree, other.AngleDegree)
+	return changes
+}
+
+func (b *BrandingPageBackgroundGradient) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingPageBackgroundGradient)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
 func (b *BrandingTheme) GetDisplayName() string {
 	if b == nil || b.DisplayName == nil {
@@ -780,11 +1710,131 @@ func (b *BrandingTheme) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of BrandingTheme.
+func (b *BrandingTheme) Clone() *BrandingTheme {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingTheme{}
+	clone.ID = clonePointer(b.ID)
+	clone.DisplayName = clonePointer(b.DisplayName)
+	clone.Borders = *b.Borders.Clone()
+	clone.Colors = *b.Colors.Clone()
+	clone.Fonts = *b.Fonts.Clone()
+	clone.PageBackground = *b.PageBackground.Clone()
+	clone.Widget = *b.Widget.Clone()
+	return clone
+}
+
+func (b *BrandingTheme) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BrandingTheme and other hold the same values.
+func (b *BrandingTheme) Equal(other *BrandingTheme) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BrandingTheme and other.
+func (b *BrandingTheme) Diff(other *BrandingTheme) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingTheme) diff(prefix string, other *BrandingTheme) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BrandingTheme{}
+	}
+	if other == nil {
+		other = &BrandingTheme{}
+	}
+	changes = diffPointer(changes, prefix+"themeId", b.ID, other.ID)
+	changes = diffPointer(changes, prefix+"displayName", b.DisplayName, other.DisplayName)
+	changes = append(changes, b.Borders.diff(prefix+"borders.", &other.Borders)...)
+	changes = append(changes, b.Colors.diff(prefix+"colors.", &other.Colors)...)
+	changes = append(changes, b.Fonts.diff(prefix+"fonts.", &other.Fonts)...)
+	changes = append(changes, b.PageBackground.diff(prefix+"page_background.", &other.PageBackground)...)
+	changes = append(changes, b.Widget.diff(prefix+"widget.", &other.Widget)...)
+	return changes
+}
+
+func (b *BrandingTheme) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingTheme)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // String returns a string representation of BrandingThemeBorders.
 func (b *BrandingThemeBorders) String() string {
 	return Stringify(b)
 }
 
+// Clone returns a deep copy of BrandingThemeBorders.
+func (b *BrandingThemeBorders) Clone() *BrandingThemeBorders {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingThemeBorders{}
+	clone.ButtonBorderRadius = b.ButtonBorderRadius
+	clone.ButtonBorderWeight = b.ButtonBorderWeight
+	clone.ButtonsStyle = b.ButtonsStyle
+	clone.InputBorderRadius = b.InputBorderRadius
+	clone.InputBorderWeight = b.InputBorderWeight
+	clone.InputsStyle = b.InputsStyle
+	clone.ShowWidgetShadow = b.ShowWidgetShadow
+	clone.WidgetBorderWeight = b.WidgetBorderWeight
+	clone.WidgetCornerRadius = b.WidgetCornerRadius
+	return clone
+}
+
+func (b *BrandingThemeBorders) cloneAny() interface{} {
+	return b.Clone()
+}
+
+// Equal reports whether BrandingThemeBorders and other hold the same values.
+func (b *BrandingThemeBorders) Equal(other *BrandingThemeBorders) bool {
+	return len(b.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between BrandingThemeBorders and other.
+func (b *BrandingThemeBorders) Diff(other *BrandingThemeBorders) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingThemeBorders) diff(prefix string, other *BrandingThemeBorders) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BrandingThemeBorders{}
+	}
+	if other == nil {
+		other = &BrandingThemeBorders{}
+	}
+	changes = diffComparable(changes, prefix+"button_border_radius", b.ButtonBorderRadius, other.ButtonBorderRadius)
+	changes = diffComparable(changes, prefix+"button_border_weight", b.ButtonBorderWeight, other.ButtonBorderWeight)
+	changes = diffComparable(changes, prefix+"buttons_style", b.ButtonsStyle, other.ButtonsStyle)
+	changes = diffComparable(changes, prefix+"input_border_radius", b.InputBorderRadius, other.InputBorderRadius)
+	changes = diffComparable(changes, prefix+"input_border_weight", b.InputBorderWeight, other.InputBorderWeight)
+	changes = diffComparable(changes, prefix+"inputs_style", b.InputsStyle, other.InputsStyle)
+	changes = diffComparable(changes, prefix+"show_widget_shadow", b.ShowWidgetShadow, other.ShowWidgetShadow)
+	changes = diffComparable(changes, prefix+"widget_border_weight", b.WidgetBorderWeight, other.WidgetBorderWeight)
+	changes = diffComparable(changes, prefix+"widget_corner_radius", b.WidgetCornerRadius, other.WidgetCornerRadius)
+	return changes
+}
+
+func (b *BrandingThemeBorders) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemeBorders)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
+}
+
 // GetBaseFocusColor returns the BaseFocusColor field if it's non-nil, zero value otherwise.
 func (b *BrandingThemeColors) GetBaseFocusColor() string {
 	if b == nil || b.BaseFocusColor == nil {
@@ -806,2120 +1856,2413 @@ func (b *BrandingThemeColors) String() string {
 	return Stringify(b)
 }
 
-// String returns a string representation of BrandingThemeFonts.
-func (b *BrandingThemeFonts) String() string {
-	return Stringify(b)
+// Clone returns a deep copy of BrandingThemeColors.
+func (b *BrandingThemeColors) Clone() *BrandingThemeColors {
+	if b == nil {
+		return nil
+	}
+	clone := &BrandingThemeColors{}
+	clone.BaseFocusColor = clonePointer(b.BaseFocusColor)
+	clone.BaseHoverColor = clonePointer(b.BaseHoverColor)
+	clone.BodyText = b.BodyText
+	clone.Error = b.Error
+	clone.Header = b.Header
+	clone.Icons = b.Icons
+	clone.InputBackground = b.InputBackground
+	clone.InputBorder = b.InputBorder
+	clone.InputFilledText = b.InputFilledText
+	clone.InputLabelsPlaceholders = b.InputLabelsPlaceholders
+	clone.LinksFocusedComponents = b.LinksFocusedComponents
+	clone.PrimaryButton = b.PrimaryButton
+	clone.PrimaryButtonLabel = b.PrimaryButtonLabel
+	clone.SecondaryButtonBorder = b.SecondaryButtonBorder
+	clone.SecondaryButtonLabel = b.SecondaryButtonLabel
+	clone.Success = b.Success
+	clone.WidgetBackground = b.WidgetBackground
+	clone.WidgetBorder = b.WidgetBorder
+	return clone
 }
 
-// String returns a string representation of BrandingThemePageBackground.
-func (b *BrandingThemePageBackground) String() string {
-	return Stringify(b)
+func (b *BrandingThemeColors) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// String returns a string representation of BrandingThemeText.
-func (b *BrandingThemeText) String() string {
-	return Stringify(b)
+// Equal reports whether BrandingThemeColors and other hold the same values.
+func (b *BrandingThemeColors) Equal(other *BrandingThemeColors) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// String returns a string representation of BrandingThemeWidget.
-func (b *BrandingThemeWidget) String() string {
-	return Stringify(b)
+// Diff returns the fields that changed between BrandingThemeColors and other.
+func (b *BrandingThemeColors) Diff(other *BrandingThemeColors) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (b *BrandingUniversalLogin) GetBody() string {
-	if b == nil || b.Body == nil {
-		return ""
+func (b *BrandingThemeColors) diff(prefix string, other *BrandingThemeColors) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
 	}
-	return *b.Body
+	if b == nil {
+		b = &BrandingThemeColors{}
+	}
+	if other == nil {
+		other = &BrandingThemeColors{}
+	}
+	changes = diffPointer(changes, prefix+"base_focus_color", b.BaseFocusColor, other.BaseFocusColor)
+	changes = diffPointer(changes, prefix+"base_hover_color", b.BaseHoverColor, other.BaseHoverColor)
+	changes = diffComparable(changes, prefix+"body_text", b.BodyText, other.BodyText)
+	changes = diffComparable(changes, prefix+"error", b.Error, other.Error)
+	changes = diffComparable(changes, prefix+"header", b.Header, other.Header)
+	changes = diffComparable(changes, prefix+"icons", b.Icons, other.Icons)
+	changes = diffComparable(changes, prefix+"input_background", b.InputBackground, other.InputBackground)
+	changes = diffComparable(changes, prefix+"input_border", b.InputBorder, other.InputBorder)
+	changes = diffComparable(changes, prefix+"input_filled_text", b.InputFilledText, other.InputFilledText)
+	changes = diffComparable(changes, prefix+"input_labels_placeholders", b.InputLabelsPlaceholders, other.InputLabelsPlaceholders)
+	changes = diffComparable(changes, prefix+"links_focused_components", b.LinksFocusedComponents, other.LinksFocusedComponents)
+	changes = diffComparable(changes, prefix+"primary_button", b.PrimaryButton, other.PrimaryButton)
+	changes = diffComparable(changes, prefix+"primary_button_label", b.PrimaryButtonLabel, other.PrimaryButtonLabel)
+	changes = diffComparable(changes, prefix+"secondary_button_border", b.SecondaryButtonBorder, other.SecondaryButtonBorder)
+	changes = diffComparable(changes, prefix+"secondary_button_label", b.SecondaryButtonLabel, other.SecondaryButtonLabel)
+	changes = diffComparable(changes, prefix+"success", b.Success, other.Success)
+	changes = diffComparable(changes, prefix+"widget_background", b.WidgetBackground, other.WidgetBackground)
+	changes = diffComparable(changes, prefix+"widget_border", b.WidgetBorder, other.WidgetBorder)
+	return changes
+}
+
+func (b *BrandingThemeColors) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemeColors)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
 }
 
-// String returns a string representation of BrandingUniversalLogin.
-func (b *BrandingUniversalLogin) String() string {
+// String returns a string representation of BrandingThemeFonts.
+func (b *BrandingThemeFonts) String() string {
 	return Stringify(b)
 }
 
-// GetAdminNotificationFrequency returns the AdminNotificationFrequency field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetAdminNotificationFrequency() []string {
-	if b == nil || b.AdminNotificationFrequency == nil {
+// Clone returns a deep copy of BrandingThemeFonts.
+func (b *BrandingThemeFonts) Clone() *BrandingThemeFonts {
+	if b == nil {
 		return nil
 	}
-	return *b.AdminNotificationFrequency
+	clone := &BrandingThemeFonts{}
+	clone.BodyText = *b.BodyText.Clone()
+	clone.ButtonsText = *b.ButtonsText.Clone()
+	clone.FontURL = b.FontURL
+	clone.InputLabels = *b.InputLabels.Clone()
+	clone.Links = *b.Links.Clone()
+	clone.LinksStyle = b.LinksStyle
+	clone.ReferenceTextSize = b.ReferenceTextSize
+	clone.Subtitle = *b.Subtitle.Clone()
+	clone.Title = *b.Title.Clone()
+	return clone
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetEnabled() bool {
-	if b == nil || b.Enabled == nil {
-		return false
-	}
-	return *b.Enabled
+func (b *BrandingThemeFonts) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetMethod returns the Method field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetMethod() string {
-	if b == nil || b.Method == nil {
-		return ""
-	}
-	return *b.Method
+// Equal reports whether BrandingThemeFonts and other hold the same values.
+func (b *BrandingThemeFonts) Equal(other *BrandingThemeFonts) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetShields returns the Shields field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetection) GetShields() []string {
-	if b == nil || b.Shields == nil {
+// Diff returns the fields that changed between BrandingThemeFonts and other.
+func (b *BrandingThemeFonts) Diff(other *BrandingThemeFonts) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BrandingThemeFonts) diff(prefix string, other *BrandingThemeFonts) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return *b.Shields
+	if b == nil {
+		b = &BrandingThemeFonts{}
+	}
+	if other == nil {
+		other = &BrandingThemeFonts{}
+	}
+	changes = append(changes, b.BodyText.diff(prefix+"body_text.", &other.BodyText)...)
+	changes = append(changes, b.ButtonsText.diff(prefix+"buttons_text.", &other.ButtonsText)...)
+	changes = diffComparable(changes, prefix+"font_url", b.FontURL, other.FontURL)
+	changes = append(changes, b.InputLabels.diff(prefix+"input_labels.", &other.InputLabels)...)
+	changes = append(changes, b.Links.diff(prefix+"links.", &other.Links)...)
+	changes = diffComparable(changes, prefix+"links_style", b.LinksStyle, other.LinksStyle)
+	changes = diffComparable(changes, prefix+"reference_text_size", b.ReferenceTextSize, other.ReferenceTextSize)
+	changes = append(changes, b.Subtitle.diff(prefix+"subtitle.", &other.Subtitle)...)
+	changes = append(changes, b.Title.diff(prefix+"title.", &other.Title)...)
+	return changes
 }
 
-// GetStage returns the Stage field.
-func (b *BreachedPasswordDetection) GetStage() *BreachedPasswordDetectionStage {
-	if b == nil {
-		return nil
+func (b *BrandingThemeFonts) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemeFonts)
+	if !ok {
+		return nil, false
 	}
-	return b.Stage
+	return b.diff(prefix, typed), true
 }
 
-// String returns a string representation of BreachedPasswordDetection.
-func (b *BreachedPasswordDetection) String() string {
+// String returns a string representation of BrandingThemePageBackground.
+func (b *BrandingThemePageBackground) String() string {
 	return Stringify(b)
 }
 
-// GetShields returns the Shields field if it's non-nil, zero value otherwise.
-func (b *BreachedPasswordDetectionPreUserRegistration) GetShields() []string {
-	if b == nil || b.Shields == nil {
+// Clone returns a deep copy of BrandingThemePageBackground.
+func (b *BrandingThemePageBackground) Clone() *BrandingThemePageBackground {
+	if b == nil {
 		return nil
 	}
-	return *b.Shields
+	clone := &BrandingThemePageBackground{}
+	clone.BackgroundColor = b.BackgroundColor
+	clone.BackgroundImageURL = b.BackgroundImageURL
+	clone.PageLayout = b.PageLayout
+	return clone
 }
 
-// String returns a string representation of BreachedPasswordDetectionPreUserRegistration.
-func (b *BreachedPasswordDetectionPreUserRegistration) String() string {
-	return Stringify(b)
+func (b *BrandingThemePageBackground) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetPreUserRegistration returns the PreUserRegistration field.
-func (b *BreachedPasswordDetectionStage) GetPreUserRegistration() *BreachedPasswordDetectionPreUserRegistration {
-	if b == nil {
-		return nil
-	}
-	return b.PreUserRegistration
+// Equal reports whether BrandingThemePageBackground and other hold the same values.
+func (b *BrandingThemePageBackground) Equal(other *BrandingThemePageBackground) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// String returns a string representation of BreachedPasswordDetectionStage.
-func (b *BreachedPasswordDetectionStage) String() string {
-	return Stringify(b)
+// Diff returns the fields that changed between BrandingThemePageBackground and other.
+func (b *BrandingThemePageBackground) Diff(other *BrandingThemePageBackground) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetAllowList returns the AllowList field if it's non-nil, zero value otherwise.
-func (b *BruteForceProtection) GetAllowList() []string {
-	if b == nil || b.AllowList == nil {
+func (b *BrandingThemePageBackground) diff(prefix string, other *BrandingThemePageBackground) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return *b.AllowList
+	if b == nil {
+		b = &BrandingThemePageBackground{}
+	}
+	if other == nil {
+		other = &BrandingThemePageBackground{}
+	}
+	changes = diffComparable(changes, prefix+"background_color", b.BackgroundColor, other.BackgroundColor)
+	changes = diffComparable(changes, prefix+"background_image_url", b.BackgroundImageURL, other.BackgroundImageURL)
+	changes = diffComparable(changes, prefix+"page_layout", b.PageLayout, other.PageLayout)
+	return changes
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (b *BruteForceProtection) GetEnabled() bool {
-	if b == nil || b.Enabled == nil {
-		return false
+func (b *BrandingThemePageBackground) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemePageBackground)
+	if !ok {
+		return nil, false
 	}
-	return *b.Enabled
+	return b.diff(prefix, typed), true
 }
 
-// GetMaxAttempts returns the MaxAttempts field if it's non-nil, zero value otherwise.
-func (b *BruteForceProtection) GetMaxAttempts() int {
-	if b == nil || b.MaxAttempts == nil {
-		return 0
-	}
-	return *b.MaxAttempts
+// String returns a string representation of BrandingThemeText.
+func (b *BrandingThemeText) String() string {
+	return Stringify(b)
 }
 
-// GetMode returns the Mode field if it's non-nil, zero value otherwise.
-func (b *BruteForceProtection) GetMode() string {
-	if b == nil || b.Mode == nil {
-		return ""
+// Clone returns a deep copy of BrandingThemeText.
+func (b *BrandingThemeText) Clone() *BrandingThemeText {
+	if b == nil {
+		return nil
 	}
-	return *b.Mode
+	clone := &BrandingThemeText{}
+	clone.Bold = b.Bold
+	clone.Size = b.Size
+	return clone
 }
 
-// GetShields returns the Shields field if it's non-nil, zero value otherwise.
-func (b *BruteForceProtection) GetShields() []string {
-	if b == nil || b.Shields == nil {
-		return nil
-	}
-	return *b.Shields
+func (b *BrandingThemeText) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// String returns a string representation of BruteForceProtection.
-func (b *BruteForceProtection) String() string {
-	return Stringify(b)
+// Equal reports whether BrandingThemeText and other hold the same values.
+func (b *BrandingThemeText) Equal(other *BrandingThemeText) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetAllowedClients returns the AllowedClients field if it's non-nil, zero value otherwise.
-func (c *Client) GetAllowedClients() []string {
-	if c == nil || c.AllowedClients == nil {
-		return nil
-	}
-	return *c.AllowedClients
+// Diff returns the fields that changed between BrandingThemeText and other.
+func (b *BrandingThemeText) Diff(other *BrandingThemeText) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetAllowedLogoutURLs returns the AllowedLogoutURLs field if it's non-nil, zero value otherwise.
-func (c *Client) GetAllowedLogoutURLs() []string {
-	if c == nil || c.AllowedLogoutURLs == nil {
+func (b *BrandingThemeText) diff(prefix string, other *BrandingThemeText) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return *c.AllowedLogoutURLs
+	if b == nil {
+		b = &BrandingThemeText{}
+	}
+	if other == nil {
+		other = &BrandingThemeText{}
+	}
+	changes = diffComparable(changes, prefix+"bold", b.Bold, other.Bold)
+	changes = diffComparable(changes, prefix+"size", b.Size, other.Size)
+	return changes
 }
 
-// GetAllowedOrigins returns the AllowedOrigins field if it's non-nil, zero value otherwise.
-func (c *Client) GetAllowedOrigins() []string {
-	if c == nil || c.AllowedOrigins == nil {
-		return nil
+func (b *BrandingThemeText) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemeText)
+	if !ok {
+		return nil, false
 	}
-	return *c.AllowedOrigins
+	return b.diff(prefix, typed), true
 }
 
-// GetAppType returns the AppType field if it's non-nil, zero value otherwise.
-func (c *Client) GetAppType() string {
-	if c == nil || c.AppType == nil {
-		return ""
-	}
-	return *c.AppType
+// String returns a string representation of BrandingThemeWidget.
+func (b *BrandingThemeWidget) String() string {
+	return Stringify(b)
 }
 
-// GetCallbacks returns the Callbacks field if it's non-nil, zero value otherwise.
-func (c *Client) GetCallbacks() []string {
-	if c == nil || c.Callbacks == nil {
+// Clone returns a deep copy of BrandingThemeWidget.
+func (b *BrandingThemeWidget) Clone() *BrandingThemeWidget {
+	if b == nil {
 		return nil
 	}
-	return *c.Callbacks
+	clone := &BrandingThemeWidget{}
+	clone.HeaderTextAlignment = b.HeaderTextAlignment
+	clone.LogoHeight = b.LogoHeight
+	clone.LogoPosition = b.LogoPosition
+	clone.LogoURL = b.LogoURL
+	clone.SocialButtonsLayout = b.SocialButtonsLayout
+	return clone
 }
 
-// GetClientAliases returns the ClientAliases field if it's non-nil, zero value otherwise.
-func (c *Client) GetClientAliases() []string {
-	if c == nil || c.ClientAliases == nil {
-		return nil
-	}
-	return *c.ClientAliases
+func (b *BrandingThemeWidget) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *Client) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
-	}
-	return *c.ClientID
+// Equal reports whether BrandingThemeWidget and other hold the same values.
+func (b *BrandingThemeWidget) Equal(other *BrandingThemeWidget) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *Client) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
-	}
-	return *c.ClientSecret
+// Diff returns the fields that changed between BrandingThemeWidget and other.
+func (b *BrandingThemeWidget) Diff(other *BrandingThemeWidget) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetCrossOriginAuth returns the CrossOriginAuth field if it's non-nil, zero value otherwise.
-func (c *Client) GetCrossOriginAuth() bool {
-	if c == nil || c.CrossOriginAuth == nil {
-		return false
+func (b *BrandingThemeWidget) diff(prefix string, other *BrandingThemeWidget) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
 	}
-	return *c.CrossOriginAuth
+	if b == nil {
+		b = &BrandingThemeWidget{}
+	}
+	if other == nil {
+		other = &BrandingThemeWidget{}
+	}
+	changes = diffComparable(changes, prefix+"header_text_alignment", b.HeaderTextAlignment, other.HeaderTextAlignment)
+	changes = diffComparable(changes, prefix+"logo_height", b.LogoHeight, other.LogoHeight)
+	changes = diffComparable(changes, prefix+"logo_position", b.LogoPosition, other.LogoPosition)
+	changes = diffComparable(changes, prefix+"logo_url", b.LogoURL, other.LogoURL)
+	changes = diffComparable(changes, prefix+"social_buttons_layout", b.SocialButtonsLayout, other.SocialButtonsLayout)
+	return changes
 }
 
-// GetCrossOriginLocation returns the CrossOriginLocation field if it's non-nil, zero value otherwise.
-func (c *Client) GetCrossOriginLocation() string {
-	if c == nil || c.CrossOriginLocation == nil {
-		return ""
+func (b *BrandingThemeWidget) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingThemeWidget)
+	if !ok {
+		return nil, false
 	}
-	return *c.CrossOriginLocation
+	return b.diff(prefix, typed), true
 }
 
-// GetCustomLoginPage returns the CustomLoginPage field if it's non-nil, zero value otherwise.
-func (c *Client) GetCustomLoginPage() string {
-	if c == nil || c.CustomLoginPage == nil {
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (b *BrandingUniversalLogin) GetBody() string {
+	if b == nil || b.Body == nil {
 		return ""
 	}
-	return *c.CustomLoginPage
+	return *b.Body
 }
 
-// GetCustomLoginPageOn returns the CustomLoginPageOn field if it's non-nil, zero value otherwise.
-func (c *Client) GetCustomLoginPageOn() bool {
-	if c == nil || c.CustomLoginPageOn == nil {
-		return false
-	}
-	return *c.CustomLoginPageOn
+// String returns a string representation of BrandingUniversalLogin.
+func (b *BrandingUniversalLogin) String() string {
+	return Stringify(b)
 }
 
-// GetCustomLoginPagePreview returns the CustomLoginPagePreview field if it's non-nil, zero value otherwise.
-func (c *Client) GetCustomLoginPagePreview() string {
-	if c == nil || c.CustomLoginPagePreview == nil {
-		return ""
+// Clone returns a deep copy of BrandingUniversalLogin.
+func (b *BrandingUniversalLogin) Clone() *BrandingUniversalLogin {
+	if b == nil {
+		return nil
 	}
-	return *c.CustomLoginPagePreview
+	clone := &BrandingUniversalLogin{}
+	clone.Body = clonePointer(b.Body)
+	return clone
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (c *Client) GetDescription() string {
-	if c == nil || c.Description == nil {
-		return ""
-	}
-	return *c.Description
+func (b *BrandingUniversalLogin) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetEncryptionKey returns the EncryptionKey field if it's non-nil, zero value otherwise.
-func (c *Client) GetEncryptionKey() map[string]string {
-	if c == nil || c.EncryptionKey == nil {
-		return map[string]string{}
-	}
-	return *c.EncryptionKey
+// Equal reports whether BrandingUniversalLogin and other hold the same values.
+func (b *BrandingUniversalLogin) Equal(other *BrandingUniversalLogin) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetFormTemplate returns the FormTemplate field if it's non-nil, zero value otherwise.
-func (c *Client) GetFormTemplate() string {
-	if c == nil || c.FormTemplate == nil {
-		return ""
-	}
-	return *c.FormTemplate
+// Diff returns the fields that changed between BrandingUniversalLogin and other.
+func (b *BrandingUniversalLogin) Diff(other *BrandingUniversalLogin) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetGrantTypes returns the GrantTypes field if it's non-nil, zero value otherwise.
-func (c *Client) GetGrantTypes() []string {
-	if c == nil || c.GrantTypes == nil {
+func (b *BrandingUniversalLogin) diff(prefix string, other *BrandingUniversalLogin) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return *c.GrantTypes
+	if b == nil {
+		b = &BrandingUniversalLogin{}
+	}
+	if other == nil {
+		other = &BrandingUniversalLogin{}
+	}
+	changes = diffPointer(changes, prefix+"body", b.Body, other.Body)
+	return changes
 }
 
-// GetInitiateLoginURI returns the InitiateLoginURI field if it's non-nil, zero value otherwise.
-func (c *Client) GetInitiateLoginURI() string {
-	if c == nil || c.InitiateLoginURI == nil {
-		return ""
+func (b *BrandingUniversalLogin) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BrandingUniversalLogin)
+	if !ok {
+		return nil, false
 	}
-	return *c.InitiateLoginURI
+	return b.diff(prefix, typed), true
 }
 
-// GetIsFirstParty returns the IsFirstParty field if it's non-nil, zero value otherwise.
-func (c *Client) GetIsFirstParty() bool {
-	if c == nil || c.IsFirstParty == nil {
-		return false
+// GetAdminNotificationFrequency returns the AdminNotificationFrequency field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetAdminNotificationFrequency() []string {
+	if b == nil || b.AdminNotificationFrequency == nil {
+		return nil
 	}
-	return *c.IsFirstParty
+	return *b.AdminNotificationFrequency
 }
 
-// GetIsTokenEndpointIPHeaderTrusted returns the IsTokenEndpointIPHeaderTrusted field if it's non-nil, zero value otherwise.
-func (c *Client) GetIsTokenEndpointIPHeaderTrusted() bool {
-	if c == nil || c.IsTokenEndpointIPHeaderTrusted == nil {
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
 		return false
 	}
-	return *c.IsTokenEndpointIPHeaderTrusted
+	return *b.Enabled
 }
 
-// GetJWTConfiguration returns the JWTConfiguration field.
-func (c *Client) GetJWTConfiguration() *ClientJWTConfiguration {
-	if c == nil {
-		return nil
+// GetMethod returns the Method field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetMethod() string {
+	if b == nil || b.Method == nil {
+		return ""
 	}
-	return c.JWTConfiguration
+	return *b.Method
 }
 
-// GetLogoURI returns the LogoURI field if it's non-nil, zero value otherwise.
-func (c *Client) GetLogoURI() string {
-	if c == nil || c.LogoURI == nil {
-		return ""
+// GetShields returns the Shields field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetection) GetShields() []string {
+	if b == nil || b.Shields == nil {
+		return nil
 	}
-	return *c.LogoURI
+	return *b.Shields
 }
 
-// GetMobile returns the Mobile field.
-func (c *Client) GetMobile() *ClientMobile {
-	if c == nil {
+// GetStage returns the Stage field.
+func (b *BreachedPasswordDetection) GetStage() *BreachedPasswordDetectionStage {
+	if b == nil {
 		return nil
 	}
-	return c.Mobile
+	return b.Stage
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *Client) GetName() string {
-	if c == nil || c.Name == nil {
-		return ""
-	}
-	return *c.Name
+// String returns a string representation of BreachedPasswordDetection.
+func (b *BreachedPasswordDetection) String() string {
+	return Stringify(b)
 }
 
-// GetNativeSocialLogin returns the NativeSocialLogin field.
-func (c *Client) GetNativeSocialLogin() *ClientNativeSocialLogin {
-	if c == nil {
+// Clone returns a deep copy of BreachedPasswordDetection.
+func (b *BreachedPasswordDetection) Clone() *BreachedPasswordDetection {
+	if b == nil {
 		return nil
 	}
-	return c.NativeSocialLogin
+	clone := &BreachedPasswordDetection{}
+	clone.Enabled = clonePointer(b.Enabled)
+	clone.Shields = clonePointerFunc(b.Shields, func(v []string) []string { return cloneSlice(v) })
+	clone.AdminNotificationFrequency = clonePointerFunc(b.AdminNotificationFrequency, func(v []string) []string { return cloneSlice(v) })
+	clone.Method = clonePointer(b.Method)
+	clone.Stage = b.Stage.Clone()
+	return clone
 }
 
-// GetOIDCConformant returns the OIDCConformant field if it's non-nil, zero value otherwise.
-func (c *Client) GetOIDCConformant() bool {
-	if c == nil || c.OIDCConformant == nil {
-		return false
-	}
-	return *c.OIDCConformant
+func (b *BreachedPasswordDetection) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetOrganizationRequireBehavior returns the OrganizationRequireBehavior field if it's non-nil, zero value otherwise.
-func (c *Client) GetOrganizationRequireBehavior() string {
-	if c == nil || c.OrganizationRequireBehavior == nil {
-		return ""
-	}
-	return *c.OrganizationRequireBehavior
+// Equal reports whether BreachedPasswordDetection and other hold the same values.
+func (b *BreachedPasswordDetection) Equal(other *BreachedPasswordDetection) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetOrganizationUsage returns the OrganizationUsage field if it's non-nil, zero value otherwise.
-func (c *Client) GetOrganizationUsage() string {
-	if c == nil || c.OrganizationUsage == nil {
-		return ""
-	}
-	return *c.OrganizationUsage
+// Diff returns the fields that changed between BreachedPasswordDetection and other.
+func (b *BreachedPasswordDetection) Diff(other *BreachedPasswordDetection) []FieldChange {
+	return b.diff("", other)
 }
 
-// GetRefreshToken returns the RefreshToken field.
-func (c *Client) GetRefreshToken() *ClientRefreshToken {
-	if c == nil {
+func (b *BreachedPasswordDetection) diff(prefix string, other *BreachedPasswordDetection) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return c.RefreshToken
+	if b == nil {
+		b = &BreachedPasswordDetection{}
+	}
+	if other == nil {
+		other = &BreachedPasswordDetection{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", b.Enabled, other.Enabled)
+	changes = diffSlicePointer(changes, prefix+"shields", b.Shields, other.Shields)
+	changes = diffSlicePointer(changes, prefix+"admin_notification_frequency", b.AdminNotificationFrequency, other.AdminNotificationFrequency)
+	changes = diffPointer(changes, prefix+"method", b.Method, other.Method)
+	changes = append(changes, b.Stage.diff(prefix+"stage.", other.Stage)...)
+	return changes
 }
 
-// GetSSO returns the SSO field if it's non-nil, zero value otherwise.
-func (c *Client) GetSSO() bool {
-	if c == nil || c.SSO == nil {
-		return false
+func (b *BreachedPasswordDetection) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BreachedPasswordDetection)
+	if !ok {
+		return nil, false
 	}
-	return *c.SSO
+	return b.diff(prefix, typed), true
 }
 
-// GetSSODisabled returns the SSODisabled field if it's non-nil, zero value otherwise.
-func (c *Client) GetSSODisabled() bool {
-	if c == nil || c.SSODisabled == nil {
-		return false
+// GetShields returns the Shields field if it's non-nil, zero value otherwise.
+func (b *BreachedPasswordDetectionPreUserRegistration) GetShields() []string {
+	if b == nil || b.Shields == nil {
+		return nil
 	}
-	return *c.SSODisabled
+	return *b.Shields
 }
 
-// GetTokenEndpointAuthMethod returns the TokenEndpointAuthMethod field if it's non-nil, zero value otherwise.
-func (c *Client) GetTokenEndpointAuthMethod() string {
-	if c == nil || c.TokenEndpointAuthMethod == nil {
-		return ""
-	}
-	return *c.TokenEndpointAuthMethod
+// String returns a string representation of BreachedPasswordDetectionPreUserRegistration.
+func (b *BreachedPasswordDetectionPreUserRegistration) String() string {
+	return Stringify(b)
 }
 
-// GetWebOrigins returns the WebOrigins field if it's non-nil, zero value otherwise.
-func (c *Client) GetWebOrigins() []string {
-	if c == nil || c.WebOrigins == nil {
+// Clone returns a deep copy of BreachedPasswordDetectionPreUserRegistration.
+func (b *BreachedPasswordDetectionPreUserRegistration) Clone() *BreachedPasswordDetectionPreUserRegistration {
+	if b == nil {
 		return nil
 	}
-	return *c.WebOrigins
+	clone := &BreachedPasswordDetectionPreUserRegistration{}
+	clone.Shields = clonePointerFunc(b.Shields, func(v []string) []string { return cloneSlice(v) })
+	return clone
 }
 
-// String returns a string representation of Client.
-func (c *Client) String() string {
-	return Stringify(c)
+func (b *BreachedPasswordDetectionPreUserRegistration) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
-func (c *ClientGrant) GetAudience() string {
-	if c == nil || c.Audience == nil {
-		return ""
-	}
-	return *c.Audience
+// Equal reports whether BreachedPasswordDetectionPreUserRegistration and other hold the same values.
+func (b *BreachedPasswordDetectionPreUserRegistration) Equal(other *BreachedPasswordDetectionPreUserRegistration) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ClientGrant) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+// Diff returns the fields that changed between BreachedPasswordDetectionPreUserRegistration and other.
+func (b *BreachedPasswordDetectionPreUserRegistration) Diff(other *BreachedPasswordDetectionPreUserRegistration) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BreachedPasswordDetectionPreUserRegistration) diff(prefix string, other *BreachedPasswordDetectionPreUserRegistration) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
 	}
-	return *c.ClientID
+	if b == nil {
+		b = &BreachedPasswordDetectionPreUserRegistration{}
+	}
+	if other == nil {
+		other = &BreachedPasswordDetectionPreUserRegistration{}
+	}
+	changes = diffSlicePointer(changes, prefix+"shields", b.Shields, other.Shields)
+	return changes
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *ClientGrant) GetID() string {
-	if c == nil || c.ID == nil {
-		return ""
+func (b *BreachedPasswordDetectionPreUserRegistration) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BreachedPasswordDetectionPreUserRegistration)
+	if !ok {
+		return nil, false
 	}
-	return *c.ID
+	return b.diff(prefix, typed), true
 }
 
-// String returns a string representation of ClientGrant.
-func (c *ClientGrant) String() string {
-	return Stringify(c)
+// GetPreUserRegistration returns the PreUserRegistration field.
+func (b *BreachedPasswordDetectionStage) GetPreUserRegistration() *BreachedPasswordDetectionPreUserRegistration {
+	if b == nil {
+		return nil
+	}
+	return b.PreUserRegistration
 }
 
-// String returns a string representation of ClientGrantList.
-func (c *ClientGrantList) String() string {
-	return Stringify(c)
+// String returns a string representation of BreachedPasswordDetectionStage.
+func (b *BreachedPasswordDetectionStage) String() string {
+	return Stringify(b)
 }
 
-// GetAlgorithm returns the Algorithm field if it's non-nil, zero value otherwise.
-func (c *ClientJWTConfiguration) GetAlgorithm() string {
-	if c == nil || c.Algorithm == nil {
-		return ""
+// Clone returns a deep copy of BreachedPasswordDetectionStage.
+func (b *BreachedPasswordDetectionStage) Clone() *BreachedPasswordDetectionStage {
+	if b == nil {
+		return nil
 	}
-	return *c.Algorithm
+	clone := &BreachedPasswordDetectionStage{}
+	clone.PreUserRegistration = b.PreUserRegistration.Clone()
+	return clone
 }
 
-// GetLifetimeInSeconds returns the LifetimeInSeconds field if it's non-nil, zero value otherwise.
-func (c *ClientJWTConfiguration) GetLifetimeInSeconds() int {
-	if c == nil || c.LifetimeInSeconds == nil {
-		return 0
-	}
-	return *c.LifetimeInSeconds
+func (b *BreachedPasswordDetectionStage) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// GetScopes returns the Scopes field if it's non-nil, zero value otherwise.
-func (c *ClientJWTConfiguration) GetScopes() map[string]string {
-	if c == nil || c.Scopes == nil {
-		return map[string]string{}
-	}
-	return *c.Scopes
+// Equal reports whether BreachedPasswordDetectionStage and other hold the same values.
+func (b *BreachedPasswordDetectionStage) Equal(other *BreachedPasswordDetectionStage) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetSecretEncoded returns the SecretEncoded field if it's non-nil, zero value otherwise.
-func (c *ClientJWTConfiguration) GetSecretEncoded() bool {
-	if c == nil || c.SecretEncoded == nil {
-		return false
-	}
-	return *c.SecretEncoded
+// Diff returns the fields that changed between BreachedPasswordDetectionStage and other.
+func (b *BreachedPasswordDetectionStage) Diff(other *BreachedPasswordDetectionStage) []FieldChange {
+	return b.diff("", other)
 }
 
-// String returns a string representation of ClientJWTConfiguration.
-func (c *ClientJWTConfiguration) String() string {
-	return Stringify(c)
+func (b *BreachedPasswordDetectionStage) diff(prefix string, other *BreachedPasswordDetectionStage) (changes []FieldChange) {
+	if b == nil && other == nil {
+		return nil
+	}
+	if b == nil {
+		b = &BreachedPasswordDetectionStage{}
+	}
+	if other == nil {
+		other = &BreachedPasswordDetectionStage{}
+	}
+	changes = append(changes, b.PreUserRegistration.diff(prefix+"pre-user-registration.", other.PreUserRegistration)...)
+	return changes
 }
 
-// String returns a string representation of ClientList.
-func (c *ClientList) String() string {
-	return Stringify(c)
+func (b *BreachedPasswordDetectionStage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BreachedPasswordDetectionStage)
+	if !ok {
+		return nil, false
+	}
+	return b.diff(prefix, typed), true
 }
 
-// GetAndroid returns the Android field.
-func (c *ClientMobile) GetAndroid() *ClientMobileAndroid {
-	if c == nil {
+// GetAllowList returns the AllowList field if it's non-nil, zero value otherwise.
+func (b *BruteForceProtection) GetAllowList() []string {
+	if b == nil || b.AllowList == nil {
 		return nil
 	}
-	return c.Android
+	return *b.AllowList
 }
 
-// GetIOS returns the IOS field.
-func (c *ClientMobile) GetIOS() *ClientMobileIOS {
-	if c == nil {
-		return nil
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (b *BruteForceProtection) GetEnabled() bool {
+	if b == nil || b.Enabled == nil {
+		return false
 	}
-	return c.IOS
+	return *b.Enabled
 }
 
-// String returns a string representation of ClientMobile.
-func (c *ClientMobile) String() string {
-	return Stringify(c)
+// GetMaxAttempts returns the MaxAttempts field if it's non-nil, zero value otherwise.
+func (b *BruteForceProtection) GetMaxAttempts() int {
+	if b == nil || b.MaxAttempts == nil {
+		return 0
+	}
+	return *b.MaxAttempts
 }
 
-// GetAppPackageName returns the AppPackageName field if it's non-nil, zero value otherwise.
-func (c *ClientMobileAndroid) GetAppPackageName() string {
-	if c == nil || c.AppPackageName == nil {
+// GetMode returns the Mode field if it's non-nil, zero value otherwise.
+func (b *BruteForceProtection) GetMode() string {
+	if b == nil || b.Mode == nil {
 		return ""
 	}
-	return *c.AppPackageName
+	return *b.Mode
 }
 
-// GetKeyHashes returns the KeyHashes field if it's non-nil, zero value otherwise.
-func (c *ClientMobileAndroid) GetKeyHashes() []string {
-	if c == nil || c.KeyHashes == nil {
+// GetShields returns the Shields field if it's non-nil, zero value otherwise.
+func (b *BruteForceProtection) GetShields() []string {
+	if b == nil || b.Shields == nil {
 		return nil
 	}
-	return *c.KeyHashes
+	return *b.Shields
 }
 
-// String returns a string representation of ClientMobileAndroid.
-func (c *ClientMobileAndroid) String() string {
-	return Stringify(c)
+// String returns a string representation of BruteForceProtection.
+func (b *BruteForceProtection) String() string {
+	return Stringify(b)
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (c *ClientMobileIOS) GetAppID() string {
-	if c == nil || c.AppID == nil {
-		return ""
+// Clone returns a deep copy of BruteForceProtection.
+func (b *BruteForceProtection) Clone() *BruteForceProtection {
+	if b == nil {
+		return nil
 	}
-	return *c.AppID
+	clone := &BruteForceProtection{}
+	clone.Enabled = clonePointer(b.Enabled)
+	clone.Shields = clonePointerFunc(b.Shields, func(v []string) []string { return cloneSlice(v) })
+	clone.AllowList = clonePointerFunc(b.AllowList, func(v []string) []string { return cloneSlice(v) })
+	clone.Mode = clonePointer(b.Mode)
+	clone.MaxAttempts = clonePointer(b.MaxAttempts)
+	return clone
 }
 
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (c *ClientMobileIOS) GetTeamID() string {
-	if c == nil || c.TeamID == nil {
-		return ""
-	}
-	return *c.TeamID
+func (b *BruteForceProtection) cloneAny() interface{} {
+	return b.Clone()
 }
 
-// String returns a string representation of ClientMobileIOS.
-func (c *ClientMobileIOS) String() string {
-	return Stringify(c)
+// Equal reports whether BruteForceProtection and other hold the same values.
+func (b *BruteForceProtection) Equal(other *BruteForceProtection) bool {
+	return len(b.diff("", other)) == 0
 }
 
-// GetApple returns the Apple field.
-func (c *ClientNativeSocialLogin) GetApple() *ClientNativeSocialLoginSupportEnabled {
-	if c == nil {
+// Diff returns the fields that changed between BruteForceProtection and other.
+func (b *BruteForceProtection) Diff(other *BruteForceProtection) []FieldChange {
+	return b.diff("", other)
+}
+
+func (b *BruteForceProtection) diff(prefix string, other *BruteForceProtection) (changes []FieldChange) {
+	if b == nil && other == nil {
 		return nil
 	}
-	return c.Apple
+	if b == nil {
+		b = &BruteForceProtection{}
+	}
+	if other == nil {
+		other = &BruteForceProtection{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", b.Enabled, other.Enabled)
+	changes = diffSlicePointer(changes, prefix+"shields", b.Shields, other.Shields)
+	changes = diffSlicePointer(changes, prefix+"allowlist", b.AllowList, other.AllowList)
+	changes = diffPointer(changes, prefix+"mode", b.Mode, other.Mode)
+	changes = diffPointer(changes, prefix+"max_attempts", b.MaxAttempts, other.MaxAttempts)
+	return changes
 }
 
-// GetFacebook returns the Facebook field.
-func (c *ClientNativeSocialLogin) GetFacebook() *ClientNativeSocialLoginSupportEnabled {
-	if c == nil {
-		return nil
+func (b *BruteForceProtection) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*BruteForceProtection)
+	if !ok {
+		return nil, false
 	}
-	return c.Facebook
+	return b.diff(prefix, typed), true
 }
 
-// String returns a string representation of ClientNativeSocialLogin.
-func (c *ClientNativeSocialLogin) String() string {
-	return Stringify(c)
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetIssuer() string {
+	if c == nil || c.Issuer == nil {
+		return ""
+	}
+	return *c.Issuer
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (c *ClientNativeSocialLoginSupportEnabled) GetEnabled() bool {
-	if c == nil || c.Enabled == nil {
-		return false
+// GetNotAfter returns the NotAfter field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetNotAfter() time.Time {
+	if c == nil || c.NotAfter == nil {
+		return time.Time{}
 	}
-	return *c.Enabled
+	return *c.NotAfter
 }
 
-// String returns a string representation of ClientNativeSocialLoginSupportEnabled.
-func (c *ClientNativeSocialLoginSupportEnabled) String() string {
-	return Stringify(c)
+// GetNotBefore returns the NotBefore field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetNotBefore() time.Time {
+	if c == nil || c.NotBefore == nil {
+		return time.Time{}
+	}
+	return *c.NotBefore
 }
 
-// GetExpirationType returns the ExpirationType field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetExpirationType() string {
-	if c == nil || c.ExpirationType == nil {
+// GetPEM returns the PEM field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetPEM() string {
+	if c == nil || c.PEM == nil {
 		return ""
 	}
-	return *c.ExpirationType
+	return *c.PEM
 }
 
-// GetIdleTokenLifetime returns the IdleTokenLifetime field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetIdleTokenLifetime() int {
-	if c == nil || c.IdleTokenLifetime == nil {
-		return 0
+// GetPublicKeyAlgorithm returns the PublicKeyAlgorithm field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetPublicKeyAlgorithm() string {
+	if c == nil || c.PublicKeyAlgorithm == nil {
+		return ""
 	}
-	return *c.IdleTokenLifetime
+	return *c.PublicKeyAlgorithm
 }
 
-// GetInfiniteIdleTokenLifetime returns the InfiniteIdleTokenLifetime field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetInfiniteIdleTokenLifetime() bool {
-	if c == nil || c.InfiniteIdleTokenLifetime == nil {
-		return false
+// GetSerialNumber returns the SerialNumber field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetSerialNumber() string {
+	if c == nil || c.SerialNumber == nil {
+		return ""
 	}
-	return *c.InfiniteIdleTokenLifetime
+	return *c.SerialNumber
 }
 
-// GetInfiniteTokenLifetime returns the InfiniteTokenLifetime field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetInfiniteTokenLifetime() bool {
-	if c == nil || c.InfiniteTokenLifetime == nil {
-		return false
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetSignatureAlgorithm() string {
+	if c == nil || c.SignatureAlgorithm == nil {
+		return ""
 	}
-	return *c.InfiniteTokenLifetime
+	return *c.SignatureAlgorithm
 }
 
-// GetLeeway returns the Leeway field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetLeeway() int {
-	if c == nil || c.Leeway == nil {
-		return 0
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetSubject() string {
+	if c == nil || c.Subject == nil {
+		return ""
 	}
-	return *c.Leeway
+	return *c.Subject
 }
 
-// GetRotationType returns the RotationType field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetRotationType() string {
-	if c == nil || c.RotationType == nil {
+// GetThumbprintSHA1 returns the ThumbprintSHA1 field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetThumbprintSHA1() string {
+	if c == nil || c.ThumbprintSHA1 == nil {
 		return ""
 	}
-	return *c.RotationType
+	return *c.ThumbprintSHA1
 }
 
-// GetTokenLifetime returns the TokenLifetime field if it's non-nil, zero value otherwise.
-func (c *ClientRefreshToken) GetTokenLifetime() int {
-	if c == nil || c.TokenLifetime == nil {
-		return 0
+// GetThumbprintSHA256 returns the ThumbprintSHA256 field if it's non-nil, zero value otherwise.
+func (c *CertificateInfo) GetThumbprintSHA256() string {
+	if c == nil || c.ThumbprintSHA256 == nil {
+		return ""
 	}
-	return *c.TokenLifetime
+	return *c.ThumbprintSHA256
 }
 
-// String returns a string representation of ClientRefreshToken.
-func (c *ClientRefreshToken) String() string {
+// String returns a string representation of CertificateInfo.
+func (c *CertificateInfo) String() string {
 	return Stringify(c)
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (c *Connection) GetDisplayName() string {
-	if c == nil || c.DisplayName == nil {
+// GetExpiryWindow returns the ExpiryWindow field if it's non-nil, zero value otherwise.
+func (c *CertificateInventory) GetExpiryWindow() string {
+	if c == nil || c.ExpiryWindow == nil {
 		return ""
 	}
-	return *c.DisplayName
+	return *c.ExpiryWindow
 }
 
-// GetEnabledClients returns the EnabledClients field if it's non-nil, zero value otherwise.
-func (c *Connection) GetEnabledClients() []string {
-	if c == nil || c.EnabledClients == nil {
+// GetGeneratedAt returns the GeneratedAt field if it's non-nil, zero value otherwise.
+func (c *CertificateInventory) GetGeneratedAt() time.Time {
+	if c == nil || c.GeneratedAt == nil {
+		return time.Time{}
+	}
+	return *c.GeneratedAt
+}
+
+// String returns a string representation of CertificateInventory.
+func (c *CertificateInventory) String() string {
+	return Stringify(c)
+}
+
+// GetCertificate returns the Certificate field.
+func (c *CertificateInventoryItem) GetCertificate() *CertificateInfo {
+	if c == nil {
 		return nil
 	}
-	return *c.EnabledClients
+	return c.Certificate
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *Connection) GetID() string {
-	if c == nil || c.ID == nil {
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetConnectionID() string {
+	if c == nil || c.ConnectionID == nil {
 		return ""
 	}
-	return *c.ID
+	return *c.ConnectionID
 }
 
-// GetIsDomainConnection returns the IsDomainConnection field if it's non-nil, zero value otherwise.
-func (c *Connection) GetIsDomainConnection() bool {
-	if c == nil || c.IsDomainConnection == nil {
-		return false
+// GetConnectionName returns the ConnectionName field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetConnectionName() string {
+	if c == nil || c.ConnectionName == nil {
+		return ""
 	}
-	return *c.IsDomainConnection
+	return *c.ConnectionName
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (c *Connection) GetMetadata() map[string]string {
-	if c == nil || c.Metadata == nil {
-		return map[string]string{}
+// GetConnectionStrategy returns the ConnectionStrategy field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetConnectionStrategy() string {
+	if c == nil || c.ConnectionStrategy == nil {
+		return ""
 	}
-	return *c.Metadata
+	return *c.ConnectionStrategy
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *Connection) GetName() string {
-	if c == nil || c.Name == nil {
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetError() string {
+	if c == nil || c.Error == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.Error
 }
 
-// GetProvisioningTicketURL returns the ProvisioningTicketURL field if it's non-nil, zero value otherwise.
-func (c *Connection) GetProvisioningTicketURL() string {
-	if c == nil || c.ProvisioningTicketURL == nil {
-		return ""
+// GetExpired returns the Expired field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetExpired() bool {
+	if c == nil || c.Expired == nil {
+		return false
 	}
-	return *c.ProvisioningTicketURL
+	return *c.Expired
 }
 
-// GetRealms returns the Realms field if it's non-nil, zero value otherwise.
-func (c *Connection) GetRealms() []string {
-	if c == nil || c.Realms == nil {
-		return nil
-	}
-	return *c.Realms
-}
-
-// GetShowAsButton returns the ShowAsButton field if it's non-nil, zero value otherwise.
-func (c *Connection) GetShowAsButton() bool {
-	if c == nil || c.ShowAsButton == nil {
+// GetExpiring returns the Expiring field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetExpiring() bool {
+	if c == nil || c.Expiring == nil {
 		return false
 	}
-	return *c.ShowAsButton
+	return *c.Expiring
 }
 
-// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
-func (c *Connection) GetStrategy() string {
-	if c == nil || c.Strategy == nil {
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetField() string {
+	if c == nil || c.Field == nil {
 		return ""
 	}
-	return *c.Strategy
-}
-
-// String returns a string representation of Connection.
-func (c *Connection) String() string {
-	return Stringify(c)
+	return *c.Field
 }
 
-// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
-func (c *ConnectionGatewayAuthentication) GetAudience() string {
-	if c == nil || c.Audience == nil {
+// GetKeyState returns the KeyState field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetKeyState() string {
+	if c == nil || c.KeyState == nil {
 		return ""
 	}
-	return *c.Audience
+	return *c.KeyState
 }
 
-// GetMethod returns the Method field if it's non-nil, zero value otherwise.
-func (c *ConnectionGatewayAuthentication) GetMethod() string {
-	if c == nil || c.Method == nil {
+// GetKID returns the KID field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetKID() string {
+	if c == nil || c.KID == nil {
 		return ""
 	}
-	return *c.Method
+	return *c.KID
 }
 
-// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
-func (c *ConnectionGatewayAuthentication) GetSecret() string {
-	if c == nil || c.Secret == nil {
+// GetSource returns the Source field if it's non-nil, zero value otherwise.
+func (c *CertificateInventoryItem) GetSource() string {
+	if c == nil || c.Source == nil {
 		return ""
 	}
-	return *c.Secret
+	return *c.Source
 }
 
-// GetSecretBase64Encoded returns the SecretBase64Encoded field if it's non-nil, zero value otherwise.
-func (c *ConnectionGatewayAuthentication) GetSecretBase64Encoded() bool {
-	if c == nil || c.SecretBase64Encoded == nil {
-		return false
+// String returns a string representation of CertificateInventoryItem.
+func (c *CertificateInventoryItem) String() string {
+	return Stringify(c)
+}
+
+// GetAllowedClients returns the AllowedClients field if it's non-nil, zero value otherwise.
+func (c *Client) GetAllowedClients() []string {
+	if c == nil || c.AllowedClients == nil {
+		return nil
 	}
-	return *c.SecretBase64Encoded
+	return *c.AllowedClients
 }
 
-// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
-func (c *ConnectionGatewayAuthentication) GetSubject() string {
-	if c == nil || c.Subject == nil {
-		return ""
+// GetAllowedLogoutURLs returns the AllowedLogoutURLs field if it's non-nil, zero value otherwise.
+func (c *Client) GetAllowedLogoutURLs() []string {
+	if c == nil || c.AllowedLogoutURLs == nil {
+		return nil
 	}
-	return *c.Subject
+	return *c.AllowedLogoutURLs
 }
 
-// String returns a string representation of ConnectionGatewayAuthentication.
-func (c *ConnectionGatewayAuthentication) String() string {
-	return Stringify(c)
+// GetAllowedOrigins returns the AllowedOrigins field if it's non-nil, zero value otherwise.
+func (c *Client) GetAllowedOrigins() []string {
+	if c == nil || c.AllowedOrigins == nil {
+		return nil
+	}
+	return *c.AllowedOrigins
 }
 
-// String returns a string representation of ConnectionList.
-func (c *ConnectionList) String() string {
-	return Stringify(c)
+// GetAppType returns the AppType field if it's non-nil, zero value otherwise.
+func (c *Client) GetAppType() string {
+	if c == nil || c.AppType == nil {
+		return ""
+	}
+	return *c.AppType
 }
 
-// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetBruteForceProtection() bool {
-	if c == nil || c.BruteForceProtection == nil {
-		return false
+// GetCallbacks returns the Callbacks field if it's non-nil, zero value otherwise.
+func (c *Client) GetCallbacks() []string {
+	if c == nil || c.Callbacks == nil {
+		return nil
 	}
-	return *c.BruteForceProtection
+	return *c.Callbacks
 }
 
-// GetConfiguration returns the Configuration field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetConfiguration() map[string]string {
-	if c == nil || c.Configuration == nil {
-		return map[string]string{}
+// GetClientAliases returns the ClientAliases field if it's non-nil, zero value otherwise.
+func (c *Client) GetClientAliases() []string {
+	if c == nil || c.ClientAliases == nil {
+		return nil
 	}
-	return *c.Configuration
+	return *c.ClientAliases
 }
 
-// GetCustomScripts returns the CustomScripts field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetCustomScripts() map[string]string {
-	if c == nil || c.CustomScripts == nil {
-		return map[string]string{}
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *Client) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
 	}
-	return *c.CustomScripts
+	return *c.ClientID
 }
 
-// GetDisableSelfServiceChangePassword returns the DisableSelfServiceChangePassword field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetDisableSelfServiceChangePassword() bool {
-	if c == nil || c.DisableSelfServiceChangePassword == nil {
-		return false
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *Client) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
 	}
-	return *c.DisableSelfServiceChangePassword
+	return *c.ClientSecret
 }
 
-// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetDisableSignup() bool {
-	if c == nil || c.DisableSignup == nil {
+// GetCrossOriginAuth returns the CrossOriginAuth field if it's non-nil, zero value otherwise.
+func (c *Client) GetCrossOriginAuth() bool {
+	if c == nil || c.CrossOriginAuth == nil {
 		return false
 	}
-	return *c.DisableSignup
+	return *c.CrossOriginAuth
 }
 
-// GetEnabledDatabaseCustomization returns the EnabledDatabaseCustomization field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetEnabledDatabaseCustomization() bool {
-	if c == nil || c.EnabledDatabaseCustomization == nil {
-		return false
+// GetCrossOriginLocation returns the CrossOriginLocation field if it's non-nil, zero value otherwise.
+func (c *Client) GetCrossOriginLocation() string {
+	if c == nil || c.CrossOriginLocation == nil {
+		return ""
 	}
-	return *c.EnabledDatabaseCustomization
+	return *c.CrossOriginLocation
 }
 
-// GetEnableScriptContext returns the EnableScriptContext field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetEnableScriptContext() bool {
-	if c == nil || c.EnableScriptContext == nil {
-		return false
+// GetCustomLoginPage returns the CustomLoginPage field if it's non-nil, zero value otherwise.
+func (c *Client) GetCustomLoginPage() string {
+	if c == nil || c.CustomLoginPage == nil {
+		return ""
 	}
-	return *c.EnableScriptContext
+	return *c.CustomLoginPage
 }
 
-// GetImportMode returns the ImportMode field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetImportMode() bool {
-	if c == nil || c.ImportMode == nil {
+// GetCustomLoginPageOn returns the CustomLoginPageOn field if it's non-nil, zero value otherwise.
+func (c *Client) GetCustomLoginPageOn() bool {
+	if c == nil || c.CustomLoginPageOn == nil {
 		return false
 	}
-	return *c.ImportMode
+	return *c.CustomLoginPageOn
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
+// GetCustomLoginPagePreview returns the CustomLoginPagePreview field if it's non-nil, zero value otherwise.
+func (c *Client) GetCustomLoginPagePreview() string {
+	if c == nil || c.CustomLoginPagePreview == nil {
+		return ""
 	}
-	return *c.NonPersistentAttrs
+	return *c.CustomLoginPagePreview
 }
 
-// GetPasswordPolicy returns the PasswordPolicy field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetPasswordPolicy() string {
-	if c == nil || c.PasswordPolicy == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (c *Client) GetDescription() string {
+	if c == nil || c.Description == nil {
 		return ""
 	}
-	return *c.PasswordPolicy
+	return *c.Description
 }
 
-// GetRequiresUsername returns the RequiresUsername field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetRequiresUsername() bool {
-	if c == nil || c.RequiresUsername == nil {
-		return false
+// GetEncryptionKey returns the EncryptionKey field if it's non-nil, zero value otherwise.
+func (c *Client) GetEncryptionKey() map[string]string {
+	if c == nil || c.EncryptionKey == nil {
+		return map[string]string{}
 	}
-	return *c.RequiresUsername
+	return *c.EncryptionKey
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetFormTemplate returns the FormTemplate field if it's non-nil, zero value otherwise.
+func (c *Client) GetFormTemplate() string {
+	if c == nil || c.FormTemplate == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *c.FormTemplate
 }
 
-// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptions) GetStrategyVersion() int {
-	if c == nil || c.StrategyVersion == nil {
-		return 0
+// GetGrantTypes returns the GrantTypes field if it's non-nil, zero value otherwise.
+func (c *Client) GetGrantTypes() []string {
+	if c == nil || c.GrantTypes == nil {
+		return nil
 	}
-	return *c.StrategyVersion
-}
-
-// String returns a string representation of ConnectionOptions.
-func (c *ConnectionOptions) String() string {
-	return Stringify(c)
+	return *c.GrantTypes
 }
 
-// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetBruteForceProtection() bool {
-	if c == nil || c.BruteForceProtection == nil {
-		return false
+// GetInitiateLoginURI returns the InitiateLoginURI field if it's non-nil, zero value otherwise.
+func (c *Client) GetInitiateLoginURI() string {
+	if c == nil || c.InitiateLoginURI == nil {
+		return ""
 	}
-	return *c.BruteForceProtection
+	return *c.InitiateLoginURI
 }
 
-// GetCertAuth returns the CertAuth field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetCertAuth() bool {
-	if c == nil || c.CertAuth == nil {
+// GetIsFirstParty returns the IsFirstParty field if it's non-nil, zero value otherwise.
+func (c *Client) GetIsFirstParty() bool {
+	if c == nil || c.IsFirstParty == nil {
 		return false
 	}
-	return *c.CertAuth
+	return *c.IsFirstParty
 }
 
-// GetDisableCache returns the DisableCache field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetDisableCache() bool {
-	if c == nil || c.DisableCache == nil {
+// GetIsTokenEndpointIPHeaderTrusted returns the IsTokenEndpointIPHeaderTrusted field if it's non-nil, zero value otherwise.
+func (c *Client) GetIsTokenEndpointIPHeaderTrusted() bool {
+	if c == nil || c.IsTokenEndpointIPHeaderTrusted == nil {
 		return false
 	}
-	return *c.DisableCache
+	return *c.IsTokenEndpointIPHeaderTrusted
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
+// GetJWTConfiguration returns the JWTConfiguration field.
+func (c *Client) GetJWTConfiguration() *ClientJWTConfiguration {
+	if c == nil {
 		return nil
 	}
-	return *c.DomainAliases
+	return c.JWTConfiguration
 }
 
-// GetIPs returns the IPs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetIPs() []string {
-	if c == nil || c.IPs == nil {
-		return nil
+// GetLogoURI returns the LogoURI field if it's non-nil, zero value otherwise.
+func (c *Client) GetLogoURI() string {
+	if c == nil || c.LogoURI == nil {
+		return ""
 	}
-	return *c.IPs
+	return *c.LogoURI
 }
 
-// GetKerberos returns the Kerberos field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetKerberos() bool {
-	if c == nil || c.Kerberos == nil {
-		return false
+// GetMobile returns the Mobile field.
+func (c *Client) GetMobile() *ClientMobile {
+	if c == nil {
+		return nil
 	}
-	return *c.Kerberos
+	return c.Mobile
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Client) GetName() string {
+	if c == nil || c.Name == nil {
 		return ""
 	}
-	return *c.LogoURL
+	return *c.Name
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// GetNativeSocialLogin returns the NativeSocialLogin field.
+func (c *Client) GetNativeSocialLogin() *ClientNativeSocialLogin {
+	if c == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	return c.NativeSocialLogin
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
+// GetOIDCConformant returns the OIDCConformant field if it's non-nil, zero value otherwise.
+func (c *Client) GetOIDCConformant() bool {
+	if c == nil || c.OIDCConformant == nil {
+		return false
 	}
-	return *c.SetUserAttributes
+	return *c.OIDCConformant
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAD) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
+// GetOrganizationRequireBehavior returns the OrganizationRequireBehavior field if it's non-nil, zero value otherwise.
+func (c *Client) GetOrganizationRequireBehavior() string {
+	if c == nil || c.OrganizationRequireBehavior == nil {
 		return ""
 	}
-	return *c.TenantDomain
-}
-
-// String returns a string representation of ConnectionOptionsAD.
-func (c *ConnectionOptionsAD) String() string {
-	return Stringify(c)
+	return *c.OrganizationRequireBehavior
 }
 
-// GetADFSServer returns the ADFSServer field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetADFSServer() string {
-	if c == nil || c.ADFSServer == nil {
+// GetOrganizationUsage returns the OrganizationUsage field if it's non-nil, zero value otherwise.
+func (c *Client) GetOrganizationUsage() string {
+	if c == nil || c.OrganizationUsage == nil {
 		return ""
 	}
-	return *c.ADFSServer
+	return *c.OrganizationUsage
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
+// GetRefreshToken returns the RefreshToken field.
+func (c *Client) GetRefreshToken() *ClientRefreshToken {
+	if c == nil {
 		return nil
 	}
-	return *c.DomainAliases
+	return c.RefreshToken
 }
 
-// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetEnableUsersAPI() bool {
-	if c == nil || c.EnableUsersAPI == nil {
+// GetSSO returns the SSO field if it's non-nil, zero value otherwise.
+func (c *Client) GetSSO() bool {
+	if c == nil || c.SSO == nil {
 		return false
 	}
-	return *c.EnableUsersAPI
+	return *c.SSO
 }
 
-// GetFedMetadataXML returns the FedMetadataXML field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetFedMetadataXML() string {
-	if c == nil || c.FedMetadataXML == nil {
-		return ""
+// GetSSODisabled returns the SSODisabled field if it's non-nil, zero value otherwise.
+func (c *Client) GetSSODisabled() bool {
+	if c == nil || c.SSODisabled == nil {
+		return false
 	}
-	return *c.FedMetadataXML
+	return *c.SSODisabled
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
+// GetTokenEndpointAuthMethod returns the TokenEndpointAuthMethod field if it's non-nil, zero value otherwise.
+func (c *Client) GetTokenEndpointAuthMethod() string {
+	if c == nil || c.TokenEndpointAuthMethod == nil {
 		return ""
 	}
-	return *c.LogoURL
+	return *c.TokenEndpointAuthMethod
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// GetWebOrigins returns the WebOrigins field if it's non-nil, zero value otherwise.
+func (c *Client) GetWebOrigins() []string {
+	if c == nil || c.WebOrigins == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
-}
-
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
-	}
-	return *c.SetUserAttributes
+	return *c.WebOrigins
 }
 
-// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetSignInEndpoint() string {
-	if c == nil || c.SignInEndpoint == nil {
-		return ""
-	}
-	return *c.SignInEndpoint
+// String returns a string representation of Client.
+func (c *Client) String() string {
+	return Stringify(c)
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
-		return ""
+// Clone returns a deep copy of Client.
+func (c *Client) Clone() *Client {
+	if c == nil {
+		return nil
 	}
-	return *c.TenantDomain
-}
-
-// GetThumbprints returns the Thumbprints field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetThumbprints() []string {
-	if c == nil || c.Thumbprints == nil {
+	clone := &Client{}
+	clone.Name = clonePointer(c.Name)
+	clone.Description = clonePointer(c.Description)
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.AppType = clonePointer(c.AppType)
+	clone.LogoURI = clonePointer(c.LogoURI)
+	clone.IsFirstParty = clonePointer(c.IsFirstParty)
+	clone.IsTokenEndpointIPHeaderTrusted = clonePointer(c.IsTokenEndpointIPHeaderTrusted)
+	clone.OIDCConformant = clonePointer(c.OIDCConformant)
+	clone.Callbacks = clonePointerFunc(c.Callbacks, func(v []string) []string { return cloneSlice(v) })
+	clone.AllowedOrigins = clonePointerFunc(c.AllowedOrigins, func(v []string) []string { return cloneSlice(v) })
+	clone.WebOrigins = clonePointerFunc(c.WebOrigins, func(v []string) []string { return cloneSlice(v) })
+	clone.ClientAliases = clonePointerFunc(c.ClientAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.AllowedClients = clonePointerFunc(c.AllowedClients, func(v []string) []string { return cloneSlice(v) })
+	clone.AllowedLogoutURLs = clonePointerFunc(c.AllowedLogoutURLs, func(v []string) []string { return cloneSlice(v) })
+	clone.JWTConfiguration = c.JWTConfiguration.Clone()
+	clone.SigningKeys = cloneSliceFunc(c.SigningKeys, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.EncryptionKey = clonePointerFunc(c.EncryptionKey, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.SSO = clonePointer(c.SSO)
+	clone.SSODisabled = clonePointer(c.SSODisabled)
+	clone.CrossOriginAuth = clonePointer(c.CrossOriginAuth)
+	clone.GrantTypes = clonePointerFunc(c.GrantTypes, func(v []string) []string { return cloneSlice(v) })
+	clone.CrossOriginLocation = clonePointer(c.CrossOriginLocation)
+	clone.CustomLoginPageOn = clonePointer(c.CustomLoginPageOn)
+	clone.CustomLoginPage = clonePointer(c.CustomLoginPage)
+	clone.CustomLoginPagePreview = clonePointer(c.CustomLoginPagePreview)
+	clone.FormTemplate = clonePointer(c.FormTemplate)
+	clone.Addons = cloneMapFunc(c.Addons, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.TokenEndpointAuthMethod = clonePointer(c.TokenEndpointAuthMethod)
+	clone.ClientMetadata = clonePointerFunc(c.ClientMetadata, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	clone.Mobile = c.Mobile.Clone()
+	clone.InitiateLoginURI = clonePointer(c.InitiateLoginURI)
+	clone.NativeSocialLogin = c.NativeSocialLogin.Clone()
+	clone.RefreshToken = c.RefreshToken.Clone()
+	clone.OrganizationUsage = clonePointer(c.OrganizationUsage)
+	clone.OrganizationRequireBehavior = clonePointer(c.OrganizationRequireBehavior)
+	return clone
+}
+
+func (c *Client) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether Client and other hold the same values.
+func (c *Client) Equal(other *Client) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Client and other.
+func (c *Client) Diff(other *Client) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *Client) diff(prefix string, other *Client) (changes []FieldChange) {
+	if c == nil && other == nil {
 		return nil
 	}
-	return *c.Thumbprints
+	if c == nil {
+		c = &Client{}
+	}
+	if other == nil {
+		other = &Client{}
+	}
+	changes = diffPointer(changes, prefix+"name", c.Name, other.Name)
+	changes = diffPointer(changes, prefix+"description", c.Description, other.Description)
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"app_type", c.AppType, other.AppType)
+	changes = diffPointer(changes, prefix+"logo_uri", c.LogoURI, other.LogoURI)
+	changes = diffPointer(changes, prefix+"is_first_party", c.IsFirstParty, other.IsFirstParty)
+	changes = diffPointer(changes, prefix+"is_token_endpoint_ip_header_trusted", c.IsTokenEndpointIPHeaderTrusted, other.IsTokenEndpointIPHeaderTrusted)
+	changes = diffPointer(changes, prefix+"oidc_conformant", c.OIDCConformant, other.OIDCConformant)
+	changes = diffSlicePointer(changes, prefix+"callbacks", c.Callbacks, other.Callbacks)
+	changes = diffSlicePointer(changes, prefix+"allowed_origins", c.AllowedOrigins, other.AllowedOrigins)
+	changes = diffSlicePointer(changes, prefix+"web_origins", c.WebOrigins, other.WebOrigins)
+	changes = diffSlicePointer(changes, prefix+"client_aliases", c.ClientAliases, other.ClientAliases)
+	changes = diffSlicePointer(changes, prefix+"allowed_clients", c.AllowedClients, other.AllowedClients)
+	changes = diffSlicePointer(changes, prefix+"allowed_logout_urls", c.AllowedLogoutURLs, other.AllowedLogoutURLs)
+	changes = append(changes, c.JWTConfiguration.diff(prefix+"jwt_configuration.", other.JWTConfiguration)...)
+	changes = diffSlice(changes, prefix+"signing_keys", c.SigningKeys, other.SigningKeys)
+	changes = diffMapPointer(changes, prefix+"encryption_key", c.EncryptionKey, other.EncryptionKey)
+	changes = diffPointer(changes, prefix+"sso", c.SSO, other.SSO)
+	changes = diffPointer(changes, prefix+"sso_disabled", c.SSODisabled, other.SSODisabled)
+	changes = diffPointer(changes, prefix+"cross_origin_authentication", c.CrossOriginAuth, other.CrossOriginAuth)
+	changes = diffSlicePointer(changes, prefix+"grant_types", c.GrantTypes, other.GrantTypes)
+	changes = diffPointer(changes, prefix+"cross_origin_loc", c.CrossOriginLocation, other.CrossOriginLocation)
+	changes = diffPointer(changes, prefix+"custom_login_page_on", c.CustomLoginPageOn, other.CustomLoginPageOn)
+	changes = diffPointer(changes, prefix+"custom_login_page", c.CustomLoginPage, other.CustomLoginPage)
+	changes = diffPointer(changes, prefix+"custom_login_page_preview", c.CustomLoginPagePreview, other.CustomLoginPagePreview)
+	changes = diffPointer(changes, prefix+"form_template", c.FormTemplate, other.FormTemplate)
+	changes = diffMap(changes, prefix+"addons", c.Addons, other.Addons)
+	changes = diffPointer(changes, prefix+"token_endpoint_auth_method", c.TokenEndpointAuthMethod, other.TokenEndpointAuthMethod)
+	changes = diffMapPointer(changes, prefix+"client_metadata", c.ClientMetadata, other.ClientMetadata)
+	changes = append(changes, c.Mobile.diff(prefix+"mobile.", other.Mobile)...)
+	changes = diffPointer(changes, prefix+"initiate_login_uri", c.InitiateLoginURI, other.InitiateLoginURI)
+	changes = append(changes, c.NativeSocialLogin.diff(prefix+"native_social_login.", other.NativeSocialLogin)...)
+	changes = append(changes, c.RefreshToken.diff(prefix+"refresh_token.", other.RefreshToken)...)
+	changes = diffPointer(changes, prefix+"organization_usage", c.OrganizationUsage, other.OrganizationUsage)
+	changes = diffPointer(changes, prefix+"organization_require_behavior", c.OrganizationRequireBehavior, other.OrganizationRequireBehavior)
+	return changes
+}
+
+func (c *Client) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Client)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
 }
 
-// GetTrustEmailVerified returns the TrustEmailVerified field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsADFS) GetTrustEmailVerified() string {
-	if c == nil || c.TrustEmailVerified == nil {
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetAudience() string {
+	if c == nil || c.Audience == nil {
 		return ""
 	}
-	return *c.TrustEmailVerified
-}
-
-// String returns a string representation of ConnectionOptionsADFS.
-func (c *ConnectionOptionsADFS) String() string {
-	return Stringify(c)
+	return *c.Audience
 }
 
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetClientID() string {
+func (c *ClientGrant) GetClientID() string {
 	if c == nil || c.ClientID == nil {
 		return ""
 	}
 	return *c.ClientID
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *ClientGrant) GetID() string {
+	if c == nil || c.ID == nil {
 		return ""
 	}
-	return *c.ClientSecret
+	return *c.ID
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetEmail() bool {
-	if c == nil || c.Email == nil {
-		return false
-	}
-	return *c.Email
+// String returns a string representation of ClientGrant.
+func (c *ClientGrant) String() string {
+	return Stringify(c)
 }
 
-// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetKeyID() string {
-	if c == nil || c.KeyID == nil {
-		return ""
+// Clone returns a deep copy of ClientGrant.
+func (c *ClientGrant) Clone() *ClientGrant {
+	if c == nil {
+		return nil
 	}
-	return *c.KeyID
+	clone := &ClientGrant{}
+	clone.ID = clonePointer(c.ID)
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.Audience = clonePointer(c.Audience)
+	clone.Scope = cloneSlice(c.Scope)
+	return clone
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetName() bool {
-	if c == nil || c.Name == nil {
-		return false
-	}
-	return *c.Name
+func (c *ClientGrant) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
-	}
-	return *c.NonPersistentAttrs
+// Equal reports whether ClientGrant and other hold the same values.
+func (c *ClientGrant) Equal(other *ClientGrant) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetScope() string {
-	if c == nil || c.Scope == nil {
-		return ""
-	}
-	return *c.Scope
+// Diff returns the fields that changed between ClientGrant and other.
+func (c *ClientGrant) Diff(other *ClientGrant) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
+func (c *ClientGrant) diff(prefix string, other *ClientGrant) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.SetUserAttributes
+	if c == nil {
+		c = &ClientGrant{}
+	}
+	if other == nil {
+		other = &ClientGrant{}
+	}
+	changes = diffPointer(changes, prefix+"id", c.ID, other.ID)
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"audience", c.Audience, other.Audience)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	return changes
 }
 
-// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsApple) GetTeamID() string {
-	if c == nil || c.TeamID == nil {
-		return ""
+func (c *ClientGrant) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientGrant)
+	if !ok {
+		return nil, false
 	}
-	return *c.TeamID
+	return c.diff(prefix, typed), true
 }
 
-// String returns a string representation of ConnectionOptionsApple.
-func (c *ConnectionOptionsApple) String() string {
+// String returns a string representation of ClientGrantList.
+func (c *ClientGrantList) String() string {
 	return Stringify(c)
 }
 
-// GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetAdmin() bool {
-	if c == nil || c.Admin == nil {
-		return false
+// GetAlgorithm returns the Algorithm field if it's non-nil, zero value otherwise.
+func (c *ClientJWTConfiguration) GetAlgorithm() string {
+	if c == nil || c.Algorithm == nil {
+		return ""
 	}
-	return *c.Admin
+	return *c.Algorithm
 }
 
-// GetAgreedTerms returns the AgreedTerms field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetAgreedTerms() bool {
-	if c == nil || c.AgreedTerms == nil {
-		return false
+// GetLifetimeInSeconds returns the LifetimeInSeconds field if it's non-nil, zero value otherwise.
+func (c *ClientJWTConfiguration) GetLifetimeInSeconds() int {
+	if c == nil || c.LifetimeInSeconds == nil {
+		return 0
 	}
-	return *c.AgreedTerms
+	return *c.LifetimeInSeconds
 }
 
-// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetAppID() string {
-	if c == nil || c.AppID == nil {
-		return ""
+// GetScopes returns the Scopes field if it's non-nil, zero value otherwise.
+func (c *ClientJWTConfiguration) GetScopes() map[string]string {
+	if c == nil || c.Scopes == nil {
+		return map[string]string{}
 	}
-	return *c.AppID
+	return *c.Scopes
 }
 
-// GetAssignedPlans returns the AssignedPlans field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetAssignedPlans() bool {
-	if c == nil || c.AssignedPlans == nil {
+// GetSecretEncoded returns the SecretEncoded field if it's non-nil, zero value otherwise.
+func (c *ClientJWTConfiguration) GetSecretEncoded() bool {
+	if c == nil || c.SecretEncoded == nil {
 		return false
 	}
-	return *c.AssignedPlans
+	return *c.SecretEncoded
 }
 
-// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetBasicProfile() bool {
-	if c == nil || c.BasicProfile == nil {
-		return false
-	}
-	return *c.BasicProfile
+// String returns a string representation of ClientJWTConfiguration.
+func (c *ClientJWTConfiguration) String() string {
+	return Stringify(c)
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+// Clone returns a deep copy of ClientJWTConfiguration.
+func (c *ClientJWTConfiguration) Clone() *ClientJWTConfiguration {
+	if c == nil {
+		return nil
 	}
-	return *c.ClientID
+	clone := &ClientJWTConfiguration{}
+	clone.LifetimeInSeconds = clonePointer(c.LifetimeInSeconds)
+	clone.SecretEncoded = clonePointer(c.SecretEncoded)
+	clone.Scopes = clonePointerFunc(c.Scopes, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.Algorithm = clonePointer(c.Algorithm)
+	return clone
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
-	}
-	return *c.ClientSecret
+func (c *ClientJWTConfiguration) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetDomain() string {
-	if c == nil || c.Domain == nil {
-		return ""
-	}
-	return *c.Domain
+// Equal reports whether ClientJWTConfiguration and other hold the same values.
+func (c *ClientJWTConfiguration) Equal(other *ClientJWTConfiguration) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
+// Diff returns the fields that changed between ClientJWTConfiguration and other.
+func (c *ClientJWTConfiguration) Diff(other *ClientJWTConfiguration) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ClientJWTConfiguration) diff(prefix string, other *ClientJWTConfiguration) (changes []FieldChange) {
+	if c == nil && other == nil {
 		return nil
 	}
-	return *c.DomainAliases
+	if c == nil {
+		c = &ClientJWTConfiguration{}
+	}
+	if other == nil {
+		other = &ClientJWTConfiguration{}
+	}
+	changes = diffPointer(changes, prefix+"lifetime_in_seconds", c.LifetimeInSeconds, other.LifetimeInSeconds)
+	changes = diffPointer(changes, prefix+"secret_encoded", c.SecretEncoded, other.SecretEncoded)
+	changes = diffMapPointer(changes, prefix+"scopes", c.Scopes, other.Scopes)
+	changes = diffPointer(changes, prefix+"alg", c.Algorithm, other.Algorithm)
+	return changes
 }
 
-// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetEnableUsersAPI() bool {
-	if c == nil || c.EnableUsersAPI == nil {
-		return false
+func (c *ClientJWTConfiguration) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientJWTConfiguration)
+	if !ok {
+		return nil, false
 	}
-	return *c.EnableUsersAPI
+	return c.diff(prefix, typed), true
 }
 
-// GetExtendedProfile returns the ExtendedProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetExtendedProfile() bool {
-	if c == nil || c.ExtendedProfile == nil {
-		return false
-	}
-	return *c.ExtendedProfile
+// String returns a string representation of ClientList.
+func (c *ClientList) String() string {
+	return Stringify(c)
 }
 
-// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetGroups() bool {
-	if c == nil || c.Groups == nil {
-		return false
+// GetAndroid returns the Android field.
+func (c *ClientMobile) GetAndroid() *ClientMobileAndroid {
+	if c == nil {
+		return nil
 	}
-	return *c.Groups
+	return c.Android
 }
 
-// GetIdentityAPI returns the IdentityAPI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetIdentityAPI() string {
-	if c == nil || c.IdentityAPI == nil {
-		return ""
+// GetIOS returns the IOS field.
+func (c *ClientMobile) GetIOS() *ClientMobileIOS {
+	if c == nil {
+		return nil
 	}
-	return *c.IdentityAPI
+	return c.IOS
 }
 
-// GetIsSuspended returns the IsSuspended field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetIsSuspended() bool {
-	if c == nil || c.IsSuspended == nil {
-		return false
-	}
-	return *c.IsSuspended
+// String returns a string representation of ClientMobile.
+func (c *ClientMobile) String() string {
+	return Stringify(c)
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
-		return ""
+// Clone returns a deep copy of ClientMobile.
+func (c *ClientMobile) Clone() *ClientMobile {
+	if c == nil {
+		return nil
 	}
-	return *c.LogoURL
+	clone := &ClientMobile{}
+	clone.Android = c.Android.Clone()
+	clone.IOS = c.IOS.Clone()
+	return clone
 }
 
-// GetMaxGroupsToRetrieve returns the MaxGroupsToRetrieve field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetMaxGroupsToRetrieve() string {
-	if c == nil || c.MaxGroupsToRetrieve == nil {
-		return ""
-	}
-	return *c.MaxGroupsToRetrieve
+func (c *ClientMobile) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetNestedGroups returns the NestedGroups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetNestedGroups() bool {
-	if c == nil || c.NestedGroups == nil {
-		return false
-	}
-	return *c.NestedGroups
+// Equal reports whether ClientMobile and other hold the same values.
+func (c *ClientMobile) Equal(other *ClientMobile) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// Diff returns the fields that changed between ClientMobile and other.
+func (c *ClientMobile) Diff(other *ClientMobile) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ClientMobile) diff(prefix string, other *ClientMobile) (changes []FieldChange) {
+	if c == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	if c == nil {
+		c = &ClientMobile{}
+	}
+	if other == nil {
+		other = &ClientMobile{}
+	}
+	changes = append(changes, c.Android.diff(prefix+"android.", other.Android)...)
+	changes = append(changes, c.IOS.diff(prefix+"ios.", other.IOS)...)
+	return changes
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
+func (c *ClientMobile) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientMobile)
+	if !ok {
+		return nil, false
 	}
-	return *c.SetUserAttributes
+	return c.diff(prefix, typed), true
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
+// GetAppPackageName returns the AppPackageName field if it's non-nil, zero value otherwise.
+func (c *ClientMobileAndroid) GetAppPackageName() string {
+	if c == nil || c.AppPackageName == nil {
 		return ""
 	}
-	return *c.TenantDomain
+	return *c.AppPackageName
 }
 
-// GetTrustEmailVerified returns the TrustEmailVerified field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetTrustEmailVerified() string {
-	if c == nil || c.TrustEmailVerified == nil {
-		return ""
+// GetKeyHashes returns the KeyHashes field if it's non-nil, zero value otherwise.
+func (c *ClientMobileAndroid) GetKeyHashes() []string {
+	if c == nil || c.KeyHashes == nil {
+		return nil
 	}
-	return *c.TrustEmailVerified
+	return *c.KeyHashes
 }
 
-// GetUseCommonEndpoint returns the UseCommonEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetUseCommonEndpoint() bool {
-	if c == nil || c.UseCommonEndpoint == nil {
-		return false
-	}
-	return *c.UseCommonEndpoint
+// String returns a string representation of ClientMobileAndroid.
+func (c *ClientMobileAndroid) String() string {
+	return Stringify(c)
 }
 
-// GetUseWSFederation returns the UseWSFederation field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetUseWSFederation() bool {
-	if c == nil || c.UseWSFederation == nil {
-		return false
+// Clone returns a deep copy of ClientMobileAndroid.
+func (c *ClientMobileAndroid) Clone() *ClientMobileAndroid {
+	if c == nil {
+		return nil
 	}
-	return *c.UseWSFederation
+	clone := &ClientMobileAndroid{}
+	clone.AppPackageName = clonePointer(c.AppPackageName)
+	clone.KeyHashes = clonePointerFunc(c.KeyHashes, func(v []string) []string { return cloneSlice(v) })
+	return clone
 }
 
-// GetWAADCommonEndpoint returns the WAADCommonEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetWAADCommonEndpoint() bool {
-	if c == nil || c.WAADCommonEndpoint == nil {
-		return false
-	}
-	return *c.WAADCommonEndpoint
+func (c *ClientMobileAndroid) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetWAADProtocol returns the WAADProtocol field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsAzureAD) GetWAADProtocol() string {
-	if c == nil || c.WAADProtocol == nil {
-		return ""
-	}
-	return *c.WAADProtocol
+// Equal reports whether ClientMobileAndroid and other hold the same values.
+func (c *ClientMobileAndroid) Equal(other *ClientMobileAndroid) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// String returns a string representation of ConnectionOptionsAzureAD.
-func (c *ConnectionOptionsAzureAD) String() string {
-	return Stringify(c)
+// Diff returns the fields that changed between ClientMobileAndroid and other.
+func (c *ClientMobileAndroid) Diff(other *ClientMobileAndroid) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmail) GetBruteForceProtection() bool {
-	if c == nil || c.BruteForceProtection == nil {
-		return false
+func (c *ClientMobileAndroid) diff(prefix string, other *ClientMobileAndroid) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.BruteForceProtection
+	if c == nil {
+		c = &ClientMobileAndroid{}
+	}
+	if other == nil {
+		other = &ClientMobileAndroid{}
+	}
+	changes = diffPointer(changes, prefix+"app_package_name", c.AppPackageName, other.AppPackageName)
+	changes = diffSlicePointer(changes, prefix+"sha256_cert_fingerprints", c.KeyHashes, other.KeyHashes)
+	return changes
 }
 
-// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmail) GetDisableSignup() bool {
-	if c == nil || c.DisableSignup == nil {
-		return false
+func (c *ClientMobileAndroid) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientMobileAndroid)
+	if !ok {
+		return nil, false
 	}
-	return *c.DisableSignup
+	return c.diff(prefix, typed), true
 }
 
-// GetEmail returns the Email field.
-func (c *ConnectionOptionsEmail) GetEmail() *ConnectionOptionsEmailSettings {
-	if c == nil {
-		return nil
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (c *ClientMobileIOS) GetAppID() string {
+	if c == nil || c.AppID == nil {
+		return ""
 	}
-	return c.Email
+	return *c.AppID
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmail) GetName() string {
-	if c == nil || c.Name == nil {
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (c *ClientMobileIOS) GetTeamID() string {
+	if c == nil || c.TeamID == nil {
 		return ""
 	}
-	return *c.Name
+	return *c.TeamID
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmail) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
-	}
-	return *c.NonPersistentAttrs
+// String returns a string representation of ClientMobileIOS.
+func (c *ClientMobileIOS) String() string {
+	return Stringify(c)
 }
 
-// GetOTP returns the OTP field.
-func (c *ConnectionOptionsEmail) GetOTP() *ConnectionOptionsOTP {
+// Clone returns a deep copy of ClientMobileIOS.
+func (c *ClientMobileIOS) Clone() *ClientMobileIOS {
 	if c == nil {
 		return nil
 	}
-	return c.OTP
+	clone := &ClientMobileIOS{}
+	clone.TeamID = clonePointer(c.TeamID)
+	clone.AppID = clonePointer(c.AppID)
+	return clone
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmail) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
-	}
-	return *c.SetUserAttributes
+func (c *ClientMobileIOS) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// String returns a string representation of ConnectionOptionsEmail.
-func (c *ConnectionOptionsEmail) String() string {
-	return Stringify(c)
+// Equal reports whether ClientMobileIOS and other hold the same values.
+func (c *ClientMobileIOS) Equal(other *ClientMobileIOS) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmailSettings) GetBody() string {
-	if c == nil || c.Body == nil {
-		return ""
+// Diff returns the fields that changed between ClientMobileIOS and other.
+func (c *ClientMobileIOS) Diff(other *ClientMobileIOS) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ClientMobileIOS) diff(prefix string, other *ClientMobileIOS) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.Body
+	if c == nil {
+		c = &ClientMobileIOS{}
+	}
+	if other == nil {
+		other = &ClientMobileIOS{}
+	}
+	changes = diffPointer(changes, prefix+"team_id", c.TeamID, other.TeamID)
+	changes = diffPointer(changes, prefix+"app_bundle_identifier", c.AppID, other.AppID)
+	return changes
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmailSettings) GetFrom() string {
-	if c == nil || c.From == nil {
-		return ""
+func (c *ClientMobileIOS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientMobileIOS)
+	if !ok {
+		return nil, false
 	}
-	return *c.From
+	return c.diff(prefix, typed), true
 }
 
-// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmailSettings) GetSubject() string {
-	if c == nil || c.Subject == nil {
-		return ""
+// GetApple returns the Apple field.
+func (c *ClientNativeSocialLogin) GetApple() *ClientNativeSocialLoginSupportEnabled {
+	if c == nil {
+		return nil
 	}
-	return *c.Subject
+	return c.Apple
 }
 
-// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsEmailSettings) GetSyntax() string {
-	if c == nil || c.Syntax == nil {
-		return ""
+// GetFacebook returns the Facebook field.
+func (c *ClientNativeSocialLogin) GetFacebook() *ClientNativeSocialLoginSupportEnabled {
+	if c == nil {
+		return nil
 	}
-	return *c.Syntax
+	return c.Facebook
 }
 
-// String returns a string representation of ConnectionOptionsEmailSettings.
-func (c *ConnectionOptionsEmailSettings) String() string {
+// String returns a string representation of ClientNativeSocialLogin.
+func (c *ClientNativeSocialLogin) String() string {
 	return Stringify(c)
 }
 
-// GetAdsManagement returns the AdsManagement field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetAdsManagement() bool {
-	if c == nil || c.AdsManagement == nil {
-		return false
+// Clone returns a deep copy of ClientNativeSocialLogin.
+func (c *ClientNativeSocialLogin) Clone() *ClientNativeSocialLogin {
+	if c == nil {
+		return nil
 	}
-	return *c.AdsManagement
+	clone := &ClientNativeSocialLogin{}
+	clone.Apple = c.Apple.Clone()
+	clone.Facebook = c.Facebook.Clone()
+	return clone
 }
 
-// GetAdsRead returns the AdsRead field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetAdsRead() bool {
-	if c == nil || c.AdsRead == nil {
-		return false
-	}
-	return *c.AdsRead
+func (c *ClientNativeSocialLogin) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetAllowContextProfileField returns the AllowContextProfileField field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetAllowContextProfileField() bool {
-	if c == nil || c.AllowContextProfileField == nil {
-		return false
-	}
-	return *c.AllowContextProfileField
+// Equal reports whether ClientNativeSocialLogin and other hold the same values.
+func (c *ClientNativeSocialLogin) Equal(other *ClientNativeSocialLogin) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetBusinessManagement returns the BusinessManagement field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetBusinessManagement() bool {
-	if c == nil || c.BusinessManagement == nil {
-		return false
-	}
-	return *c.BusinessManagement
+// Diff returns the fields that changed between ClientNativeSocialLogin and other.
+func (c *ClientNativeSocialLogin) Diff(other *ClientNativeSocialLogin) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+func (c *ClientNativeSocialLogin) diff(prefix string, other *ClientNativeSocialLogin) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.ClientID
+	if c == nil {
+		c = &ClientNativeSocialLogin{}
+	}
+	if other == nil {
+		other = &ClientNativeSocialLogin{}
+	}
+	changes = append(changes, c.Apple.diff(prefix+"apple.", other.Apple)...)
+	changes = append(changes, c.Facebook.diff(prefix+"facebook.", other.Facebook)...)
+	return changes
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
+func (c *ClientNativeSocialLogin) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientNativeSocialLogin)
+	if !ok {
+		return nil, false
 	}
-	return *c.ClientSecret
+	return c.diff(prefix, typed), true
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetEmail() bool {
-	if c == nil || c.Email == nil {
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *ClientNativeSocialLoginSupportEnabled) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
 		return false
 	}
-	return *c.Email
+	return *c.Enabled
 }
 
-// GetGroupsAccessMemberInfo returns the GroupsAccessMemberInfo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetGroupsAccessMemberInfo() bool {
-	if c == nil || c.GroupsAccessMemberInfo == nil {
-		return false
-	}
-	return *c.GroupsAccessMemberInfo
+// String returns a string representation of ClientNativeSocialLoginSupportEnabled.
+func (c *ClientNativeSocialLoginSupportEnabled) String() string {
+	return Stringify(c)
 }
 
-// GetLeadsRetrieval returns the LeadsRetrieval field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetLeadsRetrieval() bool {
-	if c == nil || c.LeadsRetrieval == nil {
-		return false
+// Clone returns a deep copy of ClientNativeSocialLoginSupportEnabled.
+func (c *ClientNativeSocialLoginSupportEnabled) Clone() *ClientNativeSocialLoginSupportEnabled {
+	if c == nil {
+		return nil
 	}
-	return *c.LeadsRetrieval
+	clone := &ClientNativeSocialLoginSupportEnabled{}
+	clone.Enabled = clonePointer(c.Enabled)
+	return clone
 }
 
-// GetManageNotifications returns the ManageNotifications field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetManageNotifications() bool {
-	if c == nil || c.ManageNotifications == nil {
-		return false
-	}
-	return *c.ManageNotifications
+func (c *ClientNativeSocialLoginSupportEnabled) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetManagePages returns the ManagePages field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetManagePages() bool {
-	if c == nil || c.ManagePages == nil {
-		return false
-	}
-	return *c.ManagePages
+// Equal reports whether ClientNativeSocialLoginSupportEnabled and other hold the same values.
+func (c *ClientNativeSocialLoginSupportEnabled) Equal(other *ClientNativeSocialLoginSupportEnabled) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// Diff returns the fields that changed between ClientNativeSocialLoginSupportEnabled and other.
+func (c *ClientNativeSocialLoginSupportEnabled) Diff(other *ClientNativeSocialLoginSupportEnabled) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ClientNativeSocialLoginSupportEnabled) diff(prefix string, other *ClientNativeSocialLoginSupportEnabled) (changes []FieldChange) {
+	if c == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	if c == nil {
+		c = &ClientNativeSocialLoginSupportEnabled{}
+	}
+	if other == nil {
+		other = &ClientNativeSocialLoginSupportEnabled{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", c.Enabled, other.Enabled)
+	return changes
 }
 
-// GetPagesManageCTA returns the PagesManageCTA field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesManageCTA() bool {
-	if c == nil || c.PagesManageCTA == nil {
-		return false
+func (c *ClientNativeSocialLoginSupportEnabled) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientNativeSocialLoginSupportEnabled)
+	if !ok {
+		return nil, false
 	}
-	return *c.PagesManageCTA
+	return c.diff(prefix, typed), true
 }
 
-// GetPagesManageInstantArticles returns the PagesManageInstantArticles field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesManageInstantArticles() bool {
-	if c == nil || c.PagesManageInstantArticles == nil {
-		return false
+// GetExpirationType returns the ExpirationType field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetExpirationType() string {
+	if c == nil || c.ExpirationType == nil {
+		return ""
 	}
-	return *c.PagesManageInstantArticles
+	return *c.ExpirationType
 }
 
-// GetPagesMessaging returns the PagesMessaging field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesMessaging() bool {
-	if c == nil || c.PagesMessaging == nil {
-		return false
+// GetIdleTokenLifetime returns the IdleTokenLifetime field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetIdleTokenLifetime() int {
+	if c == nil || c.IdleTokenLifetime == nil {
+		return 0
 	}
-	return *c.PagesMessaging
+	return *c.IdleTokenLifetime
 }
 
-// GetPagesMessagingPhoneNumber returns the PagesMessagingPhoneNumber field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesMessagingPhoneNumber() bool {
-	if c == nil || c.PagesMessagingPhoneNumber == nil {
+// GetInfiniteIdleTokenLifetime returns the InfiniteIdleTokenLifetime field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetInfiniteIdleTokenLifetime() bool {
+	if c == nil || c.InfiniteIdleTokenLifetime == nil {
 		return false
 	}
-	return *c.PagesMessagingPhoneNumber
+	return *c.InfiniteIdleTokenLifetime
 }
 
-// GetPagesMessagingSubscriptions returns the PagesMessagingSubscriptions field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesMessagingSubscriptions() bool {
-	if c == nil || c.PagesMessagingSubscriptions == nil {
+// GetInfiniteTokenLifetime returns the InfiniteTokenLifetime field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetInfiniteTokenLifetime() bool {
+	if c == nil || c.InfiniteTokenLifetime == nil {
 		return false
 	}
-	return *c.PagesMessagingSubscriptions
+	return *c.InfiniteTokenLifetime
 }
 
-// GetPagesShowList returns the PagesShowList field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPagesShowList() bool {
-	if c == nil || c.PagesShowList == nil {
-		return false
+// GetLeeway returns the Leeway field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetLeeway() int {
+	if c == nil || c.Leeway == nil {
+		return 0
 	}
-	return *c.PagesShowList
+	return *c.Leeway
 }
 
-// GetPublicProfile returns the PublicProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPublicProfile() bool {
-	if c == nil || c.PublicProfile == nil {
-		return false
+// GetRotationType returns the RotationType field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetRotationType() string {
+	if c == nil || c.RotationType == nil {
+		return ""
 	}
-	return *c.PublicProfile
+	return *c.RotationType
 }
 
-// GetPublishActions returns the PublishActions field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPublishActions() bool {
-	if c == nil || c.PublishActions == nil {
-		return false
+// GetTokenLifetime returns the TokenLifetime field if it's non-nil, zero value otherwise.
+func (c *ClientRefreshToken) GetTokenLifetime() int {
+	if c == nil || c.TokenLifetime == nil {
+		return 0
 	}
-	return *c.PublishActions
+	return *c.TokenLifetime
 }
 
-// GetPublishPages returns the PublishPages field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPublishPages() bool {
-	if c == nil || c.PublishPages == nil {
-		return false
-	}
-	return *c.PublishPages
+// String returns a string representation of ClientRefreshToken.
+func (c *ClientRefreshToken) String() string {
+	return Stringify(c)
 }
 
-// GetPublishToGroups returns the PublishToGroups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPublishToGroups() bool {
-	if c == nil || c.PublishToGroups == nil {
-		return false
+// Clone returns a deep copy of ClientRefreshToken.
+func (c *ClientRefreshToken) Clone() *ClientRefreshToken {
+	if c == nil {
+		return nil
 	}
-	return *c.PublishToGroups
+	clone := &ClientRefreshToken{}
+	clone.RotationType = clonePointer(c.RotationType)
+	clone.ExpirationType = clonePointer(c.ExpirationType)
+	clone.Leeway = clonePointer(c.Leeway)
+	clone.TokenLifetime = clonePointer(c.TokenLifetime)
+	clone.InfiniteTokenLifetime = clonePointer(c.InfiniteTokenLifetime)
+	clone.InfiniteIdleTokenLifetime = clonePointer(c.InfiniteIdleTokenLifetime)
+	clone.IdleTokenLifetime = clonePointer(c.IdleTokenLifetime)
+	return clone
 }
 
-// GetPublishVideo returns the PublishVideo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetPublishVideo() bool {
-	if c == nil || c.PublishVideo == nil {
-		return false
-	}
-	return *c.PublishVideo
+func (c *ClientRefreshToken) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetReadAudienceNetworkInsights returns the ReadAudienceNetworkInsights field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetReadAudienceNetworkInsights() bool {
-	if c == nil || c.ReadAudienceNetworkInsights == nil {
-		return false
-	}
-	return *c.ReadAudienceNetworkInsights
+// Equal reports whether ClientRefreshToken and other hold the same values.
+func (c *ClientRefreshToken) Equal(other *ClientRefreshToken) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetReadInsights returns the ReadInsights field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetReadInsights() bool {
-	if c == nil || c.ReadInsights == nil {
-		return false
-	}
-	return *c.ReadInsights
+// Diff returns the fields that changed between ClientRefreshToken and other.
+func (c *ClientRefreshToken) Diff(other *ClientRefreshToken) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetReadMailbox returns the ReadMailbox field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetReadMailbox() bool {
-	if c == nil || c.ReadMailbox == nil {
-		return false
+func (c *ClientRefreshToken) diff(prefix string, other *ClientRefreshToken) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.ReadMailbox
+	if c == nil {
+		c = &ClientRefreshToken{}
+	}
+	if other == nil {
+		other = &ClientRefreshToken{}
+	}
+	changes = diffPointer(changes, prefix+"rotation_type", c.RotationType, other.RotationType)
+	changes = diffPointer(changes, prefix+"expiration_type", c.ExpirationType, other.ExpirationType)
+	changes = diffPointer(changes, prefix+"leeway", c.Leeway, other.Leeway)
+	changes = diffPointer(changes, prefix+"token_lifetime", c.TokenLifetime, other.TokenLifetime)
+	changes = diffPointer(changes, prefix+"infinite_token_lifetime", c.InfiniteTokenLifetime, other.InfiniteTokenLifetime)
+	changes = diffPointer(changes, prefix+"infinite_idle_token_lifetime", c.InfiniteIdleTokenLifetime, other.InfiniteIdleTokenLifetime)
+	changes = diffPointer(changes, prefix+"idle_token_lifetime", c.IdleTokenLifetime, other.IdleTokenLifetime)
+	return changes
 }
 
-// GetReadPageMailboxes returns the ReadPageMailboxes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetReadPageMailboxes() bool {
-	if c == nil || c.ReadPageMailboxes == nil {
-		return false
+func (c *ClientRefreshToken) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ClientRefreshToken)
+	if !ok {
+		return nil, false
 	}
-	return *c.ReadPageMailboxes
+	return c.diff(prefix, typed), true
 }
 
-// GetReadStream returns the ReadStream field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetReadStream() bool {
-	if c == nil || c.ReadStream == nil {
-		return false
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (c *Connection) GetDisplayName() string {
+	if c == nil || c.DisplayName == nil {
+		return ""
 	}
-	return *c.ReadStream
+	return *c.DisplayName
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetScope() string {
-	if c == nil || c.Scope == nil {
-		return ""
+// GetEnabledClients returns the EnabledClients field if it's non-nil, zero value otherwise.
+func (c *Connection) GetEnabledClients() []string {
+	if c == nil || c.EnabledClients == nil {
+		return nil
 	}
-	return *c.Scope
+	return *c.EnabledClients
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *Connection) GetID() string {
+	if c == nil || c.ID == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *c.ID
 }
 
-// GetUserAgeRange returns the UserAgeRange field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserAgeRange() bool {
-	if c == nil || c.UserAgeRange == nil {
+// GetIsDomainConnection returns the IsDomainConnection field if it's non-nil, zero value otherwise.
+func (c *Connection) GetIsDomainConnection() bool {
+	if c == nil || c.IsDomainConnection == nil {
 		return false
 	}
-	return *c.UserAgeRange
+	return *c.IsDomainConnection
 }
 
-// GetUserBirthday returns the UserBirthday field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserBirthday() bool {
-	if c == nil || c.UserBirthday == nil {
-		return false
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (c *Connection) GetMetadata() map[string]string {
+	if c == nil || c.Metadata == nil {
+		return map[string]string{}
 	}
-	return *c.UserBirthday
+	return *c.Metadata
 }
 
-// GetUserEvents returns the UserEvents field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserEvents() bool {
-	if c == nil || c.UserEvents == nil {
-		return false
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *Connection) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
 	}
-	return *c.UserEvents
+	return *c.Name
 }
 
-// GetUserFriends returns the UserFriends field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserFriends() bool {
-	if c == nil || c.UserFriends == nil {
-		return false
+// GetProvisioningTicketURL returns the ProvisioningTicketURL field if it's non-nil, zero value otherwise.
+func (c *Connection) GetProvisioningTicketURL() string {
+	if c == nil || c.ProvisioningTicketURL == nil {
+		return ""
 	}
-	return *c.UserFriends
+	return *c.ProvisioningTicketURL
 }
 
-// GetUserGender returns the UserGender field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserGender() bool {
-	if c == nil || c.UserGender == nil {
-		return false
+// GetRealms returns the Realms field if it's non-nil, zero value otherwise.
+func (c *Connection) GetRealms() []string {
+	if c == nil || c.Realms == nil {
+		return nil
 	}
-	return *c.UserGender
+	return *c.Realms
 }
 
-// GetUserGroups returns the UserGroups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserGroups() bool {
-	if c == nil || c.UserGroups == nil {
+// GetShowAsButton returns the ShowAsButton field if it's non-nil, zero value otherwise.
+func (c *Connection) GetShowAsButton() bool {
+	if c == nil || c.ShowAsButton == nil {
 		return false
 	}
-	return *c.UserGroups
+	return *c.ShowAsButton
 }
 
-// GetUserHometown returns the UserHometown field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserHometown() bool {
-	if c == nil || c.UserHometown == nil {
-		return false
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (c *Connection) GetStrategy() string {
+	if c == nil || c.Strategy == nil {
+		return ""
 	}
-	return *c.UserHometown
+	return *c.Strategy
 }
 
-// GetUserLikes returns the UserLikes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserLikes() bool {
-	if c == nil || c.UserLikes == nil {
-		return false
-	}
-	return *c.UserLikes
+// String returns a string representation of Connection.
+func (c *Connection) String() string {
+	return Stringify(c)
 }
 
-// GetUserLink returns the UserLink field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserLink() bool {
-	if c == nil || c.UserLink == nil {
-		return false
+// Clone returns a deep copy of Connection.
+func (c *Connection) Clone() *Connection {
+	if c == nil {
+		return nil
 	}
-	return *c.UserLink
+	clone := &Connection{}
+	clone.ID = clonePointer(c.ID)
+	clone.Name = clonePointer(c.Name)
+	clone.DisplayName = clonePointer(c.DisplayName)
+	clone.Strategy = clonePointer(c.Strategy)
+	clone.IsDomainConnection = clonePointer(c.IsDomainConnection)
+	clone.Options = cloneValue(c.Options)
+	clone.EnabledClients = clonePointerFunc(c.EnabledClients, func(v []string) []string { return cloneSlice(v) })
+	clone.Realms = clonePointerFunc(c.Realms, func(v []string) []string { return cloneSlice(v) })
+	clone.Metadata = clonePointerFunc(c.Metadata, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.ProvisioningTicketURL = clonePointer(c.ProvisioningTicketURL)
+	clone.ShowAsButton = clonePointer(c.ShowAsButton)
+	return clone
 }
 
-// GetUserLocation returns the UserLocation field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserLocation() bool {
-	if c == nil || c.UserLocation == nil {
-		return false
-	}
-	return *c.UserLocation
+func (c *Connection) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetUserManagedGroups returns the UserManagedGroups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserManagedGroups() bool {
-	if c == nil || c.UserManagedGroups == nil {
-		return false
-	}
-	return *c.UserManagedGroups
+// Equal reports whether Connection and other hold the same values.
+func (c *Connection) Equal(other *Connection) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetUserPhotos returns the UserPhotos field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserPhotos() bool {
-	if c == nil || c.UserPhotos == nil {
-		return false
-	}
-	return *c.UserPhotos
+// Diff returns the fields that changed between Connection and other.
+func (c *Connection) Diff(other *Connection) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetUserPosts returns the UserPosts field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserPosts() bool {
-	if c == nil || c.UserPosts == nil {
-		return false
+func (c *Connection) diff(prefix string, other *Connection) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.UserPosts
-}
-
-// GetUserStatus returns the UserStatus field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserStatus() bool {
-	if c == nil || c.UserStatus == nil {
-		return false
+	if c == nil {
+		c = &Connection{}
 	}
-	return *c.UserStatus
-}
-
-// GetUserTaggedPlaces returns the UserTaggedPlaces field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserTaggedPlaces() bool {
-	if c == nil || c.UserTaggedPlaces == nil {
-		return false
+	if other == nil {
+		other = &Connection{}
 	}
-	return *c.UserTaggedPlaces
+	changes = diffPointer(changes, prefix+"id", c.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", c.Name, other.Name)
+	changes = diffPointer(changes, prefix+"display_name", c.DisplayName, other.DisplayName)
+	changes = diffPointer(changes, prefix+"strategy", c.Strategy, other.Strategy)
+	changes = diffPointer(changes, prefix+"is_domain_connection", c.IsDomainConnection, other.IsDomainConnection)
+	changes = diffValue(changes, prefix+"options", c.Options, other.Options)
+	changes = diffSlicePointer(changes, prefix+"enabled_clients", c.EnabledClients, other.EnabledClients)
+	changes = diffSlicePointer(changes, prefix+"realms", c.Realms, other.Realms)
+	changes = diffMapPointer(changes, prefix+"metadata", c.Metadata, other.Metadata)
+	changes = diffPointer(changes, prefix+"provisioning_ticket_url", c.ProvisioningTicketURL, other.ProvisioningTicketURL)
+	changes = diffPointer(changes, prefix+"show_as_button", c.ShowAsButton, other.ShowAsButton)
+	return changes
 }
 
-// GetUserVideos returns the UserVideos field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsFacebook) GetUserVideos() bool {
-	if c == nil || c.UserVideos == nil {
-		return false
+func (c *Connection) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Connection)
+	if !ok {
+		return nil, false
 	}
-	return *c.UserVideos
+	return c.diff(prefix, typed), true
 }
 
-// String returns a string representation of ConnectionOptionsFacebook.
-func (c *ConnectionOptionsFacebook) String() string {
-	return Stringify(c)
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (c *ConnectionGatewayAuthentication) GetAudience() string {
+	if c == nil || c.Audience == nil {
+		return ""
+	}
+	return *c.Audience
 }
 
-// GetAdminOrg returns the AdminOrg field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetAdminOrg() bool {
-	if c == nil || c.AdminOrg == nil {
-		return false
+// GetMethod returns the Method field if it's non-nil, zero value otherwise.
+func (c *ConnectionGatewayAuthentication) GetMethod() string {
+	if c == nil || c.Method == nil {
+		return ""
 	}
-	return *c.AdminOrg
+	return *c.Method
 }
 
-// GetAdminPublicKey returns the AdminPublicKey field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetAdminPublicKey() bool {
-	if c == nil || c.AdminPublicKey == nil {
-		return false
+// GetSecret returns the Secret field if it's non-nil, zero value otherwise.
+func (c *ConnectionGatewayAuthentication) GetSecret() string {
+	if c == nil || c.Secret == nil {
+		return ""
 	}
-	return *c.AdminPublicKey
+	return *c.Secret
 }
 
-// GetAdminRepoHook returns the AdminRepoHook field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetAdminRepoHook() bool {
-	if c == nil || c.AdminRepoHook == nil {
+// GetSecretBase64Encoded returns the SecretBase64Encoded field if it's non-nil, zero value otherwise.
+func (c *ConnectionGatewayAuthentication) GetSecretBase64Encoded() bool {
+	if c == nil || c.SecretBase64Encoded == nil {
 		return false
 	}
-	return *c.AdminRepoHook
+	return *c.SecretBase64Encoded
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetClientID() string {
-	if c == nil || c.ClientID == nil {
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (c *ConnectionGatewayAuthentication) GetSubject() string {
+	if c == nil || c.Subject == nil {
 		return ""
 	}
-	return *c.ClientID
+	return *c.Subject
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
-	}
-	return *c.ClientSecret
+// String returns a string representation of ConnectionGatewayAuthentication.
+func (c *ConnectionGatewayAuthentication) String() string {
+	return Stringify(c)
 }
 
-// GetDeleteRepo returns the DeleteRepo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetDeleteRepo() bool {
-	if c == nil || c.DeleteRepo == nil {
-		return false
+// Clone returns a deep copy of ConnectionGatewayAuthentication.
+func (c *ConnectionGatewayAuthentication) Clone() *ConnectionGatewayAuthentication {
+	if c == nil {
+		return nil
 	}
-	return *c.DeleteRepo
+	clone := &ConnectionGatewayAuthentication{}
+	clone.Method = clonePointer(c.Method)
+	clone.Subject = clonePointer(c.Subject)
+	clone.Audience = clonePointer(c.Audience)
+	clone.Secret = clonePointer(c.Secret)
+	clone.SecretBase64Encoded = clonePointer(c.SecretBase64Encoded)
+	return clone
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetEmail() bool {
-	if c == nil || c.Email == nil {
-		return false
-	}
-	return *c.Email
+func (c *ConnectionGatewayAuthentication) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetFollow returns the Follow field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetFollow() bool {
-	if c == nil || c.Follow == nil {
-		return false
-	}
-	return *c.Follow
+// Equal reports whether ConnectionGatewayAuthentication and other hold the same values.
+func (c *ConnectionGatewayAuthentication) Equal(other *ConnectionGatewayAuthentication) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetGist returns the Gist field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetGist() bool {
-	if c == nil || c.Gist == nil {
-		return false
-	}
-	return *c.Gist
+// Diff returns the fields that changed between ConnectionGatewayAuthentication and other.
+func (c *ConnectionGatewayAuthentication) Diff(other *ConnectionGatewayAuthentication) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+func (c *ConnectionGatewayAuthentication) diff(prefix string, other *ConnectionGatewayAuthentication) (changes []FieldChange) {
+	if c == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	if c == nil {
+		c = &ConnectionGatewayAuthentication{}
+	}
+	if other == nil {
+		other = &ConnectionGatewayAuthentication{}
+	}
+	changes = diffPointer(changes, prefix+"method", c.Method, other.Method)
+	changes = diffPointer(changes, prefix+"subject", c.Subject, other.Subject)
+	changes = diffPointer(changes, prefix+"audience", c.Audience, other.Audience)
+	changes = diffPointer(changes, prefix+"secret", c.Secret, other.Secret)
+	changes = diffPointer(changes, prefix+"secret_base64_encoded", c.SecretBase64Encoded, other.SecretBase64Encoded)
+	return changes
 }
 
-// GetNotifications returns the Notifications field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetNotifications() bool {
-	if c == nil || c.Notifications == nil {
-		return false
+func (c *ConnectionGatewayAuthentication) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionGatewayAuthentication)
+	if !ok {
+		return nil, false
 	}
-	return *c.Notifications
+	return c.diff(prefix, typed), true
 }
 
-// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetProfile() bool {
-	if c == nil || c.Profile == nil {
-		return false
-	}
-	return *c.Profile
+// String returns a string representation of ConnectionList.
+func (c *ConnectionList) String() string {
+	return Stringify(c)
 }
 
-// GetPublicRepo returns the PublicRepo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetPublicRepo() bool {
-	if c == nil || c.PublicRepo == nil {
+// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetBruteForceProtection() bool {
+	if c == nil || c.BruteForceProtection == nil {
 		return false
 	}
-	return *c.PublicRepo
+	return *c.BruteForceProtection
 }
 
-// GetReadOrg returns the ReadOrg field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetReadOrg() bool {
-	if c == nil || c.ReadOrg == nil {
-		return false
+// GetConfiguration returns the Configuration field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetConfiguration() map[string]string {
+	if c == nil || c.Configuration == nil {
+		return map[string]string{}
 	}
-	return *c.ReadOrg
+	return *c.Configuration
 }
 
-// GetReadPublicKey returns the ReadPublicKey field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetReadPublicKey() bool {
-	if c == nil || c.ReadPublicKey == nil {
-		return false
+// GetCustomScripts returns the CustomScripts field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetCustomScripts() map[string]string {
+	if c == nil || c.CustomScripts == nil {
+		return map[string]string{}
 	}
-	return *c.ReadPublicKey
+	return *c.CustomScripts
 }
 
-// GetReadRepoHook returns the ReadRepoHook field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetReadRepoHook() bool {
-	if c == nil || c.ReadRepoHook == nil {
+// GetDisableSelfServiceChangePassword returns the DisableSelfServiceChangePassword field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetDisableSelfServiceChangePassword() bool {
+	if c == nil || c.DisableSelfServiceChangePassword == nil {
 		return false
 	}
-	return *c.ReadRepoHook
+	return *c.DisableSelfServiceChangePassword
 }
 
-// GetReadUser returns the ReadUser field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetReadUser() bool {
-	if c == nil || c.ReadUser == nil {
+// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetDisableSignup() bool {
+	if c == nil || c.DisableSignup == nil {
 		return false
 	}
-	return *c.ReadUser
+	return *c.DisableSignup
 }
 
-// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetRepo() bool {
-	if c == nil || c.Repo == nil {
+// GetEnabledDatabaseCustomization returns the EnabledDatabaseCustomization field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetEnabledDatabaseCustomization() bool {
+	if c == nil || c.EnabledDatabaseCustomization == nil {
 		return false
 	}
-	return *c.Repo
+	return *c.EnabledDatabaseCustomization
 }
 
-// GetRepoDeployment returns the RepoDeployment field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetRepoDeployment() bool {
-	if c == nil || c.RepoDeployment == nil {
+// GetEnableScriptContext returns the EnableScriptContext field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetEnableScriptContext() bool {
+	if c == nil || c.EnableScriptContext == nil {
 		return false
 	}
-	return *c.RepoDeployment
+	return *c.EnableScriptContext
 }
 
-// GetRepoStatus returns the RepoStatus field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetRepoStatus() bool {
-	if c == nil || c.RepoStatus == nil {
+// GetImportMode returns the ImportMode field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetImportMode() bool {
+	if c == nil || c.ImportMode == nil {
 		return false
 	}
-	return *c.RepoStatus
+	return *c.ImportMode
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
 	}
-	return *c.SetUserAttributes
+	return *c.NonPersistentAttrs
 }
 
-// GetWriteOrg returns the WriteOrg field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetWriteOrg() bool {
-	if c == nil || c.WriteOrg == nil {
-		return false
+// GetPasswordPolicy returns the PasswordPolicy field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetPasswordPolicy() string {
+	if c == nil || c.PasswordPolicy == nil {
+		return ""
 	}
-	return *c.WriteOrg
+	return *c.PasswordPolicy
 }
 
-// GetWritePublicKey returns the WritePublicKey field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetWritePublicKey() bool {
-	if c == nil || c.WritePublicKey == nil {
+// GetRequiresUsername returns the RequiresUsername field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetRequiresUsername() bool {
+	if c == nil || c.RequiresUsername == nil {
 		return false
 	}
-	return *c.WritePublicKey
+	return *c.RequiresUsername
 }
 
-// GetWriteRepoHook returns the WriteRepoHook field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGitHub) GetWriteRepoHook() bool {
-	if c == nil || c.WriteRepoHook == nil {
-		return false
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
 	}
-	return *c.WriteRepoHook
-}
-
-// String returns a string representation of ConnectionOptionsGitHub.
-func (c *ConnectionOptionsGitHub) String() string {
-	return Stringify(c)
+	return *c.SetUserAttributes
 }
 
-// GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetAdmin() bool {
-	if c == nil || c.Admin == nil {
-		return false
+// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptions) GetStrategyVersion() int {
+	if c == nil || c.StrategyVersion == nil {
+		return 0
 	}
-	return *c.Admin
+	return *c.StrategyVersion
 }
 
-// GetAgreedTerms returns the AgreedTerms field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetAgreedTerms() bool {
-	if c == nil || c.AgreedTerms == nil {
-		return false
-	}
-	return *c.AgreedTerms
+// String returns a string representation of ConnectionOptions.
+func (c *ConnectionOptions) String() string {
+	return Stringify(c)
 }
 
-// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetBasicProfile() bool {
-	if c == nil || c.BasicProfile == nil {
-		return false
+// Clone returns a deep copy of ConnectionOptions.
+func (c *ConnectionOptions) Clone() *ConnectionOptions {
+	if c == nil {
+		return nil
 	}
-	return *c.BasicProfile
+	clone := &ConnectionOptions{}
+	clone.MFA = cloneMapFunc(c.MFA, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.Validation = cloneMapFunc(c.Validation, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.PasswordPolicy = clonePointer(c.PasswordPolicy)
+	clone.PasswordHistory = cloneMapFunc(c.PasswordHistory, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.PasswordNoPersonalInfo = cloneMapFunc(c.PasswordNoPersonalInfo, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.PasswordDictionary = cloneMapFunc(c.PasswordDictionary, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.PasswordComplexityOptions = cloneMapFunc(c.PasswordComplexityOptions, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.EnableScriptContext = clonePointer(c.EnableScriptContext)
+	clone.EnabledDatabaseCustomization = clonePointer(c.EnabledDatabaseCustomization)
+	clone.BruteForceProtection = clonePointer(c.BruteForceProtection)
+	clone.ImportMode = clonePointer(c.ImportMode)
+	clone.DisableSignup = clonePointer(c.DisableSignup)
+	clone.RequiresUsername = clonePointer(c.RequiresUsername)
+	clone.CustomScripts = clonePointerFunc(c.CustomScripts, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.Configuration = clonePointerFunc(c.Configuration, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.StrategyVersion = clonePointer(c.StrategyVersion)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.DisableSelfServiceChangePassword = clonePointer(c.DisableSelfServiceChangePassword)
+	return clone
+}
+
+func (c *ConnectionOptions) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptions and other hold the same values.
+func (c *ConnectionOptions) Equal(other *ConnectionOptions) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptions and other.
+func (c *ConnectionOptions) Diff(other *ConnectionOptions) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptions) diff(prefix string, other *ConnectionOptions) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptions{}
+	}
+	if other == nil {
+		other = &ConnectionOptions{}
+	}
+	changes = diffMap(changes, prefix+"mfa", c.MFA, other.MFA)
+	changes = diffMap(changes, prefix+"validation", c.Validation, other.Validation)
+	changes = diffPointer(changes, prefix+"passwordPolicy", c.PasswordPolicy, other.PasswordPolicy)
+	changes = diffMap(changes, prefix+"password_history", c.PasswordHistory, other.PasswordHistory)
+	changes = diffMap(changes, prefix+"password_no_personal_info", c.PasswordNoPersonalInfo, other.PasswordNoPersonalInfo)
+	changes = diffMap(changes, prefix+"password_dictionary", c.PasswordDictionary, other.PasswordDictionary)
+	changes = diffMap(changes, prefix+"password_complexity_options", c.PasswordComplexityOptions, other.PasswordComplexityOptions)
+	changes = diffPointer(changes, prefix+"enable_script_context", c.EnableScriptContext, other.EnableScriptContext)
+	changes = diffPointer(changes, prefix+"enabledDatabaseCustomization", c.EnabledDatabaseCustomization, other.EnabledDatabaseCustomization)
+	changes = diffPointer(changes, prefix+"brute_force_protection", c.BruteForceProtection, other.BruteForceProtection)
+	changes = diffPointer(changes, prefix+"import_mode", c.ImportMode, other.ImportMode)
+	changes = diffPointer(changes, prefix+"disable_signup", c.DisableSignup, other.DisableSignup)
+	changes = diffPointer(changes, prefix+"requires_username", c.RequiresUsername, other.RequiresUsername)
+	changes = diffMapPointer(changes, prefix+"customScripts", c.CustomScripts, other.CustomScripts)
+	changes = diffMapPointer(changes, prefix+"configuration", c.Configuration, other.Configuration)
+	changes = diffPointer(changes, prefix+"strategy_version", c.StrategyVersion, other.StrategyVersion)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	changes = diffPointer(changes, prefix+"disable_self_service_change_password", c.DisableSelfServiceChangePassword, other.DisableSelfServiceChangePassword)
+	return changes
+}
+
+func (c *ConnectionOptions) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptions)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAD) GetBruteForceProtection() bool {
+	if c == nil || c.BruteForceProtection == nil {
+		return false
 	}
-	return *c.ClientID
+	return *c.BruteForceProtection
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
+// GetCertAuth returns the CertAuth field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAD) GetCertAuth() bool {
+	if c == nil || c.CertAuth == nil {
+		return false
 	}
-	return *c.ClientSecret
+	return *c.CertAuth
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetDomain() string {
-	if c == nil || c.Domain == nil {
-		return ""
+// GetDisableCache returns the DisableCache field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAD) GetDisableCache() bool {
+	if c == nil || c.DisableCache == nil {
+		return false
 	}
-	return *c.Domain
+	return *c.DisableCache
 }
 
 // GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetDomainAliases() []string {
+func (c *ConnectionOptionsAD) GetDomainAliases() []string {
 	if c == nil || c.DomainAliases == nil {
 		return nil
 	}
 	return *c.DomainAliases
 }
 
-// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetEnableUsersAPI() bool {
-	if c == nil || c.EnableUsersAPI == nil {
-		return false
-	}
-	return *c.EnableUsersAPI
-}
-
-// GetExtendedProfile returns the ExtendedProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetExtendedProfile() bool {
-	if c == nil || c.ExtendedProfile == nil {
-		return false
-	}
-	return *c.ExtendedProfile
-}
-
-// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetGroups() bool {
-	if c == nil || c.Groups == nil {
-		return false
+// GetIPs returns the IPs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAD) GetIPs() []string {
+	if c == nil || c.IPs == nil {
+		return nil
 	}
-	return *c.Groups
+	return *c.IPs
 }
 
-// GetIsSuspended returns the IsSuspended field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetIsSuspended() bool {
-	if c == nil || c.IsSuspended == nil {
+// GetKerberos returns the Kerberos field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAD) GetKerberos() bool {
+	if c == nil || c.Kerberos == nil {
 		return false
 	}
-	return *c.IsSuspended
+	return *c.Kerberos
 }
 
 // GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetLogoURL() string {
+func (c *ConnectionOptionsAD) GetLogoURL() string {
 	if c == nil || c.LogoURL == nil {
 		return ""
 	}
@@ -2927,7 +4270,7 @@ func (c *ConnectionOptionsGoogleApps) GetLogoURL() string {
 }
 
 // GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetNonPersistentAttrs() []string {
+func (c *ConnectionOptionsAD) GetNonPersistentAttrs() []string {
 	if c == nil || c.NonPersistentAttrs == nil {
 		return nil
 	}
@@ -2935,7 +4278,7 @@ func (c *ConnectionOptionsGoogleApps) GetNonPersistentAttrs() []string {
 }
 
 // GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetSetUserAttributes() string {
+func (c *ConnectionOptionsAD) GetSetUserAttributes() string {
 	if c == nil || c.SetUserAttributes == nil {
 		return ""
 	}
@@ -2943,3531 +4286,10918 @@ func (c *ConnectionOptionsGoogleApps) GetSetUserAttributes() string {
 }
 
 // GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleApps) GetTenantDomain() string {
+func (c *ConnectionOptionsAD) GetTenantDomain() string {
 	if c == nil || c.TenantDomain == nil {
 		return ""
 	}
 	return *c.TenantDomain
 }
 
-// String returns a string representation of ConnectionOptionsGoogleApps.
-func (c *ConnectionOptionsGoogleApps) String() string {
+// String returns a string representation of ConnectionOptionsAD.
+func (c *ConnectionOptionsAD) String() string {
 	return Stringify(c)
 }
 
-// GetAdsenseManagement returns the AdsenseManagement field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetAdsenseManagement() bool {
-	if c == nil || c.AdsenseManagement == nil {
-		return false
+// Clone returns a deep copy of ConnectionOptionsAD.
+func (c *ConnectionOptionsAD) Clone() *ConnectionOptionsAD {
+	if c == nil {
+		return nil
 	}
-	return *c.AdsenseManagement
+	clone := &ConnectionOptionsAD{}
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.IPs = clonePointerFunc(c.IPs, func(v []string) []string { return cloneSlice(v) })
+	clone.CertAuth = clonePointer(c.CertAuth)
+	clone.Kerberos = clonePointer(c.Kerberos)
+	clone.DisableCache = clonePointer(c.DisableCache)
+	clone.BruteForceProtection = clonePointer(c.BruteForceProtection)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
 }
 
-// GetAllowedAudiences returns the AllowedAudiences field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetAllowedAudiences() []string {
-	if c == nil || c.AllowedAudiences == nil {
-		return nil
-	}
-	return *c.AllowedAudiences
+func (c *ConnectionOptionsAD) cloneAny() interface{} {
+	return c.Clone()
 }
 
-// GetAnalytics returns the Analytics field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetAnalytics() bool {
-	if c == nil || c.Analytics == nil {
-		return false
-	}
-	return *c.Analytics
+// Equal reports whether ConnectionOptionsAD and other hold the same values.
+func (c *ConnectionOptionsAD) Equal(other *ConnectionOptionsAD) bool {
+	return len(c.diff("", other)) == 0
 }
 
-// GetBlogger returns the Blogger field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetBlogger() bool {
-	if c == nil || c.Blogger == nil {
-		return false
-	}
-	return *c.Blogger
+// Diff returns the fields that changed between ConnectionOptionsAD and other.
+func (c *ConnectionOptionsAD) Diff(other *ConnectionOptionsAD) []FieldChange {
+	return c.diff("", other)
 }
 
-// GetCalendar returns the Calendar field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetCalendar() bool {
-	if c == nil || c.Calendar == nil {
-		return false
+func (c *ConnectionOptionsAD) diff(prefix string, other *ConnectionOptionsAD) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
 	}
-	return *c.Calendar
+	if c == nil {
+		c = &ConnectionOptionsAD{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsAD{}
+	}
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffSlicePointer(changes, prefix+"ips", c.IPs, other.IPs)
+	changes = diffPointer(changes, prefix+"certAuth", c.CertAuth, other.CertAuth)
+	changes = diffPointer(changes, prefix+"kerberos", c.Kerberos, other.Kerberos)
+	changes = diffPointer(changes, prefix+"disable_cache", c.DisableCache, other.DisableCache)
+	changes = diffPointer(changes, prefix+"brute_force_protection", c.BruteForceProtection, other.BruteForceProtection)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
 }
 
-// GetChromeWebStore returns the ChromeWebStore field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetChromeWebStore() bool {
-	if c == nil || c.ChromeWebStore == nil {
-		return false
+func (c *ConnectionOptionsAD) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsAD)
+	if !ok {
+		return nil, false
 	}
-	return *c.ChromeWebStore
+	return c.diff(prefix, typed), true
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetClientID() string {
-	if c == nil || c.ClientID == nil {
+// GetADFSServer returns the ADFSServer field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetADFSServer() string {
+	if c == nil || c.ADFSServer == nil {
 		return ""
 	}
-	return *c.ClientID
+	return *c.ADFSServer
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
 	}
-	return *c.ClientSecret
+	return *c.DomainAliases
 }
 
-// GetContacts returns the Contacts field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetContacts() bool {
-	if c == nil || c.Contacts == nil {
+// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetEnableUsersAPI() bool {
+	if c == nil || c.EnableUsersAPI == nil {
 		return false
 	}
-	return *c.Contacts
+	return *c.EnableUsersAPI
 }
 
-// GetContentAPIForShopping returns the ContentAPIForShopping field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetContentAPIForShopping() bool {
-	if c == nil || c.ContentAPIForShopping == nil {
-		return false
+// GetFedMetadataXML returns the FedMetadataXML field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetFedMetadataXML() string {
+	if c == nil || c.FedMetadataXML == nil {
+		return ""
 	}
-	return *c.ContentAPIForShopping
+	return *c.FedMetadataXML
 }
 
-// GetCoordinate returns the Coordinate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetCoordinate() bool {
-	if c == nil || c.Coordinate == nil {
-		return false
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
 	}
-	return *c.Coordinate
+	return *c.LogoURL
 }
 
-// GetCoordinateReadonly returns the CoordinateReadonly field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetCoordinateReadonly() bool {
-	if c == nil || c.CoordinateReadonly == nil {
-		return false
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
 	}
-	return *c.CoordinateReadonly
+	return *c.NonPersistentAttrs
 }
 
-// GetDocumentList returns the DocumentList field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetDocumentList() bool {
-	if c == nil || c.DocumentList == nil {
-		return false
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
 	}
-	return *c.DocumentList
+	return *c.SetUserAttributes
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetEmail() bool {
-	if c == nil || c.Email == nil {
-		return false
+// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetSignInEndpoint() string {
+	if c == nil || c.SignInEndpoint == nil {
+		return ""
 	}
-	return *c.Email
+	return *c.SignInEndpoint
 }
 
-// GetGmail returns the Gmail field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGmail() bool {
-	if c == nil || c.Gmail == nil {
-		return false
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
 	}
-	return *c.Gmail
+	return *c.TenantDomain
 }
 
-// GetGoogleAffiliateNetwork returns the GoogleAffiliateNetwork field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGoogleAffiliateNetwork() bool {
-	if c == nil || c.GoogleAffiliateNetwork == nil {
-		return false
+// GetThumbprints returns the Thumbprints field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetThumbprints() []string {
+	if c == nil || c.Thumbprints == nil {
+		return nil
 	}
-	return *c.GoogleAffiliateNetwork
+	return *c.Thumbprints
 }
 
-// GetGoogleBooks returns the GoogleBooks field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGoogleBooks() bool {
-	if c == nil || c.GoogleBooks == nil {
+// GetTrustEmailVerified returns the TrustEmailVerified field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsADFS) GetTrustEmailVerified() string {
+	if c == nil || c.TrustEmailVerified == nil {
+		return ""
+	}
+	return *c.TrustEmailVerified
+}
+
+// String returns a string representation of ConnectionOptionsADFS.
+func (c *ConnectionOptionsADFS) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsADFS.
+func (c *ConnectionOptionsADFS) Clone() *ConnectionOptionsADFS {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsADFS{}
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.ADFSServer = clonePointer(c.ADFSServer)
+	clone.FedMetadataXML = clonePointer(c.FedMetadataXML)
+	clone.EnableUsersAPI = clonePointer(c.EnableUsersAPI)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.Thumbprints = clonePointerFunc(c.Thumbprints, func(v []string) []string { return cloneSlice(v) })
+	clone.SignInEndpoint = clonePointer(c.SignInEndpoint)
+	clone.TrustEmailVerified = clonePointer(c.TrustEmailVerified)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	return clone
+}
+
+func (c *ConnectionOptionsADFS) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsADFS and other hold the same values.
+func (c *ConnectionOptionsADFS) Equal(other *ConnectionOptionsADFS) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsADFS and other.
+func (c *ConnectionOptionsADFS) Diff(other *ConnectionOptionsADFS) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsADFS) diff(prefix string, other *ConnectionOptionsADFS) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsADFS{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsADFS{}
+	}
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"adfs_server", c.ADFSServer, other.ADFSServer)
+	changes = diffPointer(changes, prefix+"fedMetadataXml", c.FedMetadataXML, other.FedMetadataXML)
+	changes = diffPointer(changes, prefix+"api_enable_users", c.EnableUsersAPI, other.EnableUsersAPI)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	changes = diffSlicePointer(changes, prefix+"thumbprints", c.Thumbprints, other.Thumbprints)
+	changes = diffPointer(changes, prefix+"signInEndpoint", c.SignInEndpoint, other.SignInEndpoint)
+	changes = diffPointer(changes, prefix+"should_trust_email_verified_connection", c.TrustEmailVerified, other.TrustEmailVerified)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	return changes
+}
+
+func (c *ConnectionOptionsADFS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsADFS)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetEmail() bool {
+	if c == nil || c.Email == nil {
 		return false
 	}
-	return *c.GoogleBooks
+	return *c.Email
 }
 
-// GetGoogleCloudStorage returns the GoogleCloudStorage field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGoogleCloudStorage() bool {
-	if c == nil || c.GoogleCloudStorage == nil {
+// GetKeyID returns the KeyID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetKeyID() string {
+	if c == nil || c.KeyID == nil {
+		return ""
+	}
+	return *c.KeyID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetName() bool {
+	if c == nil || c.Name == nil {
 		return false
 	}
-	return *c.GoogleCloudStorage
+	return *c.Name
 }
 
-// GetGoogleDrive returns the GoogleDrive field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGoogleDrive() bool {
-	if c == nil || c.GoogleDrive == nil {
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTeamID returns the TeamID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsApple) GetTeamID() string {
+	if c == nil || c.TeamID == nil {
+		return ""
+	}
+	return *c.TeamID
+}
+
+// String returns a string representation of ConnectionOptionsApple.
+func (c *ConnectionOptionsApple) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsApple.
+func (c *ConnectionOptionsApple) Clone() *ConnectionOptionsApple {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsApple{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.TeamID = clonePointer(c.TeamID)
+	clone.KeyID = clonePointer(c.KeyID)
+	clone.Name = clonePointer(c.Name)
+	clone.Email = clonePointer(c.Email)
+	clone.Scope = clonePointer(c.Scope)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsApple) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsApple and other hold the same values.
+func (c *ConnectionOptionsApple) Equal(other *ConnectionOptionsApple) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsApple and other.
+func (c *ConnectionOptionsApple) Diff(other *ConnectionOptionsApple) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsApple) diff(prefix string, other *ConnectionOptionsApple) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsApple{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsApple{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"app_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"team_id", c.TeamID, other.TeamID)
+	changes = diffPointer(changes, prefix+"kid", c.KeyID, other.KeyID)
+	changes = diffPointer(changes, prefix+"name", c.Name, other.Name)
+	changes = diffPointer(changes, prefix+"email", c.Email, other.Email)
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsApple) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsApple)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetResponseType returns the ResponseType field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAuthParams) GetResponseType() string {
+	if c == nil || c.ResponseType == nil {
+		return ""
+	}
+	return *c.ResponseType
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAuthParams) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// String returns a string representation of ConnectionOptionsAuthParams.
+func (c *ConnectionOptionsAuthParams) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsAuthParams.
+func (c *ConnectionOptionsAuthParams) Clone() *ConnectionOptionsAuthParams {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsAuthParams{}
+	clone.Scope = clonePointer(c.Scope)
+	clone.ResponseType = clonePointer(c.ResponseType)
+	return clone
+}
+
+func (c *ConnectionOptionsAuthParams) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsAuthParams and other hold the same values.
+func (c *ConnectionOptionsAuthParams) Equal(other *ConnectionOptionsAuthParams) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsAuthParams and other.
+func (c *ConnectionOptionsAuthParams) Diff(other *ConnectionOptionsAuthParams) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsAuthParams) diff(prefix string, other *ConnectionOptionsAuthParams) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsAuthParams{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsAuthParams{}
+	}
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"response_type", c.ResponseType, other.ResponseType)
+	return changes
+}
+
+func (c *ConnectionOptionsAuthParams) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsAuthParams)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetAdmin() bool {
+	if c == nil || c.Admin == nil {
 		return false
 	}
-	return *c.GoogleDrive
+	return *c.Admin
 }
 
-// GetGoogleDriveFiles returns the GoogleDriveFiles field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGoogleDriveFiles() bool {
-	if c == nil || c.GoogleDriveFiles == nil {
+// GetAgreedTerms returns the AgreedTerms field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetAgreedTerms() bool {
+	if c == nil || c.AgreedTerms == nil {
 		return false
 	}
-	return *c.GoogleDriveFiles
+	return *c.AgreedTerms
 }
 
-// GetGooglePlus returns the GooglePlus field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetGooglePlus() bool {
-	if c == nil || c.GooglePlus == nil {
+// GetAppID returns the AppID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetAppID() string {
+	if c == nil || c.AppID == nil {
+		return ""
+	}
+	return *c.AppID
+}
+
+// GetAssignedPlans returns the AssignedPlans field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetAssignedPlans() bool {
+	if c == nil || c.AssignedPlans == nil {
 		return false
 	}
-	return *c.GooglePlus
+	return *c.AssignedPlans
 }
 
-// GetLatitudeBest returns the LatitudeBest field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetLatitudeBest() bool {
-	if c == nil || c.LatitudeBest == nil {
+// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetBasicProfile() bool {
+	if c == nil || c.BasicProfile == nil {
 		return false
 	}
-	return *c.LatitudeBest
+	return *c.BasicProfile
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetDomain() string {
+	if c == nil || c.Domain == nil {
+		return ""
+	}
+	return *c.Domain
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetEnableUsersAPI() bool {
+	if c == nil || c.EnableUsersAPI == nil {
+		return false
+	}
+	return *c.EnableUsersAPI
+}
+
+// GetExtendedProfile returns the ExtendedProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetExtendedProfile() bool {
+	if c == nil || c.ExtendedProfile == nil {
+		return false
+	}
+	return *c.ExtendedProfile
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetGroups() bool {
+	if c == nil || c.Groups == nil {
+		return false
+	}
+	return *c.Groups
+}
+
+// GetIdentityAPI returns the IdentityAPI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetIdentityAPI() string {
+	if c == nil || c.IdentityAPI == nil {
+		return ""
+	}
+	return *c.IdentityAPI
+}
+
+// GetIsSuspended returns the IsSuspended field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetIsSuspended() bool {
+	if c == nil || c.IsSuspended == nil {
+		return false
+	}
+	return *c.IsSuspended
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetMaxGroupsToRetrieve returns the MaxGroupsToRetrieve field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetMaxGroupsToRetrieve() string {
+	if c == nil || c.MaxGroupsToRetrieve == nil {
+		return ""
+	}
+	return *c.MaxGroupsToRetrieve
+}
+
+// GetNestedGroups returns the NestedGroups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetNestedGroups() bool {
+	if c == nil || c.NestedGroups == nil {
+		return false
+	}
+	return *c.NestedGroups
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
+	}
+	return *c.TenantDomain
+}
+
+// GetTrustEmailVerified returns the TrustEmailVerified field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetTrustEmailVerified() string {
+	if c == nil || c.TrustEmailVerified == nil {
+		return ""
+	}
+	return *c.TrustEmailVerified
+}
+
+// GetUseCommonEndpoint returns the UseCommonEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetUseCommonEndpoint() bool {
+	if c == nil || c.UseCommonEndpoint == nil {
+		return false
+	}
+	return *c.UseCommonEndpoint
+}
+
+// GetUseWSFederation returns the UseWSFederation field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetUseWSFederation() bool {
+	if c == nil || c.UseWSFederation == nil {
+		return false
+	}
+	return *c.UseWSFederation
+}
+
+// GetWAADCommonEndpoint returns the WAADCommonEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetWAADCommonEndpoint() bool {
+	if c == nil || c.WAADCommonEndpoint == nil {
+		return false
+	}
+	return *c.WAADCommonEndpoint
+}
+
+// GetWAADProtocol returns the WAADProtocol field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsAzureAD) GetWAADProtocol() string {
+	if c == nil || c.WAADProtocol == nil {
+		return ""
+	}
+	return *c.WAADProtocol
+}
+
+// String returns a string representation of ConnectionOptionsAzureAD.
+func (c *ConnectionOptionsAzureAD) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsAzureAD.
+func (c *ConnectionOptionsAzureAD) Clone() *ConnectionOptionsAzureAD {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsAzureAD{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.AppID = clonePointer(c.AppID)
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.Domain = clonePointer(c.Domain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.IdentityAPI = clonePointer(c.IdentityAPI)
+	clone.WAADProtocol = clonePointer(c.WAADProtocol)
+	clone.WAADCommonEndpoint = clonePointer(c.WAADCommonEndpoint)
+	clone.UseWSFederation = clonePointer(c.UseWSFederation)
+	clone.UseCommonEndpoint = clonePointer(c.UseCommonEndpoint)
+	clone.EnableUsersAPI = clonePointer(c.EnableUsersAPI)
+	clone.MaxGroupsToRetrieve = clonePointer(c.MaxGroupsToRetrieve)
+	clone.BasicProfile = clonePointer(c.BasicProfile)
+	clone.ExtendedProfile = clonePointer(c.ExtendedProfile)
+	clone.Groups = clonePointer(c.Groups)
+	clone.NestedGroups = clonePointer(c.NestedGroups)
+	clone.Admin = clonePointer(c.Admin)
+	clone.IsSuspended = clonePointer(c.IsSuspended)
+	clone.AgreedTerms = clonePointer(c.AgreedTerms)
+	clone.AssignedPlans = clonePointer(c.AssignedPlans)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.TrustEmailVerified = clonePointer(c.TrustEmailVerified)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsAzureAD) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsAzureAD and other hold the same values.
+func (c *ConnectionOptionsAzureAD) Equal(other *ConnectionOptionsAzureAD) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsAzureAD and other.
+func (c *ConnectionOptionsAzureAD) Diff(other *ConnectionOptionsAzureAD) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsAzureAD) diff(prefix string, other *ConnectionOptionsAzureAD) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsAzureAD{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsAzureAD{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"app_id", c.AppID, other.AppID)
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffPointer(changes, prefix+"domain", c.Domain, other.Domain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"identity_api", c.IdentityAPI, other.IdentityAPI)
+	changes = diffPointer(changes, prefix+"waad_protocol", c.WAADProtocol, other.WAADProtocol)
+	changes = diffPointer(changes, prefix+"waad_common_endpoint", c.WAADCommonEndpoint, other.WAADCommonEndpoint)
+	changes = diffPointer(changes, prefix+"use_wsfed", c.UseWSFederation, other.UseWSFederation)
+	changes = diffPointer(changes, prefix+"useCommonEndpoint", c.UseCommonEndpoint, other.UseCommonEndpoint)
+	changes = diffPointer(changes, prefix+"api_enable_users", c.EnableUsersAPI, other.EnableUsersAPI)
+	changes = diffPointer(changes, prefix+"max_groups_to_retrieve", c.MaxGroupsToRetrieve, other.MaxGroupsToRetrieve)
+	changes = diffPointer(changes, prefix+"basic_profile", c.BasicProfile, other.BasicProfile)
+	changes = diffPointer(changes, prefix+"ext_profile", c.ExtendedProfile, other.ExtendedProfile)
+	changes = diffPointer(changes, prefix+"ext_groups", c.Groups, other.Groups)
+	changes = diffPointer(changes, prefix+"ext_nested_groups", c.NestedGroups, other.NestedGroups)
+	changes = diffPointer(changes, prefix+"ext_admin", c.Admin, other.Admin)
+	changes = diffPointer(changes, prefix+"ext_is_suspended", c.IsSuspended, other.IsSuspended)
+	changes = diffPointer(changes, prefix+"ext_agreed_terms", c.AgreedTerms, other.AgreedTerms)
+	changes = diffPointer(changes, prefix+"ext_assigned_plans", c.AssignedPlans, other.AssignedPlans)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffPointer(changes, prefix+"should_trust_email_verified_connection", c.TrustEmailVerified, other.TrustEmailVerified)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsAzureAD) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsAzureAD)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmail) GetBruteForceProtection() bool {
+	if c == nil || c.BruteForceProtection == nil {
+		return false
+	}
+	return *c.BruteForceProtection
+}
+
+// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmail) GetDisableSignup() bool {
+	if c == nil || c.DisableSignup == nil {
+		return false
+	}
+	return *c.DisableSignup
+}
+
+// GetEmail returns the Email field.
+func (c *ConnectionOptionsEmail) GetEmail() *ConnectionOptionsEmailSettings {
+	if c == nil {
+		return nil
+	}
+	return c.Email
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmail) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmail) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetOTP returns the OTP field.
+func (c *ConnectionOptionsEmail) GetOTP() *ConnectionOptionsOTP {
+	if c == nil {
+		return nil
+	}
+	return c.OTP
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmail) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// String returns a string representation of ConnectionOptionsEmail.
+func (c *ConnectionOptionsEmail) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsEmail.
+func (c *ConnectionOptionsEmail) Clone() *ConnectionOptionsEmail {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsEmail{}
+	clone.Name = clonePointer(c.Name)
+	clone.Email = c.Email.Clone()
+	clone.OTP = c.OTP.Clone()
+	clone.AuthParams = cloneValue(c.AuthParams)
+	clone.DisableSignup = clonePointer(c.DisableSignup)
+	clone.BruteForceProtection = clonePointer(c.BruteForceProtection)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsEmail) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsEmail and other hold the same values.
+func (c *ConnectionOptionsEmail) Equal(other *ConnectionOptionsEmail) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsEmail and other.
+func (c *ConnectionOptionsEmail) Diff(other *ConnectionOptionsEmail) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsEmail) diff(prefix string, other *ConnectionOptionsEmail) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsEmail{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsEmail{}
+	}
+	changes = diffPointer(changes, prefix+"name", c.Name, other.Name)
+	changes = append(changes, c.Email.diff(prefix+"email.", other.Email)...)
+	changes = append(changes, c.OTP.diff(prefix+"totp.", other.OTP)...)
+	changes = diffValue(changes, prefix+"authParams", c.AuthParams, other.AuthParams)
+	changes = diffPointer(changes, prefix+"disable_signup", c.DisableSignup, other.DisableSignup)
+	changes = diffPointer(changes, prefix+"brute_force_protection", c.BruteForceProtection, other.BruteForceProtection)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsEmail) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsEmail)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmailSettings) GetBody() string {
+	if c == nil || c.Body == nil {
+		return ""
+	}
+	return *c.Body
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmailSettings) GetFrom() string {
+	if c == nil || c.From == nil {
+		return ""
+	}
+	return *c.From
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmailSettings) GetSubject() string {
+	if c == nil || c.Subject == nil {
+		return ""
+	}
+	return *c.Subject
+}
+
+// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsEmailSettings) GetSyntax() string {
+	if c == nil || c.Syntax == nil {
+		return ""
+	}
+	return *c.Syntax
+}
+
+// String returns a string representation of ConnectionOptionsEmailSettings.
+func (c *ConnectionOptionsEmailSettings) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsEmailSettings.
+func (c *ConnectionOptionsEmailSettings) Clone() *ConnectionOptionsEmailSettings {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsEmailSettings{}
+	clone.Syntax = clonePointer(c.Syntax)
+	clone.From = clonePointer(c.From)
+	clone.Subject = clonePointer(c.Subject)
+	clone.Body = clonePointer(c.Body)
+	return clone
+}
+
+func (c *ConnectionOptionsEmailSettings) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsEmailSettings and other hold the same values.
+func (c *ConnectionOptionsEmailSettings) Equal(other *ConnectionOptionsEmailSettings) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsEmailSettings and other.
+func (c *ConnectionOptionsEmailSettings) Diff(other *ConnectionOptionsEmailSettings) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsEmailSettings) diff(prefix string, other *ConnectionOptionsEmailSettings) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsEmailSettings{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsEmailSettings{}
+	}
+	changes = diffPointer(changes, prefix+"syntax", c.Syntax, other.Syntax)
+	changes = diffPointer(changes, prefix+"from", c.From, other.From)
+	changes = diffPointer(changes, prefix+"subject", c.Subject, other.Subject)
+	changes = diffPointer(changes, prefix+"body", c.Body, other.Body)
+	return changes
+}
+
+func (c *ConnectionOptionsEmailSettings) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsEmailSettings)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAdsManagement returns the AdsManagement field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetAdsManagement() bool {
+	if c == nil || c.AdsManagement == nil {
+		return false
+	}
+	return *c.AdsManagement
+}
+
+// GetAdsRead returns the AdsRead field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetAdsRead() bool {
+	if c == nil || c.AdsRead == nil {
+		return false
+	}
+	return *c.AdsRead
+}
+
+// GetAllowContextProfileField returns the AllowContextProfileField field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetAllowContextProfileField() bool {
+	if c == nil || c.AllowContextProfileField == nil {
+		return false
+	}
+	return *c.AllowContextProfileField
+}
+
+// GetBusinessManagement returns the BusinessManagement field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetBusinessManagement() bool {
+	if c == nil || c.BusinessManagement == nil {
+		return false
+	}
+	return *c.BusinessManagement
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetEmail() bool {
+	if c == nil || c.Email == nil {
+		return false
+	}
+	return *c.Email
+}
+
+// GetGroupsAccessMemberInfo returns the GroupsAccessMemberInfo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetGroupsAccessMemberInfo() bool {
+	if c == nil || c.GroupsAccessMemberInfo == nil {
+		return false
+	}
+	return *c.GroupsAccessMemberInfo
+}
+
+// GetLeadsRetrieval returns the LeadsRetrieval field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetLeadsRetrieval() bool {
+	if c == nil || c.LeadsRetrieval == nil {
+		return false
+	}
+	return *c.LeadsRetrieval
+}
+
+// GetManageNotifications returns the ManageNotifications field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetManageNotifications() bool {
+	if c == nil || c.ManageNotifications == nil {
+		return false
+	}
+	return *c.ManageNotifications
+}
+
+// GetManagePages returns the ManagePages field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetManagePages() bool {
+	if c == nil || c.ManagePages == nil {
+		return false
+	}
+	return *c.ManagePages
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetPagesManageCTA returns the PagesManageCTA field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesManageCTA() bool {
+	if c == nil || c.PagesManageCTA == nil {
+		return false
+	}
+	return *c.PagesManageCTA
+}
+
+// GetPagesManageInstantArticles returns the PagesManageInstantArticles field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesManageInstantArticles() bool {
+	if c == nil || c.PagesManageInstantArticles == nil {
+		return false
+	}
+	return *c.PagesManageInstantArticles
+}
+
+// GetPagesMessaging returns the PagesMessaging field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesMessaging() bool {
+	if c == nil || c.PagesMessaging == nil {
+		return false
+	}
+	return *c.PagesMessaging
+}
+
+// GetPagesMessagingPhoneNumber returns the PagesMessagingPhoneNumber field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesMessagingPhoneNumber() bool {
+	if c == nil || c.PagesMessagingPhoneNumber == nil {
+		return false
+	}
+	return *c.PagesMessagingPhoneNumber
+}
+
+// GetPagesMessagingSubscriptions returns the PagesMessagingSubscriptions field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesMessagingSubscriptions() bool {
+	if c == nil || c.PagesMessagingSubscriptions == nil {
+		return false
+	}
+	return *c.PagesMessagingSubscriptions
+}
+
+// GetPagesShowList returns the PagesShowList field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPagesShowList() bool {
+	if c == nil || c.PagesShowList == nil {
+		return false
+	}
+	return *c.PagesShowList
+}
+
+// GetPublicProfile returns the PublicProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPublicProfile() bool {
+	if c == nil || c.PublicProfile == nil {
+		return false
+	}
+	return *c.PublicProfile
+}
+
+// GetPublishActions returns the PublishActions field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPublishActions() bool {
+	if c == nil || c.PublishActions == nil {
+		return false
+	}
+	return *c.PublishActions
+}
+
+// GetPublishPages returns the PublishPages field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPublishPages() bool {
+	if c == nil || c.PublishPages == nil {
+		return false
+	}
+	return *c.PublishPages
+}
+
+// GetPublishToGroups returns the PublishToGroups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPublishToGroups() bool {
+	if c == nil || c.PublishToGroups == nil {
+		return false
+	}
+	return *c.PublishToGroups
+}
+
+// GetPublishVideo returns the PublishVideo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetPublishVideo() bool {
+	if c == nil || c.PublishVideo == nil {
+		return false
+	}
+	return *c.PublishVideo
+}
+
+// GetReadAudienceNetworkInsights returns the ReadAudienceNetworkInsights field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetReadAudienceNetworkInsights() bool {
+	if c == nil || c.ReadAudienceNetworkInsights == nil {
+		return false
+	}
+	return *c.ReadAudienceNetworkInsights
+}
+
+// GetReadInsights returns the ReadInsights field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetReadInsights() bool {
+	if c == nil || c.ReadInsights == nil {
+		return false
+	}
+	return *c.ReadInsights
+}
+
+// GetReadMailbox returns the ReadMailbox field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetReadMailbox() bool {
+	if c == nil || c.ReadMailbox == nil {
+		return false
+	}
+	return *c.ReadMailbox
+}
+
+// GetReadPageMailboxes returns the ReadPageMailboxes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetReadPageMailboxes() bool {
+	if c == nil || c.ReadPageMailboxes == nil {
+		return false
+	}
+	return *c.ReadPageMailboxes
+}
+
+// GetReadStream returns the ReadStream field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetReadStream() bool {
+	if c == nil || c.ReadStream == nil {
+		return false
+	}
+	return *c.ReadStream
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetUserAgeRange returns the UserAgeRange field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserAgeRange() bool {
+	if c == nil || c.UserAgeRange == nil {
+		return false
+	}
+	return *c.UserAgeRange
+}
+
+// GetUserBirthday returns the UserBirthday field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserBirthday() bool {
+	if c == nil || c.UserBirthday == nil {
+		return false
+	}
+	return *c.UserBirthday
+}
+
+// GetUserEvents returns the UserEvents field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserEvents() bool {
+	if c == nil || c.UserEvents == nil {
+		return false
+	}
+	return *c.UserEvents
+}
+
+// GetUserFriends returns the UserFriends field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserFriends() bool {
+	if c == nil || c.UserFriends == nil {
+		return false
+	}
+	return *c.UserFriends
+}
+
+// GetUserGender returns the UserGender field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserGender() bool {
+	if c == nil || c.UserGender == nil {
+		return false
+	}
+	return *c.UserGender
+}
+
+// GetUserGroups returns the UserGroups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserGroups() bool {
+	if c == nil || c.UserGroups == nil {
+		return false
+	}
+	return *c.UserGroups
+}
+
+// GetUserHometown returns the UserHometown field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserHometown() bool {
+	if c == nil || c.UserHometown == nil {
+		return false
+	}
+	return *c.UserHometown
+}
+
+// GetUserLikes returns the UserLikes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserLikes() bool {
+	if c == nil || c.UserLikes == nil {
+		return false
+	}
+	return *c.UserLikes
+}
+
+// GetUserLink returns the UserLink field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserLink() bool {
+	if c == nil || c.UserLink == nil {
+		return false
+	}
+	return *c.UserLink
+}
+
+// GetUserLocation returns the UserLocation field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserLocation() bool {
+	if c == nil || c.UserLocation == nil {
+		return false
+	}
+	return *c.UserLocation
+}
+
+// GetUserManagedGroups returns the UserManagedGroups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserManagedGroups() bool {
+	if c == nil || c.UserManagedGroups == nil {
+		return false
+	}
+	return *c.UserManagedGroups
+}
+
+// GetUserPhotos returns the UserPhotos field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserPhotos() bool {
+	if c == nil || c.UserPhotos == nil {
+		return false
+	}
+	return *c.UserPhotos
+}
+
+// GetUserPosts returns the UserPosts field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserPosts() bool {
+	if c == nil || c.UserPosts == nil {
+		return false
+	}
+	return *c.UserPosts
+}
+
+// GetUserStatus returns the UserStatus field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserStatus() bool {
+	if c == nil || c.UserStatus == nil {
+		return false
+	}
+	return *c.UserStatus
+}
+
+// GetUserTaggedPlaces returns the UserTaggedPlaces field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserTaggedPlaces() bool {
+	if c == nil || c.UserTaggedPlaces == nil {
+		return false
+	}
+	return *c.UserTaggedPlaces
+}
+
+// GetUserVideos returns the UserVideos field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsFacebook) GetUserVideos() bool {
+	if c == nil || c.UserVideos == nil {
+		return false
+	}
+	return *c.UserVideos
+}
+
+// String returns a string representation of ConnectionOptionsFacebook.
+func (c *ConnectionOptionsFacebook) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsFacebook.
+func (c *ConnectionOptionsFacebook) Clone() *ConnectionOptionsFacebook {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsFacebook{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.AllowContextProfileField = clonePointer(c.AllowContextProfileField)
+	clone.Email = clonePointer(c.Email)
+	clone.GroupsAccessMemberInfo = clonePointer(c.GroupsAccessMemberInfo)
+	clone.PublishToGroups = clonePointer(c.PublishToGroups)
+	clone.UserAgeRange = clonePointer(c.UserAgeRange)
+	clone.UserBirthday = clonePointer(c.UserBirthday)
+	clone.AdsManagement = clonePointer(c.AdsManagement)
+	clone.AdsRead = clonePointer(c.AdsRead)
+	clone.ReadAudienceNetworkInsights = clonePointer(c.ReadAudienceNetworkInsights)
+	clone.ReadInsights = clonePointer(c.ReadInsights)
+	clone.ManageNotifications = clonePointer(c.ManageNotifications)
+	clone.PublishActions = clonePointer(c.PublishActions)
+	clone.ReadMailbox = clonePointer(c.ReadMailbox)
+	clone.PublicProfile = clonePointer(c.PublicProfile)
+	clone.UserEvents = clonePointer(c.UserEvents)
+	clone.UserFriends = clonePointer(c.UserFriends)
+	clone.UserGender = clonePointer(c.UserGender)
+	clone.UserHometown = clonePointer(c.UserHometown)
+	clone.UserLikes = clonePointer(c.UserLikes)
+	clone.UserLink = clonePointer(c.UserLink)
+	clone.UserLocation = clonePointer(c.UserLocation)
+	clone.UserPhotos = clonePointer(c.UserPhotos)
+	clone.UserPosts = clonePointer(c.UserPosts)
+	clone.UserTaggedPlaces = clonePointer(c.UserTaggedPlaces)
+	clone.UserVideos = clonePointer(c.UserVideos)
+	clone.BusinessManagement = clonePointer(c.BusinessManagement)
+	clone.LeadsRetrieval = clonePointer(c.LeadsRetrieval)
+	clone.ManagePages = clonePointer(c.ManagePages)
+	clone.PagesManageCTA = clonePointer(c.PagesManageCTA)
+	clone.PagesManageInstantArticles = clonePointer(c.PagesManageInstantArticles)
+	clone.PagesShowList = clonePointer(c.PagesShowList)
+	clone.PagesMessaging = clonePointer(c.PagesMessaging)
+	clone.PagesMessagingPhoneNumber = clonePointer(c.PagesMessagingPhoneNumber)
+	clone.PagesMessagingSubscriptions = clonePointer(c.PagesMessagingSubscriptions)
+	clone.PublishPages = clonePointer(c.PublishPages)
+	clone.PublishVideo = clonePointer(c.PublishVideo)
+	clone.ReadPageMailboxes = clonePointer(c.ReadPageMailboxes)
+	clone.ReadStream = clonePointer(c.ReadStream)
+	clone.UserGroups = clonePointer(c.UserGroups)
+	clone.UserManagedGroups = clonePointer(c.UserManagedGroups)
+	clone.UserStatus = clonePointer(c.UserStatus)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.Scope = clonePointer(c.Scope)
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsFacebook) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsFacebook and other hold the same values.
+func (c *ConnectionOptionsFacebook) Equal(other *ConnectionOptionsFacebook) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsFacebook and other.
+func (c *ConnectionOptionsFacebook) Diff(other *ConnectionOptionsFacebook) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsFacebook) diff(prefix string, other *ConnectionOptionsFacebook) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsFacebook{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsFacebook{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"allow_context_profile_field", c.AllowContextProfileField, other.AllowContextProfileField)
+	changes = diffPointer(changes, prefix+"email", c.Email, other.Email)
+	changes = diffPointer(changes, prefix+"groups_access_member_info", c.GroupsAccessMemberInfo, other.GroupsAccessMemberInfo)
+	changes = diffPointer(changes, prefix+"publish_to_groups", c.PublishToGroups, other.PublishToGroups)
+	changes = diffPointer(changes, prefix+"user_age_range", c.UserAgeRange, other.UserAgeRange)
+	changes = diffPointer(changes, prefix+"user_birthday", c.UserBirthday, other.UserBirthday)
+	changes = diffPointer(changes, prefix+"ads_management", c.AdsManagement, other.AdsManagement)
+	changes = diffPointer(changes, prefix+"ads_read", c.AdsRead, other.AdsRead)
+	changes = diffPointer(changes, prefix+"read_audience_network_insights", c.ReadAudienceNetworkInsights, other.ReadAudienceNetworkInsights)
+	changes = diffPointer(changes, prefix+"read_insights", c.ReadInsights, other.ReadInsights)
+	changes = diffPointer(changes, prefix+"manage_notifications", c.ManageNotifications, other.ManageNotifications)
+	changes = diffPointer(changes, prefix+"publish_actions", c.PublishActions, other.PublishActions)
+	changes = diffPointer(changes, prefix+"read_mailbox", c.ReadMailbox, other.ReadMailbox)
+	changes = diffPointer(changes, prefix+"public_profile", c.PublicProfile, other.PublicProfile)
+	changes = diffPointer(changes, prefix+"user_events", c.UserEvents, other.UserEvents)
+	changes = diffPointer(changes, prefix+"user_friends", c.UserFriends, other.UserFriends)
+	changes = diffPointer(changes, prefix+"user_gender", c.UserGender, other.UserGender)
+	changes = diffPointer(changes, prefix+"user_hometown", c.UserHometown, other.UserHometown)
+	changes = diffPointer(changes, prefix+"user_likes", c.UserLikes, other.UserLikes)
+	changes = diffPointer(changes, prefix+"user_link", c.UserLink, other.UserLink)
+	changes = diffPointer(changes, prefix+"user_location", c.UserLocation, other.UserLocation)
+	changes = diffPointer(changes, prefix+"user_photos", c.UserPhotos, other.UserPhotos)
+	changes = diffPointer(changes, prefix+"user_posts", c.UserPosts, other.UserPosts)
+	changes = diffPointer(changes, prefix+"user_tagged_places", c.UserTaggedPlaces, other.UserTaggedPlaces)
+	changes = diffPointer(changes, prefix+"user_videos", c.UserVideos, other.UserVideos)
+	changes = diffPointer(changes, prefix+"business_management", c.BusinessManagement, other.BusinessManagement)
+	changes = diffPointer(changes, prefix+"leads_retrieval", c.LeadsRetrieval, other.LeadsRetrieval)
+	changes = diffPointer(changes, prefix+"manage_pages", c.ManagePages, other.ManagePages)
+	changes = diffPointer(changes, prefix+"pages_manage_cta", c.PagesManageCTA, other.PagesManageCTA)
+	changes = diffPointer(changes, prefix+"pages_manage_instant_articles", c.PagesManageInstantArticles, other.PagesManageInstantArticles)
+	changes = diffPointer(changes, prefix+"pages_show_list", c.PagesShowList, other.PagesShowList)
+	changes = diffPointer(changes, prefix+"pages_messaging", c.PagesMessaging, other.PagesMessaging)
+	changes = diffPointer(changes, prefix+"pages_messaging_phone_number", c.PagesMessagingPhoneNumber, other.PagesMessagingPhoneNumber)
+	changes = diffPointer(changes, prefix+"pages_messaging_subscriptions", c.PagesMessagingSubscriptions, other.PagesMessagingSubscriptions)
+	changes = diffPointer(changes, prefix+"publish_pages", c.PublishPages, other.PublishPages)
+	changes = diffPointer(changes, prefix+"publish_video", c.PublishVideo, other.PublishVideo)
+	changes = diffPointer(changes, prefix+"read_page_mailboxes", c.ReadPageMailboxes, other.ReadPageMailboxes)
+	changes = diffPointer(changes, prefix+"read_stream", c.ReadStream, other.ReadStream)
+	changes = diffPointer(changes, prefix+"user_groups", c.UserGroups, other.UserGroups)
+	changes = diffPointer(changes, prefix+"user_managed_groups", c.UserManagedGroups, other.UserManagedGroups)
+	changes = diffPointer(changes, prefix+"user_status", c.UserStatus, other.UserStatus)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsFacebook) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsFacebook)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAdminOrg returns the AdminOrg field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetAdminOrg() bool {
+	if c == nil || c.AdminOrg == nil {
+		return false
+	}
+	return *c.AdminOrg
+}
+
+// GetAdminPublicKey returns the AdminPublicKey field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetAdminPublicKey() bool {
+	if c == nil || c.AdminPublicKey == nil {
+		return false
+	}
+	return *c.AdminPublicKey
+}
+
+// GetAdminRepoHook returns the AdminRepoHook field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetAdminRepoHook() bool {
+	if c == nil || c.AdminRepoHook == nil {
+		return false
+	}
+	return *c.AdminRepoHook
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetDeleteRepo returns the DeleteRepo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetDeleteRepo() bool {
+	if c == nil || c.DeleteRepo == nil {
+		return false
+	}
+	return *c.DeleteRepo
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetEmail() bool {
+	if c == nil || c.Email == nil {
+		return false
+	}
+	return *c.Email
+}
+
+// GetFollow returns the Follow field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetFollow() bool {
+	if c == nil || c.Follow == nil {
+		return false
+	}
+	return *c.Follow
+}
+
+// GetGist returns the Gist field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetGist() bool {
+	if c == nil || c.Gist == nil {
+		return false
+	}
+	return *c.Gist
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetNotifications returns the Notifications field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetNotifications() bool {
+	if c == nil || c.Notifications == nil {
+		return false
+	}
+	return *c.Notifications
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetProfile() bool {
+	if c == nil || c.Profile == nil {
+		return false
+	}
+	return *c.Profile
+}
+
+// GetPublicRepo returns the PublicRepo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetPublicRepo() bool {
+	if c == nil || c.PublicRepo == nil {
+		return false
+	}
+	return *c.PublicRepo
+}
+
+// GetReadOrg returns the ReadOrg field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetReadOrg() bool {
+	if c == nil || c.ReadOrg == nil {
+		return false
+	}
+	return *c.ReadOrg
+}
+
+// GetReadPublicKey returns the ReadPublicKey field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetReadPublicKey() bool {
+	if c == nil || c.ReadPublicKey == nil {
+		return false
+	}
+	return *c.ReadPublicKey
+}
+
+// GetReadRepoHook returns the ReadRepoHook field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetReadRepoHook() bool {
+	if c == nil || c.ReadRepoHook == nil {
+		return false
+	}
+	return *c.ReadRepoHook
+}
+
+// GetReadUser returns the ReadUser field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetReadUser() bool {
+	if c == nil || c.ReadUser == nil {
+		return false
+	}
+	return *c.ReadUser
+}
+
+// GetRepo returns the Repo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetRepo() bool {
+	if c == nil || c.Repo == nil {
+		return false
+	}
+	return *c.Repo
+}
+
+// GetRepoDeployment returns the RepoDeployment field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetRepoDeployment() bool {
+	if c == nil || c.RepoDeployment == nil {
+		return false
+	}
+	return *c.RepoDeployment
+}
+
+// GetRepoStatus returns the RepoStatus field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetRepoStatus() bool {
+	if c == nil || c.RepoStatus == nil {
+		return false
+	}
+	return *c.RepoStatus
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetWriteOrg returns the WriteOrg field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetWriteOrg() bool {
+	if c == nil || c.WriteOrg == nil {
+		return false
+	}
+	return *c.WriteOrg
+}
+
+// GetWritePublicKey returns the WritePublicKey field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetWritePublicKey() bool {
+	if c == nil || c.WritePublicKey == nil {
+		return false
+	}
+	return *c.WritePublicKey
+}
+
+// GetWriteRepoHook returns the WriteRepoHook field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGitHub) GetWriteRepoHook() bool {
+	if c == nil || c.WriteRepoHook == nil {
+		return false
+	}
+	return *c.WriteRepoHook
+}
+
+// String returns a string representation of ConnectionOptionsGitHub.
+func (c *ConnectionOptionsGitHub) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsGitHub.
+func (c *ConnectionOptionsGitHub) Clone() *ConnectionOptionsGitHub {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsGitHub{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.Email = clonePointer(c.Email)
+	clone.ReadUser = clonePointer(c.ReadUser)
+	clone.Follow = clonePointer(c.Follow)
+	clone.PublicRepo = clonePointer(c.PublicRepo)
+	clone.Repo = clonePointer(c.Repo)
+	clone.RepoDeployment = clonePointer(c.RepoDeployment)
+	clone.RepoStatus = clonePointer(c.RepoStatus)
+	clone.DeleteRepo = clonePointer(c.DeleteRepo)
+	clone.Notifications = clonePointer(c.Notifications)
+	clone.Gist = clonePointer(c.Gist)
+	clone.ReadRepoHook = clonePointer(c.ReadRepoHook)
+	clone.WriteRepoHook = clonePointer(c.WriteRepoHook)
+	clone.AdminRepoHook = clonePointer(c.AdminRepoHook)
+	clone.ReadOrg = clonePointer(c.ReadOrg)
+	clone.AdminOrg = clonePointer(c.AdminOrg)
+	clone.ReadPublicKey = clonePointer(c.ReadPublicKey)
+	clone.WritePublicKey = clonePointer(c.WritePublicKey)
+	clone.AdminPublicKey = clonePointer(c.AdminPublicKey)
+	clone.WriteOrg = clonePointer(c.WriteOrg)
+	clone.Profile = clonePointer(c.Profile)
+	clone.Scope = cloneSliceFunc(c.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsGitHub) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsGitHub and other hold the same values.
+func (c *ConnectionOptionsGitHub) Equal(other *ConnectionOptionsGitHub) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsGitHub and other.
+func (c *ConnectionOptionsGitHub) Diff(other *ConnectionOptionsGitHub) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsGitHub) diff(prefix string, other *ConnectionOptionsGitHub) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsGitHub{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsGitHub{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"email", c.Email, other.Email)
+	changes = diffPointer(changes, prefix+"read_user", c.ReadUser, other.ReadUser)
+	changes = diffPointer(changes, prefix+"follow", c.Follow, other.Follow)
+	changes = diffPointer(changes, prefix+"public_repo", c.PublicRepo, other.PublicRepo)
+	changes = diffPointer(changes, prefix+"repo", c.Repo, other.Repo)
+	changes = diffPointer(changes, prefix+"repo_deployment", c.RepoDeployment, other.RepoDeployment)
+	changes = diffPointer(changes, prefix+"repo_status", c.RepoStatus, other.RepoStatus)
+	changes = diffPointer(changes, prefix+"delete_repo", c.DeleteRepo, other.DeleteRepo)
+	changes = diffPointer(changes, prefix+"notifications", c.Notifications, other.Notifications)
+	changes = diffPointer(changes, prefix+"gist", c.Gist, other.Gist)
+	changes = diffPointer(changes, prefix+"read_repo_hook", c.ReadRepoHook, other.ReadRepoHook)
+	changes = diffPointer(changes, prefix+"write_repo_hook", c.WriteRepoHook, other.WriteRepoHook)
+	changes = diffPointer(changes, prefix+"admin_repo_hook", c.AdminRepoHook, other.AdminRepoHook)
+	changes = diffPointer(changes, prefix+"read_org", c.ReadOrg, other.ReadOrg)
+	changes = diffPointer(changes, prefix+"admin_org", c.AdminOrg, other.AdminOrg)
+	changes = diffPointer(changes, prefix+"read_public_key", c.ReadPublicKey, other.ReadPublicKey)
+	changes = diffPointer(changes, prefix+"write_public_key", c.WritePublicKey, other.WritePublicKey)
+	changes = diffPointer(changes, prefix+"admin_public_key", c.AdminPublicKey, other.AdminPublicKey)
+	changes = diffPointer(changes, prefix+"write_org", c.WriteOrg, other.WriteOrg)
+	changes = diffPointer(changes, prefix+"profile", c.Profile, other.Profile)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsGitHub) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsGitHub)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAdmin returns the Admin field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetAdmin() bool {
+	if c == nil || c.Admin == nil {
+		return false
+	}
+	return *c.Admin
+}
+
+// GetAgreedTerms returns the AgreedTerms field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetAgreedTerms() bool {
+	if c == nil || c.AgreedTerms == nil {
+		return false
+	}
+	return *c.AgreedTerms
+}
+
+// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetBasicProfile() bool {
+	if c == nil || c.BasicProfile == nil {
+		return false
+	}
+	return *c.BasicProfile
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetDomain() string {
+	if c == nil || c.Domain == nil {
+		return ""
+	}
+	return *c.Domain
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetEnableUsersAPI returns the EnableUsersAPI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetEnableUsersAPI() bool {
+	if c == nil || c.EnableUsersAPI == nil {
+		return false
+	}
+	return *c.EnableUsersAPI
+}
+
+// GetExtendedProfile returns the ExtendedProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetExtendedProfile() bool {
+	if c == nil || c.ExtendedProfile == nil {
+		return false
+	}
+	return *c.ExtendedProfile
+}
+
+// GetGroups returns the Groups field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetGroups() bool {
+	if c == nil || c.Groups == nil {
+		return false
+	}
+	return *c.Groups
+}
+
+// GetIsSuspended returns the IsSuspended field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetIsSuspended() bool {
+	if c == nil || c.IsSuspended == nil {
+		return false
+	}
+	return *c.IsSuspended
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleApps) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
+	}
+	return *c.TenantDomain
+}
+
+// String returns a string representation of ConnectionOptionsGoogleApps.
+func (c *ConnectionOptionsGoogleApps) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsGoogleApps.
+func (c *ConnectionOptionsGoogleApps) Clone() *ConnectionOptionsGoogleApps {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsGoogleApps{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.Domain = clonePointer(c.Domain)
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.EnableUsersAPI = clonePointer(c.EnableUsersAPI)
+	clone.BasicProfile = clonePointer(c.BasicProfile)
+	clone.ExtendedProfile = clonePointer(c.ExtendedProfile)
+	clone.Groups = clonePointer(c.Groups)
+	clone.Admin = clonePointer(c.Admin)
+	clone.IsSuspended = clonePointer(c.IsSuspended)
+	clone.AgreedTerms = clonePointer(c.AgreedTerms)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsGoogleApps) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsGoogleApps and other hold the same values.
+func (c *ConnectionOptionsGoogleApps) Equal(other *ConnectionOptionsGoogleApps) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsGoogleApps and other.
+func (c *ConnectionOptionsGoogleApps) Diff(other *ConnectionOptionsGoogleApps) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsGoogleApps) diff(prefix string, other *ConnectionOptionsGoogleApps) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsGoogleApps{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsGoogleApps{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"domain", c.Domain, other.Domain)
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffPointer(changes, prefix+"api_enable_users", c.EnableUsersAPI, other.EnableUsersAPI)
+	changes = diffPointer(changes, prefix+"basic_profile", c.BasicProfile, other.BasicProfile)
+	changes = diffPointer(changes, prefix+"ext_profile", c.ExtendedProfile, other.ExtendedProfile)
+	changes = diffPointer(changes, prefix+"ext_groups", c.Groups, other.Groups)
+	changes = diffPointer(changes, prefix+"ext_is_admin", c.Admin, other.Admin)
+	changes = diffPointer(changes, prefix+"ext_is_suspended", c.IsSuspended, other.IsSuspended)
+	changes = diffPointer(changes, prefix+"ext_agreed_terms", c.AgreedTerms, other.AgreedTerms)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsGoogleApps) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsGoogleApps)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAdsenseManagement returns the AdsenseManagement field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetAdsenseManagement() bool {
+	if c == nil || c.AdsenseManagement == nil {
+		return false
+	}
+	return *c.AdsenseManagement
+}
+
+// GetAllowedAudiences returns the AllowedAudiences field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetAllowedAudiences() []string {
+	if c == nil || c.AllowedAudiences == nil {
+		return nil
+	}
+	return *c.AllowedAudiences
+}
+
+// GetAnalytics returns the Analytics field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetAnalytics() bool {
+	if c == nil || c.Analytics == nil {
+		return false
+	}
+	return *c.Analytics
+}
+
+// GetBlogger returns the Blogger field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetBlogger() bool {
+	if c == nil || c.Blogger == nil {
+		return false
+	}
+	return *c.Blogger
+}
+
+// GetCalendar returns the Calendar field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetCalendar() bool {
+	if c == nil || c.Calendar == nil {
+		return false
+	}
+	return *c.Calendar
+}
+
+// GetChromeWebStore returns the ChromeWebStore field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetChromeWebStore() bool {
+	if c == nil || c.ChromeWebStore == nil {
+		return false
+	}
+	return *c.ChromeWebStore
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetContacts returns the Contacts field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetContacts() bool {
+	if c == nil || c.Contacts == nil {
+		return false
+	}
+	return *c.Contacts
+}
+
+// GetContentAPIForShopping returns the ContentAPIForShopping field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetContentAPIForShopping() bool {
+	if c == nil || c.ContentAPIForShopping == nil {
+		return false
+	}
+	return *c.ContentAPIForShopping
+}
+
+// GetCoordinate returns the Coordinate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetCoordinate() bool {
+	if c == nil || c.Coordinate == nil {
+		return false
+	}
+	return *c.Coordinate
+}
+
+// GetCoordinateReadonly returns the CoordinateReadonly field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetCoordinateReadonly() bool {
+	if c == nil || c.CoordinateReadonly == nil {
+		return false
+	}
+	return *c.CoordinateReadonly
+}
+
+// GetDocumentList returns the DocumentList field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetDocumentList() bool {
+	if c == nil || c.DocumentList == nil {
+		return false
+	}
+	return *c.DocumentList
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetEmail() bool {
+	if c == nil || c.Email == nil {
+		return false
+	}
+	return *c.Email
+}
+
+// GetGmail returns the Gmail field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGmail() bool {
+	if c == nil || c.Gmail == nil {
+		return false
+	}
+	return *c.Gmail
+}
+
+// GetGoogleAffiliateNetwork returns the GoogleAffiliateNetwork field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGoogleAffiliateNetwork() bool {
+	if c == nil || c.GoogleAffiliateNetwork == nil {
+		return false
+	}
+	return *c.GoogleAffiliateNetwork
+}
+
+// GetGoogleBooks returns the GoogleBooks field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGoogleBooks() bool {
+	if c == nil || c.GoogleBooks == nil {
+		return false
+	}
+	return *c.GoogleBooks
+}
+
+// GetGoogleCloudStorage returns the GoogleCloudStorage field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGoogleCloudStorage() bool {
+	if c == nil || c.GoogleCloudStorage == nil {
+		return false
+	}
+	return *c.GoogleCloudStorage
+}
+
+// GetGoogleDrive returns the GoogleDrive field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGoogleDrive() bool {
+	if c == nil || c.GoogleDrive == nil {
+		return false
+	}
+	return *c.GoogleDrive
+}
+
+// GetGoogleDriveFiles returns the GoogleDriveFiles field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGoogleDriveFiles() bool {
+	if c == nil || c.GoogleDriveFiles == nil {
+		return false
+	}
+	return *c.GoogleDriveFiles
+}
+
+// GetGooglePlus returns the GooglePlus field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetGooglePlus() bool {
+	if c == nil || c.GooglePlus == nil {
+		return false
+	}
+	return *c.GooglePlus
+}
+
+// GetLatitudeBest returns the LatitudeBest field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetLatitudeBest() bool {
+	if c == nil || c.LatitudeBest == nil {
+		return false
+	}
+	return *c.LatitudeBest
+}
+
+// GetLatitudeCity returns the LatitudeCity field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetLatitudeCity() bool {
+	if c == nil || c.LatitudeCity == nil {
+		return false
+	}
+	return *c.LatitudeCity
+}
+
+// GetModerator returns the Moderator field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetModerator() bool {
+	if c == nil || c.Moderator == nil {
+		return false
+	}
+	return *c.Moderator
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetOrkut returns the Orkut field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetOrkut() bool {
+	if c == nil || c.Orkut == nil {
+		return false
+	}
+	return *c.Orkut
+}
+
+// GetPicasaWeb returns the PicasaWeb field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetPicasaWeb() bool {
+	if c == nil || c.PicasaWeb == nil {
+		return false
+	}
+	return *c.PicasaWeb
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetProfile() bool {
+	if c == nil || c.Profile == nil {
+		return false
+	}
+	return *c.Profile
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetSites returns the Sites field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetSites() bool {
+	if c == nil || c.Sites == nil {
+		return false
+	}
+	return *c.Sites
+}
+
+// GetSpreadsheets returns the Spreadsheets field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetSpreadsheets() bool {
+	if c == nil || c.Spreadsheets == nil {
+		return false
+	}
+	return *c.Spreadsheets
+}
+
+// GetTasks returns the Tasks field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetTasks() bool {
+	if c == nil || c.Tasks == nil {
+		return false
+	}
+	return *c.Tasks
+}
+
+// GetURLShortener returns the URLShortener field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetURLShortener() bool {
+	if c == nil || c.URLShortener == nil {
+		return false
+	}
+	return *c.URLShortener
+}
+
+// GetWebmasterTools returns the WebmasterTools field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetWebmasterTools() bool {
+	if c == nil || c.WebmasterTools == nil {
+		return false
+	}
+	return *c.WebmasterTools
+}
+
+// GetYoutube returns the Youtube field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsGoogleOAuth2) GetYoutube() bool {
+	if c == nil || c.Youtube == nil {
+		return false
+	}
+	return *c.Youtube
+}
+
+// String returns a string representation of ConnectionOptionsGoogleOAuth2.
+func (c *ConnectionOptionsGoogleOAuth2) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsGoogleOAuth2.
+func (c *ConnectionOptionsGoogleOAuth2) Clone() *ConnectionOptionsGoogleOAuth2 {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsGoogleOAuth2{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.AllowedAudiences = clonePointerFunc(c.AllowedAudiences, func(v []string) []string { return cloneSlice(v) })
+	clone.Email = clonePointer(c.Email)
+	clone.Profile = clonePointer(c.Profile)
+	clone.Contacts = clonePointer(c.Contacts)
+	clone.Blogger = clonePointer(c.Blogger)
+	clone.Calendar = clonePointer(c.Calendar)
+	clone.Gmail = clonePointer(c.Gmail)
+	clone.GooglePlus = clonePointer(c.GooglePlus)
+	clone.Orkut = clonePointer(c.Orkut)
+	clone.PicasaWeb = clonePointer(c.PicasaWeb)
+	clone.Tasks = clonePointer(c.Tasks)
+	clone.Youtube = clonePointer(c.Youtube)
+	clone.AdsenseManagement = clonePointer(c.AdsenseManagement)
+	clone.GoogleAffiliateNetwork = clonePointer(c.GoogleAffiliateNetwork)
+	clone.Analytics = clonePointer(c.Analytics)
+	clone.GoogleBooks = clonePointer(c.GoogleBooks)
+	clone.GoogleCloudStorage = clonePointer(c.GoogleCloudStorage)
+	clone.ContentAPIForShopping = clonePointer(c.ContentAPIForShopping)
+	clone.ChromeWebStore = clonePointer(c.ChromeWebStore)
+	clone.DocumentList = clonePointer(c.DocumentList)
+	clone.GoogleDrive = clonePointer(c.GoogleDrive)
+	clone.GoogleDriveFiles = clonePointer(c.GoogleDriveFiles)
+	clone.LatitudeBest = clonePointer(c.LatitudeBest)
+	clone.LatitudeCity = clonePointer(c.LatitudeCity)
+	clone.Moderator = clonePointer(c.Moderator)
+	clone.Sites = clonePointer(c.Sites)
+	clone.Spreadsheets = clonePointer(c.Spreadsheets)
+	clone.URLShortener = clonePointer(c.URLShortener)
+	clone.WebmasterTools = clonePointer(c.WebmasterTools)
+	clone.Coordinate = clonePointer(c.Coordinate)
+	clone.CoordinateReadonly = clonePointer(c.CoordinateReadonly)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.Scope = cloneSliceFunc(c.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsGoogleOAuth2) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsGoogleOAuth2 and other hold the same values.
+func (c *ConnectionOptionsGoogleOAuth2) Equal(other *ConnectionOptionsGoogleOAuth2) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsGoogleOAuth2 and other.
+func (c *ConnectionOptionsGoogleOAuth2) Diff(other *ConnectionOptionsGoogleOAuth2) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsGoogleOAuth2) diff(prefix string, other *ConnectionOptionsGoogleOAuth2) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsGoogleOAuth2{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsGoogleOAuth2{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffSlicePointer(changes, prefix+"allowed_audiences", c.AllowedAudiences, other.AllowedAudiences)
+	changes = diffPointer(changes, prefix+"email", c.Email, other.Email)
+	changes = diffPointer(changes, prefix+"profile", c.Profile, other.Profile)
+	changes = diffPointer(changes, prefix+"contacts", c.Contacts, other.Contacts)
+	changes = diffPointer(changes, prefix+"blogger", c.Blogger, other.Blogger)
+	changes = diffPointer(changes, prefix+"calendar", c.Calendar, other.Calendar)
+	changes = diffPointer(changes, prefix+"gmail", c.Gmail, other.Gmail)
+	changes = diffPointer(changes, prefix+"google_plus", c.GooglePlus, other.GooglePlus)
+	changes = diffPointer(changes, prefix+"orkut", c.Orkut, other.Orkut)
+	changes = diffPointer(changes, prefix+"picasa_web", c.PicasaWeb, other.PicasaWeb)
+	changes = diffPointer(changes, prefix+"tasks", c.Tasks, other.Tasks)
+	changes = diffPointer(changes, prefix+"youtube", c.Youtube, other.Youtube)
+	changes = diffPointer(changes, prefix+"adsense_management", c.AdsenseManagement, other.AdsenseManagement)
+	changes = diffPointer(changes, prefix+"google_affiliate_network", c.GoogleAffiliateNetwork, other.GoogleAffiliateNetwork)
+	changes = diffPointer(changes, prefix+"analytics", c.Analytics, other.Analytics)
+	changes = diffPointer(changes, prefix+"google_books", c.GoogleBooks, other.GoogleBooks)
+	changes = diffPointer(changes, prefix+"google_cloud_storage", c.GoogleCloudStorage, other.GoogleCloudStorage)
+	changes = diffPointer(changes, prefix+"content_api_for_shopping", c.ContentAPIForShopping, other.ContentAPIForShopping)
+	changes = diffPointer(changes, prefix+"chrome_web_store", c.ChromeWebStore, other.ChromeWebStore)
+	changes = diffPointer(changes, prefix+"document_list", c.DocumentList, other.DocumentList)
+	changes = diffPointer(changes, prefix+"google_drive", c.GoogleDrive, other.GoogleDrive)
+	changes = diffPointer(changes, prefix+"google_drive_files", c.GoogleDriveFiles, other.GoogleDriveFiles)
+	changes = diffPointer(changes, prefix+"latitude_best", c.LatitudeBest, other.LatitudeBest)
+	changes = diffPointer(changes, prefix+"latitude_city", c.LatitudeCity, other.LatitudeCity)
+	changes = diffPointer(changes, prefix+"moderator", c.Moderator, other.Moderator)
+	changes = diffPointer(changes, prefix+"sites", c.Sites, other.Sites)
+	changes = diffPointer(changes, prefix+"spreadsheets", c.Spreadsheets, other.Spreadsheets)
+	changes = diffPointer(changes, prefix+"url_shortener", c.URLShortener, other.URLShortener)
+	changes = diffPointer(changes, prefix+"webmaster_tools", c.WebmasterTools, other.WebmasterTools)
+	changes = diffPointer(changes, prefix+"coordinate", c.Coordinate, other.Coordinate)
+	changes = diffPointer(changes, prefix+"coordinate_readonly", c.CoordinateReadonly, other.CoordinateReadonly)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsGoogleOAuth2) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsGoogleOAuth2)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetBasicProfile() bool {
+	if c == nil || c.BasicProfile == nil {
+		return false
+	}
+	return *c.BasicProfile
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetEmail() bool {
+	if c == nil || c.Email == nil {
+		return false
+	}
+	return *c.Email
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetProfile() bool {
+	if c == nil || c.Profile == nil {
+		return false
+	}
+	return *c.Profile
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsLinkedin) GetStrategyVersion() int {
+	if c == nil || c.StrategyVersion == nil {
+		return 0
+	}
+	return *c.StrategyVersion
+}
+
+// String returns a string representation of ConnectionOptionsLinkedin.
+func (c *ConnectionOptionsLinkedin) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsLinkedin.
+func (c *ConnectionOptionsLinkedin) Clone() *ConnectionOptionsLinkedin {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsLinkedin{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.StrategyVersion = clonePointer(c.StrategyVersion)
+	clone.Email = clonePointer(c.Email)
+	clone.Profile = clonePointer(c.Profile)
+	clone.BasicProfile = clonePointer(c.BasicProfile)
+	clone.Scope = cloneSliceFunc(c.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsLinkedin) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsLinkedin and other hold the same values.
+func (c *ConnectionOptionsLinkedin) Equal(other *ConnectionOptionsLinkedin) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsLinkedin and other.
+func (c *ConnectionOptionsLinkedin) Diff(other *ConnectionOptionsLinkedin) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsLinkedin) diff(prefix string, other *ConnectionOptionsLinkedin) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsLinkedin{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsLinkedin{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"strategy_version", c.StrategyVersion, other.StrategyVersion)
+	changes = diffPointer(changes, prefix+"email", c.Email, other.Email)
+	changes = diffPointer(changes, prefix+"profile", c.Profile, other.Profile)
+	changes = diffPointer(changes, prefix+"basic_profile", c.BasicProfile, other.BasicProfile)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsLinkedin) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsLinkedin)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetActive returns the Active field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsMFA) GetActive() bool {
+	if c == nil || c.Active == nil {
+		return false
+	}
+	return *c.Active
+}
+
+// GetReturnEnrollSettings returns the ReturnEnrollSettings field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsMFA) GetReturnEnrollSettings() bool {
+	if c == nil || c.ReturnEnrollSettings == nil {
+		return false
+	}
+	return *c.ReturnEnrollSettings
+}
+
+// String returns a string representation of ConnectionOptionsMFA.
+func (c *ConnectionOptionsMFA) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsMFA.
+func (c *ConnectionOptionsMFA) Clone() *ConnectionOptionsMFA {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsMFA{}
+	clone.Active = clonePointer(c.Active)
+	clone.ReturnEnrollSettings = clonePointer(c.ReturnEnrollSettings)
+	return clone
+}
+
+func (c *ConnectionOptionsMFA) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsMFA and other hold the same values.
+func (c *ConnectionOptionsMFA) Equal(other *ConnectionOptionsMFA) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsMFA and other.
+func (c *ConnectionOptionsMFA) Diff(other *ConnectionOptionsMFA) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsMFA) diff(prefix string, other *ConnectionOptionsMFA) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsMFA{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsMFA{}
+	}
+	changes = diffPointer(changes, prefix+"active", c.Active, other.Active)
+	changes = diffPointer(changes, prefix+"return_enroll_settings", c.ReturnEnrollSettings, other.ReturnEnrollSettings)
+	return changes
+}
+
+func (c *ConnectionOptionsMFA) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsMFA)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAuthorizationURL returns the AuthorizationURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetAuthorizationURL() string {
+	if c == nil || c.AuthorizationURL == nil {
+		return ""
+	}
+	return *c.AuthorizationURL
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetPKCEEnabled returns the PKCEEnabled field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetPKCEEnabled() bool {
+	if c == nil || c.PKCEEnabled == nil {
+		return false
+	}
+	return *c.PKCEEnabled
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// GetScripts returns the Scripts field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetScripts() map[string]string {
+	if c == nil || c.Scripts == nil {
+		return map[string]string{}
+	}
+	return *c.Scripts
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTokenURL returns the TokenURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOAuth2) GetTokenURL() string {
+	if c == nil || c.TokenURL == nil {
+		return ""
+	}
+	return *c.TokenURL
+}
+
+// String returns a string representation of ConnectionOptionsOAuth2.
+func (c *ConnectionOptionsOAuth2) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsOAuth2.
+func (c *ConnectionOptionsOAuth2) Clone() *ConnectionOptionsOAuth2 {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsOAuth2{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.AuthorizationURL = clonePointer(c.AuthorizationURL)
+	clone.TokenURL = clonePointer(c.TokenURL)
+	clone.Scope = clonePointer(c.Scope)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.PKCEEnabled = clonePointer(c.PKCEEnabled)
+	clone.Scripts = clonePointerFunc(c.Scripts, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsOAuth2) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsOAuth2 and other hold the same values.
+func (c *ConnectionOptionsOAuth2) Equal(other *ConnectionOptionsOAuth2) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsOAuth2 and other.
+func (c *ConnectionOptionsOAuth2) Diff(other *ConnectionOptionsOAuth2) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsOAuth2) diff(prefix string, other *ConnectionOptionsOAuth2) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsOAuth2{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsOAuth2{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"authorizationURL", c.AuthorizationURL, other.AuthorizationURL)
+	changes = diffPointer(changes, prefix+"tokenURL", c.TokenURL, other.TokenURL)
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"pkce_enabled", c.PKCEEnabled, other.PKCEEnabled)
+	changes = diffMapPointer(changes, prefix+"scripts", c.Scripts, other.Scripts)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsOAuth2) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsOAuth2)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetAuthorizationEndpoint() string {
+	if c == nil || c.AuthorizationEndpoint == nil {
+		return ""
+	}
+	return *c.AuthorizationEndpoint
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetDiscoveryURL returns the DiscoveryURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetDiscoveryURL() string {
+	if c == nil || c.DiscoveryURL == nil {
+		return ""
+	}
+	return *c.DiscoveryURL
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetIssuer() string {
+	if c == nil || c.Issuer == nil {
+		return ""
+	}
+	return *c.Issuer
+}
+
+// GetJWKSURI returns the JWKSURI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetJWKSURI() string {
+	if c == nil || c.JWKSURI == nil {
+		return ""
+	}
+	return *c.JWKSURI
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
+	}
+	return *c.TenantDomain
+}
+
+// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetTokenEndpoint() string {
+	if c == nil || c.TokenEndpoint == nil {
+		return ""
+	}
+	return *c.TokenEndpoint
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetUserInfoEndpoint returns the UserInfoEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOIDC) GetUserInfoEndpoint() string {
+	if c == nil || c.UserInfoEndpoint == nil {
+		return ""
+	}
+	return *c.UserInfoEndpoint
+}
+
+// String returns a string representation of ConnectionOptionsOIDC.
+func (c *ConnectionOptionsOIDC) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsOIDC.
+func (c *ConnectionOptionsOIDC) Clone() *ConnectionOptionsOIDC {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsOIDC{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.DiscoveryURL = clonePointer(c.DiscoveryURL)
+	clone.AuthorizationEndpoint = clonePointer(c.AuthorizationEndpoint)
+	clone.Issuer = clonePointer(c.Issuer)
+	clone.JWKSURI = clonePointer(c.JWKSURI)
+	clone.Type = clonePointer(c.Type)
+	clone.UserInfoEndpoint = clonePointer(c.UserInfoEndpoint)
+	clone.TokenEndpoint = clonePointer(c.TokenEndpoint)
+	clone.Scope = clonePointer(c.Scope)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsOIDC) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsOIDC and other hold the same values.
+func (c *ConnectionOptionsOIDC) Equal(other *ConnectionOptionsOIDC) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsOIDC and other.
+func (c *ConnectionOptionsOIDC) Diff(other *ConnectionOptionsOIDC) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsOIDC) diff(prefix string, other *ConnectionOptionsOIDC) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsOIDC{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsOIDC{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"discovery_url", c.DiscoveryURL, other.DiscoveryURL)
+	changes = diffPointer(changes, prefix+"authorization_endpoint", c.AuthorizationEndpoint, other.AuthorizationEndpoint)
+	changes = diffPointer(changes, prefix+"issuer", c.Issuer, other.Issuer)
+	changes = diffPointer(changes, prefix+"jwks_uri", c.JWKSURI, other.JWKSURI)
+	changes = diffPointer(changes, prefix+"type", c.Type, other.Type)
+	changes = diffPointer(changes, prefix+"userinfo_endpoint", c.UserInfoEndpoint, other.UserInfoEndpoint)
+	changes = diffPointer(changes, prefix+"token_endpoint", c.TokenEndpoint, other.TokenEndpoint)
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsOIDC) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsOIDC)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetAuthorizationEndpoint() string {
+	if c == nil || c.AuthorizationEndpoint == nil {
+		return ""
+	}
+	return *c.AuthorizationEndpoint
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetDomain() string {
+	if c == nil || c.Domain == nil {
+		return ""
+	}
+	return *c.Domain
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetIssuer() string {
+	if c == nil || c.Issuer == nil {
+		return ""
+	}
+	return *c.Issuer
+}
+
+// GetJWKSURI returns the JWKSURI field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetJWKSURI() string {
+	if c == nil || c.JWKSURI == nil {
+		return ""
+	}
+	return *c.JWKSURI
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetScope() string {
+	if c == nil || c.Scope == nil {
+		return ""
+	}
+	return *c.Scope
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetTokenEndpoint() string {
+	if c == nil || c.TokenEndpoint == nil {
+		return ""
+	}
+	return *c.TokenEndpoint
+}
+
+// GetUserInfoEndpoint returns the UserInfoEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOkta) GetUserInfoEndpoint() string {
+	if c == nil || c.UserInfoEndpoint == nil {
+		return ""
+	}
+	return *c.UserInfoEndpoint
+}
+
+// String returns a string representation of ConnectionOptionsOkta.
+func (c *ConnectionOptionsOkta) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsOkta.
+func (c *ConnectionOptionsOkta) Clone() *ConnectionOptionsOkta {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsOkta{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.Domain = clonePointer(c.Domain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.AuthorizationEndpoint = clonePointer(c.AuthorizationEndpoint)
+	clone.Issuer = clonePointer(c.Issuer)
+	clone.JWKSURI = clonePointer(c.JWKSURI)
+	clone.UserInfoEndpoint = clonePointer(c.UserInfoEndpoint)
+	clone.TokenEndpoint = clonePointer(c.TokenEndpoint)
+	clone.Scope = clonePointer(c.Scope)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsOkta) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsOkta and other hold the same values.
+func (c *ConnectionOptionsOkta) Equal(other *ConnectionOptionsOkta) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsOkta and other.
+func (c *ConnectionOptionsOkta) Diff(other *ConnectionOptionsOkta) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsOkta) diff(prefix string, other *ConnectionOptionsOkta) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsOkta{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsOkta{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"domain", c.Domain, other.Domain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"authorization_endpoint", c.AuthorizationEndpoint, other.AuthorizationEndpoint)
+	changes = diffPointer(changes, prefix+"issuer", c.Issuer, other.Issuer)
+	changes = diffPointer(changes, prefix+"jwks_uri", c.JWKSURI, other.JWKSURI)
+	changes = diffPointer(changes, prefix+"userinfo_endpoint", c.UserInfoEndpoint, other.UserInfoEndpoint)
+	changes = diffPointer(changes, prefix+"token_endpoint", c.TokenEndpoint, other.TokenEndpoint)
+	changes = diffPointer(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsOkta) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsOkta)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetLength returns the Length field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOTP) GetLength() int {
+	if c == nil || c.Length == nil {
+		return 0
+	}
+	return *c.Length
+}
+
+// GetTimeStep returns the TimeStep field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsOTP) GetTimeStep() int {
+	if c == nil || c.TimeStep == nil {
+		return 0
+	}
+	return *c.TimeStep
+}
+
+// String returns a string representation of ConnectionOptionsOTP.
+func (c *ConnectionOptionsOTP) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsOTP.
+func (c *ConnectionOptionsOTP) Clone() *ConnectionOptionsOTP {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsOTP{}
+	clone.TimeStep = clonePointer(c.TimeStep)
+	clone.Length = clonePointer(c.Length)
+	return clone
+}
+
+func (c *ConnectionOptionsOTP) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsOTP and other hold the same values.
+func (c *ConnectionOptionsOTP) Equal(other *ConnectionOptionsOTP) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsOTP and other.
+func (c *ConnectionOptionsOTP) Diff(other *ConnectionOptionsOTP) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsOTP) diff(prefix string, other *ConnectionOptionsOTP) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsOTP{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsOTP{}
+	}
+	changes = diffPointer(changes, prefix+"time_step", c.TimeStep, other.TimeStep)
+	changes = diffPointer(changes, prefix+"length", c.Length, other.Length)
+	return changes
+}
+
+func (c *ConnectionOptionsOTP) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsOTP)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetCert returns the Cert field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetCert() string {
+	if c == nil || c.Cert == nil {
+		return ""
+	}
+	return *c.Cert
+}
+
+// GetDigestAlgorithm returns the DigestAlgorithm field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetDigestAlgorithm() string {
+	if c == nil || c.DigestAlgorithm == nil {
+		return ""
+	}
+	return *c.DigestAlgorithm
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetIdpInitiated returns the IdpInitiated field.
+func (c *ConnectionOptionsPingFederate) GetIdpInitiated() *ConnectionOptionsSAMLIdpInitiated {
+	if c == nil {
+		return nil
+	}
+	return c.IdpInitiated
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetPingFederateBaseURL returns the PingFederateBaseURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetPingFederateBaseURL() string {
+	if c == nil || c.PingFederateBaseURL == nil {
+		return ""
+	}
+	return *c.PingFederateBaseURL
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetSignatureAlgorithm() string {
+	if c == nil || c.SignatureAlgorithm == nil {
+		return ""
+	}
+	return *c.SignatureAlgorithm
+}
+
+// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetSignInEndpoint() string {
+	if c == nil || c.SignInEndpoint == nil {
+		return ""
+	}
+	return *c.SignInEndpoint
+}
+
+// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetSigningCert() string {
+	if c == nil || c.SigningCert == nil {
+		return ""
+	}
+	return *c.SigningCert
+}
+
+// GetSignSAMLRequest returns the SignSAMLRequest field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetSignSAMLRequest() bool {
+	if c == nil || c.SignSAMLRequest == nil {
+		return false
+	}
+	return *c.SignSAMLRequest
+}
+
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsPingFederate) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
+	}
+	return *c.TenantDomain
+}
+
+// String returns a string representation of ConnectionOptionsPingFederate.
+func (c *ConnectionOptionsPingFederate) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsPingFederate.
+func (c *ConnectionOptionsPingFederate) Clone() *ConnectionOptionsPingFederate {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsPingFederate{}
+	clone.SigningCert = clonePointer(c.SigningCert)
+	clone.Cert = clonePointer(c.Cert)
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.IdpInitiated = c.IdpInitiated.Clone()
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.SignInEndpoint = clonePointer(c.SignInEndpoint)
+	clone.DigestAlgorithm = clonePointer(c.DigestAlgorithm)
+	clone.SignSAMLRequest = clonePointer(c.SignSAMLRequest)
+	clone.SignatureAlgorithm = clonePointer(c.SignatureAlgorithm)
+	clone.PingFederateBaseURL = clonePointer(c.PingFederateBaseURL)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	return clone
+}
+
+func (c *ConnectionOptionsPingFederate) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsPingFederate and other hold the same values.
+func (c *ConnectionOptionsPingFederate) Equal(other *ConnectionOptionsPingFederate) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsPingFederate and other.
+func (c *ConnectionOptionsPingFederate) Diff(other *ConnectionOptionsPingFederate) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsPingFederate) diff(prefix string, other *ConnectionOptionsPingFederate) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsPingFederate{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsPingFederate{}
+	}
+	changes = diffPointer(changes, prefix+"signingCert", c.SigningCert, other.SigningCert)
+	changes = diffPointer(changes, prefix+"cert", c.Cert, other.Cert)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = append(changes, c.IdpInitiated.diff(prefix+"idpinitiated.", other.IdpInitiated)...)
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"signInEndpoint", c.SignInEndpoint, other.SignInEndpoint)
+	changes = diffPointer(changes, prefix+"digestAlgorithm", c.DigestAlgorithm, other.DigestAlgorithm)
+	changes = diffPointer(changes, prefix+"signSAMLRequest", c.SignSAMLRequest, other.SignSAMLRequest)
+	changes = diffPointer(changes, prefix+"signatureAlgorithm", c.SignatureAlgorithm, other.SignatureAlgorithm)
+	changes = diffPointer(changes, prefix+"pingFederateBaseUrl", c.PingFederateBaseURL, other.PingFederateBaseURL)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	return changes
+}
+
+func (c *ConnectionOptionsPingFederate) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsPingFederate)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetCommunityBaseURL returns the CommunityBaseURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetCommunityBaseURL() string {
+	if c == nil || c.CommunityBaseURL == nil {
+		return ""
+	}
+	return *c.CommunityBaseURL
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetProfile() bool {
+	if c == nil || c.Profile == nil {
+		return false
+	}
+	return *c.Profile
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSalesforce) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// String returns a string representation of ConnectionOptionsSalesforce.
+func (c *ConnectionOptionsSalesforce) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsSalesforce.
+func (c *ConnectionOptionsSalesforce) Clone() *ConnectionOptionsSalesforce {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsSalesforce{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.Profile = clonePointer(c.Profile)
+	clone.Scope = cloneSliceFunc(c.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.CommunityBaseURL = clonePointer(c.CommunityBaseURL)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsSalesforce) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsSalesforce and other hold the same values.
+func (c *ConnectionOptionsSalesforce) Equal(other *ConnectionOptionsSalesforce) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsSalesforce and other.
+func (c *ConnectionOptionsSalesforce) Diff(other *ConnectionOptionsSalesforce) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsSalesforce) diff(prefix string, other *ConnectionOptionsSalesforce) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsSalesforce{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsSalesforce{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"profile", c.Profile, other.Profile)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"community_base_url", c.CommunityBaseURL, other.CommunityBaseURL)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsSalesforce) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsSalesforce)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetCert returns the Cert field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetCert() string {
+	if c == nil || c.Cert == nil {
+		return ""
+	}
+	return *c.Cert
+}
+
+// GetDebug returns the Debug field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetDebug() bool {
+	if c == nil || c.Debug == nil {
+		return false
+	}
+	return *c.Debug
+}
+
+// GetDigestAglorithm returns the DigestAglorithm field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetDigestAglorithm() string {
+	if c == nil || c.DigestAglorithm == nil {
+		return ""
+	}
+	return *c.DigestAglorithm
+}
+
+// GetDisableSignOut returns the DisableSignOut field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetDisableSignOut() bool {
+	if c == nil || c.DisableSignOut == nil {
+		return false
+	}
+	return *c.DisableSignOut
+}
+
+// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetDomainAliases() []string {
+	if c == nil || c.DomainAliases == nil {
+		return nil
+	}
+	return *c.DomainAliases
+}
+
+// GetEntityID returns the EntityID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetEntityID() string {
+	if c == nil || c.EntityID == nil {
+		return ""
+	}
+	return *c.EntityID
+}
+
+// GetExpires returns the Expires field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetExpires() string {
+	if c == nil || c.Expires == nil {
+		return ""
+	}
+	return *c.Expires
+}
+
+// GetIdpInitiated returns the IdpInitiated field.
+func (c *ConnectionOptionsSAML) GetIdpInitiated() *ConnectionOptionsSAMLIdpInitiated {
+	if c == nil {
+		return nil
+	}
+	return c.IdpInitiated
+}
+
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetLogoURL() string {
+	if c == nil || c.LogoURL == nil {
+		return ""
+	}
+	return *c.LogoURL
+}
+
+// GetMetadataURL returns the MetadataURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetMetadataURL() string {
+	if c == nil || c.MetadataURL == nil {
+		return ""
+	}
+	return *c.MetadataURL
+}
+
+// GetMetadataXML returns the MetadataXML field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetMetadataXML() string {
+	if c == nil || c.MetadataXML == nil {
+		return ""
+	}
+	return *c.MetadataXML
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetProtocolBinding returns the ProtocolBinding field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetProtocolBinding() string {
+	if c == nil || c.ProtocolBinding == nil {
+		return ""
+	}
+	return *c.ProtocolBinding
+}
+
+// GetRequestTemplate returns the RequestTemplate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetRequestTemplate() string {
+	if c == nil || c.RequestTemplate == nil {
+		return ""
+	}
+	return *c.RequestTemplate
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSignatureAlgorithm() string {
+	if c == nil || c.SignatureAlgorithm == nil {
+		return ""
+	}
+	return *c.SignatureAlgorithm
+}
+
+// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSignInEndpoint() string {
+	if c == nil || c.SignInEndpoint == nil {
+		return ""
+	}
+	return *c.SignInEndpoint
+}
+
+// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSigningCert() string {
+	if c == nil || c.SigningCert == nil {
+		return ""
+	}
+	return *c.SigningCert
+}
+
+// GetSigningKey returns the SigningKey field.
+func (c *ConnectionOptionsSAML) GetSigningKey() *ConnectionOptionsSAMLSigningKey {
+	if c == nil {
+		return nil
+	}
+	return c.SigningKey
+}
+
+// GetSignOutEndpoint returns the SignOutEndpoint field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSignOutEndpoint() string {
+	if c == nil || c.SignOutEndpoint == nil {
+		return ""
+	}
+	return *c.SignOutEndpoint
+}
+
+// GetSignSAMLRequest returns the SignSAMLRequest field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetSignSAMLRequest() bool {
+	if c == nil || c.SignSAMLRequest == nil {
+		return false
+	}
+	return *c.SignSAMLRequest
+}
+
+// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetTenantDomain() string {
+	if c == nil || c.TenantDomain == nil {
+		return ""
+	}
+	return *c.TenantDomain
+}
+
+// GetUserIDAttribute returns the UserIDAttribute field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAML) GetUserIDAttribute() string {
+	if c == nil || c.UserIDAttribute == nil {
+		return ""
+	}
+	return *c.UserIDAttribute
+}
+
+// String returns a string representation of ConnectionOptionsSAML.
+func (c *ConnectionOptionsSAML) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsSAML.
+func (c *ConnectionOptionsSAML) Clone() *ConnectionOptionsSAML {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsSAML{}
+	clone.Cert = clonePointer(c.Cert)
+	clone.Debug = clonePointer(c.Debug)
+	clone.Expires = clonePointer(c.Expires)
+	clone.IdpInitiated = c.IdpInitiated.Clone()
+	clone.SigningKey = c.SigningKey.Clone()
+	clone.SigningCert = clonePointer(c.SigningCert)
+	clone.Thumbprints = cloneSliceFunc(c.Thumbprints, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.ProtocolBinding = clonePointer(c.ProtocolBinding)
+	clone.TenantDomain = clonePointer(c.TenantDomain)
+	clone.DomainAliases = clonePointerFunc(c.DomainAliases, func(v []string) []string { return cloneSlice(v) })
+	clone.SignInEndpoint = clonePointer(c.SignInEndpoint)
+	clone.SignOutEndpoint = clonePointer(c.SignOutEndpoint)
+	clone.DisableSignOut = clonePointer(c.DisableSignOut)
+	clone.SignatureAlgorithm = clonePointer(c.SignatureAlgorithm)
+	clone.DigestAglorithm = clonePointer(c.DigestAglorithm)
+	clone.MetadataXML = clonePointer(c.MetadataXML)
+	clone.MetadataURL = clonePointer(c.MetadataURL)
+	clone.FieldsMap = cloneMapFunc(c.FieldsMap, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.Subject = cloneMapFunc(c.Subject, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.SignSAMLRequest = clonePointer(c.SignSAMLRequest)
+	clone.RequestTemplate = clonePointer(c.RequestTemplate)
+	clone.UserIDAttribute = clonePointer(c.UserIDAttribute)
+	clone.LogoURL = clonePointer(c.LogoURL)
+	clone.EntityID = clonePointer(c.EntityID)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsSAML) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsSAML and other hold the same values.
+func (c *ConnectionOptionsSAML) Equal(other *ConnectionOptionsSAML) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsSAML and other.
+func (c *ConnectionOptionsSAML) Diff(other *ConnectionOptionsSAML) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsSAML) diff(prefix string, other *ConnectionOptionsSAML) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsSAML{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsSAML{}
+	}
+	changes = diffPointer(changes, prefix+"cert", c.Cert, other.Cert)
+	changes = diffPointer(changes, prefix+"debug", c.Debug, other.Debug)
+	changes = diffPointer(changes, prefix+"expires", c.Expires, other.Expires)
+	changes = append(changes, c.IdpInitiated.diff(prefix+"idpinitiated.", other.IdpInitiated)...)
+	changes = append(changes, c.SigningKey.diff(prefix+"signing_key.", other.SigningKey)...)
+	changes = diffPointer(changes, prefix+"signingCert", c.SigningCert, other.SigningCert)
+	changes = diffSlice(changes, prefix+"thumbprints", c.Thumbprints, other.Thumbprints)
+	changes = diffPointer(changes, prefix+"protocolBinding", c.ProtocolBinding, other.ProtocolBinding)
+	changes = diffPointer(changes, prefix+"tenant_domain", c.TenantDomain, other.TenantDomain)
+	changes = diffSlicePointer(changes, prefix+"domain_aliases", c.DomainAliases, other.DomainAliases)
+	changes = diffPointer(changes, prefix+"signInEndpoint", c.SignInEndpoint, other.SignInEndpoint)
+	changes = diffPointer(changes, prefix+"signOutEndpoint", c.SignOutEndpoint, other.SignOutEndpoint)
+	changes = diffPointer(changes, prefix+"disableSignout", c.DisableSignOut, other.DisableSignOut)
+	changes = diffPointer(changes, prefix+"signatureAlgorithm", c.SignatureAlgorithm, other.SignatureAlgorithm)
+	changes = diffPointer(changes, prefix+"digestAlgorithm", c.DigestAglorithm, other.DigestAglorithm)
+	changes = diffPointer(changes, prefix+"metadataXml", c.MetadataXML, other.MetadataXML)
+	changes = diffPointer(changes, prefix+"metadataUrl", c.MetadataURL, other.MetadataURL)
+	changes = diffMap(changes, prefix+"fieldsMap", c.FieldsMap, other.FieldsMap)
+	changes = diffMap(changes, prefix+"subject", c.Subject, other.Subject)
+	changes = diffPointer(changes, prefix+"signSAMLRequest", c.SignSAMLRequest, other.SignSAMLRequest)
+	changes = diffPointer(changes, prefix+"requestTemplate", c.RequestTemplate, other.RequestTemplate)
+	changes = diffPointer(changes, prefix+"user_id_attribute", c.UserIDAttribute, other.UserIDAttribute)
+	changes = diffPointer(changes, prefix+"icon_url", c.LogoURL, other.LogoURL)
+	changes = diffPointer(changes, prefix+"entityId", c.EntityID, other.EntityID)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsSAML) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsSAML)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetClientAuthorizeQuery returns the ClientAuthorizeQuery field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetClientAuthorizeQuery() string {
+	if c == nil || c.ClientAuthorizeQuery == nil {
+		return ""
+	}
+	return *c.ClientAuthorizeQuery
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientProtocol returns the ClientProtocol field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetClientProtocol() string {
+	if c == nil || c.ClientProtocol == nil {
+		return ""
+	}
+	return *c.ClientProtocol
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetEnabled() bool {
+	if c == nil || c.Enabled == nil {
+		return false
+	}
+	return *c.Enabled
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLIdpInitiated) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// String returns a string representation of ConnectionOptionsSAMLIdpInitiated.
+func (c *ConnectionOptionsSAMLIdpInitiated) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsSAMLIdpInitiated.
+func (c *ConnectionOptionsSAMLIdpInitiated) Clone() *ConnectionOptionsSAMLIdpInitiated {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsSAMLIdpInitiated{}
+	clone.Enabled = clonePointer(c.Enabled)
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientProtocol = clonePointer(c.ClientProtocol)
+	clone.ClientAuthorizeQuery = clonePointer(c.ClientAuthorizeQuery)
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsSAMLIdpInitiated) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsSAMLIdpInitiated and other hold the same values.
+func (c *ConnectionOptionsSAMLIdpInitiated) Equal(other *ConnectionOptionsSAMLIdpInitiated) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsSAMLIdpInitiated and other.
+func (c *ConnectionOptionsSAMLIdpInitiated) Diff(other *ConnectionOptionsSAMLIdpInitiated) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsSAMLIdpInitiated) diff(prefix string, other *ConnectionOptionsSAMLIdpInitiated) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsSAMLIdpInitiated{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsSAMLIdpInitiated{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", c.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_protocol", c.ClientProtocol, other.ClientProtocol)
+	changes = diffPointer(changes, prefix+"client_authorizequery", c.ClientAuthorizeQuery, other.ClientAuthorizeQuery)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	return changes
+}
+
+func (c *ConnectionOptionsSAMLIdpInitiated) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsSAMLIdpInitiated)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetCert returns the Cert field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLSigningKey) GetCert() string {
+	if c == nil || c.Cert == nil {
+		return ""
+	}
+	return *c.Cert
+}
+
+// GetKey returns the Key field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSAMLSigningKey) GetKey() string {
+	if c == nil || c.Key == nil {
+		return ""
+	}
+	return *c.Key
+}
+
+// String returns a string representation of ConnectionOptionsSAMLSigningKey.
+func (c *ConnectionOptionsSAMLSigningKey) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsSAMLSigningKey.
+func (c *ConnectionOptionsSAMLSigningKey) Clone() *ConnectionOptionsSAMLSigningKey {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsSAMLSigningKey{}
+	clone.Key = clonePointer(c.Key)
+	clone.Cert = clonePointer(c.Cert)
+	return clone
+}
+
+func (c *ConnectionOptionsSAMLSigningKey) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsSAMLSigningKey and other hold the same values.
+func (c *ConnectionOptionsSAMLSigningKey) Equal(other *ConnectionOptionsSAMLSigningKey) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsSAMLSigningKey and other.
+func (c *ConnectionOptionsSAMLSigningKey) Diff(other *ConnectionOptionsSAMLSigningKey) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsSAMLSigningKey) diff(prefix string, other *ConnectionOptionsSAMLSigningKey) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsSAMLSigningKey{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsSAMLSigningKey{}
+	}
+	changes = diffPointer(changes, prefix+"key", c.Key, other.Key)
+	changes = diffPointer(changes, prefix+"cert", c.Cert, other.Cert)
+	return changes
+}
+
+func (c *ConnectionOptionsSAMLSigningKey) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsSAMLSigningKey)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetBruteForceProtection() bool {
+	if c == nil || c.BruteForceProtection == nil {
+		return false
+	}
+	return *c.BruteForceProtection
+}
+
+// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetDisableSignup() bool {
+	if c == nil || c.DisableSignup == nil {
+		return false
+	}
+	return *c.DisableSignup
+}
+
+// GetForwardRequestInfo returns the ForwardRequestInfo field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetForwardRequestInfo() bool {
+	if c == nil || c.ForwardRequestInfo == nil {
+		return false
+	}
+	return *c.ForwardRequestInfo
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetFrom() string {
+	if c == nil || c.From == nil {
+		return ""
+	}
+	return *c.From
+}
+
+// GetGatewayAuthentication returns the GatewayAuthentication field.
+func (c *ConnectionOptionsSMS) GetGatewayAuthentication() *ConnectionGatewayAuthentication {
+	if c == nil {
+		return nil
+	}
+	return c.GatewayAuthentication
+}
+
+// GetGatewayURL returns the GatewayURL field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetGatewayURL() string {
+	if c == nil || c.GatewayURL == nil {
+		return ""
+	}
+	return *c.GatewayURL
+}
+
+// GetMessagingServiceSID returns the MessagingServiceSID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetMessagingServiceSID() string {
+	if c == nil || c.MessagingServiceSID == nil {
+		return ""
+	}
+	return *c.MessagingServiceSID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetOTP returns the OTP field.
+func (c *ConnectionOptionsSMS) GetOTP() *ConnectionOptionsOTP {
+	if c == nil {
+		return nil
+	}
+	return c.OTP
+}
+
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetProvider() string {
+	if c == nil || c.Provider == nil {
+		return ""
+	}
+	return *c.Provider
+}
+
+// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetSyntax() string {
+	if c == nil || c.Syntax == nil {
+		return ""
+	}
+	return *c.Syntax
+}
+
+// GetTemplate returns the Template field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetTemplate() string {
+	if c == nil || c.Template == nil {
+		return ""
+	}
+	return *c.Template
+}
+
+// GetTwilioSID returns the TwilioSID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetTwilioSID() string {
+	if c == nil || c.TwilioSID == nil {
+		return ""
+	}
+	return *c.TwilioSID
+}
+
+// GetTwilioToken returns the TwilioToken field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsSMS) GetTwilioToken() string {
+	if c == nil || c.TwilioToken == nil {
+		return ""
+	}
+	return *c.TwilioToken
+}
+
+// String returns a string representation of ConnectionOptionsSMS.
+func (c *ConnectionOptionsSMS) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsSMS.
+func (c *ConnectionOptionsSMS) Clone() *ConnectionOptionsSMS {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsSMS{}
+	clone.Name = clonePointer(c.Name)
+	clone.From = clonePointer(c.From)
+	clone.Syntax = clonePointer(c.Syntax)
+	clone.Template = clonePointer(c.Template)
+	clone.OTP = c.OTP.Clone()
+	clone.AuthParams = cloneValue(c.AuthParams)
+	clone.TwilioSID = clonePointer(c.TwilioSID)
+	clone.TwilioToken = clonePointer(c.TwilioToken)
+	clone.MessagingServiceSID = clonePointer(c.MessagingServiceSID)
+	clone.Provider = clonePointer(c.Provider)
+	clone.GatewayURL = clonePointer(c.GatewayURL)
+	clone.GatewayAuthentication = c.GatewayAuthentication.Clone()
+	clone.ForwardRequestInfo = clonePointer(c.ForwardRequestInfo)
+	clone.DisableSignup = clonePointer(c.DisableSignup)
+	clone.BruteForceProtection = clonePointer(c.BruteForceProtection)
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsSMS) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsSMS and other hold the same values.
+func (c *ConnectionOptionsSMS) Equal(other *ConnectionOptionsSMS) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsSMS and other.
+func (c *ConnectionOptionsSMS) Diff(other *ConnectionOptionsSMS) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsSMS) diff(prefix string, other *ConnectionOptionsSMS) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsSMS{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsSMS{}
+	}
+	changes = diffPointer(changes, prefix+"name", c.Name, other.Name)
+	changes = diffPointer(changes, prefix+"from", c.From, other.From)
+	changes = diffPointer(changes, prefix+"syntax", c.Syntax, other.Syntax)
+	changes = diffPointer(changes, prefix+"template", c.Template, other.Template)
+	changes = append(changes, c.OTP.diff(prefix+"totp.", other.OTP)...)
+	changes = diffValue(changes, prefix+"authParams", c.AuthParams, other.AuthParams)
+	changes = diffPointer(changes, prefix+"twilio_sid", c.TwilioSID, other.TwilioSID)
+	changes = diffPointer(changes, prefix+"twilio_token", c.TwilioToken, other.TwilioToken)
+	changes = diffPointer(changes, prefix+"messaging_service_sid", c.MessagingServiceSID, other.MessagingServiceSID)
+	changes = diffPointer(changes, prefix+"provider", c.Provider, other.Provider)
+	changes = diffPointer(changes, prefix+"gateway_url", c.GatewayURL, other.GatewayURL)
+	changes = append(changes, c.GatewayAuthentication.diff(prefix+"gateway_authentication.", other.GatewayAuthentication)...)
+	changes = diffPointer(changes, prefix+"forward_request_info", c.ForwardRequestInfo, other.ForwardRequestInfo)
+	changes = diffPointer(changes, prefix+"disable_signup", c.DisableSignup, other.DisableSignup)
+	changes = diffPointer(changes, prefix+"brute_force_protection", c.BruteForceProtection, other.BruteForceProtection)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsSMS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsSMS)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetMax returns the Max field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsUsernameValidation) GetMax() int {
+	if c == nil || c.Max == nil {
+		return 0
+	}
+	return *c.Max
+}
+
+// GetMin returns the Min field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsUsernameValidation) GetMin() int {
+	if c == nil || c.Min == nil {
+		return 0
+	}
+	return *c.Min
+}
+
+// String returns a string representation of ConnectionOptionsUsernameValidation.
+func (c *ConnectionOptionsUsernameValidation) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsUsernameValidation.
+func (c *ConnectionOptionsUsernameValidation) Clone() *ConnectionOptionsUsernameValidation {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsUsernameValidation{}
+	clone.Min = clonePointer(c.Min)
+	clone.Max = clonePointer(c.Max)
+	return clone
+}
+
+func (c *ConnectionOptionsUsernameValidation) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsUsernameValidation and other hold the same values.
+func (c *ConnectionOptionsUsernameValidation) Equal(other *ConnectionOptionsUsernameValidation) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsUsernameValidation and other.
+func (c *ConnectionOptionsUsernameValidation) Diff(other *ConnectionOptionsUsernameValidation) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsUsernameValidation) diff(prefix string, other *ConnectionOptionsUsernameValidation) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsUsernameValidation{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsUsernameValidation{}
+	}
+	changes = diffPointer(changes, prefix+"min", c.Min, other.Min)
+	changes = diffPointer(changes, prefix+"max", c.Max, other.Max)
+	return changes
+}
+
+func (c *ConnectionOptionsUsernameValidation) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsUsernameValidation)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetUsername returns the Username field.
+func (c *ConnectionOptionsValidation) GetUsername() *ConnectionOptionsUsernameValidation {
+	if c == nil {
+		return nil
+	}
+	return c.Username
+}
+
+// String returns a string representation of ConnectionOptionsValidation.
+func (c *ConnectionOptionsValidation) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsValidation.
+func (c *ConnectionOptionsValidation) Clone() *ConnectionOptionsValidation {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsValidation{}
+	clone.Username = c.Username.Clone()
+	return clone
+}
+
+func (c *ConnectionOptionsValidation) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsValidation and other hold the same values.
+func (c *ConnectionOptionsValidation) Equal(other *ConnectionOptionsValidation) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsValidation and other.
+func (c *ConnectionOptionsValidation) Diff(other *ConnectionOptionsValidation) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsValidation) diff(prefix string, other *ConnectionOptionsValidation) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsValidation{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsValidation{}
+	}
+	changes = append(changes, c.Username.diff(prefix+"username.", other.Username)...)
+	return changes
+}
+
+func (c *ConnectionOptionsValidation) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsValidation)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetCalendars returns the Calendars field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetCalendars() bool {
+	if c == nil || c.Calendars == nil {
+		return false
+	}
+	return *c.Calendars
+}
+
+// GetCalendarsUpdate returns the CalendarsUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetCalendarsUpdate() bool {
+	if c == nil || c.CalendarsUpdate == nil {
+		return false
+	}
+	return *c.CalendarsUpdate
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetClientID() string {
+	if c == nil || c.ClientID == nil {
+		return ""
+	}
+	return *c.ClientID
+}
+
+// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetClientSecret() string {
+	if c == nil || c.ClientSecret == nil {
+		return ""
+	}
+	return *c.ClientSecret
+}
+
+// GetContacts returns the Contacts field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetContacts() bool {
+	if c == nil || c.Contacts == nil {
+		return false
+	}
+	return *c.Contacts
+}
+
+// GetContactsUpdate returns the ContactsUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetContactsUpdate() bool {
+	if c == nil || c.ContactsUpdate == nil {
+		return false
+	}
+	return *c.ContactsUpdate
+}
+
+// GetDevice returns the Device field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetDevice() bool {
+	if c == nil || c.Device == nil {
+		return false
+	}
+	return *c.Device
+}
+
+// GetDeviceCommand returns the DeviceCommand field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetDeviceCommand() bool {
+	if c == nil || c.DeviceCommand == nil {
+		return false
+	}
+	return *c.DeviceCommand
+}
+
+// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetEmails() bool {
+	if c == nil || c.Emails == nil {
+		return false
+	}
+	return *c.Emails
+}
+
+// GetEmailsUpdate returns the EmailsUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetEmailsUpdate() bool {
+	if c == nil || c.EmailsUpdate == nil {
+		return false
+	}
+	return *c.EmailsUpdate
+}
+
+// GetFiles returns the Files field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetFiles() bool {
+	if c == nil || c.Files == nil {
+		return false
+	}
+	return *c.Files
+}
+
+// GetFilesAll returns the FilesAll field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetFilesAll() bool {
+	if c == nil || c.FilesAll == nil {
+		return false
+	}
+	return *c.FilesAll
+}
+
+// GetFilesAllUpdate returns the FilesAllUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetFilesAllUpdate() bool {
+	if c == nil || c.FilesAllUpdate == nil {
+		return false
+	}
+	return *c.FilesAllUpdate
+}
+
+// GetFilesUpdate returns the FilesUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetFilesUpdate() bool {
+	if c == nil || c.FilesUpdate == nil {
+		return false
+	}
+	return *c.FilesUpdate
+}
+
+// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetNonPersistentAttrs() []string {
+	if c == nil || c.NonPersistentAttrs == nil {
+		return nil
+	}
+	return *c.NonPersistentAttrs
+}
+
+// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetNotes() bool {
+	if c == nil || c.Notes == nil {
+		return false
+	}
+	return *c.Notes
+}
+
+// GetNotesCreate returns the NotesCreate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetNotesCreate() bool {
+	if c == nil || c.NotesCreate == nil {
+		return false
+	}
+	return *c.NotesCreate
+}
+
+// GetNotesUpdate returns the NotesUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetNotesUpdate() bool {
+	if c == nil || c.NotesUpdate == nil {
+		return false
+	}
+	return *c.NotesUpdate
+}
+
+// GetOfflineAccess returns the OfflineAccess field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetOfflineAccess() bool {
+	if c == nil || c.OfflineAccess == nil {
+		return false
+	}
+	return *c.OfflineAccess
+}
+
+// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetSetUserAttributes() string {
+	if c == nil || c.SetUserAttributes == nil {
+		return ""
+	}
+	return *c.SetUserAttributes
+}
+
+// GetSignin returns the Signin field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetSignin() bool {
+	if c == nil || c.Signin == nil {
+		return false
+	}
+	return *c.Signin
+}
+
+// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetStrategyVersion() int {
+	if c == nil || c.StrategyVersion == nil {
+		return 0
+	}
+	return *c.StrategyVersion
+}
+
+// GetTasks returns the Tasks field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetTasks() bool {
+	if c == nil || c.Tasks == nil {
+		return false
+	}
+	return *c.Tasks
+}
+
+// GetTasksUpdate returns the TasksUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetTasksUpdate() bool {
+	if c == nil || c.TasksUpdate == nil {
+		return false
+	}
+	return *c.TasksUpdate
+}
+
+// GetUser returns the User field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetUser() bool {
+	if c == nil || c.User == nil {
+		return false
+	}
+	return *c.User
+}
+
+// GetUserActivity returns the UserActivity field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetUserActivity() bool {
+	if c == nil || c.UserActivity == nil {
+		return false
+	}
+	return *c.UserActivity
+}
+
+// GetUserUpdate returns the UserUpdate field if it's non-nil, zero value otherwise.
+func (c *ConnectionOptionsWindowsLive) GetUserUpdate() bool {
+	if c == nil || c.UserUpdate == nil {
+		return false
+	}
+	return *c.UserUpdate
+}
+
+// String returns a string representation of ConnectionOptionsWindowsLive.
+func (c *ConnectionOptionsWindowsLive) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of ConnectionOptionsWindowsLive.
+func (c *ConnectionOptionsWindowsLive) Clone() *ConnectionOptionsWindowsLive {
+	if c == nil {
+		return nil
+	}
+	clone := &ConnectionOptionsWindowsLive{}
+	clone.ClientID = clonePointer(c.ClientID)
+	clone.ClientSecret = clonePointer(c.ClientSecret)
+	clone.StrategyVersion = clonePointer(c.StrategyVersion)
+	clone.OfflineAccess = clonePointer(c.OfflineAccess)
+	clone.UserUpdate = clonePointer(c.UserUpdate)
+	clone.UserActivity = clonePointer(c.UserActivity)
+	clone.Device = clonePointer(c.Device)
+	clone.Emails = clonePointer(c.Emails)
+	clone.NotesUpdate = clonePointer(c.NotesUpdate)
+	clone.User = clonePointer(c.User)
+	clone.DeviceCommand = clonePointer(c.DeviceCommand)
+	clone.EmailsUpdate = clonePointer(c.EmailsUpdate)
+	clone.Calendars = clonePointer(c.Calendars)
+	clone.CalendarsUpdate = clonePointer(c.CalendarsUpdate)
+	clone.Contacts = clonePointer(c.Contacts)
+	clone.ContactsUpdate = clonePointer(c.ContactsUpdate)
+	clone.Files = clonePointer(c.Files)
+	clone.FilesAll = clonePointer(c.FilesAll)
+	clone.FilesUpdate = clonePointer(c.FilesUpdate)
+	clone.FilesAllUpdate = clonePointer(c.FilesAllUpdate)
+	clone.Notes = clonePointer(c.Notes)
+	clone.NotesCreate = clonePointer(c.NotesCreate)
+	clone.Tasks = clonePointer(c.Tasks)
+	clone.TasksUpdate = clonePointer(c.TasksUpdate)
+	clone.Signin = clonePointer(c.Signin)
+	clone.Scope = cloneSliceFunc(c.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.SetUserAttributes = clonePointer(c.SetUserAttributes)
+	clone.NonPersistentAttrs = clonePointerFunc(c.NonPersistentAttrs, func(v []string) []string { return cloneSlice(v) })
+	clone.UpstreamParams = cloneMapFunc(c.UpstreamParams, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (c *ConnectionOptionsWindowsLive) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether ConnectionOptionsWindowsLive and other hold the same values.
+func (c *ConnectionOptionsWindowsLive) Equal(other *ConnectionOptionsWindowsLive) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ConnectionOptionsWindowsLive and other.
+func (c *ConnectionOptionsWindowsLive) Diff(other *ConnectionOptionsWindowsLive) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *ConnectionOptionsWindowsLive) diff(prefix string, other *ConnectionOptionsWindowsLive) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &ConnectionOptionsWindowsLive{}
+	}
+	if other == nil {
+		other = &ConnectionOptionsWindowsLive{}
+	}
+	changes = diffPointer(changes, prefix+"client_id", c.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_secret", c.ClientSecret, other.ClientSecret)
+	changes = diffPointer(changes, prefix+"strategy_version", c.StrategyVersion, other.StrategyVersion)
+	changes = diffPointer(changes, prefix+"offline_access", c.OfflineAccess, other.OfflineAccess)
+	changes = diffPointer(changes, prefix+"graph_user_update", c.UserUpdate, other.UserUpdate)
+	changes = diffPointer(changes, prefix+"graph_user_activity", c.UserActivity, other.UserActivity)
+	changes = diffPointer(changes, prefix+"graph_device", c.Device, other.Device)
+	changes = diffPointer(changes, prefix+"graph_emails", c.Emails, other.Emails)
+	changes = diffPointer(changes, prefix+"graph_notes_update", c.NotesUpdate, other.NotesUpdate)
+	changes = diffPointer(changes, prefix+"graph_user", c.User, other.User)
+	changes = diffPointer(changes, prefix+"graph_device_command", c.DeviceCommand, other.DeviceCommand)
+	changes = diffPointer(changes, prefix+"graph_emails_update", c.EmailsUpdate, other.EmailsUpdate)
+	changes = diffPointer(changes, prefix+"graph_calendars", c.Calendars, other.Calendars)
+	changes = diffPointer(changes, prefix+"graph_calendars_update", c.CalendarsUpdate, other.CalendarsUpdate)
+	changes = diffPointer(changes, prefix+"graph_contacts", c.Contacts, other.Contacts)
+	changes = diffPointer(changes, prefix+"graph_contacts_update", c.ContactsUpdate, other.ContactsUpdate)
+	changes = diffPointer(changes, prefix+"graph_files", c.Files, other.Files)
+	changes = diffPointer(changes, prefix+"graph_files_all", c.FilesAll, other.FilesAll)
+	changes = diffPointer(changes, prefix+"graph_files_update", c.FilesUpdate, other.FilesUpdate)
+	changes = diffPointer(changes, prefix+"graph_files_all_update", c.FilesAllUpdate, other.FilesAllUpdate)
+	changes = diffPointer(changes, prefix+"graph_notes", c.Notes, other.Notes)
+	changes = diffPointer(changes, prefix+"graph_notes_create", c.NotesCreate, other.NotesCreate)
+	changes = diffPointer(changes, prefix+"graph_tasks", c.Tasks, other.Tasks)
+	changes = diffPointer(changes, prefix+"graph_tasks_update", c.TasksUpdate, other.TasksUpdate)
+	changes = diffPointer(changes, prefix+"signin", c.Signin, other.Signin)
+	changes = diffSlice(changes, prefix+"scope", c.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"set_user_root_attributes", c.SetUserAttributes, other.SetUserAttributes)
+	changes = diffSlicePointer(changes, prefix+"non_persistent_attrs", c.NonPersistentAttrs, other.NonPersistentAttrs)
+	changes = diffMap(changes, prefix+"upstream_params", c.UpstreamParams, other.UpstreamParams)
+	return changes
+}
+
+func (c *ConnectionOptionsWindowsLive) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ConnectionOptionsWindowsLive)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// String returns a string representation of CreateEnrollmentTicket.
+func (c *CreateEnrollmentTicket) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of CreateEnrollmentTicket.
+func (c *CreateEnrollmentTicket) Clone() *CreateEnrollmentTicket {
+	if c == nil {
+		return nil
+	}
+	clone := &CreateEnrollmentTicket{}
+	clone.UserID = c.UserID
+	clone.Email = c.Email
+	clone.SendMail = c.SendMail
+	return clone
+}
+
+func (c *CreateEnrollmentTicket) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether CreateEnrollmentTicket and other hold the same values.
+func (c *CreateEnrollmentTicket) Equal(other *CreateEnrollmentTicket) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between CreateEnrollmentTicket and other.
+func (c *CreateEnrollmentTicket) Diff(other *CreateEnrollmentTicket) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *CreateEnrollmentTicket) diff(prefix string, other *CreateEnrollmentTicket) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &CreateEnrollmentTicket{}
+	}
+	if other == nil {
+		other = &CreateEnrollmentTicket{}
+	}
+	changes = diffComparable(changes, prefix+"user_id", c.UserID, other.UserID)
+	changes = diffComparable(changes, prefix+"email", c.Email, other.Email)
+	changes = diffComparable(changes, prefix+"send_mail", c.SendMail, other.SendMail)
+	return changes
+}
+
+func (c *CreateEnrollmentTicket) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*CreateEnrollmentTicket)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetCNAMEAPIKey returns the CNAMEAPIKey field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetCNAMEAPIKey() string {
+	if c == nil || c.CNAMEAPIKey == nil {
+		return ""
+	}
+	return *c.CNAMEAPIKey
+}
+
+// GetCustomClientIPHeader returns the CustomClientIPHeader field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetCustomClientIPHeader() string {
+	if c == nil || c.CustomClientIPHeader == nil {
+		return ""
+	}
+	return *c.CustomClientIPHeader
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetDomain() string {
+	if c == nil || c.Domain == nil {
+		return ""
+	}
+	return *c.Domain
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetID() string {
+	if c == nil || c.ID == nil {
+		return ""
+	}
+	return *c.ID
+}
+
+// GetOriginDomainName returns the OriginDomainName field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetOriginDomainName() string {
+	if c == nil || c.OriginDomainName == nil {
+		return ""
+	}
+	return *c.OriginDomainName
+}
+
+// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetPrimary() bool {
+	if c == nil || c.Primary == nil {
+		return false
+	}
+	return *c.Primary
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetStatus() string {
+	if c == nil || c.Status == nil {
+		return ""
+	}
+	return *c.Status
+}
+
+// GetTLSPolicy returns the TLSPolicy field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetTLSPolicy() string {
+	if c == nil || c.TLSPolicy == nil {
+		return ""
+	}
+	return *c.TLSPolicy
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetType() string {
+	if c == nil || c.Type == nil {
+		return ""
+	}
+	return *c.Type
+}
+
+// GetVerification returns the Verification field.
+func (c *CustomDomain) GetVerification() *CustomDomainVerification {
+	if c == nil {
+		return nil
+	}
+	return c.Verification
+}
+
+// GetVerificationMethod returns the VerificationMethod field if it's non-nil, zero value otherwise.
+func (c *CustomDomain) GetVerificationMethod() string {
+	if c == nil || c.VerificationMethod == nil {
+		return ""
+	}
+	return *c.VerificationMethod
+}
+
+// String returns a string representation of CustomDomain.
+func (c *CustomDomain) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of CustomDomain.
+func (c *CustomDomain) Clone() *CustomDomain {
+	if c == nil {
+		return nil
+	}
+	clone := &CustomDomain{}
+	clone.ID = clonePointer(c.ID)
+	clone.Domain = clonePointer(c.Domain)
+	clone.Type = clonePointer(c.Type)
+	clone.Primary = clonePointer(c.Primary)
+	clone.Status = clonePointer(c.Status)
+	clone.OriginDomainName = clonePointer(c.OriginDomainName)
+	clone.CNAMEAPIKey = clonePointer(c.CNAMEAPIKey)
+	clone.VerificationMethod = clonePointer(c.VerificationMethod)
+	clone.Verification = c.Verification.Clone()
+	clone.TLSPolicy = clonePointer(c.TLSPolicy)
+	clone.CustomClientIPHeader = clonePointer(c.CustomClientIPHeader)
+	return clone
+}
+
+func (c *CustomDomain) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether CustomDomain and other hold the same values.
+func (c *CustomDomain) Equal(other *CustomDomain) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between CustomDomain and other.
+func (c *CustomDomain) Diff(other *CustomDomain) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *CustomDomain) diff(prefix string, other *CustomDomain) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &CustomDomain{}
+	}
+	if other == nil {
+		other = &CustomDomain{}
+	}
+	changes = diffPointer(changes, prefix+"custom_domain_id", c.ID, other.ID)
+	changes = diffPointer(changes, prefix+"domain", c.Domain, other.Domain)
+	changes = diffPointer(changes, prefix+"type", c.Type, other.Type)
+	changes = diffPointer(changes, prefix+"primary", c.Primary, other.Primary)
+	changes = diffPointer(changes, prefix+"status", c.Status, other.Status)
+	changes = diffPointer(changes, prefix+"origin_domain_name", c.OriginDomainName, other.OriginDomainName)
+	changes = diffPointer(changes, prefix+"cname_api_key", c.CNAMEAPIKey, other.CNAMEAPIKey)
+	changes = diffPointer(changes, prefix+"verification_method", c.VerificationMethod, other.VerificationMethod)
+	changes = append(changes, c.Verification.diff(prefix+"verification.", other.Verification)...)
+	changes = diffPointer(changes, prefix+"tls_policy", c.TLSPolicy, other.TLSPolicy)
+	changes = diffPointer(changes, prefix+"custom_client_ip_header", c.CustomClientIPHeader, other.CustomClientIPHeader)
+	return changes
+}
+
+func (c *CustomDomain) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*CustomDomain)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// String returns a string representation of CustomDomainVerification.
+func (c *CustomDomainVerification) String() string {
+	return Stringify(c)
+}
+
+// Clone returns a deep copy of CustomDomainVerification.
+func (c *CustomDomainVerification) Clone() *CustomDomainVerification {
+	if c == nil {
+		return nil
+	}
+	clone := &CustomDomainVerification{}
+	clone.Methods = cloneSliceFunc(c.Methods, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	return clone
+}
+
+func (c *CustomDomainVerification) cloneAny() interface{} {
+	return c.Clone()
+}
+
+// Equal reports whether CustomDomainVerification and other hold the same values.
+func (c *CustomDomainVerification) Equal(other *CustomDomainVerification) bool {
+	return len(c.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between CustomDomainVerification and other.
+func (c *CustomDomainVerification) Diff(other *CustomDomainVerification) []FieldChange {
+	return c.diff("", other)
+}
+
+func (c *CustomDomainVerification) diff(prefix string, other *CustomDomainVerification) (changes []FieldChange) {
+	if c == nil && other == nil {
+		return nil
+	}
+	if c == nil {
+		c = &CustomDomainVerification{}
+	}
+	if other == nil {
+		other = &CustomDomainVerification{}
+	}
+	changes = diffSlice(changes, prefix+"methods", c.Methods, other.Methods)
+	return changes
+}
+
+func (c *CustomDomainVerification) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*CustomDomainVerification)
+	if !ok {
+		return nil, false
+	}
+	return c.diff(prefix, typed), true
+}
+
+// GetChange returns the Change field if it's non-nil, zero value otherwise.
+func (c *CustomScriptChange) GetChange() string {
+	if c == nil || c.Change == nil {
+		return ""
+	}
+	return *c.Change
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (c *CustomScriptChange) GetName() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetUnifiedDiff returns the UnifiedDiff field if it's non-nil, zero value otherwise.
+func (c *CustomScriptChange) GetUnifiedDiff() string {
+	if c == nil || c.UnifiedDiff == nil {
+		return ""
+	}
+	return *c.UnifiedDiff
+}
+
+// String returns a string representation of CustomScriptChange.
+func (c *CustomScriptChange) String() string {
+	return Stringify(c)
+}
+
+// GetConfiguration returns the Configuration field if it's non-nil, zero value otherwise.
+func (c *CustomScripts) GetConfiguration() map[string]string {
+	if c == nil || c.Configuration == nil {
+		return map[string]string{}
+	}
+	return *c.Configuration
+}
+
+// GetScripts returns the Scripts field if it's non-nil, zero value otherwise.
+func (c *CustomScripts) GetScripts() map[string]string {
+	if c == nil || c.Scripts == nil {
+		return map[string]string{}
+	}
+	return *c.Scripts
+}
+
+// String returns a string representation of CustomScripts.
+func (c *CustomScripts) String() string {
+	return Stringify(c)
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetCreatedAt() time.Time {
+	if d == nil || d.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *d.CreatedAt
+}
+
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetDate() time.Time {
+	if d == nil || d.Date == nil {
+		return time.Time{}
+	}
+	return *d.Date
+}
+
+// GetLeakedPasswords returns the LeakedPasswords field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetLeakedPasswords() int {
+	if d == nil || d.LeakedPasswords == nil {
+		return 0
+	}
+	return *d.LeakedPasswords
+}
+
+// GetLogins returns the Logins field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetLogins() int {
+	if d == nil || d.Logins == nil {
+		return 0
+	}
+	return *d.Logins
+}
+
+// GetSignups returns the Signups field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetSignups() int {
+	if d == nil || d.Signups == nil {
+		return 0
+	}
+	return *d.Signups
+}
+
+// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
+func (d *DailyStat) GetUpdatedAt() time.Time {
+	if d == nil || d.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *d.UpdatedAt
+}
+
+// String returns a string representation of DailyStat.
+func (d *DailyStat) String() string {
+	return Stringify(d)
+}
+
+// Clone returns a deep copy of DailyStat.
+func (d *DailyStat) Clone() *DailyStat {
+	if d == nil {
+		return nil
+	}
+	clone := &DailyStat{}
+	clone.Date = clonePointer(d.Date)
+	clone.Logins = clonePointer(d.Logins)
+	clone.Signups = clonePointer(d.Signups)
+	clone.LeakedPasswords = clonePointer(d.LeakedPasswords)
+	clone.UpdatedAt = clonePointer(d.UpdatedAt)
+	clone.CreatedAt = clonePointer(d.CreatedAt)
+	return clone
+}
+
+func (d *DailyStat) cloneAny() interface{} {
+	return d.Clone()
+}
+
+// Equal reports whether DailyStat and other hold the same values.
+func (d *DailyStat) Equal(other *DailyStat) bool {
+	return len(d.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between DailyStat and other.
+func (d *DailyStat) Diff(other *DailyStat) []FieldChange {
+	return d.diff("", other)
+}
+
+func (d *DailyStat) diff(prefix string, other *DailyStat) (changes []FieldChange) {
+	if d == nil && other == nil {
+		return nil
+	}
+	if d == nil {
+		d = &DailyStat{}
+	}
+	if other == nil {
+		other = &DailyStat{}
+	}
+	changes = diffTime(changes, prefix+"date", d.Date, other.Date)
+	changes = diffPointer(changes, prefix+"logins", d.Logins, other.Logins)
+	changes = diffPointer(changes, prefix+"signups", d.Signups, other.Signups)
+	changes = diffPointer(changes, prefix+"leaked_passwords", d.LeakedPasswords, other.LeakedPasswords)
+	changes = diffTime(changes, prefix+"updated_at", d.UpdatedAt, other.UpdatedAt)
+	changes = diffTime(changes, prefix+"created_at", d.CreatedAt, other.CreatedAt)
+	return changes
+}
+
+func (d *DailyStat) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*DailyStat)
+	if !ok {
+		return nil, false
+	}
+	return d.diff(prefix, typed), true
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (d *DesiredRole) GetDescription() string {
+	if d == nil || d.Description == nil {
+		return ""
+	}
+	return *d.Description
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (d *DesiredRole) GetName() string {
+	if d == nil || d.Name == nil {
+		return ""
+	}
+	return *d.Name
+}
+
+// String returns a string representation of DesiredRole.
+func (d *DesiredRole) String() string {
+	return Stringify(d)
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (e *EffectivePermission) GetDescription() string {
+	if e == nil || e.Description == nil {
+		return ""
+	}
+	return *e.Description
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EffectivePermission) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetResourceServerIdentifier returns the ResourceServerIdentifier field if it's non-nil, zero value otherwise.
+func (e *EffectivePermission) GetResourceServerIdentifier() string {
+	if e == nil || e.ResourceServerIdentifier == nil {
+		return ""
+	}
+	return *e.ResourceServerIdentifier
+}
+
+// GetResourceServerName returns the ResourceServerName field if it's non-nil, zero value otherwise.
+func (e *EffectivePermission) GetResourceServerName() string {
+	if e == nil || e.ResourceServerName == nil {
+		return ""
+	}
+	return *e.ResourceServerName
+}
+
+// String returns a string representation of EffectivePermission.
+func (e *EffectivePermission) String() string {
+	return Stringify(e)
+}
+
+// GetResourceServers returns the ResourceServers field if it's non-nil, zero value otherwise.
+func (e *EffectivePermissionsOptions) GetResourceServers() []string {
+	if e == nil || e.ResourceServers == nil {
+		return nil
+	}
+	return *e.ResourceServers
+}
+
+// String returns a string representation of EffectivePermissionsOptions.
+func (e *EffectivePermissionsOptions) String() string {
+	return Stringify(e)
+}
+
+// GetCredentials returns the Credentials field.
+func (e *Email) GetCredentials() *EmailCredentials {
+	if e == nil {
+		return nil
+	}
+	return e.Credentials
+}
+
+// GetDefaultFromAddress returns the DefaultFromAddress field if it's non-nil, zero value otherwise.
+func (e *Email) GetDefaultFromAddress() string {
+	if e == nil || e.DefaultFromAddress == nil {
+		return ""
+	}
+	return *e.DefaultFromAddress
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (e *Email) GetEnabled() bool {
+	if e == nil || e.Enabled == nil {
+		return false
+	}
+	return *e.Enabled
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Email) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// String returns a string representation of Email.
+func (e *Email) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of Email.
+func (e *Email) Clone() *Email {
+	if e == nil {
+		return nil
+	}
+	clone := &Email{}
+	clone.Name = clonePointer(e.Name)
+	clone.Enabled = clonePointer(e.Enabled)
+	clone.DefaultFromAddress = clonePointer(e.DefaultFromAddress)
+	clone.Credentials = e.Credentials.Clone()
+	clone.Settings = cloneMapFunc(e.Settings, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (e *Email) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether Email and other hold the same values.
+func (e *Email) Equal(other *Email) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Email and other.
+func (e *Email) Diff(other *Email) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *Email) diff(prefix string, other *Email) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &Email{}
+	}
+	if other == nil {
+		other = &Email{}
+	}
+	changes = diffPointer(changes, prefix+"name", e.Name, other.Name)
+	changes = diffPointer(changes, prefix+"enabled", e.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"default_from_address", e.DefaultFromAddress, other.DefaultFromAddress)
+	changes = append(changes, e.Credentials.diff(prefix+"credentials.", other.Credentials)...)
+	changes = diffMap(changes, prefix+"settings", e.Settings, other.Settings)
+	return changes
+}
+
+func (e *Email) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Email)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetAccessKeyID() string {
+	if e == nil || e.AccessKeyID == nil {
+		return ""
+	}
+	return *e.AccessKeyID
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetAPIKey() string {
+	if e == nil || e.APIKey == nil {
+		return ""
+	}
+	return *e.APIKey
+}
+
+// GetAPIUser returns the APIUser field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetAPIUser() string {
+	if e == nil || e.APIUser == nil {
+		return ""
+	}
+	return *e.APIUser
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetDomain() string {
+	if e == nil || e.Domain == nil {
+		return ""
+	}
+	return *e.Domain
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetRegion() string {
+	if e == nil || e.Region == nil {
+		return ""
+	}
+	return *e.Region
+}
+
+// GetSecretAccessKey returns the SecretAccessKey field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetSecretAccessKey() string {
+	if e == nil || e.SecretAccessKey == nil {
+		return ""
+	}
+	return *e.SecretAccessKey
+}
+
+// GetSMTPHost returns the SMTPHost field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetSMTPHost() string {
+	if e == nil || e.SMTPHost == nil {
+		return ""
+	}
+	return *e.SMTPHost
+}
+
+// GetSMTPPass returns the SMTPPass field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetSMTPPass() string {
+	if e == nil || e.SMTPPass == nil {
+		return ""
+	}
+	return *e.SMTPPass
+}
+
+// GetSMTPPort returns the SMTPPort field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetSMTPPort() int {
+	if e == nil || e.SMTPPort == nil {
+		return 0
+	}
+	return *e.SMTPPort
+}
+
+// GetSMTPUser returns the SMTPUser field if it's non-nil, zero value otherwise.
+func (e *EmailCredentials) GetSMTPUser() string {
+	if e == nil || e.SMTPUser == nil {
+		return ""
+	}
+	return *e.SMTPUser
+}
+
+// String returns a string representation of EmailCredentials.
+func (e *EmailCredentials) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailCredentials.
+func (e *EmailCredentials) Clone() *EmailCredentials {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailCredentials{}
+	clone.APIUser = clonePointer(e.APIUser)
+	clone.APIKey = clonePointer(e.APIKey)
+	clone.AccessKeyID = clonePointer(e.AccessKeyID)
+	clone.SecretAccessKey = clonePointer(e.SecretAccessKey)
+	clone.Region = clonePointer(e.Region)
+	clone.SMTPHost = clonePointer(e.SMTPHost)
+	clone.SMTPPort = clonePointer(e.SMTPPort)
+	clone.SMTPUser = clonePointer(e.SMTPUser)
+	clone.SMTPPass = clonePointer(e.SMTPPass)
+	clone.Domain = clonePointer(e.Domain)
+	return clone
+}
+
+func (e *EmailCredentials) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailCredentials and other hold the same values.
+func (e *EmailCredentials) Equal(other *EmailCredentials) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailCredentials and other.
+func (e *EmailCredentials) Diff(other *EmailCredentials) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailCredentials) diff(prefix string, other *EmailCredentials) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailCredentials{}
+	}
+	if other == nil {
+		other = &EmailCredentials{}
+	}
+	changes = diffPointer(changes, prefix+"api_user", e.APIUser, other.APIUser)
+	changes = diffPointer(changes, prefix+"api_key", e.APIKey, other.APIKey)
+	changes = diffPointer(changes, prefix+"accessKeyId", e.AccessKeyID, other.AccessKeyID)
+	changes = diffPointer(changes, prefix+"secretAccessKey", e.SecretAccessKey, other.SecretAccessKey)
+	changes = diffPointer(changes, prefix+"region", e.Region, other.Region)
+	changes = diffPointer(changes, prefix+"smtp_host", e.SMTPHost, other.SMTPHost)
+	changes = diffPointer(changes, prefix+"smtp_port", e.SMTPPort, other.SMTPPort)
+	changes = diffPointer(changes, prefix+"smtp_user", e.SMTPUser, other.SMTPUser)
+	changes = diffPointer(changes, prefix+"smtp_pass", e.SMTPPass, other.SMTPPass)
+	changes = diffPointer(changes, prefix+"domain", e.Domain, other.Domain)
+	return changes
+}
+
+func (e *EmailCredentials) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailCredentials)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetDefaultFromAddress returns the DefaultFromAddress field if it's non-nil, zero value otherwise.
+func (e *EmailProvider) GetDefaultFromAddress() string {
+	if e == nil || e.DefaultFromAddress == nil {
+		return ""
+	}
+	return *e.DefaultFromAddress
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (e *EmailProvider) GetEnabled() bool {
+	if e == nil || e.Enabled == nil {
+		return false
+	}
+	return *e.Enabled
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *EmailProvider) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// String returns a string representation of EmailProvider.
+func (e *EmailProvider) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProvider.
+func (e *EmailProvider) Clone() *EmailProvider {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProvider{}
+	clone.Name = clonePointer(e.Name)
+	clone.Enabled = clonePointer(e.Enabled)
+	clone.DefaultFromAddress = clonePointer(e.DefaultFromAddress)
+	clone.Credentials = cloneValue(e.Credentials)
+	clone.Settings = cloneValue(e.Settings)
+	return clone
+}
+
+func (e *EmailProvider) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProvider and other hold the same values.
+func (e *EmailProvider) Equal(other *EmailProvider) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProvider and other.
+func (e *EmailProvider) Diff(other *EmailProvider) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProvider) diff(prefix string, other *EmailProvider) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProvider{}
+	}
+	if other == nil {
+		other = &EmailProvider{}
+	}
+	changes = diffPointer(changes, prefix+"name", e.Name, other.Name)
+	changes = diffPointer(changes, prefix+"enabled", e.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"default_from_address", e.DefaultFromAddress, other.DefaultFromAddress)
+	changes = diffValue(changes, prefix+"credentials", e.Credentials, other.Credentials)
+	changes = diffValue(changes, prefix+"settings", e.Settings, other.Settings)
+	return changes
+}
+
+func (e *EmailProvider) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProvider)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsMailgun) GetAPIKey() string {
+	if e == nil || e.APIKey == nil {
+		return ""
+	}
+	return *e.APIKey
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsMailgun) GetDomain() string {
+	if e == nil || e.Domain == nil {
+		return ""
+	}
+	return *e.Domain
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsMailgun) GetRegion() string {
+	if e == nil || e.Region == nil {
+		return ""
+	}
+	return *e.Region
+}
+
+// String returns a string representation of EmailProviderCredentialsMailgun.
+func (e *EmailProviderCredentialsMailgun) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsMailgun.
+func (e *EmailProviderCredentialsMailgun) Clone() *EmailProviderCredentialsMailgun {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsMailgun{}
+	clone.APIKey = clonePointer(e.APIKey)
+	clone.Domain = clonePointer(e.Domain)
+	clone.Region = clonePointer(e.Region)
+	return clone
+}
+
+func (e *EmailProviderCredentialsMailgun) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsMailgun and other hold the same values.
+func (e *EmailProviderCredentialsMailgun) Equal(other *EmailProviderCredentialsMailgun) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsMailgun and other.
+func (e *EmailProviderCredentialsMailgun) Diff(other *EmailProviderCredentialsMailgun) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsMailgun) diff(prefix string, other *EmailProviderCredentialsMailgun) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsMailgun{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsMailgun{}
+	}
+	changes = diffPointer(changes, prefix+"api_key", e.APIKey, other.APIKey)
+	changes = diffPointer(changes, prefix+"domain", e.Domain, other.Domain)
+	changes = diffPointer(changes, prefix+"region", e.Region, other.Region)
+	return changes
+}
+
+func (e *EmailProviderCredentialsMailgun) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsMailgun)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsMandrill) GetAPIKey() string {
+	if e == nil || e.APIKey == nil {
+		return ""
+	}
+	return *e.APIKey
+}
+
+// String returns a string representation of EmailProviderCredentialsMandrill.
+func (e *EmailProviderCredentialsMandrill) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsMandrill.
+func (e *EmailProviderCredentialsMandrill) Clone() *EmailProviderCredentialsMandrill {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsMandrill{}
+	clone.APIKey = clonePointer(e.APIKey)
+	return clone
+}
+
+func (e *EmailProviderCredentialsMandrill) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsMandrill and other hold the same values.
+func (e *EmailProviderCredentialsMandrill) Equal(other *EmailProviderCredentialsMandrill) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsMandrill and other.
+func (e *EmailProviderCredentialsMandrill) Diff(other *EmailProviderCredentialsMandrill) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsMandrill) diff(prefix string, other *EmailProviderCredentialsMandrill) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsMandrill{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsMandrill{}
+	}
+	changes = diffPointer(changes, prefix+"api_key", e.APIKey, other.APIKey)
+	return changes
+}
+
+func (e *EmailProviderCredentialsMandrill) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsMandrill)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSendGrid) GetAPIKey() string {
+	if e == nil || e.APIKey == nil {
+		return ""
+	}
+	return *e.APIKey
+}
+
+// String returns a string representation of EmailProviderCredentialsSendGrid.
+func (e *EmailProviderCredentialsSendGrid) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsSendGrid.
+func (e *EmailProviderCredentialsSendGrid) Clone() *EmailProviderCredentialsSendGrid {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsSendGrid{}
+	clone.APIKey = clonePointer(e.APIKey)
+	return clone
+}
+
+func (e *EmailProviderCredentialsSendGrid) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsSendGrid and other hold the same values.
+func (e *EmailProviderCredentialsSendGrid) Equal(other *EmailProviderCredentialsSendGrid) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsSendGrid and other.
+func (e *EmailProviderCredentialsSendGrid) Diff(other *EmailProviderCredentialsSendGrid) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsSendGrid) diff(prefix string, other *EmailProviderCredentialsSendGrid) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsSendGrid{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsSendGrid{}
+	}
+	changes = diffPointer(changes, prefix+"api_key", e.APIKey, other.APIKey)
+	return changes
+}
+
+func (e *EmailProviderCredentialsSendGrid) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsSendGrid)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSES) GetAccessKeyID() string {
+	if e == nil || e.AccessKeyID == nil {
+		return ""
+	}
+	return *e.AccessKeyID
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSES) GetRegion() string {
+	if e == nil || e.Region == nil {
+		return ""
+	}
+	return *e.Region
+}
+
+// GetSecretAccessKey returns the SecretAccessKey field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSES) GetSecretAccessKey() string {
+	if e == nil || e.SecretAccessKey == nil {
+		return ""
+	}
+	return *e.SecretAccessKey
+}
+
+// String returns a string representation of EmailProviderCredentialsSES.
+func (e *EmailProviderCredentialsSES) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsSES.
+func (e *EmailProviderCredentialsSES) Clone() *EmailProviderCredentialsSES {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsSES{}
+	clone.AccessKeyID = clonePointer(e.AccessKeyID)
+	clone.SecretAccessKey = clonePointer(e.SecretAccessKey)
+	clone.Region = clonePointer(e.Region)
+	return clone
+}
+
+func (e *EmailProviderCredentialsSES) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsSES and other hold the same values.
+func (e *EmailProviderCredentialsSES) Equal(other *EmailProviderCredentialsSES) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsSES and other.
+func (e *EmailProviderCredentialsSES) Diff(other *EmailProviderCredentialsSES) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsSES) diff(prefix string, other *EmailProviderCredentialsSES) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsSES{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsSES{}
+	}
+	changes = diffPointer(changes, prefix+"accessKeyId", e.AccessKeyID, other.AccessKeyID)
+	changes = diffPointer(changes, prefix+"secretAccessKey", e.SecretAccessKey, other.SecretAccessKey)
+	changes = diffPointer(changes, prefix+"region", e.Region, other.Region)
+	return changes
+}
+
+func (e *EmailProviderCredentialsSES) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsSES)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetSMTPHost returns the SMTPHost field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSMTP) GetSMTPHost() string {
+	if e == nil || e.SMTPHost == nil {
+		return ""
+	}
+	return *e.SMTPHost
+}
+
+// GetSMTPPass returns the SMTPPass field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSMTP) GetSMTPPass() string {
+	if e == nil || e.SMTPPass == nil {
+		return ""
+	}
+	return *e.SMTPPass
+}
+
+// GetSMTPPort returns the SMTPPort field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSMTP) GetSMTPPort() int {
+	if e == nil || e.SMTPPort == nil {
+		return 0
+	}
+	return *e.SMTPPort
+}
+
+// GetSMTPUser returns the SMTPUser field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSMTP) GetSMTPUser() string {
+	if e == nil || e.SMTPUser == nil {
+		return ""
+	}
+	return *e.SMTPUser
+}
+
+// String returns a string representation of EmailProviderCredentialsSMTP.
+func (e *EmailProviderCredentialsSMTP) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsSMTP.
+func (e *EmailProviderCredentialsSMTP) Clone() *EmailProviderCredentialsSMTP {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsSMTP{}
+	clone.SMTPHost = clonePointer(e.SMTPHost)
+	clone.SMTPPort = clonePointer(e.SMTPPort)
+	clone.SMTPUser = clonePointer(e.SMTPUser)
+	clone.SMTPPass = clonePointer(e.SMTPPass)
+	return clone
+}
+
+func (e *EmailProviderCredentialsSMTP) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsSMTP and other hold the same values.
+func (e *EmailProviderCredentialsSMTP) Equal(other *EmailProviderCredentialsSMTP) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsSMTP and other.
+func (e *EmailProviderCredentialsSMTP) Diff(other *EmailProviderCredentialsSMTP) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsSMTP) diff(prefix string, other *EmailProviderCredentialsSMTP) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsSMTP{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsSMTP{}
+	}
+	changes = diffPointer(changes, prefix+"smtp_host", e.SMTPHost, other.SMTPHost)
+	changes = diffPointer(changes, prefix+"smtp_port", e.SMTPPort, other.SMTPPort)
+	changes = diffPointer(changes, prefix+"smtp_user", e.SMTPUser, other.SMTPUser)
+	changes = diffPointer(changes, prefix+"smtp_pass", e.SMTPPass, other.SMTPPass)
+	return changes
+}
+
+func (e *EmailProviderCredentialsSMTP) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsSMTP)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSparkPost) GetAPIKey() string {
+	if e == nil || e.APIKey == nil {
+		return ""
+	}
+	return *e.APIKey
+}
+
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (e *EmailProviderCredentialsSparkPost) GetRegion() string {
+	if e == nil || e.Region == nil {
+		return ""
+	}
+	return *e.Region
+}
+
+// String returns a string representation of EmailProviderCredentialsSparkPost.
+func (e *EmailProviderCredentialsSparkPost) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderCredentialsSparkPost.
+func (e *EmailProviderCredentialsSparkPost) Clone() *EmailProviderCredentialsSparkPost {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderCredentialsSparkPost{}
+	clone.APIKey = clonePointer(e.APIKey)
+	clone.Region = clonePointer(e.Region)
+	return clone
+}
+
+func (e *EmailProviderCredentialsSparkPost) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderCredentialsSparkPost and other hold the same values.
+func (e *EmailProviderCredentialsSparkPost) Equal(other *EmailProviderCredentialsSparkPost) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderCredentialsSparkPost and other.
+func (e *EmailProviderCredentialsSparkPost) Diff(other *EmailProviderCredentialsSparkPost) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderCredentialsSparkPost) diff(prefix string, other *EmailProviderCredentialsSparkPost) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderCredentialsSparkPost{}
+	}
+	if other == nil {
+		other = &EmailProviderCredentialsSparkPost{}
+	}
+	changes = diffPointer(changes, prefix+"api_key", e.APIKey, other.APIKey)
+	changes = diffPointer(changes, prefix+"region", e.Region, other.Region)
+	return changes
+}
+
+func (e *EmailProviderCredentialsSparkPost) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderCredentialsSparkPost)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetMessage returns the Message field.
+func (e *EmailProviderSettingsMandrill) GetMessage() *EmailProviderSettingsMandrillMessage {
+	if e == nil {
+		return nil
+	}
+	return e.Message
+}
+
+// String returns a string representation of EmailProviderSettingsMandrill.
+func (e *EmailProviderSettingsMandrill) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsMandrill.
+func (e *EmailProviderSettingsMandrill) Clone() *EmailProviderSettingsMandrill {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsMandrill{}
+	clone.Message = e.Message.Clone()
+	return clone
+}
+
+func (e *EmailProviderSettingsMandrill) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsMandrill and other hold the same values.
+func (e *EmailProviderSettingsMandrill) Equal(other *EmailProviderSettingsMandrill) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsMandrill and other.
+func (e *EmailProviderSettingsMandrill) Diff(other *EmailProviderSettingsMandrill) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsMandrill) diff(prefix string, other *EmailProviderSettingsMandrill) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsMandrill{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsMandrill{}
+	}
+	changes = append(changes, e.Message.diff(prefix+"message.", other.Message)...)
+	return changes
+}
+
+func (e *EmailProviderSettingsMandrill) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsMandrill)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetViewContentLink returns the ViewContentLink field if it's non-nil, zero value otherwise.
+func (e *EmailProviderSettingsMandrillMessage) GetViewContentLink() bool {
+	if e == nil || e.ViewContentLink == nil {
+		return false
+	}
+	return *e.ViewContentLink
+}
+
+// String returns a string representation of EmailProviderSettingsMandrillMessage.
+func (e *EmailProviderSettingsMandrillMessage) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsMandrillMessage.
+func (e *EmailProviderSettingsMandrillMessage) Clone() *EmailProviderSettingsMandrillMessage {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsMandrillMessage{}
+	clone.ViewContentLink = clonePointer(e.ViewContentLink)
+	return clone
+}
+
+func (e *EmailProviderSettingsMandrillMessage) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsMandrillMessage and other hold the same values.
+func (e *EmailProviderSettingsMandrillMessage) Equal(other *EmailProviderSettingsMandrillMessage) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsMandrillMessage and other.
+func (e *EmailProviderSettingsMandrillMessage) Diff(other *EmailProviderSettingsMandrillMessage) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsMandrillMessage) diff(prefix string, other *EmailProviderSettingsMandrillMessage) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsMandrillMessage{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsMandrillMessage{}
+	}
+	changes = diffPointer(changes, prefix+"view_content_link", e.ViewContentLink, other.ViewContentLink)
+	return changes
+}
+
+func (e *EmailProviderSettingsMandrillMessage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsMandrillMessage)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetMessage returns the Message field.
+func (e *EmailProviderSettingsSES) GetMessage() *EmailProviderSettingsSESMessage {
+	if e == nil {
+		return nil
+	}
+	return e.Message
+}
+
+// String returns a string representation of EmailProviderSettingsSES.
+func (e *EmailProviderSettingsSES) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsSES.
+func (e *EmailProviderSettingsSES) Clone() *EmailProviderSettingsSES {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsSES{}
+	clone.Message = e.Message.Clone()
+	return clone
+}
+
+func (e *EmailProviderSettingsSES) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsSES and other hold the same values.
+func (e *EmailProviderSettingsSES) Equal(other *EmailProviderSettingsSES) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsSES and other.
+func (e *EmailProviderSettingsSES) Diff(other *EmailProviderSettingsSES) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsSES) diff(prefix string, other *EmailProviderSettingsSES) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsSES{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsSES{}
+	}
+	changes = append(changes, e.Message.diff(prefix+"message.", other.Message)...)
+	return changes
+}
+
+func (e *EmailProviderSettingsSES) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsSES)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetConfigurationSetName returns the ConfigurationSetName field if it's non-nil, zero value otherwise.
+func (e *EmailProviderSettingsSESMessage) GetConfigurationSetName() string {
+	if e == nil || e.ConfigurationSetName == nil {
+		return ""
+	}
+	return *e.ConfigurationSetName
+}
+
+// String returns a string representation of EmailProviderSettingsSESMessage.
+func (e *EmailProviderSettingsSESMessage) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsSESMessage.
+func (e *EmailProviderSettingsSESMessage) Clone() *EmailProviderSettingsSESMessage {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsSESMessage{}
+	clone.ConfigurationSetName = clonePointer(e.ConfigurationSetName)
+	return clone
+}
+
+func (e *EmailProviderSettingsSESMessage) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsSESMessage and other hold the same values.
+func (e *EmailProviderSettingsSESMessage) Equal(other *EmailProviderSettingsSESMessage) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsSESMessage and other.
+func (e *EmailProviderSettingsSESMessage) Diff(other *EmailProviderSettingsSESMessage) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsSESMessage) diff(prefix string, other *EmailProviderSettingsSESMessage) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsSESMessage{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsSESMessage{}
+	}
+	changes = diffPointer(changes, prefix+"configuration_set_name", e.ConfigurationSetName, other.ConfigurationSetName)
+	return changes
+}
+
+func (e *EmailProviderSettingsSESMessage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsSESMessage)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetHeaders returns the Headers field.
+func (e *EmailProviderSettingsSMTP) GetHeaders() *EmailProviderSettingsSMTPHeaders {
+	if e == nil {
+		return nil
+	}
+	return e.Headers
+}
+
+// String returns a string representation of EmailProviderSettingsSMTP.
+func (e *EmailProviderSettingsSMTP) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsSMTP.
+func (e *EmailProviderSettingsSMTP) Clone() *EmailProviderSettingsSMTP {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsSMTP{}
+	clone.Headers = e.Headers.Clone()
+	return clone
+}
+
+func (e *EmailProviderSettingsSMTP) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsSMTP and other hold the same values.
+func (e *EmailProviderSettingsSMTP) Equal(other *EmailProviderSettingsSMTP) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsSMTP and other.
+func (e *EmailProviderSettingsSMTP) Diff(other *EmailProviderSettingsSMTP) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsSMTP) diff(prefix string, other *EmailProviderSettingsSMTP) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsSMTP{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsSMTP{}
+	}
+	changes = append(changes, e.Headers.diff(prefix+"headers.", other.Headers)...)
+	return changes
+}
+
+func (e *EmailProviderSettingsSMTP) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsSMTP)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetXMCViewContentLink returns the XMCViewContentLink field if it's non-nil, zero value otherwise.
+func (e *EmailProviderSettingsSMTPHeaders) GetXMCViewContentLink() string {
+	if e == nil || e.XMCViewContentLink == nil {
+		return ""
+	}
+	return *e.XMCViewContentLink
+}
+
+// GetXSESConfigurationSet returns the XSESConfigurationSet field if it's non-nil, zero value otherwise.
+func (e *EmailProviderSettingsSMTPHeaders) GetXSESConfigurationSet() string {
+	if e == nil || e.XSESConfigurationSet == nil {
+		return ""
+	}
+	return *e.XSESConfigurationSet
+}
+
+// String returns a string representation of EmailProviderSettingsSMTPHeaders.
+func (e *EmailProviderSettingsSMTPHeaders) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailProviderSettingsSMTPHeaders.
+func (e *EmailProviderSettingsSMTPHeaders) Clone() *EmailProviderSettingsSMTPHeaders {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailProviderSettingsSMTPHeaders{}
+	clone.XMCViewContentLink = clonePointer(e.XMCViewContentLink)
+	clone.XSESConfigurationSet = clonePointer(e.XSESConfigurationSet)
+	return clone
+}
+
+func (e *EmailProviderSettingsSMTPHeaders) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailProviderSettingsSMTPHeaders and other hold the same values.
+func (e *EmailProviderSettingsSMTPHeaders) Equal(other *EmailProviderSettingsSMTPHeaders) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailProviderSettingsSMTPHeaders and other.
+func (e *EmailProviderSettingsSMTPHeaders) Diff(other *EmailProviderSettingsSMTPHeaders) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailProviderSettingsSMTPHeaders) diff(prefix string, other *EmailProviderSettingsSMTPHeaders) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailProviderSettingsSMTPHeaders{}
+	}
+	if other == nil {
+		other = &EmailProviderSettingsSMTPHeaders{}
+	}
+	changes = diffPointer(changes, prefix+"X-MC-ViewContentLink", e.XMCViewContentLink, other.XMCViewContentLink)
+	changes = diffPointer(changes, prefix+"X-SES-Configuration-Set", e.XSESConfigurationSet, other.XSESConfigurationSet)
+	return changes
+}
+
+func (e *EmailProviderSettingsSMTPHeaders) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailProviderSettingsSMTPHeaders)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetBody returns the Body field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetBody() string {
+	if e == nil || e.Body == nil {
+		return ""
+	}
+	return *e.Body
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetEnabled() bool {
+	if e == nil || e.Enabled == nil {
+		return false
+	}
+	return *e.Enabled
+}
+
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetFrom() string {
+	if e == nil || e.From == nil {
+		return ""
+	}
+	return *e.From
+}
+
+// GetIncludeEmailInRedirect returns the IncludeEmailInRedirect field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetIncludeEmailInRedirect() bool {
+	if e == nil || e.IncludeEmailInRedirect == nil {
+		return false
+	}
+	return *e.IncludeEmailInRedirect
+}
+
+// GetResultURL returns the ResultURL field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetResultURL() string {
+	if e == nil || e.ResultURL == nil {
+		return ""
+	}
+	return *e.ResultURL
+}
+
+// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetSubject() string {
+	if e == nil || e.Subject == nil {
+		return ""
+	}
+	return *e.Subject
+}
+
+// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetSyntax() string {
+	if e == nil || e.Syntax == nil {
+		return ""
+	}
+	return *e.Syntax
+}
+
+// GetTemplate returns the Template field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetTemplate() string {
+	if e == nil || e.Template == nil {
+		return ""
+	}
+	return *e.Template
+}
+
+// GetURLLifetimeInSecoonds returns the URLLifetimeInSecoonds field if it's non-nil, zero value otherwise.
+func (e *EmailTemplate) GetURLLifetimeInSecoonds() int {
+	if e == nil || e.URLLifetimeInSecoonds == nil {
+		return 0
+	}
+	return *e.URLLifetimeInSecoonds
+}
+
+// String returns a string representation of EmailTemplate.
+func (e *EmailTemplate) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EmailTemplate.
+func (e *EmailTemplate) Clone() *EmailTemplate {
+	if e == nil {
+		return nil
+	}
+	clone := &EmailTemplate{}
+	clone.Template = clonePointer(e.Template)
+	clone.Body = clonePointer(e.Body)
+	clone.From = clonePointer(e.From)
+	clone.ResultURL = clonePointer(e.ResultURL)
+	clone.Subject = clonePointer(e.Subject)
+	clone.Syntax = clonePointer(e.Syntax)
+	clone.URLLifetimeInSecoonds = clonePointer(e.URLLifetimeInSecoonds)
+	clone.Enabled = clonePointer(e.Enabled)
+	clone.IncludeEmailInRedirect = clonePointer(e.IncludeEmailInRedirect)
+	return clone
+}
+
+func (e *EmailTemplate) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EmailTemplate and other hold the same values.
+func (e *EmailTemplate) Equal(other *EmailTemplate) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EmailTemplate and other.
+func (e *EmailTemplate) Diff(other *EmailTemplate) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EmailTemplate) diff(prefix string, other *EmailTemplate) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EmailTemplate{}
+	}
+	if other == nil {
+		other = &EmailTemplate{}
+	}
+	changes = diffPointer(changes, prefix+"template", e.Template, other.Template)
+	changes = diffPointer(changes, prefix+"body", e.Body, other.Body)
+	changes = diffPointer(changes, prefix+"from", e.From, other.From)
+	changes = diffPointer(changes, prefix+"resultUrl", e.ResultURL, other.ResultURL)
+	changes = diffPointer(changes, prefix+"subject", e.Subject, other.Subject)
+	changes = diffPointer(changes, prefix+"syntax", e.Syntax, other.Syntax)
+	changes = diffPointer(changes, prefix+"urlLifetimeInSeconds", e.URLLifetimeInSecoonds, other.URLLifetimeInSecoonds)
+	changes = diffPointer(changes, prefix+"enabled", e.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"includeEmailInRedirect", e.IncludeEmailInRedirect, other.IncludeEmailInRedirect)
+	return changes
+}
+
+func (e *EmailTemplate) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EmailTemplate)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (e *EnabledClientsChange) GetConnectionID() string {
+	if e == nil || e.ConnectionID == nil {
+		return ""
+	}
+	return *e.ConnectionID
+}
+
+// GetDisable returns the Disable field if it's non-nil, zero value otherwise.
+func (e *EnabledClientsChange) GetDisable() []string {
+	if e == nil || e.Disable == nil {
+		return nil
+	}
+	return *e.Disable
+}
+
+// GetEnable returns the Enable field if it's non-nil, zero value otherwise.
+func (e *EnabledClientsChange) GetEnable() []string {
+	if e == nil || e.Enable == nil {
+		return nil
+	}
+	return *e.Enable
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (e *EnabledClientsChange) GetError() string {
+	if e == nil || e.Error == nil {
+		return ""
+	}
+	return *e.Error
+}
+
+// String returns a string representation of EnabledClientsChange.
+func (e *EnabledClientsChange) String() string {
+	return Stringify(e)
+}
+
+// GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetEnrolledAt() time.Time {
+	if e == nil || e.EnrolledAt == nil {
+		return time.Time{}
+	}
+	return *e.EnrolledAt
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetID() string {
+	if e == nil || e.ID == nil {
+		return ""
+	}
+	return *e.ID
+}
+
+// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetIdentifier() string {
+	if e == nil || e.Identifier == nil {
+		return ""
+	}
+	return *e.Identifier
+}
+
+// GetLastAuth returns the LastAuth field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetLastAuth() time.Time {
+	if e == nil || e.LastAuth == nil {
+		return time.Time{}
+	}
+	return *e.LastAuth
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetName() string {
+	if e == nil || e.Name == nil {
+		return ""
+	}
+	return *e.Name
+}
+
+// GetPhoneNumber returns the PhoneNumber field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetPhoneNumber() string {
+	if e == nil || e.PhoneNumber == nil {
+		return ""
+	}
+	return *e.PhoneNumber
+}
+
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (e *Enrollment) GetStatus() string {
+	if e == nil || e.Status == nil {
+		return ""
+	}
+	return *e.Status
+}
+
+// String returns a string representation of Enrollment.
+func (e *Enrollment) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of Enrollment.
+func (e *Enrollment) Clone() *Enrollment {
+	if e == nil {
+		return nil
+	}
+	clone := &Enrollment{}
+	clone.ID = clonePointer(e.ID)
+	clone.Status = clonePointer(e.Status)
+	clone.Name = clonePointer(e.Name)
+	clone.Identifier = clonePointer(e.Identifier)
+	clone.PhoneNumber = clonePointer(e.PhoneNumber)
+	clone.EnrolledAt = clonePointer(e.EnrolledAt)
+	clone.LastAuth = clonePointer(e.LastAuth)
+	return clone
+}
+
+func (e *Enrollment) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether Enrollment and other hold the same values.
+func (e *Enrollment) Equal(other *Enrollment) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Enrollment and other.
+func (e *Enrollment) Diff(other *Enrollment) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *Enrollment) diff(prefix string, other *Enrollment) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &Enrollment{}
+	}
+	if other == nil {
+		other = &Enrollment{}
+	}
+	changes = diffPointer(changes, prefix+"id", e.ID, other.ID)
+	changes = diffPointer(changes, prefix+"status", e.Status, other.Status)
+	changes = diffPointer(changes, prefix+"name", e.Name, other.Name)
+	changes = diffPointer(changes, prefix+"identifier", e.Identifier, other.Identifier)
+	changes = diffPointer(changes, prefix+"phone_number", e.PhoneNumber, other.PhoneNumber)
+	changes = diffTime(changes, prefix+"enrolled_at", e.EnrolledAt, other.EnrolledAt)
+	changes = diffTime(changes, prefix+"last_auth", e.LastAuth, other.LastAuth)
+	return changes
+}
+
+func (e *Enrollment) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Enrollment)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// String returns a string representation of EnrollmentTicket.
+func (e *EnrollmentTicket) String() string {
+	return Stringify(e)
+}
+
+// Clone returns a deep copy of EnrollmentTicket.
+func (e *EnrollmentTicket) Clone() *EnrollmentTicket {
+	if e == nil {
+		return nil
+	}
+	clone := &EnrollmentTicket{}
+	clone.TicketID = e.TicketID
+	clone.TicketURL = e.TicketURL
+	return clone
+}
+
+func (e *EnrollmentTicket) cloneAny() interface{} {
+	return e.Clone()
+}
+
+// Equal reports whether EnrollmentTicket and other hold the same values.
+func (e *EnrollmentTicket) Equal(other *EnrollmentTicket) bool {
+	return len(e.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between EnrollmentTicket and other.
+func (e *EnrollmentTicket) Diff(other *EnrollmentTicket) []FieldChange {
+	return e.diff("", other)
+}
+
+func (e *EnrollmentTicket) diff(prefix string, other *EnrollmentTicket) (changes []FieldChange) {
+	if e == nil && other == nil {
+		return nil
+	}
+	if e == nil {
+		e = &EnrollmentTicket{}
+	}
+	if other == nil {
+		other = &EnrollmentTicket{}
+	}
+	changes = diffComparable(changes, prefix+"ticket_id", e.TicketID, other.TicketID)
+	changes = diffComparable(changes, prefix+"ticket_url", e.TicketURL, other.TicketURL)
+	return changes
+}
+
+func (e *EnrollmentTicket) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*EnrollmentTicket)
+	if !ok {
+		return nil, false
+	}
+	return e.diff(prefix, typed), true
+}
+
+// String returns a string representation of FieldChange.
+func (f *FieldChange) String() string {
+	return Stringify(f)
+}
+
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (g *Grant) GetAudience() string {
+	if g == nil || g.Audience == nil {
+		return ""
+	}
+	return *g.Audience
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetClientID() string {
+	if g == nil || g.ClientID == nil {
+		return ""
+	}
+	return *g.ClientID
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetID() string {
+	if g == nil || g.ID == nil {
+		return ""
+	}
+	return *g.ID
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (g *Grant) GetUserID() string {
+	if g == nil || g.UserID == nil {
+		return ""
+	}
+	return *g.UserID
+}
+
+// String returns a string representation of Grant.
+func (g *Grant) String() string {
+	return Stringify(g)
+}
+
+// Clone returns a deep copy of Grant.
+func (g *Grant) Clone() *Grant {
+	if g == nil {
+		return nil
+	}
+	clone := &Grant{}
+	clone.ID = clonePointer(g.ID)
+	clone.ClientID = clonePointer(g.ClientID)
+	clone.UserID = clonePointer(g.UserID)
+	clone.Audience = clonePointer(g.Audience)
+	clone.Scope = cloneSliceFunc(g.Scope, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (g *Grant) cloneAny() interface{} {
+	return g.Clone()
+}
+
+// Equal reports whether Grant and other hold the same values.
+func (g *Grant) Equal(other *Grant) bool {
+	return len(g.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Grant and other.
+func (g *Grant) Diff(other *Grant) []FieldChange {
+	return g.diff("", other)
+}
+
+func (g *Grant) diff(prefix string, other *Grant) (changes []FieldChange) {
+	if g == nil && other == nil {
+		return nil
+	}
+	if g == nil {
+		g = &Grant{}
+	}
+	if other == nil {
+		other = &Grant{}
+	}
+	changes = diffPointer(changes, prefix+"id", g.ID, other.ID)
+	changes = diffPointer(changes, prefix+"clientID", g.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"user_id", g.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"audience", g.Audience, other.Audience)
+	changes = diffSlice(changes, prefix+"scope", g.Scope, other.Scope)
+	return changes
+}
+
+func (g *Grant) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Grant)
+	if !ok {
+		return nil, false
+	}
+	return g.diff(prefix, typed), true
+}
+
+// String returns a string representation of GrantList.
+func (g *GrantList) String() string {
+	return Stringify(g)
+}
+
+// String returns a string representation of HomeRealmDiscovery.
+func (h *HomeRealmDiscovery) String() string {
+	return Stringify(h)
+}
+
+// GetConnection returns the Connection field.
+func (h *HomeRealmMatch) GetConnection() *Connection {
+	if h == nil {
+		return nil
+	}
+	return h.Connection
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (h *HomeRealmMatch) GetDomain() string {
+	if h == nil || h.Domain == nil {
+		return ""
+	}
+	return *h.Domain
+}
+
+// GetOrganization returns the Organization field if it's non-nil, zero value otherwise.
+func (h *HomeRealmMatch) GetOrganization() bool {
+	if h == nil || h.Organization == nil {
+		return false
+	}
+	return *h.Organization
+}
+
+// String returns a string representation of HomeRealmMatch.
+func (h *HomeRealmMatch) String() string {
+	return Stringify(h)
+}
+
+// GetDependencies returns the Dependencies field if it's non-nil, zero value otherwise.
+func (h *Hook) GetDependencies() map[string]string {
+	if h == nil || h.Dependencies == nil {
+		return map[string]string{}
+	}
+	return *h.Dependencies
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (h *Hook) GetEnabled() bool {
+	if h == nil || h.Enabled == nil {
+		return false
+	}
+	return *h.Enabled
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetID() string {
+	if h == nil || h.ID == nil {
+		return ""
+	}
+	return *h.ID
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (h *Hook) GetName() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetScript returns the Script field if it's non-nil, zero value otherwise.
+func (h *Hook) GetScript() string {
+	if h == nil || h.Script == nil {
+		return ""
+	}
+	return *h.Script
+}
+
+// GetTriggerID returns the TriggerID field if it's non-nil, zero value otherwise.
+func (h *Hook) GetTriggerID() string {
+	if h == nil || h.TriggerID == nil {
+		return ""
+	}
+	return *h.TriggerID
+}
+
+// String returns a string representation of Hook.
+func (h *Hook) String() string {
+	return Stringify(h)
+}
+
+// Clone returns a deep copy of Hook.
+func (h *Hook) Clone() *Hook {
+	if h == nil {
+		return nil
+	}
+	clone := &Hook{}
+	clone.ID = clonePointer(h.ID)
+	clone.Name = clonePointer(h.Name)
+	clone.Script = clonePointer(h.Script)
+	clone.TriggerID = clonePointer(h.TriggerID)
+	clone.Dependencies = clonePointerFunc(h.Dependencies, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.Enabled = clonePointer(h.Enabled)
+	return clone
+}
+
+func (h *Hook) cloneAny() interface{} {
+	return h.Clone()
+}
+
+// Equal reports whether Hook and other hold the same values.
+func (h *Hook) Equal(other *Hook) bool {
+	return len(h.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Hook and other.
+func (h *Hook) Diff(other *Hook) []FieldChange {
+	return h.diff("", other)
+}
+
+func (h *Hook) diff(prefix string, other *Hook) (changes []FieldChange) {
+	if h == nil && other == nil {
+		return nil
+	}
+	if h == nil {
+		h = &Hook{}
+	}
+	if other == nil {
+		other = &Hook{}
+	}
+	changes = diffPointer(changes, prefix+"id", h.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", h.Name, other.Name)
+	changes = diffPointer(changes, prefix+"script", h.Script, other.Script)
+	changes = diffPointer(changes, prefix+"triggerId", h.TriggerID, other.TriggerID)
+	changes = diffMapPointer(changes, prefix+"dependencies", h.Dependencies, other.Dependencies)
+	changes = diffPointer(changes, prefix+"enabled", h.Enabled, other.Enabled)
+	return changes
+}
+
+func (h *Hook) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Hook)
+	if !ok {
+		return nil, false
+	}
+	return h.diff(prefix, typed), true
+}
+
+// String returns a string representation of HookList.
+func (h *HookList) String() string {
+	return Stringify(h)
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (j *Job) GetClientID() string {
+	if j == nil || j.ClientID == nil {
+		return ""
+	}
+	return *j.ClientID
+}
+
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (j *Job) GetConnectionID() string {
+	if j == nil || j.ConnectionID == nil {
+		return ""
+	}
+	return *j.ConnectionID
+}
+
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (j *Job) GetCreatedAt() time.Time {
+	if j == nil || j.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *j.CreatedAt
+}
+
+// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
+func (j *Job) GetExternalID() string {
+	if j == nil || j.ExternalID == nil {
+		return ""
+	}
+	return *j.ExternalID
+}
+
+// GetFormat returns the Format field if it's non-nil, zero value otherwise.
+func (j *Job) GetFormat() string {
+	if j == nil || j.Format == nil {
+		return ""
+	}
+	return *j.Format
+}
+
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (j *Job) GetID() string {
+	if j == nil || j.ID == nil {
+		return ""
+	}
+	return *j.ID
+}
+
+// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
+func (j *Job) GetLimit() int {
+	if j == nil || j.Limit == nil {
+		return 0
+	}
+	return *j.Limit
+}
+
+// GetLocation returns the Location field if it's non-nil, zero value otherwise.
+func (j *Job) GetLocation() string {
+	if j == nil || j.Location == nil {
+		return ""
+	}
+	return *j.Location
+}
+
+// GetPercentageDone returns the PercentageDone field if it's non-nil, zero value otherwise.
+func (j *Job) GetPercentageDone() int {
+	if j == nil || j.PercentageDone == nil {
+		return 0
+	}
+	return *j.PercentageDone
 }
 
-// GetLatitudeCity returns the LatitudeCity field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetLatitudeCity() bool {
-	if c == nil || c.LatitudeCity == nil {
+// GetSendCompletionEmail returns the SendCompletionEmail field if it's non-nil, zero value otherwise.
+func (j *Job) GetSendCompletionEmail() bool {
+	if j == nil || j.SendCompletionEmail == nil {
 		return false
 	}
-	return *c.LatitudeCity
+	return *j.SendCompletionEmail
 }
 
-// GetModerator returns the Moderator field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetModerator() bool {
-	if c == nil || c.Moderator == nil {
-		return false
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (j *Job) GetStatus() string {
+	if j == nil || j.Status == nil {
+		return ""
 	}
-	return *c.Moderator
+	return *j.Status
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// GetSummary returns the Summary field.
+func (j *Job) GetSummary() *JobSummary {
+	if j == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	return j.Summary
 }
 
-// GetOrkut returns the Orkut field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetOrkut() bool {
-	if c == nil || c.Orkut == nil {
-		return false
+// GetTimeLeftSeconds returns the TimeLeftSeconds field if it's non-nil, zero value otherwise.
+func (j *Job) GetTimeLeftSeconds() int {
+	if j == nil || j.TimeLeftSeconds == nil {
+		return 0
 	}
-	return *c.Orkut
+	return *j.TimeLeftSeconds
 }
 
-// GetPicasaWeb returns the PicasaWeb field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetPicasaWeb() bool {
-	if c == nil || c.PicasaWeb == nil {
-		return false
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (j *Job) GetType() string {
+	if j == nil || j.Type == nil {
+		return ""
 	}
-	return *c.PicasaWeb
+	return *j.Type
 }
 
-// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetProfile() bool {
-	if c == nil || c.Profile == nil {
+// GetUpsert returns the Upsert field if it's non-nil, zero value otherwise.
+func (j *Job) GetUpsert() bool {
+	if j == nil || j.Upsert == nil {
 		return false
 	}
-	return *c.Profile
+	return *j.Upsert
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (j *Job) GetUserID() string {
+	if j == nil || j.UserID == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *j.UserID
 }
 
-// GetSites returns the Sites field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetSites() bool {
-	if c == nil || c.Sites == nil {
-		return false
-	}
-	return *c.Sites
+// String returns a string representation of Job.
+func (j *Job) String() string {
+	return Stringify(j)
 }
 
-// GetSpreadsheets returns the Spreadsheets field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetSpreadsheets() bool {
-	if c == nil || c.Spreadsheets == nil {
-		return false
+// Clone returns a deep copy of Job.
+func (j *Job) Clone() *Job {
+	if j == nil {
+		return nil
 	}
-	return *c.Spreadsheets
+	clone := &Job{}
+	clone.ID = clonePointer(j.ID)
+	clone.Status = clonePointer(j.Status)
+	clone.Type = clonePointer(j.Type)
+	clone.CreatedAt = clonePointer(j.CreatedAt)
+	clone.UserID = clonePointer(j.UserID)
+	clone.ClientID = clonePointer(j.ClientID)
+	clone.ConnectionID = clonePointer(j.ConnectionID)
+	clone.Location = clonePointer(j.Location)
+	clone.PercentageDone = clonePointer(j.PercentageDone)
+	clone.TimeLeftSeconds = clonePointer(j.TimeLeftSeconds)
+	clone.Format = clonePointer(j.Format)
+	clone.Limit = clonePointer(j.Limit)
+	clone.Fields = cloneSliceFunc(j.Fields, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	clone.Users = cloneSliceFunc(j.Users, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	clone.Upsert = clonePointer(j.Upsert)
+	clone.ExternalID = clonePointer(j.ExternalID)
+	clone.SendCompletionEmail = clonePointer(j.SendCompletionEmail)
+	clone.Summary = j.Summary.Clone()
+	return clone
+}
+
+func (j *Job) cloneAny() interface{} {
+	return j.Clone()
+}
+
+// Equal reports whether Job and other hold the same values.
+func (j *Job) Equal(other *Job) bool {
+	return len(j.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Job and other.
+func (j *Job) Diff(other *Job) []FieldChange {
+	return j.diff("", other)
+}
+
+func (j *Job) diff(prefix string, other *Job) (changes []FieldChange) {
+	if j == nil && other == nil {
+		return nil
+	}
+	if j == nil {
+		j = &Job{}
+	}
+	if other == nil {
+		other = &Job{}
+	}
+	changes = diffPointer(changes, prefix+"id", j.ID, other.ID)
+	changes = diffPointer(changes, prefix+"status", j.Status, other.Status)
+	changes = diffPointer(changes, prefix+"type", j.Type, other.Type)
+	changes = diffTime(changes, prefix+"created_at", j.CreatedAt, other.CreatedAt)
+	changes = diffPointer(changes, prefix+"user_id", j.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"client_id", j.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"connection_id", j.ConnectionID, other.ConnectionID)
+	changes = diffPointer(changes, prefix+"location", j.Location, other.Location)
+	changes = diffPointer(changes, prefix+"percentage_done", j.PercentageDone, other.PercentageDone)
+	changes = diffPointer(changes, prefix+"time_left_seconds", j.TimeLeftSeconds, other.TimeLeftSeconds)
+	changes = diffPointer(changes, prefix+"format", j.Format, other.Format)
+	changes = diffPointer(changes, prefix+"limit", j.Limit, other.Limit)
+	changes = diffSlice(changes, prefix+"fields", j.Fields, other.Fields)
+	changes = diffSlice(changes, prefix+"users", j.Users, other.Users)
+	changes = diffPointer(changes, prefix+"upsert", j.Upsert, other.Upsert)
+	changes = diffPointer(changes, prefix+"external_id", j.ExternalID, other.ExternalID)
+	changes = diffPointer(changes, prefix+"send_completion_email", j.SendCompletionEmail, other.SendCompletionEmail)
+	changes = append(changes, j.Summary.diff(prefix+"summary.", other.Summary)...)
+	return changes
+}
+
+func (j *Job) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Job)
+	if !ok {
+		return nil, false
+	}
+	return j.diff(prefix, typed), true
 }
 
-// GetTasks returns the Tasks field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetTasks() bool {
-	if c == nil || c.Tasks == nil {
-		return false
-	}
-	return *c.Tasks
+// String returns a string representation of JobError.
+func (j *JobError) String() string {
+	return Stringify(j)
 }
 
-// GetURLShortener returns the URLShortener field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetURLShortener() bool {
-	if c == nil || c.URLShortener == nil {
-		return false
+// Clone returns a deep copy of JobError.
+func (j *JobError) Clone() *JobError {
+	if j == nil {
+		return nil
 	}
-	return *c.URLShortener
+	clone := &JobError{}
+	clone.User = cloneMapFunc(j.User, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.Errors = cloneSliceFunc(j.Errors, func(v JobUserErrors) JobUserErrors { return *v.Clone() })
+	return clone
 }
 
-// GetWebmasterTools returns the WebmasterTools field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetWebmasterTools() bool {
-	if c == nil || c.WebmasterTools == nil {
-		return false
-	}
-	return *c.WebmasterTools
+func (j *JobError) cloneAny() interface{} {
+	return j.Clone()
 }
 
-// GetYoutube returns the Youtube field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsGoogleOAuth2) GetYoutube() bool {
-	if c == nil || c.Youtube == nil {
-		return false
-	}
-	return *c.Youtube
+// Equal reports whether JobError and other hold the same values.
+func (j *JobError) Equal(other *JobError) bool {
+	return len(j.diff("", other)) == 0
 }
 
-// String returns a string representation of ConnectionOptionsGoogleOAuth2.
-func (c *ConnectionOptionsGoogleOAuth2) String() string {
-	return Stringify(c)
+// Diff returns the fields that changed between JobError and other.
+func (j *JobError) Diff(other *JobError) []FieldChange {
+	return j.diff("", other)
 }
 
-// GetBasicProfile returns the BasicProfile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetBasicProfile() bool {
-	if c == nil || c.BasicProfile == nil {
-		return false
+func (j *JobError) diff(prefix string, other *JobError) (changes []FieldChange) {
+	if j == nil && other == nil {
+		return nil
 	}
-	return *c.BasicProfile
+	if j == nil {
+		j = &JobError{}
+	}
+	if other == nil {
+		other = &JobError{}
+	}
+	changes = diffMap(changes, prefix+"user", j.User, other.User)
+	changes = diffSlice(changes, prefix+"errors", j.Errors, other.Errors)
+	return changes
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+func (j *JobError) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*JobError)
+	if !ok {
+		return nil, false
 	}
-	return *c.ClientID
+	return j.diff(prefix, typed), true
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
+// GetFailed returns the Failed field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetFailed() int {
+	if j == nil || j.Failed == nil {
+		return 0
 	}
-	return *c.ClientSecret
+	return *j.Failed
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetEmail() bool {
-	if c == nil || c.Email == nil {
-		return false
+// GetInserted returns the Inserted field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetInserted() int {
+	if j == nil || j.Inserted == nil {
+		return 0
 	}
-	return *c.Email
+	return *j.Inserted
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
+// GetTotal returns the Total field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetTotal() int {
+	if j == nil || j.Total == nil {
+		return 0
 	}
-	return *c.NonPersistentAttrs
+	return *j.Total
 }
 
-// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetProfile() bool {
-	if c == nil || c.Profile == nil {
-		return false
+// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
+func (j *JobSummary) GetUpdated() int {
+	if j == nil || j.Updated == nil {
+		return 0
 	}
-	return *c.Profile
+	return *j.Updated
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
-	}
-	return *c.SetUserAttributes
+// String returns a string representation of JobSummary.
+func (j *JobSummary) String() string {
+	return Stringify(j)
 }
 
-// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsLinkedin) GetStrategyVersion() int {
-	if c == nil || c.StrategyVersion == nil {
-		return 0
+// Clone returns a deep copy of JobSummary.
+func (j *JobSummary) Clone() *JobSummary {
+	if j == nil {
+		return nil
 	}
-	return *c.StrategyVersion
+	clone := &JobSummary{}
+	clone.Failed = clonePointer(j.Failed)
+	clone.Updated = clonePointer(j.Updated)
+	clone.Inserted = clonePointer(j.Inserted)
+	clone.Total = clonePointer(j.Total)
+	return clone
 }
 
-// String returns a string representation of ConnectionOptionsLinkedin.
-func (c *ConnectionOptionsLinkedin) String() string {
-	return Stringify(c)
+func (j *JobSummary) cloneAny() interface{} {
+	return j.Clone()
 }
 
-// GetAuthorizationURL returns the AuthorizationURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetAuthorizationURL() string {
-	if c == nil || c.AuthorizationURL == nil {
-		return ""
-	}
-	return *c.AuthorizationURL
+// Equal reports whether JobSummary and other hold the same values.
+func (j *JobSummary) Equal(other *JobSummary) bool {
+	return len(j.diff("", other)) == 0
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
-	}
-	return *c.ClientID
+// Diff returns the fields that changed between JobSummary and other.
+func (j *JobSummary) Diff(other *JobSummary) []FieldChange {
+	return j.diff("", other)
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
+func (j *JobSummary) diff(prefix string, other *JobSummary) (changes []FieldChange) {
+	if j == nil && other == nil {
+		return nil
 	}
-	return *c.ClientSecret
+	if j == nil {
+		j = &JobSummary{}
+	}
+	if other == nil {
+		other = &JobSummary{}
+	}
+	changes = diffPointer(changes, prefix+"failed", j.Failed, other.Failed)
+	changes = diffPointer(changes, prefix+"updated", j.Updated, other.Updated)
+	changes = diffPointer(changes, prefix+"inserted", j.Inserted, other.Inserted)
+	changes = diffPointer(changes, prefix+"total", j.Total, other.Total)
+	return changes
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
-		return ""
+func (j *JobSummary) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*JobSummary)
+	if !ok {
+		return nil, false
 	}
-	return *c.LogoURL
+	return j.diff(prefix, typed), true
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// String returns a string representation of JobUserErrors.
+func (j *JobUserErrors) String() string {
+	return Stringify(j)
+}
+
+// Clone returns a deep copy of JobUserErrors.
+func (j *JobUserErrors) Clone() *JobUserErrors {
+	if j == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	clone := &JobUserErrors{}
+	clone.Code = j.Code
+	clone.Message = j.Message
+	clone.Path = j.Path
+	return clone
 }
 
-// GetPKCEEnabled returns the PKCEEnabled field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetPKCEEnabled() bool {
-	if c == nil || c.PKCEEnabled == nil {
-		return false
-	}
-	return *c.PKCEEnabled
+func (j *JobUserErrors) cloneAny() interface{} {
+	return j.Clone()
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetScope() string {
-	if c == nil || c.Scope == nil {
-		return ""
-	}
-	return *c.Scope
+// Equal reports whether JobUserErrors and other hold the same values.
+func (j *JobUserErrors) Equal(other *JobUserErrors) bool {
+	return len(j.diff("", other)) == 0
 }
 
-// GetScripts returns the Scripts field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetScripts() map[string]string {
-	if c == nil || c.Scripts == nil {
-		return map[string]string{}
-	}
-	return *c.Scripts
+// Diff returns the fields that changed between JobUserErrors and other.
+func (j *JobUserErrors) Diff(other *JobUserErrors) []FieldChange {
+	return j.diff("", other)
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
+func (j *JobUserErrors) diff(prefix string, other *JobUserErrors) (changes []FieldChange) {
+	if j == nil && other == nil {
+		return nil
 	}
-	return *c.SetUserAttributes
+	if j == nil {
+		j = &JobUserErrors{}
+	}
+	if other == nil {
+		other = &JobUserErrors{}
+	}
+	changes = diffComparable(changes, prefix+"code", j.Code, other.Code)
+	changes = diffComparable(changes, prefix+"message", j.Message, other.Message)
+	changes = diffComparable(changes, prefix+"path", j.Path, other.Path)
+	return changes
 }
 
-// GetTokenURL returns the TokenURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOAuth2) GetTokenURL() string {
-	if c == nil || c.TokenURL == nil {
-		return ""
+func (j *JobUserErrors) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*JobUserErrors)
+	if !ok {
+		return nil, false
 	}
-	return *c.TokenURL
+	return j.diff(prefix, typed), true
 }
 
-// String returns a string representation of ConnectionOptionsOAuth2.
-func (c *ConnectionOptionsOAuth2) String() string {
-	return Stringify(c)
+// String returns a string representation of List.
+func (l *List) String() string {
+	return Stringify(l)
 }
 
-// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetAuthorizationEndpoint() string {
-	if c == nil || c.AuthorizationEndpoint == nil {
+// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
+func (l *Log) GetAudience() string {
+	if l == nil || l.Audience == nil {
 		return ""
 	}
-	return *c.AuthorizationEndpoint
+	return *l.Audience
 }
 
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetClientID() string {
-	if c == nil || c.ClientID == nil {
+func (l *Log) GetClientID() string {
+	if l == nil || l.ClientID == nil {
 		return ""
 	}
-	return *c.ClientID
+	return *l.ClientID
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
+// GetClientName returns the ClientName field if it's non-nil, zero value otherwise.
+func (l *Log) GetClientName() string {
+	if l == nil || l.ClientName == nil {
 		return ""
 	}
-	return *c.ClientSecret
+	return *l.ClientName
 }
 
-// GetDiscoveryURL returns the DiscoveryURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetDiscoveryURL() string {
-	if c == nil || c.DiscoveryURL == nil {
+// GetConnection returns the Connection field if it's non-nil, zero value otherwise.
+func (l *Log) GetConnection() string {
+	if l == nil || l.Connection == nil {
 		return ""
 	}
-	return *c.DiscoveryURL
-}
-
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
-		return nil
-	}
-	return *c.DomainAliases
+	return *l.Connection
 }
 
-// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetIssuer() string {
-	if c == nil || c.Issuer == nil {
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (l *Log) GetConnectionID() string {
+	if l == nil || l.ConnectionID == nil {
 		return ""
 	}
-	return *c.Issuer
+	return *l.ConnectionID
 }
 
-// GetJWKSURI returns the JWKSURI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetJWKSURI() string {
-	if c == nil || c.JWKSURI == nil {
-		return ""
+// GetDate returns the Date field if it's non-nil, zero value otherwise.
+func (l *Log) GetDate() time.Time {
+	if l == nil || l.Date == nil {
+		return time.Time{}
 	}
-	return *c.JWKSURI
+	return *l.Date
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (l *Log) GetDescription() string {
+	if l == nil || l.Description == nil {
 		return ""
 	}
-	return *c.LogoURL
+	return *l.Description
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (l *Log) GetHostname() string {
+	if l == nil || l.Hostname == nil {
+		return ""
 	}
-	return *c.NonPersistentAttrs
+	return *l.Hostname
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetScope() string {
-	if c == nil || c.Scope == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *Log) GetID() string {
+	if l == nil || l.ID == nil {
 		return ""
 	}
-	return *c.Scope
+	return *l.ID
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetIP returns the IP field if it's non-nil, zero value otherwise.
+func (l *Log) GetIP() string {
+	if l == nil || l.IP == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.IP
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
-		return ""
+// GetIsMobile returns the IsMobile field if it's non-nil, zero value otherwise.
+func (l *Log) GetIsMobile() bool {
+	if l == nil || l.IsMobile == nil {
+		return false
 	}
-	return *c.TenantDomain
+	return *l.IsMobile
 }
 
-// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetTokenEndpoint() string {
-	if c == nil || c.TokenEndpoint == nil {
+// GetLogID returns the LogID field if it's non-nil, zero value otherwise.
+func (l *Log) GetLogID() string {
+	if l == nil || l.LogID == nil {
 		return ""
 	}
-	return *c.TokenEndpoint
+	return *l.LogID
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetType() string {
-	if c == nil || c.Type == nil {
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (l *Log) GetOrganizationID() string {
+	if l == nil || l.OrganizationID == nil {
 		return ""
 	}
-	return *c.Type
+	return *l.OrganizationID
 }
 
-// GetUserInfoEndpoint returns the UserInfoEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOIDC) GetUserInfoEndpoint() string {
-	if c == nil || c.UserInfoEndpoint == nil {
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (l *Log) GetOrganizationName() string {
+	if l == nil || l.OrganizationName == nil {
 		return ""
 	}
-	return *c.UserInfoEndpoint
-}
-
-// String returns a string representation of ConnectionOptionsOIDC.
-func (c *ConnectionOptionsOIDC) String() string {
-	return Stringify(c)
+	return *l.OrganizationName
 }
 
-// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetAuthorizationEndpoint() string {
-	if c == nil || c.AuthorizationEndpoint == nil {
+// GetScope returns the Scope field if it's non-nil, zero value otherwise.
+func (l *Log) GetScope() string {
+	if l == nil || l.Scope == nil {
 		return ""
 	}
-	return *c.AuthorizationEndpoint
+	return *l.Scope
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetClientID() string {
-	if c == nil || c.ClientID == nil {
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (l *Log) GetStrategy() string {
+	if l == nil || l.Strategy == nil {
 		return ""
 	}
-	return *c.ClientID
+	return *l.Strategy
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
+// GetStrategyType returns the StrategyType field if it's non-nil, zero value otherwise.
+func (l *Log) GetStrategyType() string {
+	if l == nil || l.StrategyType == nil {
 		return ""
 	}
-	return *c.ClientSecret
+	return *l.StrategyType
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetDomain() string {
-	if c == nil || c.Domain == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (l *Log) GetType() string {
+	if l == nil || l.Type == nil {
 		return ""
 	}
-	return *c.Domain
+	return *l.Type
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
-		return nil
+// GetUserAgent returns the UserAgent field if it's non-nil, zero value otherwise.
+func (l *Log) GetUserAgent() string {
+	if l == nil || l.UserAgent == nil {
+		return ""
 	}
-	return *c.DomainAliases
+	return *l.UserAgent
 }
 
-// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetIssuer() string {
-	if c == nil || c.Issuer == nil {
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (l *Log) GetUserID() string {
+	if l == nil || l.UserID == nil {
 		return ""
 	}
-	return *c.Issuer
+	return *l.UserID
 }
 
-// GetJWKSURI returns the JWKSURI field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetJWKSURI() string {
-	if c == nil || c.JWKSURI == nil {
+// GetUserName returns the UserName field if it's non-nil, zero value otherwise.
+func (l *Log) GetUserName() string {
+	if l == nil || l.UserName == nil {
 		return ""
 	}
-	return *c.JWKSURI
+	return *l.UserName
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
-		return ""
-	}
-	return *c.LogoURL
+// String returns a string representation of Log.
+func (l *Log) String() string {
+	return Stringify(l)
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// Clone returns a deep copy of Log.
+func (l *Log) Clone() *Log {
+	if l == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	clone := &Log{}
+	clone.ID = clonePointer(l.ID)
+	clone.LogID = clonePointer(l.LogID)
+	clone.Date = clonePointer(l.Date)
+	clone.Type = clonePointer(l.Type)
+	clone.Description = clonePointer(l.Description)
+	clone.Connection = clonePointer(l.Connection)
+	clone.ConnectionID = clonePointer(l.ConnectionID)
+	clone.OrganizationID = clonePointer(l.OrganizationID)
+	clone.OrganizationName = clonePointer(l.OrganizationName)
+	clone.ClientID = clonePointer(l.ClientID)
+	clone.ClientName = clonePointer(l.ClientName)
+	clone.IP = clonePointer(l.IP)
+	clone.Hostname = clonePointer(l.Hostname)
+	clone.Details = cloneMapFunc(l.Details, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.UserID = clonePointer(l.UserID)
+	clone.UserName = clonePointer(l.UserName)
+	clone.UserAgent = clonePointer(l.UserAgent)
+	clone.Audience = clonePointer(l.Audience)
+	clone.Scope = clonePointer(l.Scope)
+	clone.Strategy = clonePointer(l.Strategy)
+	clone.StrategyType = clonePointer(l.StrategyType)
+	clone.IsMobile = clonePointer(l.IsMobile)
+	clone.LocationInfo = cloneMapFunc(l.LocationInfo, func(v interface{}) interface{} { return cloneValue(v) })
+	return clone
+}
+
+func (l *Log) cloneAny() interface{} {
+	return l.Clone()
+}
+
+// Equal reports whether Log and other hold the same values.
+func (l *Log) Equal(other *Log) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Log and other.
+func (l *Log) Diff(other *Log) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *Log) diff(prefix string, other *Log) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
+	}
+	if l == nil {
+		l = &Log{}
+	}
+	if other == nil {
+		other = &Log{}
+	}
+	changes = diffPointer(changes, prefix+"_id", l.ID, other.ID)
+	changes = diffPointer(changes, prefix+"log_id", l.LogID, other.LogID)
+	changes = diffTime(changes, prefix+"date", l.Date, other.Date)
+	changes = diffPointer(changes, prefix+"type", l.Type, other.Type)
+	changes = diffPointer(changes, prefix+"description", l.Description, other.Description)
+	changes = diffPointer(changes, prefix+"connection", l.Connection, other.Connection)
+	changes = diffPointer(changes, prefix+"connection_id", l.ConnectionID, other.ConnectionID)
+	changes = diffPointer(changes, prefix+"organization_id", l.OrganizationID, other.OrganizationID)
+	changes = diffPointer(changes, prefix+"organization_name", l.OrganizationName, other.OrganizationName)
+	changes = diffPointer(changes, prefix+"client_id", l.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"client_name", l.ClientName, other.ClientName)
+	changes = diffPointer(changes, prefix+"ip", l.IP, other.IP)
+	changes = diffPointer(changes, prefix+"hostname", l.Hostname, other.Hostname)
+	changes = diffMap(changes, prefix+"details", l.Details, other.Details)
+	changes = diffPointer(changes, prefix+"user_id", l.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"user_name", l.UserName, other.UserName)
+	changes = diffPointer(changes, prefix+"user_agent", l.UserAgent, other.UserAgent)
+	changes = diffPointer(changes, prefix+"audience", l.Audience, other.Audience)
+	changes = diffPointer(changes, prefix+"scope", l.Scope, other.Scope)
+	changes = diffPointer(changes, prefix+"strategy", l.Strategy, other.Strategy)
+	changes = diffPointer(changes, prefix+"strategy_type", l.StrategyType, other.StrategyType)
+	changes = diffPointer(changes, prefix+"isMobile", l.IsMobile, other.IsMobile)
+	changes = diffMap(changes, prefix+"location_info", l.LocationInfo, other.LocationInfo)
+	return changes
+}
+
+func (l *Log) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Log)
+	if !ok {
+		return nil, false
+	}
+	return l.diff(prefix, typed), true
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetScope() string {
-	if c == nil || c.Scope == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (l *LogStream) GetID() string {
+	if l == nil || l.ID == nil {
 		return ""
 	}
-	return *c.Scope
+	return *l.ID
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (l *LogStream) GetName() string {
+	if l == nil || l.Name == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.Name
 }
 
-// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetTokenEndpoint() string {
-	if c == nil || c.TokenEndpoint == nil {
+// GetStatus returns the Status field if it's non-nil, zero value otherwise.
+func (l *LogStream) GetStatus() string {
+	if l == nil || l.Status == nil {
 		return ""
 	}
-	return *c.TokenEndpoint
+	return *l.Status
 }
 
-// GetUserInfoEndpoint returns the UserInfoEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOkta) GetUserInfoEndpoint() string {
-	if c == nil || c.UserInfoEndpoint == nil {
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (l *LogStream) GetType() string {
+	if l == nil || l.Type == nil {
 		return ""
 	}
-	return *c.UserInfoEndpoint
+	return *l.Type
 }
 
-// String returns a string representation of ConnectionOptionsOkta.
-func (c *ConnectionOptionsOkta) String() string {
-	return Stringify(c)
+// String returns a string representation of LogStream.
+func (l *LogStream) String() string {
+	return Stringify(l)
 }
 
-// GetLength returns the Length field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOTP) GetLength() int {
-	if c == nil || c.Length == nil {
-		return 0
+// Clone returns a deep copy of LogStream.
+func (l *LogStream) Clone() *LogStream {
+	if l == nil {
+		return nil
 	}
-	return *c.Length
+	clone := &LogStream{}
+	clone.ID = clonePointer(l.ID)
+	clone.Name = clonePointer(l.Name)
+	clone.Type = clonePointer(l.Type)
+	clone.Status = clonePointer(l.Status)
+	clone.Filters = clonePointerFunc(l.Filters, func(v []map[string]string) []map[string]string {
+		return cloneSliceFunc(v, func(v map[string]string) map[string]string { return cloneMap(v) })
+	})
+	clone.Sink = cloneValue(l.Sink)
+	return clone
+}
+
+func (l *LogStream) cloneAny() interface{} {
+	return l.Clone()
+}
+
+// Equal reports whether LogStream and other hold the same values.
+func (l *LogStream) Equal(other *LogStream) bool {
+	return len(l.diff("", other)) == 0
 }
 
-// GetTimeStep returns the TimeStep field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsOTP) GetTimeStep() int {
-	if c == nil || c.TimeStep == nil {
-		return 0
+// Diff returns the fields that changed between LogStream and other.
+func (l *LogStream) Diff(other *LogStream) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *LogStream) diff(prefix string, other *LogStream) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.TimeStep
+	if l == nil {
+		l = &LogStream{}
+	}
+	if other == nil {
+		other = &LogStream{}
+	}
+	changes = diffPointer(changes, prefix+"id", l.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", l.Name, other.Name)
+	changes = diffPointer(changes, prefix+"type", l.Type, other.Type)
+	changes = diffPointer(changes, prefix+"status", l.Status, other.Status)
+	changes = diffSlicePointer(changes, prefix+"filters", l.Filters, other.Filters)
+	changes = diffValue(changes, prefix+"sink", l.Sink, other.Sink)
+	return changes
 }
 
-// String returns a string representation of ConnectionOptionsOTP.
-func (c *ConnectionOptionsOTP) String() string {
-	return Stringify(c)
+func (l *LogStream) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStream)
+	if !ok {
+		return nil, false
+	}
+	return l.diff(prefix, typed), true
 }
 
-// GetCert returns the Cert field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetCert() string {
-	if c == nil || c.Cert == nil {
+// GetAccountID returns the AccountID field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAmazonEventBridge) GetAccountID() string {
+	if l == nil || l.AccountID == nil {
 		return ""
 	}
-	return *c.Cert
+	return *l.AccountID
 }
 
-// GetDigestAlgorithm returns the DigestAlgorithm field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetDigestAlgorithm() string {
-	if c == nil || c.DigestAlgorithm == nil {
+// GetPartnerEventSource returns the PartnerEventSource field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAmazonEventBridge) GetPartnerEventSource() string {
+	if l == nil || l.PartnerEventSource == nil {
 		return ""
 	}
-	return *c.DigestAlgorithm
+	return *l.PartnerEventSource
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
-		return nil
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAmazonEventBridge) GetRegion() string {
+	if l == nil || l.Region == nil {
+		return ""
 	}
-	return *c.DomainAliases
+	return *l.Region
 }
 
-// GetIdpInitiated returns the IdpInitiated field.
-func (c *ConnectionOptionsPingFederate) GetIdpInitiated() *ConnectionOptionsSAMLIdpInitiated {
-	if c == nil {
+// String returns a string representation of LogStreamSinkAmazonEventBridge.
+func (l *LogStreamSinkAmazonEventBridge) String() string {
+	return Stringify(l)
+}
+
+// Clone returns a deep copy of LogStreamSinkAmazonEventBridge.
+func (l *LogStreamSinkAmazonEventBridge) Clone() *LogStreamSinkAmazonEventBridge {
+	if l == nil {
 		return nil
 	}
-	return c.IdpInitiated
+	clone := &LogStreamSinkAmazonEventBridge{}
+	clone.AccountID = clonePointer(l.AccountID)
+	clone.Region = clonePointer(l.Region)
+	clone.PartnerEventSource = clonePointer(l.PartnerEventSource)
+	return clone
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
-		return ""
-	}
-	return *c.LogoURL
+func (l *LogStreamSinkAmazonEventBridge) cloneAny() interface{} {
+	return l.Clone()
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+// Equal reports whether LogStreamSinkAmazonEventBridge and other hold the same values.
+func (l *LogStreamSinkAmazonEventBridge) Equal(other *LogStreamSinkAmazonEventBridge) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between LogStreamSinkAmazonEventBridge and other.
+func (l *LogStreamSinkAmazonEventBridge) Diff(other *LogStreamSinkAmazonEventBridge) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *LogStreamSinkAmazonEventBridge) diff(prefix string, other *LogStreamSinkAmazonEventBridge) (changes []FieldChange) {
+	if l == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	if l == nil {
+		l = &LogStreamSinkAmazonEventBridge{}
+	}
+	if other == nil {
+		other = &LogStreamSinkAmazonEventBridge{}
+	}
+	changes = diffPointer(changes, prefix+"awsAccountId", l.AccountID, other.AccountID)
+	changes = diffPointer(changes, prefix+"awsRegion", l.Region, other.Region)
+	changes = diffPointer(changes, prefix+"awsPartnerEventSource", l.PartnerEventSource, other.PartnerEventSource)
+	return changes
 }
 
-// GetPingFederateBaseURL returns the PingFederateBaseURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetPingFederateBaseURL() string {
-	if c == nil || c.PingFederateBaseURL == nil {
-		return ""
+func (l *LogStreamSinkAmazonEventBridge) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkAmazonEventBridge)
+	if !ok {
+		return nil, false
 	}
-	return *c.PingFederateBaseURL
+	return l.diff(prefix, typed), true
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetPartnerTopic returns the PartnerTopic field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAzureEventGrid) GetPartnerTopic() string {
+	if l == nil || l.PartnerTopic == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.PartnerTopic
 }
 
-// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetSignatureAlgorithm() string {
-	if c == nil || c.SignatureAlgorithm == nil {
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAzureEventGrid) GetRegion() string {
+	if l == nil || l.Region == nil {
 		return ""
 	}
-	return *c.SignatureAlgorithm
+	return *l.Region
 }
 
-// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetSignInEndpoint() string {
-	if c == nil || c.SignInEndpoint == nil {
+// GetResourceGroup returns the ResourceGroup field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAzureEventGrid) GetResourceGroup() string {
+	if l == nil || l.ResourceGroup == nil {
 		return ""
 	}
-	return *c.SignInEndpoint
+	return *l.ResourceGroup
 }
 
-// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetSigningCert() string {
-	if c == nil || c.SigningCert == nil {
+// GetSubscriptionID returns the SubscriptionID field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkAzureEventGrid) GetSubscriptionID() string {
+	if l == nil || l.SubscriptionID == nil {
 		return ""
 	}
-	return *c.SigningCert
+	return *l.SubscriptionID
 }
 
-// GetSignSAMLRequest returns the SignSAMLRequest field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetSignSAMLRequest() bool {
-	if c == nil || c.SignSAMLRequest == nil {
-		return false
-	}
-	return *c.SignSAMLRequest
+// String returns a string representation of LogStreamSinkAzureEventGrid.
+func (l *LogStreamSinkAzureEventGrid) String() string {
+	return Stringify(l)
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsPingFederate) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
-		return ""
+// Clone returns a deep copy of LogStreamSinkAzureEventGrid.
+func (l *LogStreamSinkAzureEventGrid) Clone() *LogStreamSinkAzureEventGrid {
+	if l == nil {
+		return nil
 	}
-	return *c.TenantDomain
+	clone := &LogStreamSinkAzureEventGrid{}
+	clone.SubscriptionID = clonePointer(l.SubscriptionID)
+	clone.ResourceGroup = clonePointer(l.ResourceGroup)
+	clone.Region = clonePointer(l.Region)
+	clone.PartnerTopic = clonePointer(l.PartnerTopic)
+	return clone
 }
 
-// String returns a string representation of ConnectionOptionsPingFederate.
-func (c *ConnectionOptionsPingFederate) String() string {
-	return Stringify(c)
+func (l *LogStreamSinkAzureEventGrid) cloneAny() interface{} {
+	return l.Clone()
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
-	}
-	return *c.ClientID
+// Equal reports whether LogStreamSinkAzureEventGrid and other hold the same values.
+func (l *LogStreamSinkAzureEventGrid) Equal(other *LogStreamSinkAzureEventGrid) bool {
+	return len(l.diff("", other)) == 0
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
-	}
-	return *c.ClientSecret
+// Diff returns the fields that changed between LogStreamSinkAzureEventGrid and other.
+func (l *LogStreamSinkAzureEventGrid) Diff(other *LogStreamSinkAzureEventGrid) []FieldChange {
+	return l.diff("", other)
 }
 
-// GetCommunityBaseURL returns the CommunityBaseURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetCommunityBaseURL() string {
-	if c == nil || c.CommunityBaseURL == nil {
-		return ""
+func (l *LogStreamSinkAzureEventGrid) diff(prefix string, other *LogStreamSinkAzureEventGrid) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.CommunityBaseURL
+	if l == nil {
+		l = &LogStreamSinkAzureEventGrid{}
+	}
+	if other == nil {
+		other = &LogStreamSinkAzureEventGrid{}
+	}
+	changes = diffPointer(changes, prefix+"azureSubscriptionId", l.SubscriptionID, other.SubscriptionID)
+	changes = diffPointer(changes, prefix+"azureResourceGroup", l.ResourceGroup, other.ResourceGroup)
+	changes = diffPointer(changes, prefix+"azureRegion", l.Region, other.Region)
+	changes = diffPointer(changes, prefix+"azurePartnerTopic", l.PartnerTopic, other.PartnerTopic)
+	return changes
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
+func (l *LogStreamSinkAzureEventGrid) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkAzureEventGrid)
+	if !ok {
+		return nil, false
 	}
-	return *c.NonPersistentAttrs
+	return l.diff(prefix, typed), true
 }
 
-// GetProfile returns the Profile field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetProfile() bool {
-	if c == nil || c.Profile == nil {
-		return false
+// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkDatadog) GetAPIKey() string {
+	if l == nil || l.APIKey == nil {
+		return ""
 	}
-	return *c.Profile
+	return *l.APIKey
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSalesforce) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkDatadog) GetRegion() string {
+	if l == nil || l.Region == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.Region
 }
 
-// String returns a string representation of ConnectionOptionsSalesforce.
-func (c *ConnectionOptionsSalesforce) String() string {
-	return Stringify(c)
+// String returns a string representation of LogStreamSinkDatadog.
+func (l *LogStreamSinkDatadog) String() string {
+	return Stringify(l)
 }
 
-// GetCert returns the Cert field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetCert() string {
-	if c == nil || c.Cert == nil {
-		return ""
+// Clone returns a deep copy of LogStreamSinkDatadog.
+func (l *LogStreamSinkDatadog) Clone() *LogStreamSinkDatadog {
+	if l == nil {
+		return nil
 	}
-	return *c.Cert
+	clone := &LogStreamSinkDatadog{}
+	clone.Region = clonePointer(l.Region)
+	clone.APIKey = clonePointer(l.APIKey)
+	return clone
 }
 
-// GetDebug returns the Debug field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetDebug() bool {
-	if c == nil || c.Debug == nil {
-		return false
-	}
-	return *c.Debug
+func (l *LogStreamSinkDatadog) cloneAny() interface{} {
+	return l.Clone()
 }
 
-// GetDigestAglorithm returns the DigestAglorithm field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetDigestAglorithm() string {
-	if c == nil || c.DigestAglorithm == nil {
-		return ""
-	}
-	return *c.DigestAglorithm
+// Equal reports whether LogStreamSinkDatadog and other hold the same values.
+func (l *LogStreamSinkDatadog) Equal(other *LogStreamSinkDatadog) bool {
+	return len(l.diff("", other)) == 0
 }
 
-// GetDisableSignOut returns the DisableSignOut field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetDisableSignOut() bool {
-	if c == nil || c.DisableSignOut == nil {
-		return false
-	}
-	return *c.DisableSignOut
+// Diff returns the fields that changed between LogStreamSinkDatadog and other.
+func (l *LogStreamSinkDatadog) Diff(other *LogStreamSinkDatadog) []FieldChange {
+	return l.diff("", other)
 }
 
-// GetDomainAliases returns the DomainAliases field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetDomainAliases() []string {
-	if c == nil || c.DomainAliases == nil {
+func (l *LogStreamSinkDatadog) diff(prefix string, other *LogStreamSinkDatadog) (changes []FieldChange) {
+	if l == nil && other == nil {
 		return nil
 	}
-	return *c.DomainAliases
+	if l == nil {
+		l = &LogStreamSinkDatadog{}
+	}
+	if other == nil {
+		other = &LogStreamSinkDatadog{}
+	}
+	changes = diffPointer(changes, prefix+"datadogRegion", l.Region, other.Region)
+	changes = diffPointer(changes, prefix+"datadogApiKey", l.APIKey, other.APIKey)
+	return changes
 }
 
-// GetEntityID returns the EntityID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetEntityID() string {
-	if c == nil || c.EntityID == nil {
-		return ""
+func (l *LogStreamSinkDatadog) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkDatadog)
+	if !ok {
+		return nil, false
 	}
-	return *c.EntityID
+	return l.diff(prefix, typed), true
 }
 
-// GetExpires returns the Expires field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetExpires() string {
-	if c == nil || c.Expires == nil {
+// GetAuthorization returns the Authorization field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkHTTP) GetAuthorization() string {
+	if l == nil || l.Authorization == nil {
 		return ""
 	}
-	return *c.Expires
+	return *l.Authorization
 }
 
-// GetIdpInitiated returns the IdpInitiated field.
-func (c *ConnectionOptionsSAML) GetIdpInitiated() *ConnectionOptionsSAMLIdpInitiated {
-	if c == nil {
-		return nil
+// GetContentFormat returns the ContentFormat field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkHTTP) GetContentFormat() string {
+	if l == nil || l.ContentFormat == nil {
+		return ""
 	}
-	return c.IdpInitiated
+	return *l.ContentFormat
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetLogoURL() string {
-	if c == nil || c.LogoURL == nil {
+// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkHTTP) GetContentType() string {
+	if l == nil || l.ContentType == nil {
 		return ""
 	}
-	return *c.LogoURL
+	return *l.ContentType
 }
 
-// GetMetadataURL returns the MetadataURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetMetadataURL() string {
-	if c == nil || c.MetadataURL == nil {
+// GetEndpoint returns the Endpoint field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkHTTP) GetEndpoint() string {
+	if l == nil || l.Endpoint == nil {
 		return ""
 	}
-	return *c.MetadataURL
+	return *l.Endpoint
 }
 
-// GetMetadataXML returns the MetadataXML field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetMetadataXML() string {
-	if c == nil || c.MetadataXML == nil {
-		return ""
+// String returns a string representation of LogStreamSinkHTTP.
+func (l *LogStreamSinkHTTP) String() string {
+	return Stringify(l)
+}
+
+// Clone returns a deep copy of LogStreamSinkHTTP.
+func (l *LogStreamSinkHTTP) Clone() *LogStreamSinkHTTP {
+	if l == nil {
+		return nil
 	}
-	return *c.MetadataXML
+	clone := &LogStreamSinkHTTP{}
+	clone.ContentFormat = clonePointer(l.ContentFormat)
+	clone.ContentType = clonePointer(l.ContentType)
+	clone.Endpoint = clonePointer(l.Endpoint)
+	clone.Authorization = clonePointer(l.Authorization)
+	clone.CustomHeaders = clonePointerFunc(l.CustomHeaders, func(v []map[string]string) []map[string]string {
+		return cloneSliceFunc(v, func(v map[string]string) map[string]string { return cloneMap(v) })
+	})
+	return clone
+}
+
+func (l *LogStreamSinkHTTP) cloneAny() interface{} {
+	return l.Clone()
+}
+
+// Equal reports whether LogStreamSinkHTTP and other hold the same values.
+func (l *LogStreamSinkHTTP) Equal(other *LogStreamSinkHTTP) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between LogStreamSinkHTTP and other.
+func (l *LogStreamSinkHTTP) Diff(other *LogStreamSinkHTTP) []FieldChange {
+	return l.diff("", other)
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+func (l *LogStreamSinkHTTP) diff(prefix string, other *LogStreamSinkHTTP) (changes []FieldChange) {
+	if l == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
+	if l == nil {
+		l = &LogStreamSinkHTTP{}
+	}
+	if other == nil {
+		other = &LogStreamSinkHTTP{}
+	}
+	changes = diffPointer(changes, prefix+"httpContentFormat", l.ContentFormat, other.ContentFormat)
+	changes = diffPointer(changes, prefix+"httpContentType", l.ContentType, other.ContentType)
+	changes = diffPointer(changes, prefix+"httpEndpoint", l.Endpoint, other.Endpoint)
+	changes = diffPointer(changes, prefix+"httpAuthorization", l.Authorization, other.Authorization)
+	changes = diffSlicePointer(changes, prefix+"httpCustomHeaders", l.CustomHeaders, other.CustomHeaders)
+	return changes
 }
 
-// GetProtocolBinding returns the ProtocolBinding field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetProtocolBinding() string {
-	if c == nil || c.ProtocolBinding == nil {
-		return ""
+func (l *LogStreamSinkHTTP) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkHTTP)
+	if !ok {
+		return nil, false
 	}
-	return *c.ProtocolBinding
+	return l.diff(prefix, typed), true
 }
 
-// GetRequestTemplate returns the RequestTemplate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetRequestTemplate() string {
-	if c == nil || c.RequestTemplate == nil {
+// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkMixpanel) GetProjectID() string {
+	if l == nil || l.ProjectID == nil {
 		return ""
 	}
-	return *c.RequestTemplate
+	return *l.ProjectID
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkMixpanel) GetRegion() string {
+	if l == nil || l.Region == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.Region
 }
 
-// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSignatureAlgorithm() string {
-	if c == nil || c.SignatureAlgorithm == nil {
+// GetServiceAccountPassword returns the ServiceAccountPassword field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkMixpanel) GetServiceAccountPassword() string {
+	if l == nil || l.ServiceAccountPassword == nil {
 		return ""
 	}
-	return *c.SignatureAlgorithm
+	return *l.ServiceAccountPassword
 }
 
-// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSignInEndpoint() string {
-	if c == nil || c.SignInEndpoint == nil {
+// GetServiceAccountUsername returns the ServiceAccountUsername field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkMixpanel) GetServiceAccountUsername() string {
+	if l == nil || l.ServiceAccountUsername == nil {
 		return ""
 	}
-	return *c.SignInEndpoint
+	return *l.ServiceAccountUsername
 }
 
-// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSigningCert() string {
-	if c == nil || c.SigningCert == nil {
-		return ""
-	}
-	return *c.SigningCert
+// String returns a string representation of LogStreamSinkMixpanel.
+func (l *LogStreamSinkMixpanel) String() string {
+	return Stringify(l)
 }
 
-// GetSigningKey returns the SigningKey field.
-func (c *ConnectionOptionsSAML) GetSigningKey() *ConnectionOptionsSAMLSigningKey {
-	if c == nil {
+// Clone returns a deep copy of LogStreamSinkMixpanel.
+func (l *LogStreamSinkMixpanel) Clone() *LogStreamSinkMixpanel {
+	if l == nil {
 		return nil
 	}
-	return c.SigningKey
+	clone := &LogStreamSinkMixpanel{}
+	clone.Region = clonePointer(l.Region)
+	clone.ProjectID = clonePointer(l.ProjectID)
+	clone.ServiceAccountUsername = clonePointer(l.ServiceAccountUsername)
+	clone.ServiceAccountPassword = clonePointer(l.ServiceAccountPassword)
+	return clone
 }
 
-// GetSignOutEndpoint returns the SignOutEndpoint field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSignOutEndpoint() string {
-	if c == nil || c.SignOutEndpoint == nil {
-		return ""
+func (l *LogStreamSinkMixpanel) cloneAny() interface{} {
+	return l.Clone()
+}
+
+// Equal reports whether LogStreamSinkMixpanel and other hold the same values.
+func (l *LogStreamSinkMixpanel) Equal(other *LogStreamSinkMixpanel) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between LogStreamSinkMixpanel and other.
+func (l *LogStreamSinkMixpanel) Diff(other *LogStreamSinkMixpanel) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *LogStreamSinkMixpanel) diff(prefix string, other *LogStreamSinkMixpanel) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.SignOutEndpoint
+	if l == nil {
+		l = &LogStreamSinkMixpanel{}
+	}
+	if other == nil {
+		other = &LogStreamSinkMixpanel{}
+	}
+	changes = diffPointer(changes, prefix+"mixpanelRegion", l.Region, other.Region)
+	changes = diffPointer(changes, prefix+"mixpanelProjectId", l.ProjectID, other.ProjectID)
+	changes = diffPointer(changes, prefix+"mixpanelServiceAccountUsername", l.ServiceAccountUsername, other.ServiceAccountUsername)
+	changes = diffPointer(changes, prefix+"mixpanelServiceAccountPassword", l.ServiceAccountPassword, other.ServiceAccountPassword)
+	return changes
 }
 
-// GetSignSAMLRequest returns the SignSAMLRequest field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetSignSAMLRequest() bool {
-	if c == nil || c.SignSAMLRequest == nil {
-		return false
+func (l *LogStreamSinkMixpanel) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkMixpanel)
+	if !ok {
+		return nil, false
 	}
-	return *c.SignSAMLRequest
+	return l.diff(prefix, typed), true
 }
 
-// GetTenantDomain returns the TenantDomain field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetTenantDomain() string {
-	if c == nil || c.TenantDomain == nil {
+// GetWriteKey returns the WriteKey field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSegment) GetWriteKey() string {
+	if l == nil || l.WriteKey == nil {
 		return ""
 	}
-	return *c.TenantDomain
+	return *l.WriteKey
 }
 
-// GetUserIDAttribute returns the UserIDAttribute field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAML) GetUserIDAttribute() string {
-	if c == nil || c.UserIDAttribute == nil {
-		return ""
+// String returns a string representation of LogStreamSinkSegment.
+func (l *LogStreamSinkSegment) String() string {
+	return Stringify(l)
+}
+
+// Clone returns a deep copy of LogStreamSinkSegment.
+func (l *LogStreamSinkSegment) Clone() *LogStreamSinkSegment {
+	if l == nil {
+		return nil
 	}
-	return *c.UserIDAttribute
+	clone := &LogStreamSinkSegment{}
+	clone.WriteKey = clonePointer(l.WriteKey)
+	return clone
 }
 
-// String returns a string representation of ConnectionOptionsSAML.
-func (c *ConnectionOptionsSAML) String() string {
-	return Stringify(c)
+func (l *LogStreamSinkSegment) cloneAny() interface{} {
+	return l.Clone()
 }
 
-// GetClientAuthorizeQuery returns the ClientAuthorizeQuery field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetClientAuthorizeQuery() string {
-	if c == nil || c.ClientAuthorizeQuery == nil {
-		return ""
+// Equal reports whether LogStreamSinkSegment and other hold the same values.
+func (l *LogStreamSinkSegment) Equal(other *LogStreamSinkSegment) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between LogStreamSinkSegment and other.
+func (l *LogStreamSinkSegment) Diff(other *LogStreamSinkSegment) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *LogStreamSinkSegment) diff(prefix string, other *LogStreamSinkSegment) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.ClientAuthorizeQuery
+	if l == nil {
+		l = &LogStreamSinkSegment{}
+	}
+	if other == nil {
+		other = &LogStreamSinkSegment{}
+	}
+	changes = diffPointer(changes, prefix+"segmentWriteKey", l.WriteKey, other.WriteKey)
+	return changes
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
+func (l *LogStreamSinkSegment) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkSegment)
+	if !ok {
+		return nil, false
 	}
-	return *c.ClientID
+	return l.diff(prefix, typed), true
 }
 
-// GetClientProtocol returns the ClientProtocol field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetClientProtocol() string {
-	if c == nil || c.ClientProtocol == nil {
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSplunk) GetDomain() string {
+	if l == nil || l.Domain == nil {
 		return ""
 	}
-	return *c.ClientProtocol
+	return *l.Domain
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetEnabled() bool {
-	if c == nil || c.Enabled == nil {
-		return false
+// GetPort returns the Port field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSplunk) GetPort() string {
+	if l == nil || l.Port == nil {
+		return ""
 	}
-	return *c.Enabled
+	return *l.Port
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
-		return nil
+// GetSecure returns the Secure field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSplunk) GetSecure() bool {
+	if l == nil || l.Secure == nil {
+		return false
 	}
-	return *c.NonPersistentAttrs
+	return *l.Secure
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLIdpInitiated) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
+// GetToken returns the Token field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSplunk) GetToken() string {
+	if l == nil || l.Token == nil {
 		return ""
 	}
-	return *c.SetUserAttributes
+	return *l.Token
 }
 
-// String returns a string representation of ConnectionOptionsSAMLIdpInitiated.
-func (c *ConnectionOptionsSAMLIdpInitiated) String() string {
-	return Stringify(c)
+// String returns a string representation of LogStreamSinkSplunk.
+func (l *LogStreamSinkSplunk) String() string {
+	return Stringify(l)
 }
 
-// GetCert returns the Cert field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLSigningKey) GetCert() string {
-	if c == nil || c.Cert == nil {
-		return ""
+// Clone returns a deep copy of LogStreamSinkSplunk.
+func (l *LogStreamSinkSplunk) Clone() *LogStreamSinkSplunk {
+	if l == nil {
+		return nil
 	}
-	return *c.Cert
+	clone := &LogStreamSinkSplunk{}
+	clone.Domain = clonePointer(l.Domain)
+	clone.Token = clonePointer(l.Token)
+	clone.Port = clonePointer(l.Port)
+	clone.Secure = clonePointer(l.Secure)
+	return clone
 }
 
-// GetKey returns the Key field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSAMLSigningKey) GetKey() string {
-	if c == nil || c.Key == nil {
-		return ""
-	}
-	return *c.Key
+func (l *LogStreamSinkSplunk) cloneAny() interface{} {
+	return l.Clone()
 }
 
-// String returns a string representation of ConnectionOptionsSAMLSigningKey.
-func (c *ConnectionOptionsSAMLSigningKey) String() string {
-	return Stringify(c)
+// Equal reports whether LogStreamSinkSplunk and other hold the same values.
+func (l *LogStreamSinkSplunk) Equal(other *LogStreamSinkSplunk) bool {
+	return len(l.diff("", other)) == 0
 }
 
-// GetBruteForceProtection returns the BruteForceProtection field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetBruteForceProtection() bool {
-	if c == nil || c.BruteForceProtection == nil {
-		return false
-	}
-	return *c.BruteForceProtection
+// Diff returns the fields that changed between LogStreamSinkSplunk and other.
+func (l *LogStreamSinkSplunk) Diff(other *LogStreamSinkSplunk) []FieldChange {
+	return l.diff("", other)
 }
 
-// GetDisableSignup returns the DisableSignup field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetDisableSignup() bool {
-	if c == nil || c.DisableSignup == nil {
-		return false
+func (l *LogStreamSinkSplunk) diff(prefix string, other *LogStreamSinkSplunk) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.DisableSignup
+	if l == nil {
+		l = &LogStreamSinkSplunk{}
+	}
+	if other == nil {
+		other = &LogStreamSinkSplunk{}
+	}
+	changes = diffPointer(changes, prefix+"splunkDomain", l.Domain, other.Domain)
+	changes = diffPointer(changes, prefix+"splunkToken", l.Token, other.Token)
+	changes = diffPointer(changes, prefix+"splunkPort", l.Port, other.Port)
+	changes = diffPointer(changes, prefix+"splunkSecure", l.Secure, other.Secure)
+	return changes
 }
 
-// GetForwardRequestInfo returns the ForwardRequestInfo field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetForwardRequestInfo() bool {
-	if c == nil || c.ForwardRequestInfo == nil {
-		return false
+func (l *LogStreamSinkSplunk) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkSplunk)
+	if !ok {
+		return nil, false
 	}
-	return *c.ForwardRequestInfo
+	return l.diff(prefix, typed), true
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetFrom() string {
-	if c == nil || c.From == nil {
+// GetSourceAddress returns the SourceAddress field if it's non-nil, zero value otherwise.
+func (l *LogStreamSinkSumo) GetSourceAddress() string {
+	if l == nil || l.SourceAddress == nil {
 		return ""
 	}
-	return *c.From
+	return *l.SourceAddress
 }
 
-// GetGatewayAuthentication returns the GatewayAuthentication field.
-func (c *ConnectionOptionsSMS) GetGatewayAuthentication() *ConnectionGatewayAuthentication {
-	if c == nil {
+// String returns a string representation of LogStreamSinkSumo.
+func (l *LogStreamSinkSumo) String() string {
+	return Stringify(l)
+}
+
+// Clone returns a deep copy of LogStreamSinkSumo.
+func (l *LogStreamSinkSumo) Clone() *LogStreamSinkSumo {
+	if l == nil {
 		return nil
 	}
-	return c.GatewayAuthentication
+	clone := &LogStreamSinkSumo{}
+	clone.SourceAddress = clonePointer(l.SourceAddress)
+	return clone
 }
 
-// GetGatewayURL returns the GatewayURL field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetGatewayURL() string {
-	if c == nil || c.GatewayURL == nil {
-		return ""
+func (l *LogStreamSinkSumo) cloneAny() interface{} {
+	return l.Clone()
+}
+
+// Equal reports whether LogStreamSinkSumo and other hold the same values.
+func (l *LogStreamSinkSumo) Equal(other *LogStreamSinkSumo) bool {
+	return len(l.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between LogStreamSinkSumo and other.
+func (l *LogStreamSinkSumo) Diff(other *LogStreamSinkSumo) []FieldChange {
+	return l.diff("", other)
+}
+
+func (l *LogStreamSinkSumo) diff(prefix string, other *LogStreamSinkSumo) (changes []FieldChange) {
+	if l == nil && other == nil {
+		return nil
 	}
-	return *c.GatewayURL
+	if l == nil {
+		l = &LogStreamSinkSumo{}
+	}
+	if other == nil {
+		other = &LogStreamSinkSumo{}
+	}
+	changes = diffPointer(changes, prefix+"sumoSourceAddress", l.SourceAddress, other.SourceAddress)
+	return changes
 }
 
-// GetMessagingServiceSID returns the MessagingServiceSID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetMessagingServiceSID() string {
-	if c == nil || c.MessagingServiceSID == nil {
-		return ""
+func (l *LogStreamSinkSumo) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*LogStreamSinkSumo)
+	if !ok {
+		return nil, false
 	}
-	return *c.MessagingServiceSID
+	return l.diff(prefix, typed), true
+}
+
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (m *MultiFactor) GetEnabled() bool {
+	if m == nil || m.Enabled == nil {
+		return false
+	}
+	return *m.Enabled
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetName() string {
-	if c == nil || c.Name == nil {
+func (m *MultiFactor) GetName() string {
+	if m == nil || m.Name == nil {
 		return ""
 	}
-	return *c.Name
+	return *m.Name
 }
 
-// GetOTP returns the OTP field.
-func (c *ConnectionOptionsSMS) GetOTP() *ConnectionOptionsOTP {
-	if c == nil {
+// GetTrialExpired returns the TrialExpired field if it's non-nil, zero value otherwise.
+func (m *MultiFactor) GetTrialExpired() bool {
+	if m == nil || m.TrialExpired == nil {
+		return false
+	}
+	return *m.TrialExpired
+}
+
+// String returns a string representation of MultiFactor.
+func (m *MultiFactor) String() string {
+	return Stringify(m)
+}
+
+// Clone returns a deep copy of MultiFactor.
+func (m *MultiFactor) Clone() *MultiFactor {
+	if m == nil {
 		return nil
 	}
-	return c.OTP
+	clone := &MultiFactor{}
+	clone.Enabled = clonePointer(m.Enabled)
+	clone.Name = clonePointer(m.Name)
+	clone.TrialExpired = clonePointer(m.TrialExpired)
+	return clone
 }
 
-// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetProvider() string {
-	if c == nil || c.Provider == nil {
-		return ""
-	}
-	return *c.Provider
+func (m *MultiFactor) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetSyntax() string {
-	if c == nil || c.Syntax == nil {
-		return ""
-	}
-	return *c.Syntax
+// Equal reports whether MultiFactor and other hold the same values.
+func (m *MultiFactor) Equal(other *MultiFactor) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetTemplate returns the Template field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetTemplate() string {
-	if c == nil || c.Template == nil {
-		return ""
-	}
-	return *c.Template
+// Diff returns the fields that changed between MultiFactor and other.
+func (m *MultiFactor) Diff(other *MultiFactor) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetTwilioSID returns the TwilioSID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetTwilioSID() string {
-	if c == nil || c.TwilioSID == nil {
-		return ""
+func (m *MultiFactor) diff(prefix string, other *MultiFactor) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *c.TwilioSID
+	if m == nil {
+		m = &MultiFactor{}
+	}
+	if other == nil {
+		other = &MultiFactor{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", m.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"name", m.Name, other.Name)
+	changes = diffPointer(changes, prefix+"trial_expired", m.TrialExpired, other.TrialExpired)
+	return changes
 }
 
-// GetTwilioToken returns the TwilioToken field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsSMS) GetTwilioToken() string {
-	if c == nil || c.TwilioToken == nil {
-		return ""
+func (m *MultiFactor) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactor)
+	if !ok {
+		return nil, false
 	}
-	return *c.TwilioToken
+	return m.diff(prefix, typed), true
 }
 
-// String returns a string representation of ConnectionOptionsSMS.
-func (c *ConnectionOptionsSMS) String() string {
-	return Stringify(c)
+// String returns a string representation of MultiFactorDUO.
+func (m *MultiFactorDUO) String() string {
+	return Stringify(m)
 }
 
-// GetCalendars returns the Calendars field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetCalendars() bool {
-	if c == nil || c.Calendars == nil {
-		return false
+// Clone returns a deep copy of MultiFactorDUO.
+func (m *MultiFactorDUO) Clone() *MultiFactorDUO {
+	if m == nil {
+		return nil
 	}
-	return *c.Calendars
+	clone := &MultiFactorDUO{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetCalendarsUpdate returns the CalendarsUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetCalendarsUpdate() bool {
-	if c == nil || c.CalendarsUpdate == nil {
-		return false
-	}
-	return *c.CalendarsUpdate
+func (m *MultiFactorDUO) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetClientID() string {
-	if c == nil || c.ClientID == nil {
-		return ""
-	}
-	return *c.ClientID
+// Equal reports whether MultiFactorDUO and other hold the same values.
+func (m *MultiFactorDUO) Equal(other *MultiFactorDUO) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetClientSecret returns the ClientSecret field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetClientSecret() string {
-	if c == nil || c.ClientSecret == nil {
-		return ""
-	}
-	return *c.ClientSecret
+// Diff returns the fields that changed between MultiFactorDUO and other.
+func (m *MultiFactorDUO) Diff(other *MultiFactorDUO) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetContacts returns the Contacts field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetContacts() bool {
-	if c == nil || c.Contacts == nil {
-		return false
+func (m *MultiFactorDUO) diff(prefix string, other *MultiFactorDUO) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *c.Contacts
+	if m == nil {
+		m = &MultiFactorDUO{}
+	}
+	if other == nil {
+		other = &MultiFactorDUO{}
+	}
+	return changes
 }
 
-// GetContactsUpdate returns the ContactsUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetContactsUpdate() bool {
-	if c == nil || c.ContactsUpdate == nil {
-		return false
+func (m *MultiFactorDUO) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorDUO)
+	if !ok {
+		return nil, false
 	}
-	return *c.ContactsUpdate
+	return m.diff(prefix, typed), true
 }
 
-// GetDevice returns the Device field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetDevice() bool {
-	if c == nil || c.Device == nil {
-		return false
+// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
+func (m *MultiFactorDUOSettings) GetHostname() string {
+	if m == nil || m.Hostname == nil {
+		return ""
 	}
-	return *c.Device
+	return *m.Hostname
 }
 
-// GetDeviceCommand returns the DeviceCommand field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetDeviceCommand() bool {
-	if c == nil || c.DeviceCommand == nil {
-		return false
+// GetIntegrationKey returns the IntegrationKey field if it's non-nil, zero value otherwise.
+func (m *MultiFactorDUOSettings) GetIntegrationKey() string {
+	if m == nil || m.IntegrationKey == nil {
+		return ""
 	}
-	return *c.DeviceCommand
+	return *m.IntegrationKey
 }
 
-// GetEmails returns the Emails field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetEmails() bool {
-	if c == nil || c.Emails == nil {
-		return false
+// GetSecretKey returns the SecretKey field if it's non-nil, zero value otherwise.
+func (m *MultiFactorDUOSettings) GetSecretKey() string {
+	if m == nil || m.SecretKey == nil {
+		return ""
 	}
-	return *c.Emails
+	return *m.SecretKey
 }
 
-// GetEmailsUpdate returns the EmailsUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetEmailsUpdate() bool {
-	if c == nil || c.EmailsUpdate == nil {
-		return false
-	}
-	return *c.EmailsUpdate
+// String returns a string representation of MultiFactorDUOSettings.
+func (m *MultiFactorDUOSettings) String() string {
+	return Stringify(m)
 }
 
-// GetFiles returns the Files field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetFiles() bool {
-	if c == nil || c.Files == nil {
-		return false
+// Clone returns a deep copy of MultiFactorDUOSettings.
+func (m *MultiFactorDUOSettings) Clone() *MultiFactorDUOSettings {
+	if m == nil {
+		return nil
 	}
-	return *c.Files
+	clone := &MultiFactorDUOSettings{}
+	clone.Hostname = clonePointer(m.Hostname)
+	clone.IntegrationKey = clonePointer(m.IntegrationKey)
+	clone.SecretKey = clonePointer(m.SecretKey)
+	return clone
 }
 
-// GetFilesAll returns the FilesAll field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetFilesAll() bool {
-	if c == nil || c.FilesAll == nil {
-		return false
-	}
-	return *c.FilesAll
+func (m *MultiFactorDUOSettings) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetFilesAllUpdate returns the FilesAllUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetFilesAllUpdate() bool {
-	if c == nil || c.FilesAllUpdate == nil {
-		return false
-	}
-	return *c.FilesAllUpdate
+// Equal reports whether MultiFactorDUOSettings and other hold the same values.
+func (m *MultiFactorDUOSettings) Equal(other *MultiFactorDUOSettings) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetFilesUpdate returns the FilesUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetFilesUpdate() bool {
-	if c == nil || c.FilesUpdate == nil {
-		return false
-	}
-	return *c.FilesUpdate
+// Diff returns the fields that changed between MultiFactorDUOSettings and other.
+func (m *MultiFactorDUOSettings) Diff(other *MultiFactorDUOSettings) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetNonPersistentAttrs returns the NonPersistentAttrs field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetNonPersistentAttrs() []string {
-	if c == nil || c.NonPersistentAttrs == nil {
+func (m *MultiFactorDUOSettings) diff(prefix string, other *MultiFactorDUOSettings) (changes []FieldChange) {
+	if m == nil && other == nil {
 		return nil
 	}
-	return *c.NonPersistentAttrs
-}
-
-// GetNotes returns the Notes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetNotes() bool {
-	if c == nil || c.Notes == nil {
-		return false
+	if m == nil {
+		m = &MultiFactorDUOSettings{}
 	}
-	return *c.Notes
+	if other == nil {
+		other = &MultiFactorDUOSettings{}
+	}
+	changes = diffPointer(changes, prefix+"host", m.Hostname, other.Hostname)
+	changes = diffPointer(changes, prefix+"ikey", m.IntegrationKey, other.IntegrationKey)
+	changes = diffPointer(changes, prefix+"skey", m.SecretKey, other.SecretKey)
+	return changes
 }
 
-// GetNotesCreate returns the NotesCreate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetNotesCreate() bool {
-	if c == nil || c.NotesCreate == nil {
-		return false
+func (m *MultiFactorDUOSettings) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorDUOSettings)
+	if !ok {
+		return nil, false
 	}
-	return *c.NotesCreate
+	return m.diff(prefix, typed), true
 }
 
-// GetNotesUpdate returns the NotesUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetNotesUpdate() bool {
-	if c == nil || c.NotesUpdate == nil {
-		return false
-	}
-	return *c.NotesUpdate
+// String returns a string representation of MultiFactorEmail.
+func (m *MultiFactorEmail) String() string {
+	return Stringify(m)
 }
 
-// GetOfflineAccess returns the OfflineAccess field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetOfflineAccess() bool {
-	if c == nil || c.OfflineAccess == nil {
-		return false
+// Clone returns a deep copy of MultiFactorEmail.
+func (m *MultiFactorEmail) Clone() *MultiFactorEmail {
+	if m == nil {
+		return nil
 	}
-	return *c.OfflineAccess
+	clone := &MultiFactorEmail{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetSetUserAttributes returns the SetUserAttributes field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetSetUserAttributes() string {
-	if c == nil || c.SetUserAttributes == nil {
-		return ""
-	}
-	return *c.SetUserAttributes
+func (m *MultiFactorEmail) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetSignin returns the Signin field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetSignin() bool {
-	if c == nil || c.Signin == nil {
-		return false
-	}
-	return *c.Signin
+// Equal reports whether MultiFactorEmail and other hold the same values.
+func (m *MultiFactorEmail) Equal(other *MultiFactorEmail) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetStrategyVersion returns the StrategyVersion field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetStrategyVersion() int {
-	if c == nil || c.StrategyVersion == nil {
-		return 0
-	}
-	return *c.StrategyVersion
+// Diff returns the fields that changed between MultiFactorEmail and other.
+func (m *MultiFactorEmail) Diff(other *MultiFactorEmail) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetTasks returns the Tasks field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetTasks() bool {
-	if c == nil || c.Tasks == nil {
-		return false
+func (m *MultiFactorEmail) diff(prefix string, other *MultiFactorEmail) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *c.Tasks
+	if m == nil {
+		m = &MultiFactorEmail{}
+	}
+	if other == nil {
+		other = &MultiFactorEmail{}
+	}
+	return changes
 }
 
-// GetTasksUpdate returns the TasksUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetTasksUpdate() bool {
-	if c == nil || c.TasksUpdate == nil {
-		return false
+func (m *MultiFactorEmail) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorEmail)
+	if !ok {
+		return nil, false
 	}
-	return *c.TasksUpdate
+	return m.diff(prefix, typed), true
 }
 
-// GetUser returns the User field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetUser() bool {
-	if c == nil || c.User == nil {
-		return false
-	}
-	return *c.User
+// String returns a string representation of MultiFactorOTP.
+func (m *MultiFactorOTP) String() string {
+	return Stringify(m)
 }
 
-// GetUserActivity returns the UserActivity field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetUserActivity() bool {
-	if c == nil || c.UserActivity == nil {
-		return false
+// Clone returns a deep copy of MultiFactorOTP.
+func (m *MultiFactorOTP) Clone() *MultiFactorOTP {
+	if m == nil {
+		return nil
 	}
-	return *c.UserActivity
+	clone := &MultiFactorOTP{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetUserUpdate returns the UserUpdate field if it's non-nil, zero value otherwise.
-func (c *ConnectionOptionsWindowsLive) GetUserUpdate() bool {
-	if c == nil || c.UserUpdate == nil {
-		return false
-	}
-	return *c.UserUpdate
+func (m *MultiFactorOTP) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// String returns a string representation of ConnectionOptionsWindowsLive.
-func (c *ConnectionOptionsWindowsLive) String() string {
-	return Stringify(c)
+// Equal reports whether MultiFactorOTP and other hold the same values.
+func (m *MultiFactorOTP) Equal(other *MultiFactorOTP) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of CreateEnrollmentTicket.
-func (c *CreateEnrollmentTicket) String() string {
-	return Stringify(c)
+// Diff returns the fields that changed between MultiFactorOTP and other.
+func (m *MultiFactorOTP) Diff(other *MultiFactorOTP) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetCNAMEAPIKey returns the CNAMEAPIKey field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetCNAMEAPIKey() string {
-	if c == nil || c.CNAMEAPIKey == nil {
-		return ""
+func (m *MultiFactorOTP) diff(prefix string, other *MultiFactorOTP) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *c.CNAMEAPIKey
+	if m == nil {
+		m = &MultiFactorOTP{}
+	}
+	if other == nil {
+		other = &MultiFactorOTP{}
+	}
+	return changes
 }
 
-// GetCustomClientIPHeader returns the CustomClientIPHeader field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetCustomClientIPHeader() string {
-	if c == nil || c.CustomClientIPHeader == nil {
-		return ""
+func (m *MultiFactorOTP) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorOTP)
+	if !ok {
+		return nil, false
 	}
-	return *c.CustomClientIPHeader
+	return m.diff(prefix, typed), true
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetDomain() string {
-	if c == nil || c.Domain == nil {
-		return ""
-	}
-	return *c.Domain
+// String returns a string representation of MultiFactorPhone.
+func (m *MultiFactorPhone) String() string {
+	return Stringify(m)
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetID() string {
-	if c == nil || c.ID == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorPhone.
+func (m *MultiFactorPhone) Clone() *MultiFactorPhone {
+	if m == nil {
+		return nil
 	}
-	return *c.ID
+	clone := &MultiFactorPhone{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetOriginDomainName returns the OriginDomainName field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetOriginDomainName() string {
-	if c == nil || c.OriginDomainName == nil {
-		return ""
-	}
-	return *c.OriginDomainName
+func (m *MultiFactorPhone) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetPrimary returns the Primary field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetPrimary() bool {
-	if c == nil || c.Primary == nil {
-		return false
-	}
-	return *c.Primary
+// Equal reports whether MultiFactorPhone and other hold the same values.
+func (m *MultiFactorPhone) Equal(other *MultiFactorPhone) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetStatus() string {
-	if c == nil || c.Status == nil {
-		return ""
+// Diff returns the fields that changed between MultiFactorPhone and other.
+func (m *MultiFactorPhone) Diff(other *MultiFactorPhone) []FieldChange {
+	return m.diff("", other)
+}
+
+func (m *MultiFactorPhone) diff(prefix string, other *MultiFactorPhone) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *c.Status
+	if m == nil {
+		m = &MultiFactorPhone{}
+	}
+	if other == nil {
+		other = &MultiFactorPhone{}
+	}
+	return changes
 }
 
-// GetTLSPolicy returns the TLSPolicy field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetTLSPolicy() string {
-	if c == nil || c.TLSPolicy == nil {
-		return ""
+func (m *MultiFactorPhone) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorPhone)
+	if !ok {
+		return nil, false
 	}
-	return *c.TLSPolicy
+	return m.diff(prefix, typed), true
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetType() string {
-	if c == nil || c.Type == nil {
+// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProvider) GetProvider() string {
+	if m == nil || m.Provider == nil {
 		return ""
 	}
-	return *c.Type
+	return *m.Provider
 }
 
-// GetVerification returns the Verification field.
-func (c *CustomDomain) GetVerification() *CustomDomainVerification {
-	if c == nil {
+// String returns a string representation of MultiFactorProvider.
+func (m *MultiFactorProvider) String() string {
+	return Stringify(m)
+}
+
+// Clone returns a deep copy of MultiFactorProvider.
+func (m *MultiFactorProvider) Clone() *MultiFactorProvider {
+	if m == nil {
 		return nil
 	}
-	return c.Verification
+	clone := &MultiFactorProvider{}
+	clone.Provider = clonePointer(m.Provider)
+	return clone
 }
 
-// GetVerificationMethod returns the VerificationMethod field if it's non-nil, zero value otherwise.
-func (c *CustomDomain) GetVerificationMethod() string {
-	if c == nil || c.VerificationMethod == nil {
-		return ""
-	}
-	return *c.VerificationMethod
+func (m *MultiFactorProvider) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// String returns a string representation of CustomDomain.
-func (c *CustomDomain) String() string {
-	return Stringify(c)
+// Equal reports whether MultiFactorProvider and other hold the same values.
+func (m *MultiFactorProvider) Equal(other *MultiFactorProvider) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of CustomDomainVerification.
-func (c *CustomDomainVerification) String() string {
-	return Stringify(c)
+// Diff returns the fields that changed between MultiFactorProvider and other.
+func (m *MultiFactorProvider) Diff(other *MultiFactorProvider) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetCreatedAt() time.Time {
-	if d == nil || d.CreatedAt == nil {
-		return time.Time{}
+func (m *MultiFactorProvider) diff(prefix string, other *MultiFactorProvider) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *d.CreatedAt
+	if m == nil {
+		m = &MultiFactorProvider{}
+	}
+	if other == nil {
+		other = &MultiFactorProvider{}
+	}
+	changes = diffPointer(changes, prefix+"provider", m.Provider, other.Provider)
+	return changes
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetDate() time.Time {
-	if d == nil || d.Date == nil {
-		return time.Time{}
+func (m *MultiFactorProvider) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorProvider)
+	if !ok {
+		return nil, false
 	}
-	return *d.Date
+	return m.diff(prefix, typed), true
 }
 
-// GetLeakedPasswords returns the LeakedPasswords field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetLeakedPasswords() int {
-	if d == nil || d.LeakedPasswords == nil {
-		return 0
+// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderAmazonSNS) GetAccessKeyID() string {
+	if m == nil || m.AccessKeyID == nil {
+		return ""
 	}
-	return *d.LeakedPasswords
+	return *m.AccessKeyID
 }
 
-// GetLogins returns the Logins field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetLogins() int {
-	if d == nil || d.Logins == nil {
-		return 0
+// GetAPNSPlatformApplicationARN returns the APNSPlatformApplicationARN field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderAmazonSNS) GetAPNSPlatformApplicationARN() string {
+	if m == nil || m.APNSPlatformApplicationARN == nil {
+		return ""
 	}
-	return *d.Logins
+	return *m.APNSPlatformApplicationARN
 }
 
-// GetSignups returns the Signups field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetSignups() int {
-	if d == nil || d.Signups == nil {
-		return 0
+// GetGCMPlatformApplicationARN returns the GCMPlatformApplicationARN field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderAmazonSNS) GetGCMPlatformApplicationARN() string {
+	if m == nil || m.GCMPlatformApplicationARN == nil {
+		return ""
 	}
-	return *d.Signups
+	return *m.GCMPlatformApplicationARN
 }
 
-// GetUpdatedAt returns the UpdatedAt field if it's non-nil, zero value otherwise.
-func (d *DailyStat) GetUpdatedAt() time.Time {
-	if d == nil || d.UpdatedAt == nil {
-		return time.Time{}
+// GetRegion returns the Region field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderAmazonSNS) GetRegion() string {
+	if m == nil || m.Region == nil {
+		return ""
 	}
-	return *d.UpdatedAt
+	return *m.Region
 }
 
-// String returns a string representation of DailyStat.
-func (d *DailyStat) String() string {
-	return Stringify(d)
+// GetSecretAccessKeyID returns the SecretAccessKeyID field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderAmazonSNS) GetSecretAccessKeyID() string {
+	if m == nil || m.SecretAccessKeyID == nil {
+		return ""
+	}
+	return *m.SecretAccessKeyID
 }
 
-// GetCredentials returns the Credentials field.
-func (e *Email) GetCredentials() *EmailCredentials {
-	if e == nil {
-		return nil
-	}
-	return e.Credentials
+// String returns a string representation of MultiFactorProviderAmazonSNS.
+func (m *MultiFactorProviderAmazonSNS) String() string {
+	return Stringify(m)
 }
 
-// GetDefaultFromAddress returns the DefaultFromAddress field if it's non-nil, zero value otherwise.
-func (e *Email) GetDefaultFromAddress() string {
-	if e == nil || e.DefaultFromAddress == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorProviderAmazonSNS.
+func (m *MultiFactorProviderAmazonSNS) Clone() *MultiFactorProviderAmazonSNS {
+	if m == nil {
+		return nil
 	}
-	return *e.DefaultFromAddress
+	clone := &MultiFactorProviderAmazonSNS{}
+	clone.AccessKeyID = clonePointer(m.AccessKeyID)
+	clone.SecretAccessKeyID = clonePointer(m.SecretAccessKeyID)
+	clone.Region = clonePointer(m.Region)
+	clone.APNSPlatformApplicationARN = clonePointer(m.APNSPlatformApplicationARN)
+	clone.GCMPlatformApplicationARN = clonePointer(m.GCMPlatformApplicationARN)
+	return clone
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (e *Email) GetEnabled() bool {
-	if e == nil || e.Enabled == nil {
-		return false
-	}
-	return *e.Enabled
+func (m *MultiFactorProviderAmazonSNS) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Email) GetName() string {
-	if e == nil || e.Name == nil {
-		return ""
-	}
-	return *e.Name
+// Equal reports whether MultiFactorProviderAmazonSNS and other hold the same values.
+func (m *MultiFactorProviderAmazonSNS) Equal(other *MultiFactorProviderAmazonSNS) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of Email.
-func (e *Email) String() string {
-	return Stringify(e)
+// Diff returns the fields that changed between MultiFactorProviderAmazonSNS and other.
+func (m *MultiFactorProviderAmazonSNS) Diff(other *MultiFactorProviderAmazonSNS) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetAccessKeyID() string {
-	if e == nil || e.AccessKeyID == nil {
-		return ""
+func (m *MultiFactorProviderAmazonSNS) diff(prefix string, other *MultiFactorProviderAmazonSNS) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.AccessKeyID
+	if m == nil {
+		m = &MultiFactorProviderAmazonSNS{}
+	}
+	if other == nil {
+		other = &MultiFactorProviderAmazonSNS{}
+	}
+	changes = diffPointer(changes, prefix+"aws_access_key_id", m.AccessKeyID, other.AccessKeyID)
+	changes = diffPointer(changes, prefix+"aws_secret_access_key", m.SecretAccessKeyID, other.SecretAccessKeyID)
+	changes = diffPointer(changes, prefix+"aws_region", m.Region, other.Region)
+	changes = diffPointer(changes, prefix+"sns_apns_platform_application_arn", m.APNSPlatformApplicationARN, other.APNSPlatformApplicationARN)
+	changes = diffPointer(changes, prefix+"sns_gcm_platform_application_arn", m.GCMPlatformApplicationARN, other.GCMPlatformApplicationARN)
+	return changes
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetAPIKey() string {
-	if e == nil || e.APIKey == nil {
-		return ""
+func (m *MultiFactorProviderAmazonSNS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorProviderAmazonSNS)
+	if !ok {
+		return nil, false
 	}
-	return *e.APIKey
+	return m.diff(prefix, typed), true
 }
 
-// GetAPIUser returns the APIUser field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetAPIUser() string {
-	if e == nil || e.APIUser == nil {
+// GetAuthToken returns the AuthToken field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderTwilio) GetAuthToken() string {
+	if m == nil || m.AuthToken == nil {
 		return ""
 	}
-	return *e.APIUser
+	return *m.AuthToken
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetDomain() string {
-	if e == nil || e.Domain == nil {
+// GetFrom returns the From field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderTwilio) GetFrom() string {
+	if m == nil || m.From == nil {
 		return ""
 	}
-	return *e.Domain
+	return *m.From
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetRegion() string {
-	if e == nil || e.Region == nil {
+// GetMessagingServiceSid returns the MessagingServiceSid field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderTwilio) GetMessagingServiceSid() string {
+	if m == nil || m.MessagingServiceSid == nil {
 		return ""
 	}
-	return *e.Region
+	return *m.MessagingServiceSid
 }
 
-// GetSecretAccessKey returns the SecretAccessKey field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetSecretAccessKey() string {
-	if e == nil || e.SecretAccessKey == nil {
+// GetSID returns the SID field if it's non-nil, zero value otherwise.
+func (m *MultiFactorProviderTwilio) GetSID() string {
+	if m == nil || m.SID == nil {
 		return ""
 	}
-	return *e.SecretAccessKey
+	return *m.SID
 }
 
-// GetSMTPHost returns the SMTPHost field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetSMTPHost() string {
-	if e == nil || e.SMTPHost == nil {
-		return ""
-	}
-	return *e.SMTPHost
+// String returns a string representation of MultiFactorProviderTwilio.
+func (m *MultiFactorProviderTwilio) String() string {
+	return Stringify(m)
 }
 
-// GetSMTPPass returns the SMTPPass field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetSMTPPass() string {
-	if e == nil || e.SMTPPass == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorProviderTwilio.
+func (m *MultiFactorProviderTwilio) Clone() *MultiFactorProviderTwilio {
+	if m == nil {
+		return nil
 	}
-	return *e.SMTPPass
+	clone := &MultiFactorProviderTwilio{}
+	clone.From = clonePointer(m.From)
+	clone.MessagingServiceSid = clonePointer(m.MessagingServiceSid)
+	clone.AuthToken = clonePointer(m.AuthToken)
+	clone.SID = clonePointer(m.SID)
+	return clone
 }
 
-// GetSMTPPort returns the SMTPPort field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetSMTPPort() int {
-	if e == nil || e.SMTPPort == nil {
-		return 0
+func (m *MultiFactorProviderTwilio) cloneAny() interface{} {
+	return m.Clone()
+}
+
+// Equal reports whether MultiFactorProviderTwilio and other hold the same values.
+func (m *MultiFactorProviderTwilio) Equal(other *MultiFactorProviderTwilio) bool {
+	return len(m.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between MultiFactorProviderTwilio and other.
+func (m *MultiFactorProviderTwilio) Diff(other *MultiFactorProviderTwilio) []FieldChange {
+	return m.diff("", other)
+}
+
+func (m *MultiFactorProviderTwilio) diff(prefix string, other *MultiFactorProviderTwilio) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.SMTPPort
+	if m == nil {
+		m = &MultiFactorProviderTwilio{}
+	}
+	if other == nil {
+		other = &MultiFactorProviderTwilio{}
+	}
+	changes = diffPointer(changes, prefix+"from", m.From, other.From)
+	changes = diffPointer(changes, prefix+"messaging_service_sid", m.MessagingServiceSid, other.MessagingServiceSid)
+	changes = diffPointer(changes, prefix+"auth_token", m.AuthToken, other.AuthToken)
+	changes = diffPointer(changes, prefix+"sid", m.SID, other.SID)
+	return changes
 }
 
-// GetSMTPUser returns the SMTPUser field if it's non-nil, zero value otherwise.
-func (e *EmailCredentials) GetSMTPUser() string {
-	if e == nil || e.SMTPUser == nil {
-		return ""
+func (m *MultiFactorProviderTwilio) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorProviderTwilio)
+	if !ok {
+		return nil, false
 	}
-	return *e.SMTPUser
+	return m.diff(prefix, typed), true
 }
 
-// String returns a string representation of EmailCredentials.
-func (e *EmailCredentials) String() string {
-	return Stringify(e)
+// String returns a string representation of MultiFactorPush.
+func (m *MultiFactorPush) String() string {
+	return Stringify(m)
 }
 
-// GetDefaultFromAddress returns the DefaultFromAddress field if it's non-nil, zero value otherwise.
-func (e *EmailProvider) GetDefaultFromAddress() string {
-	if e == nil || e.DefaultFromAddress == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorPush.
+func (m *MultiFactorPush) Clone() *MultiFactorPush {
+	if m == nil {
+		return nil
 	}
-	return *e.DefaultFromAddress
+	clone := &MultiFactorPush{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (e *EmailProvider) GetEnabled() bool {
-	if e == nil || e.Enabled == nil {
-		return false
-	}
-	return *e.Enabled
+func (m *MultiFactorPush) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *EmailProvider) GetName() string {
-	if e == nil || e.Name == nil {
-		return ""
+// Equal reports whether MultiFactorPush and other hold the same values.
+func (m *MultiFactorPush) Equal(other *MultiFactorPush) bool {
+	return len(m.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between MultiFactorPush and other.
+func (m *MultiFactorPush) Diff(other *MultiFactorPush) []FieldChange {
+	return m.diff("", other)
+}
+
+func (m *MultiFactorPush) diff(prefix string, other *MultiFactorPush) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
+	}
+	if m == nil {
+		m = &MultiFactorPush{}
+	}
+	if other == nil {
+		other = &MultiFactorPush{}
 	}
-	return *e.Name
+	return changes
 }
 
-// String returns a string representation of EmailProvider.
-func (e *EmailProvider) String() string {
-	return Stringify(e)
+func (m *MultiFactorPush) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorPush)
+	if !ok {
+		return nil, false
+	}
+	return m.diff(prefix, typed), true
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsMailgun) GetAPIKey() string {
-	if e == nil || e.APIKey == nil {
+// GetAppleAppLink returns the AppleAppLink field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushCustomApp) GetAppleAppLink() string {
+	if m == nil || m.AppleAppLink == nil {
 		return ""
 	}
-	return *e.APIKey
+	return *m.AppleAppLink
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsMailgun) GetDomain() string {
-	if e == nil || e.Domain == nil {
+// GetAppName returns the AppName field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushCustomApp) GetAppName() string {
+	if m == nil || m.AppName == nil {
 		return ""
 	}
-	return *e.Domain
+	return *m.AppName
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsMailgun) GetRegion() string {
-	if e == nil || e.Region == nil {
+// GetGoogleAppLink returns the GoogleAppLink field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushCustomApp) GetGoogleAppLink() string {
+	if m == nil || m.GoogleAppLink == nil {
 		return ""
 	}
-	return *e.Region
+	return *m.GoogleAppLink
 }
 
-// String returns a string representation of EmailProviderCredentialsMailgun.
-func (e *EmailProviderCredentialsMailgun) String() string {
-	return Stringify(e)
+// String returns a string representation of MultiFactorPushCustomApp.
+func (m *MultiFactorPushCustomApp) String() string {
+	return Stringify(m)
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsMandrill) GetAPIKey() string {
-	if e == nil || e.APIKey == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorPushCustomApp.
+func (m *MultiFactorPushCustomApp) Clone() *MultiFactorPushCustomApp {
+	if m == nil {
+		return nil
 	}
-	return *e.APIKey
+	clone := &MultiFactorPushCustomApp{}
+	clone.AppName = clonePointer(m.AppName)
+	clone.AppleAppLink = clonePointer(m.AppleAppLink)
+	clone.GoogleAppLink = clonePointer(m.GoogleAppLink)
+	return clone
 }
 
-// String returns a string representation of EmailProviderCredentialsMandrill.
-func (e *EmailProviderCredentialsMandrill) String() string {
-	return Stringify(e)
+func (m *MultiFactorPushCustomApp) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSendGrid) GetAPIKey() string {
-	if e == nil || e.APIKey == nil {
-		return ""
-	}
-	return *e.APIKey
+// Equal reports whether MultiFactorPushCustomApp and other hold the same values.
+func (m *MultiFactorPushCustomApp) Equal(other *MultiFactorPushCustomApp) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of EmailProviderCredentialsSendGrid.
-func (e *EmailProviderCredentialsSendGrid) String() string {
-	return Stringify(e)
+// Diff returns the fields that changed between MultiFactorPushCustomApp and other.
+func (m *MultiFactorPushCustomApp) Diff(other *MultiFactorPushCustomApp) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSES) GetAccessKeyID() string {
-	if e == nil || e.AccessKeyID == nil {
-		return ""
+func (m *MultiFactorPushCustomApp) diff(prefix string, other *MultiFactorPushCustomApp) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.AccessKeyID
+	if m == nil {
+		m = &MultiFactorPushCustomApp{}
+	}
+	if other == nil {
+		other = &MultiFactorPushCustomApp{}
+	}
+	changes = diffPointer(changes, prefix+"app_name", m.AppName, other.AppName)
+	changes = diffPointer(changes, prefix+"apple_app_link", m.AppleAppLink, other.AppleAppLink)
+	changes = diffPointer(changes, prefix+"google_app_link", m.GoogleAppLink, other.GoogleAppLink)
+	return changes
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSES) GetRegion() string {
-	if e == nil || e.Region == nil {
-		return ""
+func (m *MultiFactorPushCustomApp) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorPushCustomApp)
+	if !ok {
+		return nil, false
 	}
-	return *e.Region
+	return m.diff(prefix, typed), true
 }
 
-// GetSecretAccessKey returns the SecretAccessKey field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSES) GetSecretAccessKey() string {
-	if e == nil || e.SecretAccessKey == nil {
+// GetBundleID returns the BundleID field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushDirectAPNS) GetBundleID() string {
+	if m == nil || m.BundleID == nil {
 		return ""
 	}
-	return *e.SecretAccessKey
+	return *m.BundleID
 }
 
-// String returns a string representation of EmailProviderCredentialsSES.
-func (e *EmailProviderCredentialsSES) String() string {
-	return Stringify(e)
+// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushDirectAPNS) GetEnabled() bool {
+	if m == nil || m.Enabled == nil {
+		return false
+	}
+	return *m.Enabled
 }
 
-// GetSMTPHost returns the SMTPHost field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSMTP) GetSMTPHost() string {
-	if e == nil || e.SMTPHost == nil {
+// GetP12 returns the P12 field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushDirectAPNS) GetP12() string {
+	if m == nil || m.P12 == nil {
 		return ""
 	}
-	return *e.SMTPHost
+	return *m.P12
 }
 
-// GetSMTPPass returns the SMTPPass field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSMTP) GetSMTPPass() string {
-	if e == nil || e.SMTPPass == nil {
-		return ""
+// GetSandbox returns the Sandbox field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushDirectAPNS) GetSandbox() bool {
+	if m == nil || m.Sandbox == nil {
+		return false
 	}
-	return *e.SMTPPass
+	return *m.Sandbox
 }
 
-// GetSMTPPort returns the SMTPPort field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSMTP) GetSMTPPort() int {
-	if e == nil || e.SMTPPort == nil {
-		return 0
-	}
-	return *e.SMTPPort
+// String returns a string representation of MultiFactorPushDirectAPNS.
+func (m *MultiFactorPushDirectAPNS) String() string {
+	return Stringify(m)
 }
 
-// GetSMTPUser returns the SMTPUser field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSMTP) GetSMTPUser() string {
-	if e == nil || e.SMTPUser == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorPushDirectAPNS.
+func (m *MultiFactorPushDirectAPNS) Clone() *MultiFactorPushDirectAPNS {
+	if m == nil {
+		return nil
 	}
-	return *e.SMTPUser
+	clone := &MultiFactorPushDirectAPNS{}
+	clone.Sandbox = clonePointer(m.Sandbox)
+	clone.BundleID = clonePointer(m.BundleID)
+	clone.P12 = clonePointer(m.P12)
+	clone.Enabled = clonePointer(m.Enabled)
+	return clone
 }
 
-// String returns a string representation of EmailProviderCredentialsSMTP.
-func (e *EmailProviderCredentialsSMTP) String() string {
-	return Stringify(e)
+func (m *MultiFactorPushDirectAPNS) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSparkPost) GetAPIKey() string {
-	if e == nil || e.APIKey == nil {
-		return ""
+// Equal reports whether MultiFactorPushDirectAPNS and other hold the same values.
+func (m *MultiFactorPushDirectAPNS) Equal(other *MultiFactorPushDirectAPNS) bool {
+	return len(m.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between MultiFactorPushDirectAPNS and other.
+func (m *MultiFactorPushDirectAPNS) Diff(other *MultiFactorPushDirectAPNS) []FieldChange {
+	return m.diff("", other)
+}
+
+func (m *MultiFactorPushDirectAPNS) diff(prefix string, other *MultiFactorPushDirectAPNS) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.APIKey
+	if m == nil {
+		m = &MultiFactorPushDirectAPNS{}
+	}
+	if other == nil {
+		other = &MultiFactorPushDirectAPNS{}
+	}
+	changes = diffPointer(changes, prefix+"sandbox", m.Sandbox, other.Sandbox)
+	changes = diffPointer(changes, prefix+"bundle_id", m.BundleID, other.BundleID)
+	changes = diffPointer(changes, prefix+"p12", m.P12, other.P12)
+	changes = diffPointer(changes, prefix+"enabled", m.Enabled, other.Enabled)
+	return changes
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (e *EmailProviderCredentialsSparkPost) GetRegion() string {
-	if e == nil || e.Region == nil {
+func (m *MultiFactorPushDirectAPNS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorPushDirectAPNS)
+	if !ok {
+		return nil, false
+	}
+	return m.diff(prefix, typed), true
+}
+
+// GetServerKey returns the ServerKey field if it's non-nil, zero value otherwise.
+func (m *MultiFactorPushDirectFCM) GetServerKey() string {
+	if m == nil || m.ServerKey == nil {
 		return ""
 	}
-	return *e.Region
+	return *m.ServerKey
 }
 
-// String returns a string representation of EmailProviderCredentialsSparkPost.
-func (e *EmailProviderCredentialsSparkPost) String() string {
-	return Stringify(e)
+// String returns a string representation of MultiFactorPushDirectFCM.
+func (m *MultiFactorPushDirectFCM) String() string {
+	return Stringify(m)
 }
 
-// GetMessage returns the Message field.
-func (e *EmailProviderSettingsMandrill) GetMessage() *EmailProviderSettingsMandrillMessage {
-	if e == nil {
+// Clone returns a deep copy of MultiFactorPushDirectFCM.
+func (m *MultiFactorPushDirectFCM) Clone() *MultiFactorPushDirectFCM {
+	if m == nil {
 		return nil
 	}
-	return e.Message
+	clone := &MultiFactorPushDirectFCM{}
+	clone.ServerKey = clonePointer(m.ServerKey)
+	return clone
 }
 
-// String returns a string representation of EmailProviderSettingsMandrill.
-func (e *EmailProviderSettingsMandrill) String() string {
-	return Stringify(e)
+func (m *MultiFactorPushDirectFCM) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetViewContentLink returns the ViewContentLink field if it's non-nil, zero value otherwise.
-func (e *EmailProviderSettingsMandrillMessage) GetViewContentLink() bool {
-	if e == nil || e.ViewContentLink == nil {
-		return false
-	}
-	return *e.ViewContentLink
+// Equal reports whether MultiFactorPushDirectFCM and other hold the same values.
+func (m *MultiFactorPushDirectFCM) Equal(other *MultiFactorPushDirectFCM) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of EmailProviderSettingsMandrillMessage.
-func (e *EmailProviderSettingsMandrillMessage) String() string {
-	return Stringify(e)
+// Diff returns the fields that changed between MultiFactorPushDirectFCM and other.
+func (m *MultiFactorPushDirectFCM) Diff(other *MultiFactorPushDirectFCM) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetMessage returns the Message field.
-func (e *EmailProviderSettingsSES) GetMessage() *EmailProviderSettingsSESMessage {
-	if e == nil {
+func (m *MultiFactorPushDirectFCM) diff(prefix string, other *MultiFactorPushDirectFCM) (changes []FieldChange) {
+	if m == nil && other == nil {
 		return nil
 	}
-	return e.Message
-}
-
-// String returns a string representation of EmailProviderSettingsSES.
-func (e *EmailProviderSettingsSES) String() string {
-	return Stringify(e)
+	if m == nil {
+		m = &MultiFactorPushDirectFCM{}
+	}
+	if other == nil {
+		other = &MultiFactorPushDirectFCM{}
+	}
+	changes = diffPointer(changes, prefix+"server_key", m.ServerKey, other.ServerKey)
+	return changes
 }
 
-// GetConfigurationSetName returns the ConfigurationSetName field if it's non-nil, zero value otherwise.
-func (e *EmailProviderSettingsSESMessage) GetConfigurationSetName() string {
-	if e == nil || e.ConfigurationSetName == nil {
-		return ""
+func (m *MultiFactorPushDirectFCM) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorPushDirectFCM)
+	if !ok {
+		return nil, false
 	}
-	return *e.ConfigurationSetName
+	return m.diff(prefix, typed), true
 }
 
-// String returns a string representation of EmailProviderSettingsSESMessage.
-func (e *EmailProviderSettingsSESMessage) String() string {
-	return Stringify(e)
+// String returns a string representation of MultiFactorRecoveryCode.
+func (m *MultiFactorRecoveryCode) String() string {
+	return Stringify(m)
 }
 
-// GetHeaders returns the Headers field.
-func (e *EmailProviderSettingsSMTP) GetHeaders() *EmailProviderSettingsSMTPHeaders {
-	if e == nil {
+// Clone returns a deep copy of MultiFactorRecoveryCode.
+func (m *MultiFactorRecoveryCode) Clone() *MultiFactorRecoveryCode {
+	if m == nil {
 		return nil
 	}
-	return e.Headers
+	clone := &MultiFactorRecoveryCode{}
+	clone.Management = m.Management
+	return clone
 }
 
-// String returns a string representation of EmailProviderSettingsSMTP.
-func (e *EmailProviderSettingsSMTP) String() string {
-	return Stringify(e)
+func (m *MultiFactorRecoveryCode) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetXMCViewContentLink returns the XMCViewContentLink field if it's non-nil, zero value otherwise.
-func (e *EmailProviderSettingsSMTPHeaders) GetXMCViewContentLink() string {
-	if e == nil || e.XMCViewContentLink == nil {
-		return ""
+// Equal reports whether MultiFactorRecoveryCode and other hold the same values.
+func (m *MultiFactorRecoveryCode) Equal(other *MultiFactorRecoveryCode) bool {
+	return len(m.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between MultiFactorRecoveryCode and other.
+func (m *MultiFactorRecoveryCode) Diff(other *MultiFactorRecoveryCode) []FieldChange {
+	return m.diff("", other)
+}
+
+func (m *MultiFactorRecoveryCode) diff(prefix string, other *MultiFactorRecoveryCode) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.XMCViewContentLink
+	if m == nil {
+		m = &MultiFactorRecoveryCode{}
+	}
+	if other == nil {
+		other = &MultiFactorRecoveryCode{}
+	}
+	return changes
 }
 
-// GetXSESConfigurationSet returns the XSESConfigurationSet field if it's non-nil, zero value otherwise.
-func (e *EmailProviderSettingsSMTPHeaders) GetXSESConfigurationSet() string {
-	if e == nil || e.XSESConfigurationSet == nil {
-		return ""
+func (m *MultiFactorRecoveryCode) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorRecoveryCode)
+	if !ok {
+		return nil, false
 	}
-	return *e.XSESConfigurationSet
+	return m.diff(prefix, typed), true
 }
 
-// String returns a string representation of EmailProviderSettingsSMTPHeaders.
-func (e *EmailProviderSettingsSMTPHeaders) String() string {
-	return Stringify(e)
+// String returns a string representation of MultiFactorSMS.
+func (m *MultiFactorSMS) String() string {
+	return Stringify(m)
 }
 
-// GetBody returns the Body field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetBody() string {
-	if e == nil || e.Body == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorSMS.
+func (m *MultiFactorSMS) Clone() *MultiFactorSMS {
+	if m == nil {
+		return nil
 	}
-	return *e.Body
+	clone := &MultiFactorSMS{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetEnabled() bool {
-	if e == nil || e.Enabled == nil {
-		return false
-	}
-	return *e.Enabled
+func (m *MultiFactorSMS) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetFrom() string {
-	if e == nil || e.From == nil {
-		return ""
-	}
-	return *e.From
+// Equal reports whether MultiFactorSMS and other hold the same values.
+func (m *MultiFactorSMS) Equal(other *MultiFactorSMS) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetIncludeEmailInRedirect returns the IncludeEmailInRedirect field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetIncludeEmailInRedirect() bool {
-	if e == nil || e.IncludeEmailInRedirect == nil {
-		return false
-	}
-	return *e.IncludeEmailInRedirect
+// Diff returns the fields that changed between MultiFactorSMS and other.
+func (m *MultiFactorSMS) Diff(other *MultiFactorSMS) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetResultURL returns the ResultURL field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetResultURL() string {
-	if e == nil || e.ResultURL == nil {
-		return ""
+func (m *MultiFactorSMS) diff(prefix string, other *MultiFactorSMS) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.ResultURL
+	if m == nil {
+		m = &MultiFactorSMS{}
+	}
+	if other == nil {
+		other = &MultiFactorSMS{}
+	}
+	return changes
 }
 
-// GetSubject returns the Subject field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetSubject() string {
-	if e == nil || e.Subject == nil {
-		return ""
+func (m *MultiFactorSMS) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorSMS)
+	if !ok {
+		return nil, false
 	}
-	return *e.Subject
+	return m.diff(prefix, typed), true
 }
 
-// GetSyntax returns the Syntax field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetSyntax() string {
-	if e == nil || e.Syntax == nil {
+// GetEnrollmentMessage returns the EnrollmentMessage field if it's non-nil, zero value otherwise.
+func (m *MultiFactorSMSTemplate) GetEnrollmentMessage() string {
+	if m == nil || m.EnrollmentMessage == nil {
 		return ""
 	}
-	return *e.Syntax
+	return *m.EnrollmentMessage
 }
 
-// GetTemplate returns the Template field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetTemplate() string {
-	if e == nil || e.Template == nil {
+// GetVerificationMessage returns the VerificationMessage field if it's non-nil, zero value otherwise.
+func (m *MultiFactorSMSTemplate) GetVerificationMessage() string {
+	if m == nil || m.VerificationMessage == nil {
 		return ""
 	}
-	return *e.Template
+	return *m.VerificationMessage
 }
 
-// GetURLLifetimeInSecoonds returns the URLLifetimeInSecoonds field if it's non-nil, zero value otherwise.
-func (e *EmailTemplate) GetURLLifetimeInSecoonds() int {
-	if e == nil || e.URLLifetimeInSecoonds == nil {
-		return 0
+// String returns a string representation of MultiFactorSMSTemplate.
+func (m *MultiFactorSMSTemplate) String() string {
+	return Stringify(m)
+}
+
+// Clone returns a deep copy of MultiFactorSMSTemplate.
+func (m *MultiFactorSMSTemplate) Clone() *MultiFactorSMSTemplate {
+	if m == nil {
+		return nil
 	}
-	return *e.URLLifetimeInSecoonds
+	clone := &MultiFactorSMSTemplate{}
+	clone.EnrollmentMessage = clonePointer(m.EnrollmentMessage)
+	clone.VerificationMessage = clonePointer(m.VerificationMessage)
+	return clone
 }
 
-// String returns a string representation of EmailTemplate.
-func (e *EmailTemplate) String() string {
-	return Stringify(e)
+func (m *MultiFactorSMSTemplate) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetEnrolledAt returns the EnrolledAt field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetEnrolledAt() time.Time {
-	if e == nil || e.EnrolledAt == nil {
-		return time.Time{}
-	}
-	return *e.EnrolledAt
+// Equal reports whether MultiFactorSMSTemplate and other hold the same values.
+func (m *MultiFactorSMSTemplate) Equal(other *MultiFactorSMSTemplate) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetID() string {
-	if e == nil || e.ID == nil {
-		return ""
-	}
-	return *e.ID
+// Diff returns the fields that changed between MultiFactorSMSTemplate and other.
+func (m *MultiFactorSMSTemplate) Diff(other *MultiFactorSMSTemplate) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetIdentifier() string {
-	if e == nil || e.Identifier == nil {
-		return ""
+func (m *MultiFactorSMSTemplate) diff(prefix string, other *MultiFactorSMSTemplate) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *e.Identifier
+	if m == nil {
+		m = &MultiFactorSMSTemplate{}
+	}
+	if other == nil {
+		other = &MultiFactorSMSTemplate{}
+	}
+	changes = diffPointer(changes, prefix+"enrollment_message", m.EnrollmentMessage, other.EnrollmentMessage)
+	changes = diffPointer(changes, prefix+"verification_message", m.VerificationMessage, other.VerificationMessage)
+	return changes
 }
 
-// GetLastAuth returns the LastAuth field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetLastAuth() time.Time {
-	if e == nil || e.LastAuth == nil {
-		return time.Time{}
+func (m *MultiFactorSMSTemplate) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorSMSTemplate)
+	if !ok {
+		return nil, false
 	}
-	return *e.LastAuth
+	return m.diff(prefix, typed), true
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetName() string {
-	if e == nil || e.Name == nil {
-		return ""
-	}
-	return *e.Name
+// String returns a string representation of MultiFactorWebAuthnPlatform.
+func (m *MultiFactorWebAuthnPlatform) String() string {
+	return Stringify(m)
 }
 
-// GetPhoneNumber returns the PhoneNumber field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetPhoneNumber() string {
-	if e == nil || e.PhoneNumber == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorWebAuthnPlatform.
+func (m *MultiFactorWebAuthnPlatform) Clone() *MultiFactorWebAuthnPlatform {
+	if m == nil {
+		return nil
 	}
-	return *e.PhoneNumber
+	clone := &MultiFactorWebAuthnPlatform{}
+	clone.Management = m.Management
+	return clone
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (e *Enrollment) GetStatus() string {
-	if e == nil || e.Status == nil {
-		return ""
-	}
-	return *e.Status
+func (m *MultiFactorWebAuthnPlatform) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// String returns a string representation of Enrollment.
-func (e *Enrollment) String() string {
-	return Stringify(e)
+// Equal reports whether MultiFactorWebAuthnPlatform and other hold the same values.
+func (m *MultiFactorWebAuthnPlatform) Equal(other *MultiFactorWebAuthnPlatform) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// String returns a string representation of EnrollmentTicket.
-func (e *EnrollmentTicket) String() string {
-	return Stringify(e)
+// Diff returns the fields that changed between MultiFactorWebAuthnPlatform and other.
+func (m *MultiFactorWebAuthnPlatform) Diff(other *MultiFactorWebAuthnPlatform) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
-func (g *Grant) GetAudience() string {
-	if g == nil || g.Audience == nil {
-		return ""
+func (m *MultiFactorWebAuthnPlatform) diff(prefix string, other *MultiFactorWebAuthnPlatform) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *g.Audience
+	if m == nil {
+		m = &MultiFactorWebAuthnPlatform{}
+	}
+	if other == nil {
+		other = &MultiFactorWebAuthnPlatform{}
+	}
+	return changes
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetClientID() string {
-	if g == nil || g.ClientID == nil {
-		return ""
+func (m *MultiFactorWebAuthnPlatform) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorWebAuthnPlatform)
+	if !ok {
+		return nil, false
 	}
-	return *g.ClientID
+	return m.diff(prefix, typed), true
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetID() string {
-	if g == nil || g.ID == nil {
-		return ""
-	}
-	return *g.ID
+// String returns a string representation of MultiFactorWebAuthnRoaming.
+func (m *MultiFactorWebAuthnRoaming) String() string {
+	return Stringify(m)
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (g *Grant) GetUserID() string {
-	if g == nil || g.UserID == nil {
-		return ""
+// Clone returns a deep copy of MultiFactorWebAuthnRoaming.
+func (m *MultiFactorWebAuthnRoaming) Clone() *MultiFactorWebAuthnRoaming {
+	if m == nil {
+		return nil
 	}
-	return *g.UserID
+	clone := &MultiFactorWebAuthnRoaming{}
+	clone.Management = m.Management
+	return clone
 }
 
-// String returns a string representation of Grant.
-func (g *Grant) String() string {
-	return Stringify(g)
+func (m *MultiFactorWebAuthnRoaming) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// String returns a string representation of GrantList.
-func (g *GrantList) String() string {
-	return Stringify(g)
+// Equal reports whether MultiFactorWebAuthnRoaming and other hold the same values.
+func (m *MultiFactorWebAuthnRoaming) Equal(other *MultiFactorWebAuthnRoaming) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetDependencies returns the Dependencies field if it's non-nil, zero value otherwise.
-func (h *Hook) GetDependencies() map[string]string {
-	if h == nil || h.Dependencies == nil {
-		return map[string]string{}
-	}
-	return *h.Dependencies
+// Diff returns the fields that changed between MultiFactorWebAuthnRoaming and other.
+func (m *MultiFactorWebAuthnRoaming) Diff(other *MultiFactorWebAuthnRoaming) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (h *Hook) GetEnabled() bool {
-	if h == nil || h.Enabled == nil {
-		return false
+func (m *MultiFactorWebAuthnRoaming) diff(prefix string, other *MultiFactorWebAuthnRoaming) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *h.Enabled
+	if m == nil {
+		m = &MultiFactorWebAuthnRoaming{}
+	}
+	if other == nil {
+		other = &MultiFactorWebAuthnRoaming{}
+	}
+	return changes
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetID() string {
-	if h == nil || h.ID == nil {
-		return ""
+func (m *MultiFactorWebAuthnRoaming) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorWebAuthnRoaming)
+	if !ok {
+		return nil, false
 	}
-	return *h.ID
+	return m.diff(prefix, typed), true
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (h *Hook) GetName() string {
-	if h == nil || h.Name == nil {
-		return ""
+// GetOverrideRelyingParty returns the OverrideRelyingParty field if it's non-nil, zero value otherwise.
+func (m *MultiFactorWebAuthnSettings) GetOverrideRelyingParty() bool {
+	if m == nil || m.OverrideRelyingParty == nil {
+		return false
 	}
-	return *h.Name
+	return *m.OverrideRelyingParty
 }
 
-// GetScript returns the Script field if it's non-nil, zero value otherwise.
-func (h *Hook) GetScript() string {
-	if h == nil || h.Script == nil {
+// GetRelyingPartyIdentifier returns the RelyingPartyIdentifier field if it's non-nil, zero value otherwise.
+func (m *MultiFactorWebAuthnSettings) GetRelyingPartyIdentifier() string {
+	if m == nil || m.RelyingPartyIdentifier == nil {
 		return ""
 	}
-	return *h.Script
+	return *m.RelyingPartyIdentifier
 }
 
-// GetTriggerID returns the TriggerID field if it's non-nil, zero value otherwise.
-func (h *Hook) GetTriggerID() string {
-	if h == nil || h.TriggerID == nil {
+// GetUserVerification returns the UserVerification field if it's non-nil, zero value otherwise.
+func (m *MultiFactorWebAuthnSettings) GetUserVerification() string {
+	if m == nil || m.UserVerification == nil {
 		return ""
 	}
-	return *h.TriggerID
+	return *m.UserVerification
 }
 
-// String returns a string representation of Hook.
-func (h *Hook) String() string {
-	return Stringify(h)
+// String returns a string representation of MultiFactorWebAuthnSettings.
+func (m *MultiFactorWebAuthnSettings) String() string {
+	return Stringify(m)
 }
 
-// String returns a string representation of HookList.
-func (h *HookList) String() string {
-	return Stringify(h)
+// Clone returns a deep copy of MultiFactorWebAuthnSettings.
+func (m *MultiFactorWebAuthnSettings) Clone() *MultiFactorWebAuthnSettings {
+	if m == nil {
+		return nil
+	}
+	clone := &MultiFactorWebAuthnSettings{}
+	clone.OverrideRelyingParty = clonePointer(m.OverrideRelyingParty)
+	clone.RelyingPartyIdentifier = clonePointer(m.RelyingPartyIdentifier)
+	clone.UserVerification = clonePointer(m.UserVerification)
+	return clone
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (j *Job) GetClientID() string {
-	if j == nil || j.ClientID == nil {
-		return ""
-	}
-	return *j.ClientID
+func (m *MultiFactorWebAuthnSettings) cloneAny() interface{} {
+	return m.Clone()
 }
 
-// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
-func (j *Job) GetConnectionID() string {
-	if j == nil || j.ConnectionID == nil {
-		return ""
-	}
-	return *j.ConnectionID
+// Equal reports whether MultiFactorWebAuthnSettings and other hold the same values.
+func (m *MultiFactorWebAuthnSettings) Equal(other *MultiFactorWebAuthnSettings) bool {
+	return len(m.diff("", other)) == 0
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (j *Job) GetCreatedAt() time.Time {
-	if j == nil || j.CreatedAt == nil {
-		return time.Time{}
-	}
-	return *j.CreatedAt
+// Diff returns the fields that changed between MultiFactorWebAuthnSettings and other.
+func (m *MultiFactorWebAuthnSettings) Diff(other *MultiFactorWebAuthnSettings) []FieldChange {
+	return m.diff("", other)
 }
 
-// GetExternalID returns the ExternalID field if it's non-nil, zero value otherwise.
-func (j *Job) GetExternalID() string {
-	if j == nil || j.ExternalID == nil {
-		return ""
+func (m *MultiFactorWebAuthnSettings) diff(prefix string, other *MultiFactorWebAuthnSettings) (changes []FieldChange) {
+	if m == nil && other == nil {
+		return nil
 	}
-	return *j.ExternalID
+	if m == nil {
+		m = &MultiFactorWebAuthnSettings{}
+	}
+	if other == nil {
+		other = &MultiFactorWebAuthnSettings{}
+	}
+	changes = diffPointer(changes, prefix+"overrideRelyingParty", m.OverrideRelyingParty, other.OverrideRelyingParty)
+	changes = diffPointer(changes, prefix+"relyingPartyIdentifier", m.RelyingPartyIdentifier, other.RelyingPartyIdentifier)
+	changes = diffPointer(changes, prefix+"userVerification", m.UserVerification, other.UserVerification)
+	return changes
 }
 
-// GetFormat returns the Format field if it's non-nil, zero value otherwise.
-func (j *Job) GetFormat() string {
-	if j == nil || j.Format == nil {
-		return ""
+func (m *MultiFactorWebAuthnSettings) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*MultiFactorWebAuthnSettings)
+	if !ok {
+		return nil, false
 	}
-	return *j.Format
+	return m.diff(prefix, typed), true
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (j *Job) GetID() string {
-	if j == nil || j.ID == nil {
+// GetAuthorizationEndpoint returns the AuthorizationEndpoint field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetAuthorizationEndpoint() string {
+	if o == nil || o.AuthorizationEndpoint == nil {
 		return ""
 	}
-	return *j.ID
+	return *o.AuthorizationEndpoint
 }
 
-// GetLimit returns the Limit field if it's non-nil, zero value otherwise.
-func (j *Job) GetLimit() int {
-	if j == nil || j.Limit == nil {
-		return 0
+// GetDiscoveryURL returns the DiscoveryURL field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetDiscoveryURL() string {
+	if o == nil || o.DiscoveryURL == nil {
+		return ""
 	}
-	return *j.Limit
+	return *o.DiscoveryURL
 }
 
-// GetLocation returns the Location field if it's non-nil, zero value otherwise.
-func (j *Job) GetLocation() string {
-	if j == nil || j.Location == nil {
+// GetEndSessionEndpoint returns the EndSessionEndpoint field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetEndSessionEndpoint() string {
+	if o == nil || o.EndSessionEndpoint == nil {
 		return ""
 	}
-	return *j.Location
+	return *o.EndSessionEndpoint
 }
 
-// GetPercentageDone returns the PercentageDone field if it's non-nil, zero value otherwise.
-func (j *Job) GetPercentageDone() int {
-	if j == nil || j.PercentageDone == nil {
-		return 0
+// GetIDTokenSigningAlgValuesSupported returns the IDTokenSigningAlgValuesSupported field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetIDTokenSigningAlgValuesSupported() []string {
+	if o == nil || o.IDTokenSigningAlgValuesSupported == nil {
+		return nil
 	}
-	return *j.PercentageDone
+	return *o.IDTokenSigningAlgValuesSupported
 }
 
-// GetSendCompletionEmail returns the SendCompletionEmail field if it's non-nil, zero value otherwise.
-func (j *Job) GetSendCompletionEmail() bool {
-	if j == nil || j.SendCompletionEmail == nil {
-		return false
+// GetIssuer returns the Issuer field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetIssuer() string {
+	if o == nil || o.Issuer == nil {
+		return ""
 	}
-	return *j.SendCompletionEmail
+	return *o.Issuer
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (j *Job) GetStatus() string {
-	if j == nil || j.Status == nil {
+// GetJWKSURI returns the JWKSURI field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetJWKSURI() string {
+	if o == nil || o.JWKSURI == nil {
 		return ""
 	}
-	return *j.Status
+	return *o.JWKSURI
 }
 
-// GetSummary returns the Summary field.
-func (j *Job) GetSummary() *JobSummary {
-	if j == nil {
+// GetResponseTypesSupported returns the ResponseTypesSupported field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetResponseTypesSupported() []string {
+	if o == nil || o.ResponseTypesSupported == nil {
 		return nil
 	}
-	return j.Summary
+	return *o.ResponseTypesSupported
 }
 
-// GetTimeLeftSeconds returns the TimeLeftSeconds field if it's non-nil, zero value otherwise.
-func (j *Job) GetTimeLeftSeconds() int {
-	if j == nil || j.TimeLeftSeconds == nil {
-		return 0
+// GetScopesSupported returns the ScopesSupported field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetScopesSupported() []string {
+	if o == nil || o.ScopesSupported == nil {
+		return nil
 	}
-	return *j.TimeLeftSeconds
+	return *o.ScopesSupported
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (j *Job) GetType() string {
-	if j == nil || j.Type == nil {
+// GetTokenEndpoint returns the TokenEndpoint field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetTokenEndpoint() string {
+	if o == nil || o.TokenEndpoint == nil {
 		return ""
 	}
-	return *j.Type
-}
-
-// GetUpsert returns the Upsert field if it's non-nil, zero value otherwise.
-func (j *Job) GetUpsert() bool {
-	if j == nil || j.Upsert == nil {
-		return false
-	}
-	return *j.Upsert
+	return *o.TokenEndpoint
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (j *Job) GetUserID() string {
-	if j == nil || j.UserID == nil {
+// GetUserInfoEndpoint returns the UserInfoEndpoint field if it's non-nil, zero value otherwise.
+func (o *OIDCDiscoveryDocument) GetUserInfoEndpoint() string {
+	if o == nil || o.UserInfoEndpoint == nil {
 		return ""
 	}
-	return *j.UserID
+	return *o.UserInfoEndpoint
 }
 
-// String returns a string representation of Job.
-func (j *Job) String() string {
-	return Stringify(j)
+// String returns a string representation of OIDCDiscoveryDocument.
+func (o *OIDCDiscoveryDocument) String() string {
+	return Stringify(o)
 }
 
-// String returns a string representation of JobError.
-func (j *JobError) String() string {
-	return Stringify(j)
+// GetBranding returns the Branding field.
+func (o *Organization) GetBranding() *OrganizationBranding {
+	if o == nil {
+		return nil
+	}
+	return o.Branding
 }
 
-// GetFailed returns the Failed field if it's non-nil, zero value otherwise.
-func (j *JobSummary) GetFailed() int {
-	if j == nil || j.Failed == nil {
-		return 0
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (o *Organization) GetDisplayName() string {
+	if o == nil || o.DisplayName == nil {
+		return ""
 	}
-	return *j.Failed
+	return *o.DisplayName
 }
 
-// GetInserted returns the Inserted field if it's non-nil, zero value otherwise.
-func (j *JobSummary) GetInserted() int {
-	if j == nil || j.Inserted == nil {
-		return 0
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *Organization) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
 	}
-	return *j.Inserted
+	return *o.ID
 }
 
-// GetTotal returns the Total field if it's non-nil, zero value otherwise.
-func (j *JobSummary) GetTotal() int {
-	if j == nil || j.Total == nil {
-		return 0
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (o *Organization) GetMetadata() map[string]string {
+	if o == nil || o.Metadata == nil {
+		return map[string]string{}
 	}
-	return *j.Total
+	return *o.Metadata
 }
 
-// GetUpdated returns the Updated field if it's non-nil, zero value otherwise.
-func (j *JobSummary) GetUpdated() int {
-	if j == nil || j.Updated == nil {
-		return 0
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *Organization) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
 	}
-	return *j.Updated
+	return *o.Name
 }
 
-// String returns a string representation of JobSummary.
-func (j *JobSummary) String() string {
-	return Stringify(j)
+// String returns a string representation of Organization.
+func (o *Organization) String() string {
+	return Stringify(o)
 }
 
-// String returns a string representation of JobUserErrors.
-func (j *JobUserErrors) String() string {
-	return Stringify(j)
+// Clone returns a deep copy of Organization.
+func (o *Organization) Clone() *Organization {
+	if o == nil {
+		return nil
+	}
+	clone := &Organization{}
+	clone.ID = clonePointer(o.ID)
+	clone.Name = clonePointer(o.Name)
+	clone.DisplayName = clonePointer(o.DisplayName)
+	clone.Branding = o.Branding.Clone()
+	clone.Metadata = clonePointerFunc(o.Metadata, func(v map[string]string) map[string]string { return cloneMap(v) })
+	return clone
 }
 
-// String returns a string representation of List.
-func (l *List) String() string {
-	return Stringify(l)
+func (o *Organization) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetAudience returns the Audience field if it's non-nil, zero value otherwise.
-func (l *Log) GetAudience() string {
-	if l == nil || l.Audience == nil {
-		return ""
-	}
-	return *l.Audience
+// Equal reports whether Organization and other hold the same values.
+func (o *Organization) Equal(other *Organization) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (l *Log) GetClientID() string {
-	if l == nil || l.ClientID == nil {
-		return ""
+// Diff returns the fields that changed between Organization and other.
+func (o *Organization) Diff(other *Organization) []FieldChange {
+	return o.diff("", other)
+}
+
+func (o *Organization) diff(prefix string, other *Organization) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.ClientID
+	if o == nil {
+		o = &Organization{}
+	}
+	if other == nil {
+		other = &Organization{}
+	}
+	changes = diffPointer(changes, prefix+"id", o.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", o.Name, other.Name)
+	changes = diffPointer(changes, prefix+"display_name", o.DisplayName, other.DisplayName)
+	changes = append(changes, o.Branding.diff(prefix+"branding.", other.Branding)...)
+	changes = diffMapPointer(changes, prefix+"metadata", o.Metadata, other.Metadata)
+	return changes
 }
 
-// GetClientName returns the ClientName field if it's non-nil, zero value otherwise.
-func (l *Log) GetClientName() string {
-	if l == nil || l.ClientName == nil {
-		return ""
+func (o *Organization) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Organization)
+	if !ok {
+		return nil, false
 	}
-	return *l.ClientName
+	return o.diff(prefix, typed), true
 }
 
-// GetConnection returns the Connection field if it's non-nil, zero value otherwise.
-func (l *Log) GetConnection() string {
-	if l == nil || l.Connection == nil {
-		return ""
+// GetColors returns the Colors field if it's non-nil, zero value otherwise.
+func (o *OrganizationBranding) GetColors() map[string]string {
+	if o == nil || o.Colors == nil {
+		return map[string]string{}
 	}
-	return *l.Connection
+	return *o.Colors
 }
 
-// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
-func (l *Log) GetConnectionID() string {
-	if l == nil || l.ConnectionID == nil {
+// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
+func (o *OrganizationBranding) GetLogoURL() string {
+	if o == nil || o.LogoURL == nil {
 		return ""
 	}
-	return *l.ConnectionID
+	return *o.LogoURL
 }
 
-// GetDate returns the Date field if it's non-nil, zero value otherwise.
-func (l *Log) GetDate() time.Time {
-	if l == nil || l.Date == nil {
-		return time.Time{}
-	}
-	return *l.Date
+// String returns a string representation of OrganizationBranding.
+func (o *OrganizationBranding) String() string {
+	return Stringify(o)
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (l *Log) GetDescription() string {
-	if l == nil || l.Description == nil {
-		return ""
+// Clone returns a deep copy of OrganizationBranding.
+func (o *OrganizationBranding) Clone() *OrganizationBranding {
+	if o == nil {
+		return nil
 	}
-	return *l.Description
+	clone := &OrganizationBranding{}
+	clone.LogoURL = clonePointer(o.LogoURL)
+	clone.Colors = clonePointerFunc(o.Colors, func(v map[string]string) map[string]string { return cloneMap(v) })
+	return clone
 }
 
-// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
-func (l *Log) GetHostname() string {
-	if l == nil || l.Hostname == nil {
-		return ""
-	}
-	return *l.Hostname
+func (o *OrganizationBranding) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *Log) GetID() string {
-	if l == nil || l.ID == nil {
-		return ""
+// Equal reports whether OrganizationBranding and other hold the same values.
+func (o *OrganizationBranding) Equal(other *OrganizationBranding) bool {
+	return len(o.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between OrganizationBranding and other.
+func (o *OrganizationBranding) Diff(other *OrganizationBranding) []FieldChange {
+	return o.diff("", other)
+}
+
+func (o *OrganizationBranding) diff(prefix string, other *OrganizationBranding) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.ID
+	if o == nil {
+		o = &OrganizationBranding{}
+	}
+	if other == nil {
+		other = &OrganizationBranding{}
+	}
+	changes = diffPointer(changes, prefix+"logo_url", o.LogoURL, other.LogoURL)
+	changes = diffMapPointer(changes, prefix+"colors", o.Colors, other.Colors)
+	return changes
 }
 
-// GetIP returns the IP field if it's non-nil, zero value otherwise.
-func (l *Log) GetIP() string {
-	if l == nil || l.IP == nil {
-		return ""
+func (o *OrganizationBranding) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationBranding)
+	if !ok {
+		return nil, false
 	}
-	return *l.IP
+	return o.diff(prefix, typed), true
 }
 
-// GetIsMobile returns the IsMobile field if it's non-nil, zero value otherwise.
-func (l *Log) GetIsMobile() bool {
-	if l == nil || l.IsMobile == nil {
+// GetAssignMembershipOnLogin returns the AssignMembershipOnLogin field if it's non-nil, zero value otherwise.
+func (o *OrganizationConnection) GetAssignMembershipOnLogin() bool {
+	if o == nil || o.AssignMembershipOnLogin == nil {
 		return false
 	}
-	return *l.IsMobile
+	return *o.AssignMembershipOnLogin
 }
 
-// GetLogID returns the LogID field if it's non-nil, zero value otherwise.
-func (l *Log) GetLogID() string {
-	if l == nil || l.LogID == nil {
-		return ""
+// GetConnection returns the Connection field.
+func (o *OrganizationConnection) GetConnection() *OrganizationConnectionDetails {
+	if o == nil {
+		return nil
 	}
-	return *l.LogID
+	return o.Connection
 }
 
-// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
-func (l *Log) GetOrganizationID() string {
-	if l == nil || l.OrganizationID == nil {
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (o *OrganizationConnection) GetConnectionID() string {
+	if o == nil || o.ConnectionID == nil {
 		return ""
 	}
-	return *l.OrganizationID
+	return *o.ConnectionID
 }
 
-// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
-func (l *Log) GetOrganizationName() string {
-	if l == nil || l.OrganizationName == nil {
-		return ""
-	}
-	return *l.OrganizationName
+// String returns a string representation of OrganizationConnection.
+func (o *OrganizationConnection) String() string {
+	return Stringify(o)
 }
 
-// GetScope returns the Scope field if it's non-nil, zero value otherwise.
-func (l *Log) GetScope() string {
-	if l == nil || l.Scope == nil {
-		return ""
+// Clone returns a deep copy of OrganizationConnection.
+func (o *OrganizationConnection) Clone() *OrganizationConnection {
+	if o == nil {
+		return nil
 	}
-	return *l.Scope
+	clone := &OrganizationConnection{}
+	clone.ConnectionID = clonePointer(o.ConnectionID)
+	clone.AssignMembershipOnLogin = clonePointer(o.AssignMembershipOnLogin)
+	clone.Connection = o.Connection.Clone()
+	return clone
 }
 
-// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
-func (l *Log) GetStrategy() string {
-	if l == nil || l.Strategy == nil {
-		return ""
-	}
-	return *l.Strategy
+func (o *OrganizationConnection) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetStrategyType returns the StrategyType field if it's non-nil, zero value otherwise.
-func (l *Log) GetStrategyType() string {
-	if l == nil || l.StrategyType == nil {
-		return ""
-	}
-	return *l.StrategyType
+// Equal reports whether OrganizationConnection and other hold the same values.
+func (o *OrganizationConnection) Equal(other *OrganizationConnection) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (l *Log) GetType() string {
-	if l == nil || l.Type == nil {
-		return ""
+// Diff returns the fields that changed between OrganizationConnection and other.
+func (o *OrganizationConnection) Diff(other *OrganizationConnection) []FieldChange {
+	return o.diff("", other)
+}
+
+func (o *OrganizationConnection) diff(prefix string, other *OrganizationConnection) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.Type
+	if o == nil {
+		o = &OrganizationConnection{}
+	}
+	if other == nil {
+		other = &OrganizationConnection{}
+	}
+	changes = diffPointer(changes, prefix+"connection_id", o.ConnectionID, other.ConnectionID)
+	changes = diffPointer(changes, prefix+"assign_membership_on_login", o.AssignMembershipOnLogin, other.AssignMembershipOnLogin)
+	changes = append(changes, o.Connection.diff(prefix+"connection.", other.Connection)...)
+	return changes
 }
 
-// GetUserAgent returns the UserAgent field if it's non-nil, zero value otherwise.
-func (l *Log) GetUserAgent() string {
-	if l == nil || l.UserAgent == nil {
-		return ""
+func (o *OrganizationConnection) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationConnection)
+	if !ok {
+		return nil, false
 	}
-	return *l.UserAgent
+	return o.diff(prefix, typed), true
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (l *Log) GetUserID() string {
-	if l == nil || l.UserID == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrganizationConnectionDetails) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *l.UserID
+	return *o.Name
 }
 
-// GetUserName returns the UserName field if it's non-nil, zero value otherwise.
-func (l *Log) GetUserName() string {
-	if l == nil || l.UserName == nil {
+// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
+func (o *OrganizationConnectionDetails) GetStrategy() string {
+	if o == nil || o.Strategy == nil {
 		return ""
 	}
-	return *l.UserName
+	return *o.Strategy
 }
 
-// String returns a string representation of Log.
-func (l *Log) String() string {
-	return Stringify(l)
+// String returns a string representation of OrganizationConnectionDetails.
+func (o *OrganizationConnectionDetails) String() string {
+	return Stringify(o)
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (l *LogStream) GetID() string {
-	if l == nil || l.ID == nil {
-		return ""
+// Clone returns a deep copy of OrganizationConnectionDetails.
+func (o *OrganizationConnectionDetails) Clone() *OrganizationConnectionDetails {
+	if o == nil {
+		return nil
 	}
-	return *l.ID
+	clone := &OrganizationConnectionDetails{}
+	clone.Name = clonePointer(o.Name)
+	clone.Strategy = clonePointer(o.Strategy)
+	return clone
+}
+
+func (o *OrganizationConnectionDetails) cloneAny() interface{} {
+	return o.Clone()
+}
+
+// Equal reports whether OrganizationConnectionDetails and other hold the same values.
+func (o *OrganizationConnectionDetails) Equal(other *OrganizationConnectionDetails) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (l *LogStream) GetName() string {
-	if l == nil || l.Name == nil {
-		return ""
-	}
-	return *l.Name
+// Diff returns the fields that changed between OrganizationConnectionDetails and other.
+func (o *OrganizationConnectionDetails) Diff(other *OrganizationConnectionDetails) []FieldChange {
+	return o.diff("", other)
 }
 
-// GetStatus returns the Status field if it's non-nil, zero value otherwise.
-func (l *LogStream) GetStatus() string {
-	if l == nil || l.Status == nil {
-		return ""
+func (o *OrganizationConnectionDetails) diff(prefix string, other *OrganizationConnectionDetails) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.Status
+	if o == nil {
+		o = &OrganizationConnectionDetails{}
+	}
+	if other == nil {
+		other = &OrganizationConnectionDetails{}
+	}
+	changes = diffPointer(changes, prefix+"name", o.Name, other.Name)
+	changes = diffPointer(changes, prefix+"strategy", o.Strategy, other.Strategy)
+	return changes
 }
 
-// GetType returns the Type field if it's non-nil, zero value otherwise.
-func (l *LogStream) GetType() string {
-	if l == nil || l.Type == nil {
-		return ""
+func (o *OrganizationConnectionDetails) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationConnectionDetails)
+	if !ok {
+		return nil, false
 	}
-	return *l.Type
+	return o.diff(prefix, typed), true
 }
 
-// String returns a string representation of LogStream.
-func (l *LogStream) String() string {
-	return Stringify(l)
+// String returns a string representation of OrganizationConnectionList.
+func (o *OrganizationConnectionList) String() string {
+	return Stringify(o)
 }
 
-// GetAccountID returns the AccountID field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAmazonEventBridge) GetAccountID() string {
-	if l == nil || l.AccountID == nil {
-		return ""
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (o *OrganizationDesiredMember) GetRoles() []string {
+	if o == nil || o.Roles == nil {
+		return nil
 	}
-	return *l.AccountID
+	return *o.Roles
 }
 
-// GetPartnerEventSource returns the PartnerEventSource field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAmazonEventBridge) GetPartnerEventSource() string {
-	if l == nil || l.PartnerEventSource == nil {
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (o *OrganizationDesiredMember) GetUserID() string {
+	if o == nil || o.UserID == nil {
 		return ""
 	}
-	return *l.PartnerEventSource
+	return *o.UserID
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAmazonEventBridge) GetRegion() string {
-	if l == nil || l.Region == nil {
+// String returns a string representation of OrganizationDesiredMember.
+func (o *OrganizationDesiredMember) String() string {
+	return Stringify(o)
+}
+
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetClientID() string {
+	if o == nil || o.ClientID == nil {
 		return ""
 	}
-	return *l.Region
+	return *o.ClientID
 }
 
-// String returns a string representation of LogStreamSinkAmazonEventBridge.
-func (l *LogStreamSinkAmazonEventBridge) String() string {
-	return Stringify(l)
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetConnectionID() string {
+	if o == nil || o.ConnectionID == nil {
+		return ""
+	}
+	return *o.ConnectionID
 }
 
-// GetPartnerTopic returns the PartnerTopic field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAzureEventGrid) GetPartnerTopic() string {
-	if l == nil || l.PartnerTopic == nil {
+// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetCreatedAt() string {
+	if o == nil || o.CreatedAt == nil {
 		return ""
 	}
-	return *l.PartnerTopic
+	return *o.CreatedAt
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAzureEventGrid) GetRegion() string {
-	if l == nil || l.Region == nil {
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetExpiresAt() string {
+	if o == nil || o.ExpiresAt == nil {
 		return ""
 	}
-	return *l.Region
+	return *o.ExpiresAt
 }
 
-// GetResourceGroup returns the ResourceGroup field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAzureEventGrid) GetResourceGroup() string {
-	if l == nil || l.ResourceGroup == nil {
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetID() string {
+	if o == nil || o.ID == nil {
 		return ""
 	}
-	return *l.ResourceGroup
+	return *o.ID
 }
 
-// GetSubscriptionID returns the SubscriptionID field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkAzureEventGrid) GetSubscriptionID() string {
-	if l == nil || l.SubscriptionID == nil {
+// GetInvitationURL returns the InvitationURL field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetInvitationURL() string {
+	if o == nil || o.InvitationURL == nil {
 		return ""
 	}
-	return *l.SubscriptionID
+	return *o.InvitationURL
 }
 
-// String returns a string representation of LogStreamSinkAzureEventGrid.
-func (l *LogStreamSinkAzureEventGrid) String() string {
-	return Stringify(l)
+// GetInvitee returns the Invitee field.
+func (o *OrganizationInvitation) GetInvitee() *OrganizationInvitationInvitee {
+	if o == nil {
+		return nil
+	}
+	return o.Invitee
 }
 
-// GetAPIKey returns the APIKey field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkDatadog) GetAPIKey() string {
-	if l == nil || l.APIKey == nil {
-		return ""
+// GetInviter returns the Inviter field.
+func (o *OrganizationInvitation) GetInviter() *OrganizationInvitationInviter {
+	if o == nil {
+		return nil
 	}
-	return *l.APIKey
+	return o.Inviter
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkDatadog) GetRegion() string {
-	if l == nil || l.Region == nil {
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetOrganizationID() string {
+	if o == nil || o.OrganizationID == nil {
 		return ""
 	}
-	return *l.Region
+	return *o.OrganizationID
 }
 
-// String returns a string representation of LogStreamSinkDatadog.
-func (l *LogStreamSinkDatadog) String() string {
-	return Stringify(l)
+// GetSendInvitationEmail returns the SendInvitationEmail field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetSendInvitationEmail() bool {
+	if o == nil || o.SendInvitationEmail == nil {
+		return false
+	}
+	return *o.SendInvitationEmail
 }
 
-// GetAuthorization returns the Authorization field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkHTTP) GetAuthorization() string {
-	if l == nil || l.Authorization == nil {
+// GetTicketID returns the TicketID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetTicketID() string {
+	if o == nil || o.TicketID == nil {
 		return ""
 	}
-	return *l.Authorization
+	return *o.TicketID
 }
 
-// GetContentFormat returns the ContentFormat field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkHTTP) GetContentFormat() string {
-	if l == nil || l.ContentFormat == nil {
-		return ""
+// GetTTLSec returns the TTLSec field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitation) GetTTLSec() int {
+	if o == nil || o.TTLSec == nil {
+		return 0
 	}
-	return *l.ContentFormat
+	return *o.TTLSec
 }
 
-// GetContentType returns the ContentType field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkHTTP) GetContentType() string {
-	if l == nil || l.ContentType == nil {
-		return ""
-	}
-	return *l.ContentType
+// String returns a string representation of OrganizationInvitation.
+func (o *OrganizationInvitation) String() string {
+	return Stringify(o)
 }
 
-// GetEndpoint returns the Endpoint field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkHTTP) GetEndpoint() string {
-	if l == nil || l.Endpoint == nil {
-		return ""
+// Clone returns a deep copy of OrganizationInvitation.
+func (o *OrganizationInvitation) Clone() *OrganizationInvitation {
+	if o == nil {
+		return nil
 	}
-	return *l.Endpoint
+	clone := &OrganizationInvitation{}
+	clone.ID = clonePointer(o.ID)
+	clone.OrganizationID = clonePointer(o.OrganizationID)
+	clone.Inviter = o.Inviter.Clone()
+	clone.Invitee = o.Invitee.Clone()
+	clone.InvitationURL = clonePointer(o.InvitationURL)
+	clone.CreatedAt = clonePointer(o.CreatedAt)
+	clone.TTLSec = clonePointer(o.TTLSec)
+	clone.ExpiresAt = clonePointer(o.ExpiresAt)
+	clone.ClientID = clonePointer(o.ClientID)
+	clone.ConnectionID = clonePointer(o.ConnectionID)
+	clone.AppMetadata = cloneMapFunc(o.AppMetadata, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.UserMetadata = cloneMapFunc(o.UserMetadata, func(v interface{}) interface{} { return cloneValue(v) })
+	clone.Roles = cloneSlice(o.Roles)
+	clone.TicketID = clonePointer(o.TicketID)
+	clone.SendInvitationEmail = clonePointer(o.SendInvitationEmail)
+	return clone
 }
 
-// String returns a string representation of LogStreamSinkHTTP.
-func (l *LogStreamSinkHTTP) String() string {
-	return Stringify(l)
+func (o *OrganizationInvitation) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetProjectID returns the ProjectID field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkMixpanel) GetProjectID() string {
-	if l == nil || l.ProjectID == nil {
-		return ""
-	}
-	return *l.ProjectID
+// Equal reports whether OrganizationInvitation and other hold the same values.
+func (o *OrganizationInvitation) Equal(other *OrganizationInvitation) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkMixpanel) GetRegion() string {
-	if l == nil || l.Region == nil {
-		return ""
+// Diff returns the fields that changed between OrganizationInvitation and other.
+func (o *OrganizationInvitation) Diff(other *OrganizationInvitation) []FieldChange {
+	return o.diff("", other)
+}
+
+func (o *OrganizationInvitation) diff(prefix string, other *OrganizationInvitation) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.Region
+	if o == nil {
+		o = &OrganizationInvitation{}
+	}
+	if other == nil {
+		other = &OrganizationInvitation{}
+	}
+	changes = diffPointer(changes, prefix+"id", o.ID, other.ID)
+	changes = diffPointer(changes, prefix+"organization_id", o.OrganizationID, other.OrganizationID)
+	changes = append(changes, o.Inviter.diff(prefix+"inviter.", other.Inviter)...)
+	changes = append(changes, o.Invitee.diff(prefix+"invitee.", other.Invitee)...)
+	changes = diffPointer(changes, prefix+"invitation_url", o.InvitationURL, other.InvitationURL)
+	changes = diffPointer(changes, prefix+"created_at", o.CreatedAt, other.CreatedAt)
+	changes = diffPointer(changes, prefix+"ttl_sec", o.TTLSec, other.TTLSec)
+	changes = diffPointer(changes, prefix+"expires_at", o.ExpiresAt, other.ExpiresAt)
+	changes = diffPointer(changes, prefix+"client_id", o.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"connection_id", o.ConnectionID, other.ConnectionID)
+	changes = diffMap(changes, prefix+"app_metadata", o.AppMetadata, other.AppMetadata)
+	changes = diffMap(changes, prefix+"user_metadata", o.UserMetadata, other.UserMetadata)
+	changes = diffSlice(changes, prefix+"roles", o.Roles, other.Roles)
+	changes = diffPointer(changes, prefix+"ticket_id", o.TicketID, other.TicketID)
+	changes = diffPointer(changes, prefix+"send_invitation_email", o.SendInvitationEmail, other.SendInvitationEmail)
+	return changes
 }
 
-// GetServiceAccountPassword returns the ServiceAccountPassword field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkMixpanel) GetServiceAccountPassword() string {
-	if l == nil || l.ServiceAccountPassword == nil {
-		return ""
+func (o *OrganizationInvitation) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationInvitation)
+	if !ok {
+		return nil, false
 	}
-	return *l.ServiceAccountPassword
+	return o.diff(prefix, typed), true
 }
 
-// GetServiceAccountUsername returns the ServiceAccountUsername field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkMixpanel) GetServiceAccountUsername() string {
-	if l == nil || l.ServiceAccountUsername == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationInvitee) GetEmail() string {
+	if o == nil || o.Email == nil {
 		return ""
 	}
-	return *l.ServiceAccountUsername
+	return *o.Email
 }
 
-// String returns a string representation of LogStreamSinkMixpanel.
-func (l *LogStreamSinkMixpanel) String() string {
-	return Stringify(l)
+// String returns a string representation of OrganizationInvitationInvitee.
+func (o *OrganizationInvitationInvitee) String() string {
+	return Stringify(o)
 }
 
-// GetWriteKey returns the WriteKey field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSegment) GetWriteKey() string {
-	if l == nil || l.WriteKey == nil {
-		return ""
+// Clone returns a deep copy of OrganizationInvitationInvitee.
+func (o *OrganizationInvitationInvitee) Clone() *OrganizationInvitationInvitee {
+	if o == nil {
+		return nil
 	}
-	return *l.WriteKey
+	clone := &OrganizationInvitationInvitee{}
+	clone.Email = clonePointer(o.Email)
+	return clone
 }
 
-// String returns a string representation of LogStreamSinkSegment.
-func (l *LogStreamSinkSegment) String() string {
-	return Stringify(l)
+func (o *OrganizationInvitationInvitee) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSplunk) GetDomain() string {
-	if l == nil || l.Domain == nil {
-		return ""
-	}
-	return *l.Domain
+// Equal reports whether OrganizationInvitationInvitee and other hold the same values.
+func (o *OrganizationInvitationInvitee) Equal(other *OrganizationInvitationInvitee) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// GetPort returns the Port field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSplunk) GetPort() string {
-	if l == nil || l.Port == nil {
-		return ""
-	}
-	return *l.Port
+// Diff returns the fields that changed between OrganizationInvitationInvitee and other.
+func (o *OrganizationInvitationInvitee) Diff(other *OrganizationInvitationInvitee) []FieldChange {
+	return o.diff("", other)
 }
 
-// GetSecure returns the Secure field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSplunk) GetSecure() bool {
-	if l == nil || l.Secure == nil {
-		return false
+func (o *OrganizationInvitationInvitee) diff(prefix string, other *OrganizationInvitationInvitee) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *l.Secure
-}
-
-// GetToken returns the Token field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSplunk) GetToken() string {
-	if l == nil || l.Token == nil {
-		return ""
+	if o == nil {
+		o = &OrganizationInvitationInvitee{}
 	}
-	return *l.Token
+	if other == nil {
+		other = &OrganizationInvitationInvitee{}
+	}
+	changes = diffPointer(changes, prefix+"email", o.Email, other.Email)
+	return changes
 }
 
-// String returns a string representation of LogStreamSinkSplunk.
-func (l *LogStreamSinkSplunk) String() string {
-	return Stringify(l)
+func (o *OrganizationInvitationInvitee) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationInvitationInvitee)
+	if !ok {
+		return nil, false
+	}
+	return o.diff(prefix, typed), true
 }
 
-// GetSourceAddress returns the SourceAddress field if it's non-nil, zero value otherwise.
-func (l *LogStreamSinkSumo) GetSourceAddress() string {
-	if l == nil || l.SourceAddress == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationInviter) GetName() string {
+	if o == nil || o.Name == nil {
 		return ""
 	}
-	return *l.SourceAddress
+	return *o.Name
 }
 
-// String returns a string representation of LogStreamSinkSumo.
-func (l *LogStreamSinkSumo) String() string {
-	return Stringify(l)
+// String returns a string representation of OrganizationInvitationInviter.
+func (o *OrganizationInvitationInviter) String() string {
+	return Stringify(o)
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (m *MultiFactor) GetEnabled() bool {
-	if m == nil || m.Enabled == nil {
-		return false
+// Clone returns a deep copy of OrganizationInvitationInviter.
+func (o *OrganizationInvitationInviter) Clone() *OrganizationInvitationInviter {
+	if o == nil {
+		return nil
 	}
-	return *m.Enabled
+	clone := &OrganizationInvitationInviter{}
+	clone.Name = clonePointer(o.Name)
+	return clone
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (m *MultiFactor) GetName() string {
-	if m == nil || m.Name == nil {
-		return ""
-	}
-	return *m.Name
+func (o *OrganizationInvitationInviter) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// GetTrialExpired returns the TrialExpired field if it's non-nil, zero value otherwise.
-func (m *MultiFactor) GetTrialExpired() bool {
-	if m == nil || m.TrialExpired == nil {
-		return false
-	}
-	return *m.TrialExpired
+// Equal reports whether OrganizationInvitationInviter and other hold the same values.
+func (o *OrganizationInvitationInviter) Equal(other *OrganizationInvitationInviter) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// String returns a string representation of MultiFactor.
-func (m *MultiFactor) String() string {
-	return Stringify(m)
+// Diff returns the fields that changed between OrganizationInvitationInviter and other.
+func (o *OrganizationInvitationInviter) Diff(other *OrganizationInvitationInviter) []FieldChange {
+	return o.diff("", other)
 }
 
-// String returns a string representation of MultiFactorDUO.
-func (m *MultiFactorDUO) String() string {
-	return Stringify(m)
+func (o *OrganizationInvitationInviter) diff(prefix string, other *OrganizationInvitationInviter) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
+	}
+	if o == nil {
+		o = &OrganizationInvitationInviter{}
+	}
+	if other == nil {
+		other = &OrganizationInvitationInviter{}
+	}
+	changes = diffPointer(changes, prefix+"name", o.Name, other.Name)
+	return changes
 }
 
-// GetHostname returns the Hostname field if it's non-nil, zero value otherwise.
-func (m *MultiFactorDUOSettings) GetHostname() string {
-	if m == nil || m.Hostname == nil {
-		return ""
+func (o *OrganizationInvitationInviter) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationInvitationInviter)
+	if !ok {
+		return nil, false
 	}
-	return *m.Hostname
+	return o.diff(prefix, typed), true
 }
 
-// GetIntegrationKey returns the IntegrationKey field if it's non-nil, zero value otherwise.
-func (m *MultiFactorDUOSettings) GetIntegrationKey() string {
-	if m == nil || m.IntegrationKey == nil {
+// String returns a string representation of OrganizationInvitationList.
+func (o *OrganizationInvitationList) String() string {
+	return Stringify(o)
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationRefreshOptions) GetAction() string {
+	if o == nil || o.Action == nil {
 		return ""
 	}
-	return *m.IntegrationKey
+	return *o.Action
 }
 
-// GetSecretKey returns the SecretKey field if it's non-nil, zero value otherwise.
-func (m *MultiFactorDUOSettings) GetSecretKey() string {
-	if m == nil || m.SecretKey == nil {
-		return ""
+// GetDelay returns the Delay field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationRefreshOptions) GetDelay() time.Duration {
+	if o == nil || o.Delay == nil {
+		return 0
 	}
-	return *m.SecretKey
+	return *o.Delay
 }
 
-// String returns a string representation of MultiFactorDUOSettings.
-func (m *MultiFactorDUOSettings) String() string {
-	return Stringify(m)
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationRefreshOptions) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
 }
 
-// String returns a string representation of MultiFactorEmail.
-func (m *MultiFactorEmail) String() string {
-	return Stringify(m)
+// GetWithin returns the Within field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationRefreshOptions) GetWithin() time.Duration {
+	if o == nil || o.Within == nil {
+		return 0
+	}
+	return *o.Within
 }
 
-// String returns a string representation of MultiFactorOTP.
-func (m *MultiFactorOTP) String() string {
-	return Stringify(m)
+// String returns a string representation of OrganizationInvitationRefreshOptions.
+func (o *OrganizationInvitationRefreshOptions) String() string {
+	return Stringify(o)
 }
 
-// String returns a string representation of MultiFactorPhone.
-func (m *MultiFactorPhone) String() string {
-	return Stringify(m)
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationReport) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
 }
 
-// GetProvider returns the Provider field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProvider) GetProvider() string {
-	if m == nil || m.Provider == nil {
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationReport) GetOrganizationID() string {
+	if o == nil || o.OrganizationID == nil {
 		return ""
 	}
-	return *m.Provider
+	return *o.OrganizationID
 }
 
-// String returns a string representation of MultiFactorProvider.
-func (m *MultiFactorProvider) String() string {
-	return Stringify(m)
+// String returns a string representation of OrganizationInvitationReport.
+func (o *OrganizationInvitationReport) String() string {
+	return Stringify(o)
 }
 
-// GetAccessKeyID returns the AccessKeyID field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderAmazonSNS) GetAccessKeyID() string {
-	if m == nil || m.AccessKeyID == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetAction() string {
+	if o == nil || o.Action == nil {
 		return ""
 	}
-	return *m.AccessKeyID
+	return *o.Action
 }
 
-// GetAPNSPlatformApplicationARN returns the APNSPlatformApplicationARN field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderAmazonSNS) GetAPNSPlatformApplicationARN() string {
-	if m == nil || m.APNSPlatformApplicationARN == nil {
-		return ""
+// GetApplied returns the Applied field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetApplied() bool {
+	if o == nil || o.Applied == nil {
+		return false
 	}
-	return *m.APNSPlatformApplicationARN
+	return *o.Applied
 }
 
-// GetGCMPlatformApplicationARN returns the GCMPlatformApplicationARN field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderAmazonSNS) GetGCMPlatformApplicationARN() string {
-	if m == nil || m.GCMPlatformApplicationARN == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetEmail() string {
+	if o == nil || o.Email == nil {
 		return ""
 	}
-	return *m.GCMPlatformApplicationARN
+	return *o.Email
 }
 
-// GetRegion returns the Region field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderAmazonSNS) GetRegion() string {
-	if m == nil || m.Region == nil {
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetError() string {
+	if o == nil || o.Error == nil {
 		return ""
 	}
-	return *m.Region
+	return *o.Error
 }
 
-// GetSecretAccessKeyID returns the SecretAccessKeyID field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderAmazonSNS) GetSecretAccessKeyID() string {
-	if m == nil || m.SecretAccessKeyID == nil {
-		return ""
+// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetExpiresAt() time.Time {
+	if o == nil || o.ExpiresAt == nil {
+		return time.Time{}
 	}
-	return *m.SecretAccessKeyID
-}
-
-// String returns a string representation of MultiFactorProviderAmazonSNS.
-func (m *MultiFactorProviderAmazonSNS) String() string {
-	return Stringify(m)
+	return *o.ExpiresAt
 }
 
-// GetAuthToken returns the AuthToken field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderTwilio) GetAuthToken() string {
-	if m == nil || m.AuthToken == nil {
+// GetInvitationID returns the InvitationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitationResult) GetInvitationID() string {
+	if o == nil || o.InvitationID == nil {
 		return ""
 	}
-	return *m.AuthToken
+	return *o.InvitationID
 }
 
-// GetFrom returns the From field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderTwilio) GetFrom() string {
-	if m == nil || m.From == nil {
-		return ""
-	}
-	return *m.From
+// String returns a string representation of OrganizationInvitationResult.
+func (o *OrganizationInvitationResult) String() string {
+	return Stringify(o)
 }
 
-// GetMessagingServiceSid returns the MessagingServiceSid field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderTwilio) GetMessagingServiceSid() string {
-	if m == nil || m.MessagingServiceSid == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitee) GetEmail() string {
+	if o == nil || o.Email == nil {
 		return ""
 	}
-	return *m.MessagingServiceSid
+	return *o.Email
 }
 
-// GetSID returns the SID field if it's non-nil, zero value otherwise.
-func (m *MultiFactorProviderTwilio) GetSID() string {
-	if m == nil || m.SID == nil {
-		return ""
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (o *OrganizationInvitee) GetRoles() []string {
+	if o == nil || o.Roles == nil {
+		return nil
 	}
-	return *m.SID
-}
-
-// String returns a string representation of MultiFactorProviderTwilio.
-func (m *MultiFactorProviderTwilio) String() string {
-	return Stringify(m)
+	return *o.Roles
 }
 
-// String returns a string representation of MultiFactorPush.
-func (m *MultiFactorPush) String() string {
-	return Stringify(m)
+// String returns a string representation of OrganizationInvitee.
+func (o *OrganizationInvitee) String() string {
+	return Stringify(o)
 }
 
-// GetAppleAppLink returns the AppleAppLink field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushCustomApp) GetAppleAppLink() string {
-	if m == nil || m.AppleAppLink == nil {
+// GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetClientID() string {
+	if o == nil || o.ClientID == nil {
 		return ""
 	}
-	return *m.AppleAppLink
+	return *o.ClientID
 }
 
-// GetAppName returns the AppName field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushCustomApp) GetAppName() string {
-	if m == nil || m.AppName == nil {
+// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetConnectionID() string {
+	if o == nil || o.ConnectionID == nil {
 		return ""
 	}
-	return *m.AppName
+	return *o.ConnectionID
 }
 
-// GetGoogleAppLink returns the GoogleAppLink field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushCustomApp) GetGoogleAppLink() string {
-	if m == nil || m.GoogleAppLink == nil {
-		return ""
+// GetDelay returns the Delay field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetDelay() time.Duration {
+	if o == nil || o.Delay == nil {
+		return 0
 	}
-	return *m.GoogleAppLink
+	return *o.Delay
 }
 
-// String returns a string representation of MultiFactorPushCustomApp.
-func (m *MultiFactorPushCustomApp) String() string {
-	return Stringify(m)
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
 }
 
-// GetBundleID returns the BundleID field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushDirectAPNS) GetBundleID() string {
-	if m == nil || m.BundleID == nil {
+// GetInviterName returns the InviterName field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetInviterName() string {
+	if o == nil || o.InviterName == nil {
 		return ""
 	}
-	return *m.BundleID
+	return *o.InviterName
 }
 
-// GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushDirectAPNS) GetEnabled() bool {
-	if m == nil || m.Enabled == nil {
+// GetSendInvitationEmail returns the SendInvitationEmail field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetSendInvitationEmail() bool {
+	if o == nil || o.SendInvitationEmail == nil {
 		return false
 	}
-	return *m.Enabled
+	return *o.SendInvitationEmail
 }
 
-// GetP12 returns the P12 field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushDirectAPNS) GetP12() string {
-	if m == nil || m.P12 == nil {
-		return ""
+// GetTTLSec returns the TTLSec field if it's non-nil, zero value otherwise.
+func (o *OrganizationInviteOptions) GetTTLSec() int {
+	if o == nil || o.TTLSec == nil {
+		return 0
 	}
-	return *m.P12
+	return *o.TTLSec
 }
 
-// GetSandbox returns the Sandbox field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushDirectAPNS) GetSandbox() bool {
-	if m == nil || m.Sandbox == nil {
-		return false
-	}
-	return *m.Sandbox
+// String returns a string representation of OrganizationInviteOptions.
+func (o *OrganizationInviteOptions) String() string {
+	return Stringify(o)
 }
 
-// String returns a string representation of MultiFactorPushDirectAPNS.
-func (m *MultiFactorPushDirectAPNS) String() string {
-	return Stringify(m)
+// String returns a string representation of OrganizationList.
+func (o *OrganizationList) String() string {
+	return Stringify(o)
 }
 
-// GetServerKey returns the ServerKey field if it's non-nil, zero value otherwise.
-func (m *MultiFactorPushDirectFCM) GetServerKey() string {
-	if m == nil || m.ServerKey == nil {
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (o *OrganizationMember) GetEmail() string {
+	if o == nil || o.Email == nil {
 		return ""
 	}
-	return *m.ServerKey
-}
-
-// String returns a string representation of MultiFactorPushDirectFCM.
-func (m *MultiFactorPushDirectFCM) String() string {
-	return Stringify(m)
+	return *o.Email
 }
 
-// String returns a string representation of MultiFactorRecoveryCode.
-func (m *MultiFactorRecoveryCode) String() string {
-	return Stringify(m)
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrganizationMember) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
 }
 
-// String returns a string representation of MultiFactorSMS.
-func (m *MultiFactorSMS) String() string {
-	return Stringify(m)
+// GetPicture returns the Picture field if it's non-nil, zero value otherwise.
+func (o *OrganizationMember) GetPicture() string {
+	if o == nil || o.Picture == nil {
+		return ""
+	}
+	return *o.Picture
 }
 
-// GetEnrollmentMessage returns the EnrollmentMessage field if it's non-nil, zero value otherwise.
-func (m *MultiFactorSMSTemplate) GetEnrollmentMessage() string {
-	if m == nil || m.EnrollmentMessage == nil {
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (o *OrganizationMember) GetUserID() string {
+	if o == nil || o.UserID == nil {
 		return ""
 	}
-	return *m.EnrollmentMessage
+	return *o.UserID
 }
 
-// GetVerificationMessage returns the VerificationMessage field if it's non-nil, zero value otherwise.
-func (m *MultiFactorSMSTemplate) GetVerificationMessage() string {
-	if m == nil || m.VerificationMessage == nil {
-		return ""
+// String returns a string representation of OrganizationMember.
+func (o *OrganizationMember) String() string {
+	return Stringify(o)
+}
+
+// Clone returns a deep copy of OrganizationMember.
+func (o *OrganizationMember) Clone() *OrganizationMember {
+	if o == nil {
+		return nil
 	}
-	return *m.VerificationMessage
+	clone := &OrganizationMember{}
+	clone.UserID = clonePointer(o.UserID)
+	clone.Picture = clonePointer(o.Picture)
+	clone.Name = clonePointer(o.Name)
+	clone.Email = clonePointer(o.Email)
+	return clone
 }
 
-// String returns a string representation of MultiFactorSMSTemplate.
-func (m *MultiFactorSMSTemplate) String() string {
-	return Stringify(m)
+func (o *OrganizationMember) cloneAny() interface{} {
+	return o.Clone()
 }
 
-// String returns a string representation of MultiFactorWebAuthnPlatform.
-func (m *MultiFactorWebAuthnPlatform) String() string {
-	return Stringify(m)
+// Equal reports whether OrganizationMember and other hold the same values.
+func (o *OrganizationMember) Equal(other *OrganizationMember) bool {
+	return len(o.diff("", other)) == 0
 }
 
-// String returns a string representation of MultiFactorWebAuthnRoaming.
-func (m *MultiFactorWebAuthnRoaming) String() string {
-	return Stringify(m)
+// Diff returns the fields that changed between OrganizationMember and other.
+func (o *OrganizationMember) Diff(other *OrganizationMember) []FieldChange {
+	return o.diff("", other)
 }
 
-// GetOverrideRelyingParty returns the OverrideRelyingParty field if it's non-nil, zero value otherwise.
-func (m *MultiFactorWebAuthnSettings) GetOverrideRelyingParty() bool {
-	if m == nil || m.OverrideRelyingParty == nil {
-		return false
+func (o *OrganizationMember) diff(prefix string, other *OrganizationMember) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *m.OverrideRelyingParty
+	if o == nil {
+		o = &OrganizationMember{}
+	}
+	if other == nil {
+		other = &OrganizationMember{}
+	}
+	changes = diffPointer(changes, prefix+"user_id", o.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"picture", o.Picture, other.Picture)
+	changes = diffPointer(changes, prefix+"name", o.Name, other.Name)
+	changes = diffPointer(changes, prefix+"email", o.Email, other.Email)
+	return changes
 }
 
-// GetRelyingPartyIdentifier returns the RelyingPartyIdentifier field if it's non-nil, zero value otherwise.
-func (m *MultiFactorWebAuthnSettings) GetRelyingPartyIdentifier() string {
-	if m == nil || m.RelyingPartyIdentifier == nil {
-		return ""
+func (o *OrganizationMember) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationMember)
+	if !ok {
+		return nil, false
 	}
-	return *m.RelyingPartyIdentifier
+	return o.diff(prefix, typed), true
 }
 
-// GetUserVerification returns the UserVerification field if it's non-nil, zero value otherwise.
-func (m *MultiFactorWebAuthnSettings) GetUserVerification() string {
-	if m == nil || m.UserVerification == nil {
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberChange) GetAction() string {
+	if o == nil || o.Action == nil {
 		return ""
 	}
-	return *m.UserVerification
+	return *o.Action
 }
 
-// String returns a string representation of MultiFactorWebAuthnSettings.
-func (m *MultiFactorWebAuthnSettings) String() string {
-	return Stringify(m)
+// GetApplied returns the Applied field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberChange) GetApplied() bool {
+	if o == nil || o.Applied == nil {
+		return false
+	}
+	return *o.Applied
 }
 
-// GetBranding returns the Branding field.
-func (o *Organization) GetBranding() *OrganizationBranding {
-	if o == nil {
+// GetRoles returns the Roles field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberChange) GetRoles() []string {
+	if o == nil || o.Roles == nil {
 		return nil
 	}
-	return o.Branding
+	return *o.Roles
 }
 
-// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
-func (o *Organization) GetDisplayName() string {
-	if o == nil || o.DisplayName == nil {
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberChange) GetUserID() string {
+	if o == nil || o.UserID == nil {
 		return ""
 	}
-	return *o.DisplayName
+	return *o.UserID
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *Organization) GetID() string {
-	if o == nil || o.ID == nil {
+// String returns a string representation of OrganizationMemberChange.
+func (o *OrganizationMemberChange) String() string {
+	return Stringify(o)
+}
+
+// String returns a string representation of OrganizationMemberList.
+func (o *OrganizationMemberList) String() string {
+	return Stringify(o)
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberRole) GetDescription() string {
+	if o == nil || o.Description == nil {
 		return ""
 	}
-	return *o.ID
+	return *o.Description
 }
 
-// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
-func (o *Organization) GetMetadata() map[string]string {
-	if o == nil || o.Metadata == nil {
-		return map[string]string{}
+// GetID returns the ID field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberRole) GetID() string {
+	if o == nil || o.ID == nil {
+		return ""
 	}
-	return *o.Metadata
+	return *o.ID
 }
 
 // GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *Organization) GetName() string {
+func (o *OrganizationMemberRole) GetName() string {
 	if o == nil || o.Name == nil {
 		return ""
 	}
 	return *o.Name
 }
 
-// String returns a string representation of Organization.
-func (o *Organization) String() string {
+// String returns a string representation of OrganizationMemberRole.
+func (o *OrganizationMemberRole) String() string {
 	return Stringify(o)
 }
 
-// GetColors returns the Colors field if it's non-nil, zero value otherwise.
-func (o *OrganizationBranding) GetColors() map[string]string {
-	if o == nil || o.Colors == nil {
-		return map[string]string{}
+// Clone returns a deep copy of OrganizationMemberRole.
+func (o *OrganizationMemberRole) Clone() *OrganizationMemberRole {
+	if o == nil {
+		return nil
 	}
-	return *o.Colors
+	clone := &OrganizationMemberRole{}
+	clone.ID = clonePointer(o.ID)
+	clone.Name = clonePointer(o.Name)
+	clone.Description = clonePointer(o.Description)
+	return clone
 }
 
-// GetLogoURL returns the LogoURL field if it's non-nil, zero value otherwise.
-func (o *OrganizationBranding) GetLogoURL() string {
-	if o == nil || o.LogoURL == nil {
-		return ""
+func (o *OrganizationMemberRole) cloneAny() interface{} {
+	return o.Clone()
+}
+
+// Equal reports whether OrganizationMemberRole and other hold the same values.
+func (o *OrganizationMemberRole) Equal(other *OrganizationMemberRole) bool {
+	return len(o.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between OrganizationMemberRole and other.
+func (o *OrganizationMemberRole) Diff(other *OrganizationMemberRole) []FieldChange {
+	return o.diff("", other)
+}
+
+func (o *OrganizationMemberRole) diff(prefix string, other *OrganizationMemberRole) (changes []FieldChange) {
+	if o == nil && other == nil {
+		return nil
 	}
-	return *o.LogoURL
+	if o == nil {
+		o = &OrganizationMemberRole{}
+	}
+	if other == nil {
+		other = &OrganizationMemberRole{}
+	}
+	changes = diffPointer(changes, prefix+"id", o.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", o.Name, other.Name)
+	changes = diffPointer(changes, prefix+"description", o.Description, other.Description)
+	return changes
 }
 
-// String returns a string representation of OrganizationBranding.
-func (o *OrganizationBranding) String() string {
+func (o *OrganizationMemberRole) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*OrganizationMemberRole)
+	if !ok {
+		return nil, false
+	}
+	return o.diff(prefix, typed), true
+}
+
+// String returns a string representation of OrganizationMemberRoleList.
+func (o *OrganizationMemberRoleList) String() string {
 	return Stringify(o)
 }
 
-// GetAssignMembershipOnLogin returns the AssignMembershipOnLogin field if it's non-nil, zero value otherwise.
-func (o *OrganizationConnection) GetAssignMembershipOnLogin() bool {
-	if o == nil || o.AssignMembershipOnLogin == nil {
+// GetBatchDelay returns the BatchDelay field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncOptions) GetBatchDelay() time.Duration {
+	if o == nil || o.BatchDelay == nil {
+		return 0
+	}
+	return *o.BatchDelay
+}
+
+// GetBatchSize returns the BatchSize field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncOptions) GetBatchSize() int {
+	if o == nil || o.BatchSize == nil {
+		return 0
+	}
+	return *o.BatchSize
+}
+
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncOptions) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
 		return false
 	}
-	return *o.AssignMembershipOnLogin
+	return *o.DryRun
 }
 
-// GetConnection returns the Connection field.
-func (o *OrganizationConnection) GetConnection() *OrganizationConnectionDetails {
-	if o == nil {
-		return nil
+// GetKeepUndesired returns the KeepUndesired field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncOptions) GetKeepUndesired() bool {
+	if o == nil || o.KeepUndesired == nil {
+		return false
 	}
-	return o.Connection
+	return *o.KeepUndesired
 }
 
-// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
-func (o *OrganizationConnection) GetConnectionID() string {
-	if o == nil || o.ConnectionID == nil {
+// String returns a string representation of OrganizationMemberSyncOptions.
+func (o *OrganizationMemberSyncOptions) String() string {
+	return Stringify(o)
+}
+
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncReport) GetDryRun() bool {
+	if o == nil || o.DryRun == nil {
+		return false
+	}
+	return *o.DryRun
+}
+
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncReport) GetOrganizationID() string {
+	if o == nil || o.OrganizationID == nil {
 		return ""
 	}
-	return *o.ConnectionID
+	return *o.OrganizationID
 }
 
-// String returns a string representation of OrganizationConnection.
-func (o *OrganizationConnection) String() string {
+// GetUnchanged returns the Unchanged field if it's non-nil, zero value otherwise.
+func (o *OrganizationMemberSyncReport) GetUnchanged() int {
+	if o == nil || o.Unchanged == nil {
+		return 0
+	}
+	return *o.Unchanged
+}
+
+// String returns a string representation of OrganizationMemberSyncReport.
+func (o *OrganizationMemberSyncReport) String() string {
 	return Stringify(o)
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OrganizationConnectionDetails) GetName() string {
-	if o == nil || o.Name == nil {
+// GetConnectionIDs returns the ConnectionIDs field if it's non-nil, zero value otherwise.
+func (o *OrganizationProvisioning) GetConnectionIDs() []string {
+	if o == nil || o.ConnectionIDs == nil {
+		return nil
+	}
+	return *o.ConnectionIDs
+}
+
+// GetInvitationID returns the InvitationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationProvisioning) GetInvitationID() string {
+	if o == nil || o.InvitationID == nil {
 		return ""
 	}
-	return *o.Name
+	return *o.InvitationID
 }
 
-// GetStrategy returns the Strategy field if it's non-nil, zero value otherwise.
-func (o *OrganizationConnectionDetails) GetStrategy() string {
-	if o == nil || o.Strategy == nil {
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (o *OrganizationProvisioning) GetOrganizationID() string {
+	if o == nil || o.OrganizationID == nil {
 		return ""
 	}
-	return *o.Strategy
+	return *o.OrganizationID
 }
 
-// String returns a string representation of OrganizationConnectionDetails.
-func (o *OrganizationConnectionDetails) String() string {
-	return Stringify(o)
+// GetRoleIDs returns the RoleIDs field if it's non-nil, zero value otherwise.
+func (o *OrganizationProvisioning) GetRoleIDs() []string {
+	if o == nil || o.RoleIDs == nil {
+		return nil
+	}
+	return *o.RoleIDs
 }
 
-// String returns a string representation of OrganizationConnectionList.
-func (o *OrganizationConnectionList) String() string {
+// String returns a string representation of OrganizationProvisioning.
+func (o *OrganizationProvisioning) String() string {
 	return Stringify(o)
 }
 
+// GetAdminRoles returns the AdminRoles field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplate) GetAdminRoles() []string {
+	if o == nil || o.AdminRoles == nil {
+		return nil
+	}
+	return *o.AdminRoles
+}
+
+// GetBranding returns the Branding field.
+func (o *OrganizationTemplate) GetBranding() *OrganizationBranding {
+	if o == nil {
+		return nil
+	}
+	return o.Branding
+}
+
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetClientID() string {
+func (o *OrganizationTemplate) GetClientID() string {
 	if o == nil || o.ClientID == nil {
 		return ""
 	}
 	return *o.ClientID
 }
 
-// GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetConnectionID() string {
-	if o == nil || o.ConnectionID == nil {
+// GetInviterName returns the InviterName field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplate) GetInviterName() string {
+	if o == nil || o.InviterName == nil {
 		return ""
 	}
-	return *o.ConnectionID
+	return *o.InviterName
 }
 
-// GetCreatedAt returns the CreatedAt field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetCreatedAt() string {
-	if o == nil || o.CreatedAt == nil {
-		return ""
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplate) GetMetadata() map[string]string {
+	if o == nil || o.Metadata == nil {
+		return map[string]string{}
 	}
-	return *o.CreatedAt
+	return *o.Metadata
 }
 
-// GetExpiresAt returns the ExpiresAt field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetExpiresAt() string {
-	if o == nil || o.ExpiresAt == nil {
+// String returns a string representation of OrganizationTemplate.
+func (o *OrganizationTemplate) String() string {
+	return Stringify(o)
+}
+
+// GetAdminEmail returns the AdminEmail field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplateParams) GetAdminEmail() string {
+	if o == nil || o.AdminEmail == nil {
 		return ""
 	}
-	return *o.ExpiresAt
+	return *o.AdminEmail
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetID() string {
-	if o == nil || o.ID == nil {
+// GetDisplayName returns the DisplayName field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplateParams) GetDisplayName() string {
+	if o == nil || o.DisplayName == nil {
 		return ""
 	}
-	return *o.ID
+	return *o.DisplayName
 }
 
-// GetInvitationURL returns the InvitationURL field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetInvitationURL() string {
-	if o == nil || o.InvitationURL == nil {
-		return ""
+// GetMetadata returns the Metadata field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplateParams) GetMetadata() map[string]string {
+	if o == nil || o.Metadata == nil {
+		return map[string]string{}
 	}
-	return *o.InvitationURL
+	return *o.Metadata
 }
 
-// GetInvitee returns the Invitee field.
-func (o *OrganizationInvitation) GetInvitee() *OrganizationInvitationInvitee {
-	if o == nil {
-		return nil
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (o *OrganizationTemplateParams) GetName() string {
+	if o == nil || o.Name == nil {
+		return ""
 	}
-	return o.Invitee
+	return *o.Name
 }
 
-// GetInviter returns the Inviter field.
-func (o *OrganizationInvitation) GetInviter() *OrganizationInvitationInviter {
-	if o == nil {
-		return nil
+// String returns a string representation of OrganizationTemplateParams.
+func (o *OrganizationTemplateParams) String() string {
+	return Stringify(o)
+}
+
+// GetMinLength returns the MinLength field if it's non-nil, zero value otherwise.
+func (p *PasswordComplexityOptions) GetMinLength() int {
+	if p == nil || p.MinLength == nil {
+		return 0
 	}
-	return o.Inviter
+	return *p.MinLength
 }
 
-// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetOrganizationID() string {
-	if o == nil || o.OrganizationID == nil {
-		return ""
+// String returns a string representation of PasswordComplexityOptions.
+func (p *PasswordComplexityOptions) String() string {
+	return Stringify(p)
+}
+
+// GetDictionary returns the Dictionary field if it's non-nil, zero value otherwise.
+func (p *PasswordDictionary) GetDictionary() []string {
+	if p == nil || p.Dictionary == nil {
+		return nil
 	}
-	return *o.OrganizationID
+	return *p.Dictionary
 }
 
-// GetSendInvitationEmail returns the SendInvitationEmail field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetSendInvitationEmail() bool {
-	if o == nil || o.SendInvitationEmail == nil {
+// GetEnable returns the Enable field if it's non-nil, zero value otherwise.
+func (p *PasswordDictionary) GetEnable() bool {
+	if p == nil || p.Enable == nil {
 		return false
 	}
-	return *o.SendInvitationEmail
+	return *p.Enable
 }
 
-// GetTicketID returns the TicketID field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetTicketID() string {
-	if o == nil || o.TicketID == nil {
-		return ""
-	}
-	return *o.TicketID
+// String returns a string representation of PasswordDictionary.
+func (p *PasswordDictionary) String() string {
+	return Stringify(p)
 }
 
-// GetTTLSec returns the TTLSec field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitation) GetTTLSec() int {
-	if o == nil || o.TTLSec == nil {
+// GetEnable returns the Enable field if it's non-nil, zero value otherwise.
+func (p *PasswordHistory) GetEnable() bool {
+	if p == nil || p.Enable == nil {
+		return false
+	}
+	return *p.Enable
+}
+
+// GetSize returns the Size field if it's non-nil, zero value otherwise.
+func (p *PasswordHistory) GetSize() int {
+	if p == nil || p.Size == nil {
 		return 0
 	}
-	return *o.TTLSec
+	return *p.Size
 }
 
-// String returns a string representation of OrganizationInvitation.
-func (o *OrganizationInvitation) String() string {
-	return Stringify(o)
+// String returns a string representation of PasswordHistory.
+func (p *PasswordHistory) String() string {
+	return Stringify(p)
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitationInvitee) GetEmail() string {
-	if o == nil || o.Email == nil {
-		return ""
+// GetEnable returns the Enable field if it's non-nil, zero value otherwise.
+func (p *PasswordNoPersonalInfo) GetEnable() bool {
+	if p == nil || p.Enable == nil {
+		return false
 	}
-	return *o.Email
+	return *p.Enable
 }
 
-// String returns a string representation of OrganizationInvitationInvitee.
-func (o *OrganizationInvitationInvitee) String() string {
-	return Stringify(o)
+// String returns a string representation of PasswordNoPersonalInfo.
+func (p *PasswordNoPersonalInfo) String() string {
+	return Stringify(p)
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OrganizationInvitationInviter) GetName() string {
-	if o == nil || o.Name == nil {
-		return ""
+// GetComplexityOptions returns the ComplexityOptions field.
+func (p *PasswordSettings) GetComplexityOptions() *PasswordComplexityOptions {
+	if p == nil {
+		return nil
 	}
-	return *o.Name
+	return p.ComplexityOptions
 }
 
-// String returns a string representation of OrganizationInvitationInviter.
-func (o *OrganizationInvitationInviter) String() string {
-	return Stringify(o)
+// GetDictionary returns the Dictionary field.
+func (p *PasswordSettings) GetDictionary() *PasswordDictionary {
+	if p == nil {
+		return nil
+	}
+	return p.Dictionary
 }
 
-// String returns a string representation of OrganizationInvitationList.
-func (o *OrganizationInvitationList) String() string {
-	return Stringify(o)
+// GetHistory returns the History field.
+func (p *PasswordSettings) GetHistory() *PasswordHistory {
+	if p == nil {
+		return nil
+	}
+	return p.History
 }
 
-// String returns a string representation of OrganizationList.
-func (o *OrganizationList) String() string {
-	return Stringify(o)
+// GetNoPersonalInfo returns the NoPersonalInfo field.
+func (p *PasswordSettings) GetNoPersonalInfo() *PasswordNoPersonalInfo {
+	if p == nil {
+		return nil
+	}
+	return p.NoPersonalInfo
 }
 
-// GetEmail returns the Email field if it's non-nil, zero value otherwise.
-func (o *OrganizationMember) GetEmail() string {
-	if o == nil || o.Email == nil {
+// GetPolicy returns the Policy field if it's non-nil, zero value otherwise.
+func (p *PasswordSettings) GetPolicy() string {
+	if p == nil || p.Policy == nil {
 		return ""
 	}
-	return *o.Email
+	return *p.Policy
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OrganizationMember) GetName() string {
-	if o == nil || o.Name == nil {
-		return ""
-	}
-	return *o.Name
+// String returns a string representation of PasswordSettings.
+func (p *PasswordSettings) String() string {
+	return Stringify(p)
 }
 
-// GetPicture returns the Picture field if it's non-nil, zero value otherwise.
-func (o *OrganizationMember) GetPicture() string {
-	if o == nil || o.Picture == nil {
+// GetCode returns the Code field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetCode() string {
+	if p == nil || p.Code == nil {
 		return ""
 	}
-	return *o.Picture
+	return *p.Code
 }
 
-// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
-func (o *OrganizationMember) GetUserID() string {
-	if o == nil || o.UserID == nil {
+// GetField returns the Field field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetField() string {
+	if p == nil || p.Field == nil {
 		return ""
 	}
-	return *o.UserID
-}
-
-// String returns a string representation of OrganizationMember.
-func (o *OrganizationMember) String() string {
-	return Stringify(o)
-}
-
-// String returns a string representation of OrganizationMemberList.
-func (o *OrganizationMemberList) String() string {
-	return Stringify(o)
+	return *p.Field
 }
 
-// GetDescription returns the Description field if it's non-nil, zero value otherwise.
-func (o *OrganizationMemberRole) GetDescription() string {
-	if o == nil || o.Description == nil {
+// GetMessage returns the Message field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetMessage() string {
+	if p == nil || p.Message == nil {
 		return ""
 	}
-	return *o.Description
+	return *p.Message
 }
 
-// GetID returns the ID field if it's non-nil, zero value otherwise.
-func (o *OrganizationMemberRole) GetID() string {
-	if o == nil || o.ID == nil {
-		return ""
+// GetMinLength returns the MinLength field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetMinLength() int {
+	if p == nil || p.MinLength == nil {
+		return 0
 	}
-	return *o.ID
+	return *p.MinLength
 }
 
-// GetName returns the Name field if it's non-nil, zero value otherwise.
-func (o *OrganizationMemberRole) GetName() string {
-	if o == nil || o.Name == nil {
-		return ""
+// GetMissingCharacterTypes returns the MissingCharacterTypes field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetMissingCharacterTypes() []string {
+	if p == nil || p.MissingCharacterTypes == nil {
+		return nil
 	}
-	return *o.Name
+	return *p.MissingCharacterTypes
 }
 
-// String returns a string representation of OrganizationMemberRole.
-func (o *OrganizationMemberRole) String() string {
-	return Stringify(o)
+// GetRequiredCharacterTypes returns the RequiredCharacterTypes field if it's non-nil, zero value otherwise.
+func (p *PasswordViolation) GetRequiredCharacterTypes() int {
+	if p == nil || p.RequiredCharacterTypes == nil {
+		return 0
+	}
+	return *p.RequiredCharacterTypes
 }
 
-// String returns a string representation of OrganizationMemberRoleList.
-func (o *OrganizationMemberRoleList) String() string {
-	return Stringify(o)
+// String returns a string representation of PasswordViolation.
+func (p *PasswordViolation) String() string {
+	return Stringify(p)
 }
 
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
@@ -6507,11 +15237,113 @@ func (p *Permission) String() string {
 	return Stringify(p)
 }
 
+// Clone returns a deep copy of Permission.
+func (p *Permission) Clone() *Permission {
+	if p == nil {
+		return nil
+	}
+	clone := &Permission{}
+	clone.ResourceServerIdentifier = clonePointer(p.ResourceServerIdentifier)
+	clone.ResourceServerName = clonePointer(p.ResourceServerName)
+	clone.Name = clonePointer(p.Name)
+	clone.Description = clonePointer(p.Description)
+	return clone
+}
+
+func (p *Permission) cloneAny() interface{} {
+	return p.Clone()
+}
+
+// Equal reports whether Permission and other hold the same values.
+func (p *Permission) Equal(other *Permission) bool {
+	return len(p.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Permission and other.
+func (p *Permission) Diff(other *Permission) []FieldChange {
+	return p.diff("", other)
+}
+
+func (p *Permission) diff(prefix string, other *Permission) (changes []FieldChange) {
+	if p == nil && other == nil {
+		return nil
+	}
+	if p == nil {
+		p = &Permission{}
+	}
+	if other == nil {
+		other = &Permission{}
+	}
+	changes = diffPointer(changes, prefix+"resource_server_identifier", p.ResourceServerIdentifier, other.ResourceServerIdentifier)
+	changes = diffPointer(changes, prefix+"resource_server_name", p.ResourceServerName, other.ResourceServerName)
+	changes = diffPointer(changes, prefix+"permission_name", p.Name, other.Name)
+	changes = diffPointer(changes, prefix+"description", p.Description, other.Description)
+	return changes
+}
+
+func (p *Permission) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Permission)
+	if !ok {
+		return nil, false
+	}
+	return p.diff(prefix, typed), true
+}
+
 // String returns a string representation of PermissionList.
 func (p *PermissionList) String() string {
 	return Stringify(p)
 }
 
+// String returns a string representation of PermissionResolver.
+func (p *PermissionResolver) String() string {
+	return Stringify(p)
+}
+
+// GetOrganizationID returns the OrganizationID field if it's non-nil, zero value otherwise.
+func (p *PermissionSource) GetOrganizationID() string {
+	if p == nil || p.OrganizationID == nil {
+		return ""
+	}
+	return *p.OrganizationID
+}
+
+// GetOrganizationName returns the OrganizationName field if it's non-nil, zero value otherwise.
+func (p *PermissionSource) GetOrganizationName() string {
+	if p == nil || p.OrganizationName == nil {
+		return ""
+	}
+	return *p.OrganizationName
+}
+
+// GetRoleID returns the RoleID field if it's non-nil, zero value otherwise.
+func (p *PermissionSource) GetRoleID() string {
+	if p == nil || p.RoleID == nil {
+		return ""
+	}
+	return *p.RoleID
+}
+
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (p *PermissionSource) GetRoleName() string {
+	if p == nil || p.RoleName == nil {
+		return ""
+	}
+	return *p.RoleName
+}
+
+// GetType returns the Type field if it's non-nil, zero value otherwise.
+func (p *PermissionSource) GetType() string {
+	if p == nil || p.Type == nil {
+		return ""
+	}
+	return *p.Type
+}
+
+// String returns a string representation of PermissionSource.
+func (p *PermissionSource) String() string {
+	return Stringify(p)
+}
+
 // GetMessageTypes returns the MessageTypes field if it's non-nil, zero value otherwise.
 func (p *PhoneMessageTypes) GetMessageTypes() []string {
 	if p == nil || p.MessageTypes == nil {
@@ -6525,6 +15357,52 @@ func (p *PhoneMessageTypes) String() string {
 	return Stringify(p)
 }
 
+// Clone returns a deep copy of PhoneMessageTypes.
+func (p *PhoneMessageTypes) Clone() *PhoneMessageTypes {
+	if p == nil {
+		return nil
+	}
+	clone := &PhoneMessageTypes{}
+	clone.MessageTypes = clonePointerFunc(p.MessageTypes, func(v []string) []string { return cloneSlice(v) })
+	return clone
+}
+
+func (p *PhoneMessageTypes) cloneAny() interface{} {
+	return p.Clone()
+}
+
+// Equal reports whether PhoneMessageTypes and other hold the same values.
+func (p *PhoneMessageTypes) Equal(other *PhoneMessageTypes) bool {
+	return len(p.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between PhoneMessageTypes and other.
+func (p *PhoneMessageTypes) Diff(other *PhoneMessageTypes) []FieldChange {
+	return p.diff("", other)
+}
+
+func (p *PhoneMessageTypes) diff(prefix string, other *PhoneMessageTypes) (changes []FieldChange) {
+	if p == nil && other == nil {
+		return nil
+	}
+	if p == nil {
+		p = &PhoneMessageTypes{}
+	}
+	if other == nil {
+		other = &PhoneMessageTypes{}
+	}
+	changes = diffSlicePointer(changes, prefix+"message_types", p.MessageTypes, other.MessageTypes)
+	return changes
+}
+
+func (p *PhoneMessageTypes) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*PhoneMessageTypes)
+	if !ok {
+		return nil, false
+	}
+	return p.diff(prefix, typed), true
+}
+
 // GetMaxAttempts returns the MaxAttempts field if it's non-nil, zero value otherwise.
 func (p *PreLogin) GetMaxAttempts() int {
 	if p == nil || p.MaxAttempts == nil {
@@ -6546,6 +15424,54 @@ func (p *PreLogin) String() string {
 	return Stringify(p)
 }
 
+// Clone returns a deep copy of PreLogin.
+func (p *PreLogin) Clone() *PreLogin {
+	if p == nil {
+		return nil
+	}
+	clone := &PreLogin{}
+	clone.MaxAttempts = clonePointer(p.MaxAttempts)
+	clone.Rate = clonePointer(p.Rate)
+	return clone
+}
+
+func (p *PreLogin) cloneAny() interface{} {
+	return p.Clone()
+}
+
+// Equal reports whether PreLogin and other hold the same values.
+func (p *PreLogin) Equal(other *PreLogin) bool {
+	return len(p.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between PreLogin and other.
+func (p *PreLogin) Diff(other *PreLogin) []FieldChange {
+	return p.diff("", other)
+}
+
+func (p *PreLogin) diff(prefix string, other *PreLogin) (changes []FieldChange) {
+	if p == nil && other == nil {
+		return nil
+	}
+	if p == nil {
+		p = &PreLogin{}
+	}
+	if other == nil {
+		other = &PreLogin{}
+	}
+	changes = diffPointer(changes, prefix+"max_attempts", p.MaxAttempts, other.MaxAttempts)
+	changes = diffPointer(changes, prefix+"rate", p.Rate, other.Rate)
+	return changes
+}
+
+func (p *PreLogin) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*PreLogin)
+	if !ok {
+		return nil, false
+	}
+	return p.diff(prefix, typed), true
+}
+
 // GetMaxAttempts returns the MaxAttempts field if it's non-nil, zero value otherwise.
 func (p *PreUserRegistration) GetMaxAttempts() int {
 	if p == nil || p.MaxAttempts == nil {
@@ -6567,6 +15493,54 @@ func (p *PreUserRegistration) String() string {
 	return Stringify(p)
 }
 
+// Clone returns a deep copy of PreUserRegistration.
+func (p *PreUserRegistration) Clone() *PreUserRegistration {
+	if p == nil {
+		return nil
+	}
+	clone := &PreUserRegistration{}
+	clone.MaxAttempts = clonePointer(p.MaxAttempts)
+	clone.Rate = clonePointer(p.Rate)
+	return clone
+}
+
+func (p *PreUserRegistration) cloneAny() interface{} {
+	return p.Clone()
+}
+
+// Equal reports whether PreUserRegistration and other hold the same values.
+func (p *PreUserRegistration) Equal(other *PreUserRegistration) bool {
+	return len(p.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between PreUserRegistration and other.
+func (p *PreUserRegistration) Diff(other *PreUserRegistration) []FieldChange {
+	return p.diff("", other)
+}
+
+func (p *PreUserRegistration) diff(prefix string, other *PreUserRegistration) (changes []FieldChange) {
+	if p == nil && other == nil {
+		return nil
+	}
+	if p == nil {
+		p = &PreUserRegistration{}
+	}
+	if other == nil {
+		other = &PreUserRegistration{}
+	}
+	changes = diffPointer(changes, prefix+"max_attempts", p.MaxAttempts, other.MaxAttempts)
+	changes = diffPointer(changes, prefix+"rate", p.Rate, other.Rate)
+	return changes
+}
+
+func (p *PreUserRegistration) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*PreUserRegistration)
+	if !ok {
+		return nil, false
+	}
+	return p.diff(prefix, typed), true
+}
+
 // GetIdentifierFirst returns the IdentifierFirst field if it's non-nil, zero value otherwise.
 func (p *Prompt) GetIdentifierFirst() bool {
 	if p == nil || p.IdentifierFirst == nil {
@@ -6588,6 +15562,56 @@ func (p *Prompt) String() string {
 	return Stringify(p)
 }
 
+// Clone returns a deep copy of Prompt.
+func (p *Prompt) Clone() *Prompt {
+	if p == nil {
+		return nil
+	}
+	clone := &Prompt{}
+	clone.UniversalLoginExperience = p.UniversalLoginExperience
+	clone.IdentifierFirst = clonePointer(p.IdentifierFirst)
+	clone.WebAuthnPlatformFirstFactor = clonePointer(p.WebAuthnPlatformFirstFactor)
+	return clone
+}
+
+func (p *Prompt) cloneAny() interface{} {
+	return p.Clone()
+}
+
+// Equal reports whether Prompt and other hold the same values.
+func (p *Prompt) Equal(other *Prompt) bool {
+	return len(p.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Prompt and other.
+func (p *Prompt) Diff(other *Prompt) []FieldChange {
+	return p.diff("", other)
+}
+
+func (p *Prompt) diff(prefix string, other *Prompt) (changes []FieldChange) {
+	if p == nil && other == nil {
+		return nil
+	}
+	if p == nil {
+		p = &Prompt{}
+	}
+	if other == nil {
+		other = &Prompt{}
+	}
+	changes = diffComparable(changes, prefix+"universal_login_experience", p.UniversalLoginExperience, other.UniversalLoginExperience)
+	changes = diffPointer(changes, prefix+"identifier_first", p.IdentifierFirst, other.IdentifierFirst)
+	changes = diffPointer(changes, prefix+"webauthn_platform_first_factor", p.WebAuthnPlatformFirstFactor, other.WebAuthnPlatformFirstFactor)
+	return changes
+}
+
+func (p *Prompt) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Prompt)
+	if !ok {
+		return nil, false
+	}
+	return p.diff(prefix, typed), true
+}
+
 // GetAllowOfflineAccess returns the AllowOfflineAccess field if it's non-nil, zero value otherwise.
 func (r *ResourceServer) GetAllowOfflineAccess() bool {
 	if r == nil || r.AllowOfflineAccess == nil {
@@ -6705,6 +15729,80 @@ func (r *ResourceServer) String() string {
 	return Stringify(r)
 }
 
+// Clone returns a deep copy of ResourceServer.
+func (r *ResourceServer) Clone() *ResourceServer {
+	if r == nil {
+		return nil
+	}
+	clone := &ResourceServer{}
+	clone.ID = clonePointer(r.ID)
+	clone.Name = clonePointer(r.Name)
+	clone.Identifier = clonePointer(r.Identifier)
+	clone.Scopes = clonePointerFunc(r.Scopes, func(v []ResourceServerScope) []ResourceServerScope {
+		return cloneSliceFunc(v, func(v ResourceServerScope) ResourceServerScope { return *v.Clone() })
+	})
+	clone.SigningAlgorithm = clonePointer(r.SigningAlgorithm)
+	clone.SigningSecret = clonePointer(r.SigningSecret)
+	clone.AllowOfflineAccess = clonePointer(r.AllowOfflineAccess)
+	clone.TokenLifetime = clonePointer(r.TokenLifetime)
+	clone.TokenLifetimeForWeb = clonePointer(r.TokenLifetimeForWeb)
+	clone.SkipConsentForVerifiableFirstPartyClients = clonePointer(r.SkipConsentForVerifiableFirstPartyClients)
+	clone.VerificationLocation = clonePointer(r.VerificationLocation)
+	clone.Options = clonePointerFunc(r.Options, func(v map[string]string) map[string]string { return cloneMap(v) })
+	clone.EnforcePolicies = clonePointer(r.EnforcePolicies)
+	clone.TokenDialect = clonePointer(r.TokenDialect)
+	return clone
+}
+
+func (r *ResourceServer) cloneAny() interface{} {
+	return r.Clone()
+}
+
+// Equal reports whether ResourceServer and other hold the same values.
+func (r *ResourceServer) Equal(other *ResourceServer) bool {
+	return len(r.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ResourceServer and other.
+func (r *ResourceServer) Diff(other *ResourceServer) []FieldChange {
+	return r.diff("", other)
+}
+
+func (r *ResourceServer) diff(prefix string, other *ResourceServer) (changes []FieldChange) {
+	if r == nil && other == nil {
+		return nil
+	}
+	if r == nil {
+		r = &ResourceServer{}
+	}
+	if other == nil {
+		other = &ResourceServer{}
+	}
+	changes = diffPointer(changes, prefix+"id", r.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", r.Name, other.Name)
+	changes = diffPointer(changes, prefix+"identifier", r.Identifier, other.Identifier)
+	changes = diffSlicePointer(changes, prefix+"scopes", r.Scopes, other.Scopes)
+	changes = diffPointer(changes, prefix+"signing_alg", r.SigningAlgorithm, other.SigningAlgorithm)
+	changes = diffPointer(changes, prefix+"signing_secret", r.SigningSecret, other.SigningSecret)
+	changes = diffPointer(changes, prefix+"allow_offline_access", r.AllowOfflineAccess, other.AllowOfflineAccess)
+	changes = diffPointer(changes, prefix+"token_lifetime", r.TokenLifetime, other.TokenLifetime)
+	changes = diffPointer(changes, prefix+"token_lifetime_for_web", r.TokenLifetimeForWeb, other.TokenLifetimeForWeb)
+	changes = diffPointer(changes, prefix+"skip_consent_for_verifiable_first_party_clients", r.SkipConsentForVerifiableFirstPartyClients, other.SkipConsentForVerifiableFirstPartyClients)
+	changes = diffPointer(changes, prefix+"verificationLocation", r.VerificationLocation, other.VerificationLocation)
+	changes = diffMapPointer(changes, prefix+"options", r.Options, other.Options)
+	changes = diffPointer(changes, prefix+"enforce_policies", r.EnforcePolicies, other.EnforcePolicies)
+	changes = diffPointer(changes, prefix+"token_dialect", r.TokenDialect, other.TokenDialect)
+	return changes
+}
+
+func (r *ResourceServer) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ResourceServer)
+	if !ok {
+		return nil, false
+	}
+	return r.diff(prefix, typed), true
+}
+
 // String returns a string representation of ResourceServerList.
 func (r *ResourceServerList) String() string {
 	return Stringify(r)
@@ -6731,6 +15829,54 @@ func (r *ResourceServerScope) String() string {
 	return Stringify(r)
 }
 
+// Clone returns a deep copy of ResourceServerScope.
+func (r *ResourceServerScope) Clone() *ResourceServerScope {
+	if r == nil {
+		return nil
+	}
+	clone := &ResourceServerScope{}
+	clone.Value = clonePointer(r.Value)
+	clone.Description = clonePointer(r.Description)
+	return clone
+}
+
+func (r *ResourceServerScope) cloneAny() interface{} {
+	return r.Clone()
+}
+
+// Equal reports whether ResourceServerScope and other hold the same values.
+func (r *ResourceServerScope) Equal(other *ResourceServerScope) bool {
+	return len(r.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between ResourceServerScope and other.
+func (r *ResourceServerScope) Diff(other *ResourceServerScope) []FieldChange {
+	return r.diff("", other)
+}
+
+func (r *ResourceServerScope) diff(prefix string, other *ResourceServerScope) (changes []FieldChange) {
+	if r == nil && other == nil {
+		return nil
+	}
+	if r == nil {
+		r = &ResourceServerScope{}
+	}
+	if other == nil {
+		other = &ResourceServerScope{}
+	}
+	changes = diffPointer(changes, prefix+"value", r.Value, other.Value)
+	changes = diffPointer(changes, prefix+"description", r.Description, other.Description)
+	return changes
+}
+
+func (r *ResourceServerScope) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*ResourceServerScope)
+	if !ok {
+		return nil, false
+	}
+	return r.diff(prefix, typed), true
+}
+
 // GetDescription returns the Description field if it's non-nil, zero value otherwise.
 func (r *Role) GetDescription() string {
 	if r == nil || r.Description == nil {
@@ -6760,11 +15906,140 @@ func (r *Role) String() string {
 	return Stringify(r)
 }
 
+// Clone returns a deep copy of Role.
+func (r *Role) Clone() *Role {
+	if r == nil {
+		return nil
+	}
+	clone := &Role{}
+	clone.ID = clonePointer(r.ID)
+	clone.Name = clonePointer(r.Name)
+	clone.Description = clonePointer(r.Description)
+	return clone
+}
+
+func (r *Role) cloneAny() interface{} {
+	return r.Clone()
+}
+
+// Equal reports whether Role and other hold the same values.
+func (r *Role) Equal(other *Role) bool {
+	return len(r.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Role and other.
+func (r *Role) Diff(other *Role) []FieldChange {
+	return r.diff("", other)
+}
+
+func (r *Role) diff(prefix string, other *Role) (changes []FieldChange) {
+	if r == nil && other == nil {
+		return nil
+	}
+	if r == nil {
+		r = &Role{}
+	}
+	if other == nil {
+		other = &Role{}
+	}
+	changes = diffPointer(changes, prefix+"id", r.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", r.Name, other.Name)
+	changes = diffPointer(changes, prefix+"description", r.Description, other.Description)
+	return changes
+}
+
+func (r *Role) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Role)
+	if !ok {
+		return nil, false
+	}
+	return r.diff(prefix, typed), true
+}
+
+// GetAction returns the Action field if it's non-nil, zero value otherwise.
+func (r *RoleChange) GetAction() string {
+	if r == nil || r.Action == nil {
+		return ""
+	}
+	return *r.Action
+}
+
+// GetApplied returns the Applied field if it's non-nil, zero value otherwise.
+func (r *RoleChange) GetApplied() bool {
+	if r == nil || r.Applied == nil {
+		return false
+	}
+	return *r.Applied
+}
+
+// GetRoleID returns the RoleID field if it's non-nil, zero value otherwise.
+func (r *RoleChange) GetRoleID() string {
+	if r == nil || r.RoleID == nil {
+		return ""
+	}
+	return *r.RoleID
+}
+
+// GetRoleName returns the RoleName field if it's non-nil, zero value otherwise.
+func (r *RoleChange) GetRoleName() string {
+	if r == nil || r.RoleName == nil {
+		return ""
+	}
+	return *r.RoleName
+}
+
+// String returns a string representation of RoleChange.
+func (r *RoleChange) String() string {
+	return Stringify(r)
+}
+
 // String returns a string representation of RoleList.
 func (r *RoleList) String() string {
 	return Stringify(r)
 }
 
+// GetDeleteUnmanaged returns the DeleteUnmanaged field if it's non-nil, zero value otherwise.
+func (r *RoleSyncOptions) GetDeleteUnmanaged() bool {
+	if r == nil || r.DeleteUnmanaged == nil {
+		return false
+	}
+	return *r.DeleteUnmanaged
+}
+
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (r *RoleSyncOptions) GetDryRun() bool {
+	if r == nil || r.DryRun == nil {
+		return false
+	}
+	return *r.DryRun
+}
+
+// String returns a string representation of RoleSyncOptions.
+func (r *RoleSyncOptions) String() string {
+	return Stringify(r)
+}
+
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (r *RoleSyncReport) GetDryRun() bool {
+	if r == nil || r.DryRun == nil {
+		return false
+	}
+	return *r.DryRun
+}
+
+// GetUnchanged returns the Unchanged field if it's non-nil, zero value otherwise.
+func (r *RoleSyncReport) GetUnchanged() int {
+	if r == nil || r.Unchanged == nil {
+		return 0
+	}
+	return *r.Unchanged
+}
+
+// String returns a string representation of RoleSyncReport.
+func (r *RoleSyncReport) String() string {
+	return Stringify(r)
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (r *Rule) GetEnabled() bool {
 	if r == nil || r.Enabled == nil {
@@ -6810,6 +16085,60 @@ func (r *Rule) String() string {
 	return Stringify(r)
 }
 
+// Clone returns a deep copy of Rule.
+func (r *Rule) Clone() *Rule {
+	if r == nil {
+		return nil
+	}
+	clone := &Rule{}
+	clone.ID = clonePointer(r.ID)
+	clone.Name = clonePointer(r.Name)
+	clone.Script = clonePointer(r.Script)
+	clone.Order = clonePointer(r.Order)
+	clone.Enabled = clonePointer(r.Enabled)
+	return clone
+}
+
+func (r *Rule) cloneAny() interface{} {
+	return r.Clone()
+}
+
+// Equal reports whether Rule and other hold the same values.
+func (r *Rule) Equal(other *Rule) bool {
+	return len(r.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Rule and other.
+func (r *Rule) Diff(other *Rule) []FieldChange {
+	return r.diff("", other)
+}
+
+func (r *Rule) diff(prefix string, other *Rule) (changes []FieldChange) {
+	if r == nil && other == nil {
+		return nil
+	}
+	if r == nil {
+		r = &Rule{}
+	}
+	if other == nil {
+		other = &Rule{}
+	}
+	changes = diffPointer(changes, prefix+"id", r.ID, other.ID)
+	changes = diffPointer(changes, prefix+"name", r.Name, other.Name)
+	changes = diffPointer(changes, prefix+"script", r.Script, other.Script)
+	changes = diffPointer(changes, prefix+"order", r.Order, other.Order)
+	changes = diffPointer(changes, prefix+"enabled", r.Enabled, other.Enabled)
+	return changes
+}
+
+func (r *Rule) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Rule)
+	if !ok {
+		return nil, false
+	}
+	return r.diff(prefix, typed), true
+}
+
 // GetKey returns the Key field if it's non-nil, zero value otherwise.
 func (r *RuleConfig) GetKey() string {
 	if r == nil || r.Key == nil {
@@ -6831,11 +16160,173 @@ func (r *RuleConfig) String() string {
 	return Stringify(r)
 }
 
+// Clone returns a deep copy of RuleConfig.
+func (r *RuleConfig) Clone() *RuleConfig {
+	if r == nil {
+		return nil
+	}
+	clone := &RuleConfig{}
+	clone.Key = clonePointer(r.Key)
+	clone.Value = clonePointer(r.Value)
+	return clone
+}
+
+func (r *RuleConfig) cloneAny() interface{} {
+	return r.Clone()
+}
+
+// Equal reports whether RuleConfig and other hold the same values.
+func (r *RuleConfig) Equal(other *RuleConfig) bool {
+	return len(r.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between RuleConfig and other.
+func (r *RuleConfig) Diff(other *RuleConfig) []FieldChange {
+	return r.diff("", other)
+}
+
+func (r *RuleConfig) diff(prefix string, other *RuleConfig) (changes []FieldChange) {
+	if r == nil && other == nil {
+		return nil
+	}
+	if r == nil {
+		r = &RuleConfig{}
+	}
+	if other == nil {
+		other = &RuleConfig{}
+	}
+	changes = diffPointer(changes, prefix+"key", r.Key, other.Key)
+	changes = diffPointer(changes, prefix+"value", r.Value, other.Value)
+	return changes
+}
+
+func (r *RuleConfig) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*RuleConfig)
+	if !ok {
+		return nil, false
+	}
+	return r.diff(prefix, typed), true
+}
+
 // String returns a string representation of RuleList.
 func (r *RuleList) String() string {
 	return Stringify(r)
 }
 
+// GetDigestAlgorithm returns the DigestAlgorithm field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetDigestAlgorithm() string {
+	if s == nil || s.DigestAlgorithm == nil {
+		return ""
+	}
+	return *s.DigestAlgorithm
+}
+
+// GetEntityID returns the EntityID field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetEntityID() string {
+	if s == nil || s.EntityID == nil {
+		return ""
+	}
+	return *s.EntityID
+}
+
+// GetMetadataURL returns the MetadataURL field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetMetadataURL() string {
+	if s == nil || s.MetadataURL == nil {
+		return ""
+	}
+	return *s.MetadataURL
+}
+
+// GetMetadataXML returns the MetadataXML field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetMetadataXML() string {
+	if s == nil || s.MetadataXML == nil {
+		return ""
+	}
+	return *s.MetadataXML
+}
+
+// GetSignatureAlgorithm returns the SignatureAlgorithm field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetSignatureAlgorithm() string {
+	if s == nil || s.SignatureAlgorithm == nil {
+		return ""
+	}
+	return *s.SignatureAlgorithm
+}
+
+// GetSignInBinding returns the SignInBinding field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetSignInBinding() string {
+	if s == nil || s.SignInBinding == nil {
+		return ""
+	}
+	return *s.SignInBinding
+}
+
+// GetSignInEndpoint returns the SignInEndpoint field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetSignInEndpoint() string {
+	if s == nil || s.SignInEndpoint == nil {
+		return ""
+	}
+	return *s.SignInEndpoint
+}
+
+// GetSignOutBinding returns the SignOutBinding field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetSignOutBinding() string {
+	if s == nil || s.SignOutBinding == nil {
+		return ""
+	}
+	return *s.SignOutBinding
+}
+
+// GetSignOutEndpoint returns the SignOutEndpoint field if it's non-nil, zero value otherwise.
+func (s *SAMLMetadata) GetSignOutEndpoint() string {
+	if s == nil || s.SignOutEndpoint == nil {
+		return ""
+	}
+	return *s.SignOutEndpoint
+}
+
+// String returns a string representation of SAMLMetadata.
+func (s *SAMLMetadata) String() string {
+	return Stringify(s)
+}
+
+// GetConnection returns the Connection field.
+func (s *SAMLServiceProvider) GetConnection() *Connection {
+	if s == nil {
+		return nil
+	}
+	return s.Connection
+}
+
+// GetDomain returns the Domain field if it's non-nil, zero value otherwise.
+func (s *SAMLServiceProvider) GetDomain() string {
+	if s == nil || s.Domain == nil {
+		return ""
+	}
+	return *s.Domain
+}
+
+// GetSigningCert returns the SigningCert field if it's non-nil, zero value otherwise.
+func (s *SAMLServiceProvider) GetSigningCert() string {
+	if s == nil || s.SigningCert == nil {
+		return ""
+	}
+	return *s.SigningCert
+}
+
+// GetTenant returns the Tenant field if it's non-nil, zero value otherwise.
+func (s *SAMLServiceProvider) GetTenant() string {
+	if s == nil || s.Tenant == nil {
+		return ""
+	}
+	return *s.Tenant
+}
+
+// String returns a string representation of SAMLServiceProvider.
+func (s *SAMLServiceProvider) String() string {
+	return Stringify(s)
+}
+
 // GetCert returns the Cert field if it's non-nil, zero value otherwise.
 func (s *SigningKey) GetCert() string {
 	if s == nil || s.Cert == nil {
@@ -6873,67 +16364,225 @@ func (s *SigningKey) GetFingerprint() string {
 	if s == nil || s.Fingerprint == nil {
 		return ""
 	}
-	return *s.Fingerprint
+	return *s.Fingerprint
+}
+
+// GetKID returns the KID field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetKID() string {
+	if s == nil || s.KID == nil {
+		return ""
+	}
+	return *s.KID
+}
+
+// GetNext returns the Next field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetNext() bool {
+	if s == nil || s.Next == nil {
+		return false
+	}
+	return *s.Next
+}
+
+// GetPKCS7 returns the PKCS7 field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetPKCS7() string {
+	if s == nil || s.PKCS7 == nil {
+		return ""
+	}
+	return *s.PKCS7
+}
+
+// GetPrevious returns the Previous field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetPrevious() bool {
+	if s == nil || s.Previous == nil {
+		return false
+	}
+	return *s.Previous
+}
+
+// GetRevoked returns the Revoked field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetRevoked() bool {
+	if s == nil || s.Revoked == nil {
+		return false
+	}
+	return *s.Revoked
+}
+
+// GetRevokedAt returns the RevokedAt field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetRevokedAt() time.Time {
+	if s == nil || s.RevokedAt == nil {
+		return time.Time{}
+	}
+	return *s.RevokedAt
+}
+
+// GetThumbprint returns the Thumbprint field if it's non-nil, zero value otherwise.
+func (s *SigningKey) GetThumbprint() string {
+	if s == nil || s.Thumbprint == nil {
+		return ""
+	}
+	return *s.Thumbprint
+}
+
+// String returns a string representation of SigningKey.
+func (s *SigningKey) String() string {
+	return Stringify(s)
+}
+
+// Clone returns a deep copy of SigningKey.
+func (s *SigningKey) Clone() *SigningKey {
+	if s == nil {
+		return nil
+	}
+	clone := &SigningKey{}
+	clone.KID = clonePointer(s.KID)
+	clone.Cert = clonePointer(s.Cert)
+	clone.PKCS7 = clonePointer(s.PKCS7)
+	clone.Current = clonePointer(s.Current)
+	clone.Next = clonePointer(s.Next)
+	clone.Previous = clonePointer(s.Previous)
+	clone.CurrentSince = clonePointer(s.CurrentSince)
+	clone.CurrentUntil = clonePointer(s.CurrentUntil)
+	clone.Fingerprint = clonePointer(s.Fingerprint)
+	clone.Thumbprint = clonePointer(s.Thumbprint)
+	clone.Revoked = clonePointer(s.Revoked)
+	clone.RevokedAt = clonePointer(s.RevokedAt)
+	return clone
+}
+
+func (s *SigningKey) cloneAny() interface{} {
+	return s.Clone()
+}
+
+// Equal reports whether SigningKey and other hold the same values.
+func (s *SigningKey) Equal(other *SigningKey) bool {
+	return len(s.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between SigningKey and other.
+func (s *SigningKey) Diff(other *SigningKey) []FieldChange {
+	return s.diff("", other)
+}
+
+func (s *SigningKey) diff(prefix string, other *SigningKey) (changes []FieldChange) {
+	if s == nil && other == nil {
+		return nil
+	}
+	if s == nil {
+		s = &SigningKey{}
+	}
+	if other == nil {
+		other = &SigningKey{}
+	}
+	changes = diffPointer(changes, prefix+"kid", s.KID, other.KID)
+	changes = diffPointer(changes, prefix+"cert", s.Cert, other.Cert)
+	changes = diffPointer(changes, prefix+"pkcs7", s.PKCS7, other.PKCS7)
+	changes = diffPointer(changes, prefix+"current", s.Current, other.Current)
+	changes = diffPointer(changes, prefix+"next", s.Next, other.Next)
+	changes = diffPointer(changes, prefix+"previous", s.Previous, other.Previous)
+	changes = diffTime(changes, prefix+"current_since", s.CurrentSince, other.CurrentSince)
+	changes = diffTime(changes, prefix+"current_until", s.CurrentUntil, other.CurrentUntil)
+	changes = diffPointer(changes, prefix+"fingerprint", s.Fingerprint, other.Fingerprint)
+	changes = diffPointer(changes, prefix+"thumbprint", s.Thumbprint, other.Thumbprint)
+	changes = diffPointer(changes, prefix+"revoked", s.Revoked, other.Revoked)
+	changes = diffTime(changes, prefix+"revoked_at", s.RevokedAt, other.RevokedAt)
+	return changes
+}
+
+func (s *SigningKey) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*SigningKey)
+	if !ok {
+		return nil, false
+	}
+	return s.diff(prefix, typed), true
+}
+
+// GetDryRun returns the DryRun field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotation) GetDryRun() bool {
+	if s == nil || s.DryRun == nil {
+		return false
+	}
+	return *s.DryRun
+}
+
+// GetGracePeriod returns the GracePeriod field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotation) GetGracePeriod() time.Duration {
+	if s == nil || s.GracePeriod == nil {
+		return 0
+	}
+	return *s.GracePeriod
 }
 
 // GetKID returns the KID field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetKID() string {
+func (s *SigningKeyRotation) GetKID() string {
 	if s == nil || s.KID == nil {
 		return ""
 	}
 	return *s.KID
 }
 
-// GetNext returns the Next field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetNext() bool {
-	if s == nil || s.Next == nil {
-		return false
+// GetPollInterval returns the PollInterval field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotation) GetPollInterval() time.Duration {
+	if s == nil || s.PollInterval == nil {
+		return 0
 	}
-	return *s.Next
+	return *s.PollInterval
 }
 
-// GetPKCS7 returns the PKCS7 field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetPKCS7() string {
-	if s == nil || s.PKCS7 == nil {
+// GetPollTimeout returns the PollTimeout field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotation) GetPollTimeout() time.Duration {
+	if s == nil || s.PollTimeout == nil {
+		return 0
+	}
+	return *s.PollTimeout
+}
+
+// GetPreviousKID returns the PreviousKID field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotation) GetPreviousKID() string {
+	if s == nil || s.PreviousKID == nil {
 		return ""
 	}
-	return *s.PKCS7
+	return *s.PreviousKID
 }
 
-// GetPrevious returns the Previous field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetPrevious() bool {
-	if s == nil || s.Previous == nil {
-		return false
+// String returns a string representation of SigningKeyRotation.
+func (s *SigningKeyRotation) String() string {
+	return Stringify(s)
+}
+
+// GetDescription returns the Description field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotationStep) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
 	}
-	return *s.Previous
+	return *s.Description
 }
 
-// GetRevoked returns the Revoked field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetRevoked() bool {
-	if s == nil || s.Revoked == nil {
+// GetDone returns the Done field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotationStep) GetDone() bool {
+	if s == nil || s.Done == nil {
 		return false
 	}
-	return *s.Revoked
+	return *s.Done
 }
 
-// GetRevokedAt returns the RevokedAt field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetRevokedAt() time.Time {
-	if s == nil || s.RevokedAt == nil {
-		return time.Time{}
+// GetKID returns the KID field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotationStep) GetKID() string {
+	if s == nil || s.KID == nil {
+		return ""
 	}
-	return *s.RevokedAt
+	return *s.KID
 }
 
-// GetThumbprint returns the Thumbprint field if it's non-nil, zero value otherwise.
-func (s *SigningKey) GetThumbprint() string {
-	if s == nil || s.Thumbprint == nil {
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (s *SigningKeyRotationStep) GetName() string {
+	if s == nil || s.Name == nil {
 		return ""
 	}
-	return *s.Thumbprint
+	return *s.Name
 }
 
-// String returns a string representation of SigningKey.
-func (s *SigningKey) String() string {
+// String returns a string representation of SigningKeyRotationStep.
+func (s *SigningKeyRotationStep) String() string {
 	return Stringify(s)
 }
 
@@ -6958,6 +16607,54 @@ func (s *Stage) String() string {
 	return Stringify(s)
 }
 
+// Clone returns a deep copy of Stage.
+func (s *Stage) Clone() *Stage {
+	if s == nil {
+		return nil
+	}
+	clone := &Stage{}
+	clone.PreLogin = s.PreLogin.Clone()
+	clone.PreUserRegistration = s.PreUserRegistration.Clone()
+	return clone
+}
+
+func (s *Stage) cloneAny() interface{} {
+	return s.Clone()
+}
+
+// Equal reports whether Stage and other hold the same values.
+func (s *Stage) Equal(other *Stage) bool {
+	return len(s.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Stage and other.
+func (s *Stage) Diff(other *Stage) []FieldChange {
+	return s.diff("", other)
+}
+
+func (s *Stage) diff(prefix string, other *Stage) (changes []FieldChange) {
+	if s == nil && other == nil {
+		return nil
+	}
+	if s == nil {
+		s = &Stage{}
+	}
+	if other == nil {
+		other = &Stage{}
+	}
+	changes = append(changes, s.PreLogin.diff(prefix+"pre-login.", other.PreLogin)...)
+	changes = append(changes, s.PreUserRegistration.diff(prefix+"pre-user-registration.", other.PreUserRegistration)...)
+	return changes
+}
+
+func (s *Stage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Stage)
+	if !ok {
+		return nil, false
+	}
+	return s.diff(prefix, typed), true
+}
+
 // GetAllowList returns the AllowList field if it's non-nil, zero value otherwise.
 func (s *SuspiciousIPThrottling) GetAllowList() []string {
 	if s == nil || s.AllowList == nil {
@@ -6995,6 +16692,58 @@ func (s *SuspiciousIPThrottling) String() string {
 	return Stringify(s)
 }
 
+// Clone returns a deep copy of SuspiciousIPThrottling.
+func (s *SuspiciousIPThrottling) Clone() *SuspiciousIPThrottling {
+	if s == nil {
+		return nil
+	}
+	clone := &SuspiciousIPThrottling{}
+	clone.Enabled = clonePointer(s.Enabled)
+	clone.Shields = clonePointerFunc(s.Shields, func(v []string) []string { return cloneSlice(v) })
+	clone.AllowList = clonePointerFunc(s.AllowList, func(v []string) []string { return cloneSlice(v) })
+	clone.Stage = s.Stage.Clone()
+	return clone
+}
+
+func (s *SuspiciousIPThrottling) cloneAny() interface{} {
+	return s.Clone()
+}
+
+// Equal reports whether SuspiciousIPThrottling and other hold the same values.
+func (s *SuspiciousIPThrottling) Equal(other *SuspiciousIPThrottling) bool {
+	return len(s.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between SuspiciousIPThrottling and other.
+func (s *SuspiciousIPThrottling) Diff(other *SuspiciousIPThrottling) []FieldChange {
+	return s.diff("", other)
+}
+
+func (s *SuspiciousIPThrottling) diff(prefix string, other *SuspiciousIPThrottling) (changes []FieldChange) {
+	if s == nil && other == nil {
+		return nil
+	}
+	if s == nil {
+		s = &SuspiciousIPThrottling{}
+	}
+	if other == nil {
+		other = &SuspiciousIPThrottling{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", s.Enabled, other.Enabled)
+	changes = diffSlicePointer(changes, prefix+"shields", s.Shields, other.Shields)
+	changes = diffSlicePointer(changes, prefix+"allowlist", s.AllowList, other.AllowList)
+	changes = append(changes, s.Stage.diff(prefix+"stage.", other.Stage)...)
+	return changes
+}
+
+func (s *SuspiciousIPThrottling) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*SuspiciousIPThrottling)
+	if !ok {
+		return nil, false
+	}
+	return s.diff(prefix, typed), true
+}
+
 // GetAllowedLogoutURLs returns the AllowedLogoutURLs field if it's non-nil, zero value otherwise.
 func (t *Tenant) GetAllowedLogoutURLs() []string {
 	if t == nil || t.AllowedLogoutURLs == nil {
@@ -7160,6 +16909,90 @@ func (t *Tenant) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of Tenant.
+func (t *Tenant) Clone() *Tenant {
+	if t == nil {
+		return nil
+	}
+	clone := &Tenant{}
+	clone.ChangePassword = t.ChangePassword.Clone()
+	clone.GuardianMFAPage = t.GuardianMFAPage.Clone()
+	clone.DefaultAudience = clonePointer(t.DefaultAudience)
+	clone.DefaultDirectory = clonePointer(t.DefaultDirectory)
+	clone.ErrorPage = t.ErrorPage.Clone()
+	clone.DeviceFlow = t.DeviceFlow.Clone()
+	clone.Flags = t.Flags.Clone()
+	clone.FriendlyName = clonePointer(t.FriendlyName)
+	clone.PictureURL = clonePointer(t.PictureURL)
+	clone.SupportEmail = clonePointer(t.SupportEmail)
+	clone.SupportURL = clonePointer(t.SupportURL)
+	clone.UniversalLogin = t.UniversalLogin.Clone()
+	clone.AllowedLogoutURLs = clonePointerFunc(t.AllowedLogoutURLs, func(v []string) []string { return cloneSlice(v) })
+	clone.SessionLifetime = clonePointer(t.SessionLifetime)
+	clone.IdleSessionLifetime = clonePointer(t.IdleSessionLifetime)
+	clone.SandboxVersion = clonePointer(t.SandboxVersion)
+	clone.SandboxVersionAvailable = clonePointerFunc(t.SandboxVersionAvailable, func(v []string) []string { return cloneSlice(v) })
+	clone.DefaultRedirectionURI = clonePointer(t.DefaultRedirectionURI)
+	clone.EnabledLocales = clonePointerFunc(t.EnabledLocales, func(v []string) []string { return cloneSlice(v) })
+	clone.SessionCookie = t.SessionCookie.Clone()
+	return clone
+}
+
+func (t *Tenant) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether Tenant and other hold the same values.
+func (t *Tenant) Equal(other *Tenant) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Tenant and other.
+func (t *Tenant) Diff(other *Tenant) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *Tenant) diff(prefix string, other *Tenant) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &Tenant{}
+	}
+	if other == nil {
+		other = &Tenant{}
+	}
+	changes = append(changes, t.ChangePassword.diff(prefix+"change_password.", other.ChangePassword)...)
+	changes = append(changes, t.GuardianMFAPage.diff(prefix+"guardian_mfa_page.", other.GuardianMFAPage)...)
+	changes = diffPointer(changes, prefix+"default_audience", t.DefaultAudience, other.DefaultAudience)
+	changes = diffPointer(changes, prefix+"default_directory", t.DefaultDirectory, other.DefaultDirectory)
+	changes = append(changes, t.ErrorPage.diff(prefix+"error_page.", other.ErrorPage)...)
+	changes = append(changes, t.DeviceFlow.diff(prefix+"device_flow.", other.DeviceFlow)...)
+	changes = append(changes, t.Flags.diff(prefix+"flags.", other.Flags)...)
+	changes = diffPointer(changes, prefix+"friendly_name", t.FriendlyName, other.FriendlyName)
+	changes = diffPointer(changes, prefix+"picture_url", t.PictureURL, other.PictureURL)
+	changes = diffPointer(changes, prefix+"support_email", t.SupportEmail, other.SupportEmail)
+	changes = diffPointer(changes, prefix+"support_url", t.SupportURL, other.SupportURL)
+	changes = append(changes, t.UniversalLogin.diff(prefix+"universal_login.", other.UniversalLogin)...)
+	changes = diffSlicePointer(changes, prefix+"allowed_logout_urls", t.AllowedLogoutURLs, other.AllowedLogoutURLs)
+	changes = diffPointer(changes, prefix+"session_lifetime", t.SessionLifetime, other.SessionLifetime)
+	changes = diffPointer(changes, prefix+"idle_session_lifetime", t.IdleSessionLifetime, other.IdleSessionLifetime)
+	changes = diffPointer(changes, prefix+"sandbox_version", t.SandboxVersion, other.SandboxVersion)
+	changes = diffSlicePointer(changes, prefix+"sandbox_versions_available", t.SandboxVersionAvailable, other.SandboxVersionAvailable)
+	changes = diffPointer(changes, prefix+"default_redirection_uri", t.DefaultRedirectionURI, other.DefaultRedirectionURI)
+	changes = diffSlicePointer(changes, prefix+"enabled_locales", t.EnabledLocales, other.EnabledLocales)
+	changes = append(changes, t.SessionCookie.diff(prefix+"session_cookie.", other.SessionCookie)...)
+	return changes
+}
+
+func (t *Tenant) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Tenant)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (t *TenantChangePassword) GetEnabled() bool {
 	if t == nil || t.Enabled == nil {
@@ -7181,6 +17014,54 @@ func (t *TenantChangePassword) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantChangePassword.
+func (t *TenantChangePassword) Clone() *TenantChangePassword {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantChangePassword{}
+	clone.Enabled = clonePointer(t.Enabled)
+	clone.HTML = clonePointer(t.HTML)
+	return clone
+}
+
+func (t *TenantChangePassword) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantChangePassword and other hold the same values.
+func (t *TenantChangePassword) Equal(other *TenantChangePassword) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantChangePassword and other.
+func (t *TenantChangePassword) Diff(other *TenantChangePassword) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantChangePassword) diff(prefix string, other *TenantChangePassword) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantChangePassword{}
+	}
+	if other == nil {
+		other = &TenantChangePassword{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", t.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"html", t.HTML, other.HTML)
+	return changes
+}
+
+func (t *TenantChangePassword) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantChangePassword)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetCharset returns the Charset field if it's non-nil, zero value otherwise.
 func (t *TenantDeviceFlow) GetCharset() string {
 	if t == nil || t.Charset == nil {
@@ -7202,6 +17083,54 @@ func (t *TenantDeviceFlow) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantDeviceFlow.
+func (t *TenantDeviceFlow) Clone() *TenantDeviceFlow {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantDeviceFlow{}
+	clone.Charset = clonePointer(t.Charset)
+	clone.Mask = clonePointer(t.Mask)
+	return clone
+}
+
+func (t *TenantDeviceFlow) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantDeviceFlow and other hold the same values.
+func (t *TenantDeviceFlow) Equal(other *TenantDeviceFlow) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantDeviceFlow and other.
+func (t *TenantDeviceFlow) Diff(other *TenantDeviceFlow) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantDeviceFlow) diff(prefix string, other *TenantDeviceFlow) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantDeviceFlow{}
+	}
+	if other == nil {
+		other = &TenantDeviceFlow{}
+	}
+	changes = diffPointer(changes, prefix+"charset", t.Charset, other.Charset)
+	changes = diffPointer(changes, prefix+"mask", t.Mask, other.Mask)
+	return changes
+}
+
+func (t *TenantDeviceFlow) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantDeviceFlow)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetHTML returns the HTML field if it's non-nil, zero value otherwise.
 func (t *TenantErrorPage) GetHTML() string {
 	if t == nil || t.HTML == nil {
@@ -7231,6 +17160,56 @@ func (t *TenantErrorPage) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantErrorPage.
+func (t *TenantErrorPage) Clone() *TenantErrorPage {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantErrorPage{}
+	clone.HTML = clonePointer(t.HTML)
+	clone.ShowLogLink = clonePointer(t.ShowLogLink)
+	clone.URL = clonePointer(t.URL)
+	return clone
+}
+
+func (t *TenantErrorPage) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantErrorPage and other hold the same values.
+func (t *TenantErrorPage) Equal(other *TenantErrorPage) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantErrorPage and other.
+func (t *TenantErrorPage) Diff(other *TenantErrorPage) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantErrorPage) diff(prefix string, other *TenantErrorPage) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantErrorPage{}
+	}
+	if other == nil {
+		other = &TenantErrorPage{}
+	}
+	changes = diffPointer(changes, prefix+"html", t.HTML, other.HTML)
+	changes = diffPointer(changes, prefix+"show_log_link", t.ShowLogLink, other.ShowLogLink)
+	changes = diffPointer(changes, prefix+"url", t.URL, other.URL)
+	return changes
+}
+
+func (t *TenantErrorPage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantErrorPage)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetAllowChangingEnableSSO returns the AllowChangingEnableSSO field if it's non-nil, zero value otherwise.
 func (t *TenantFlags) GetAllowChangingEnableSSO() bool {
 	if t == nil || t.AllowChangingEnableSSO == nil {
@@ -7436,6 +17415,100 @@ func (t *TenantFlags) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantFlags.
+func (t *TenantFlags) Clone() *TenantFlags {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantFlags{}
+	clone.EnableClientConnections = clonePointer(t.EnableClientConnections)
+	clone.EnableAPIsSection = clonePointer(t.EnableAPIsSection)
+	clone.DisableImpersonation = clonePointer(t.DisableImpersonation)
+	clone.EnablePipeline2 = clonePointer(t.EnablePipeline2)
+	clone.EnableDynamicClientRegistration = clonePointer(t.EnableDynamicClientRegistration)
+	clone.EnableCustomDomainInEmails = clonePointer(t.EnableCustomDomainInEmails)
+	clone.EnableSSO = clonePointer(t.EnableSSO)
+	clone.AllowChangingEnableSSO = clonePointer(t.AllowChangingEnableSSO)
+	clone.UniversalLogin = clonePointer(t.UniversalLogin)
+	clone.EnableLegacyLogsSearchV2 = clonePointer(t.EnableLegacyLogsSearchV2)
+	clone.DisableClickjackProtectionHeaders = clonePointer(t.DisableClickjackProtectionHeaders)
+	clone.EnablePublicSignupUserExistsError = clonePointer(t.EnablePublicSignupUserExistsError)
+	clone.UseScopeDescriptionsForConsent = clonePointer(t.UseScopeDescriptionsForConsent)
+	clone.AllowLegacyDelegationGrantTypes = clonePointer(t.AllowLegacyDelegationGrantTypes)
+	clone.AllowLegacyROGrantTypes = clonePointer(t.AllowLegacyROGrantTypes)
+	clone.AllowLegacyTokenInfoEndpoint = clonePointer(t.AllowLegacyTokenInfoEndpoint)
+	clone.EnableLegacyProfile = clonePointer(t.EnableLegacyProfile)
+	clone.EnableIDTokenAPI2 = clonePointer(t.EnableIDTokenAPI2)
+	clone.NoDisclosureEnterpriseConnections = clonePointer(t.NoDisclosureEnterpriseConnections)
+	clone.DisableManagementAPISMSObfuscation = clonePointer(t.DisableManagementAPISMSObfuscation)
+	clone.EnableADFSWAADEmailVerification = clonePointer(t.EnableADFSWAADEmailVerification)
+	clone.RevokeRefreshTokenGrant = clonePointer(t.RevokeRefreshTokenGrant)
+	clone.DashboardLogStreams = clonePointer(t.DashboardLogStreams)
+	clone.DashboardInsightsView = clonePointer(t.DashboardInsightsView)
+	clone.DisableFieldsMapFix = clonePointer(t.DisableFieldsMapFix)
+	return clone
+}
+
+func (t *TenantFlags) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantFlags and other hold the same values.
+func (t *TenantFlags) Equal(other *TenantFlags) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantFlags and other.
+func (t *TenantFlags) Diff(other *TenantFlags) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantFlags) diff(prefix string, other *TenantFlags) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantFlags{}
+	}
+	if other == nil {
+		other = &TenantFlags{}
+	}
+	changes = diffPointer(changes, prefix+"enable_client_connections", t.EnableClientConnections, other.EnableClientConnections)
+	changes = diffPointer(changes, prefix+"enable_apis_section", t.EnableAPIsSection, other.EnableAPIsSection)
+	changes = diffPointer(changes, prefix+"disable_impersonation", t.DisableImpersonation, other.DisableImpersonation)
+	changes = diffPointer(changes, prefix+"enable_pipeline2", t.EnablePipeline2, other.EnablePipeline2)
+	changes = diffPointer(changes, prefix+"enable_dynamic_client_registration", t.EnableDynamicClientRegistration, other.EnableDynamicClientRegistration)
+	changes = diffPointer(changes, prefix+"enable_custom_domain_in_emails", t.EnableCustomDomainInEmails, other.EnableCustomDomainInEmails)
+	changes = diffPointer(changes, prefix+"enable_sso", t.EnableSSO, other.EnableSSO)
+	changes = diffPointer(changes, prefix+"allow_changing_enable_sso", t.AllowChangingEnableSSO, other.AllowChangingEnableSSO)
+	changes = diffPointer(changes, prefix+"universal_login", t.UniversalLogin, other.UniversalLogin)
+	changes = diffPointer(changes, prefix+"enable_legacy_logs_search_v2", t.EnableLegacyLogsSearchV2, other.EnableLegacyLogsSearchV2)
+	changes = diffPointer(changes, prefix+"disable_clickjack_protection_headers", t.DisableClickjackProtectionHeaders, other.DisableClickjackProtectionHeaders)
+	changes = diffPointer(changes, prefix+"enable_public_signup_user_exists_error", t.EnablePublicSignupUserExistsError, other.EnablePublicSignupUserExistsError)
+	changes = diffPointer(changes, prefix+"use_scope_descriptions_for_consent", t.UseScopeDescriptionsForConsent, other.UseScopeDescriptionsForConsent)
+	changes = diffPointer(changes, prefix+"allow_legacy_delegation_grant_types", t.AllowLegacyDelegationGrantTypes, other.AllowLegacyDelegationGrantTypes)
+	changes = diffPointer(changes, prefix+"allow_legacy_ro_grant_types", t.AllowLegacyROGrantTypes, other.AllowLegacyROGrantTypes)
+	changes = diffPointer(changes, prefix+"allow_legacy_tokeninfo_endpoint", t.AllowLegacyTokenInfoEndpoint, other.AllowLegacyTokenInfoEndpoint)
+	changes = diffPointer(changes, prefix+"enable_legacy_profile", t.EnableLegacyProfile, other.EnableLegacyProfile)
+	changes = diffPointer(changes, prefix+"enable_idtoken_api2", t.EnableIDTokenAPI2, other.EnableIDTokenAPI2)
+	changes = diffPointer(changes, prefix+"no_disclose_enterprise_connections", t.NoDisclosureEnterpriseConnections, other.NoDisclosureEnterpriseConnections)
+	changes = diffPointer(changes, prefix+"disable_management_api_sms_obfuscation", t.DisableManagementAPISMSObfuscation, other.DisableManagementAPISMSObfuscation)
+	changes = diffPointer(changes, prefix+"enable_adfs_waad_email_verification", t.EnableADFSWAADEmailVerification, other.EnableADFSWAADEmailVerification)
+	changes = diffPointer(changes, prefix+"revoke_refresh_token_grant", t.RevokeRefreshTokenGrant, other.RevokeRefreshTokenGrant)
+	changes = diffPointer(changes, prefix+"dashboard_log_streams_next", t.DashboardLogStreams, other.DashboardLogStreams)
+	changes = diffPointer(changes, prefix+"dashboard_insights_view", t.DashboardInsightsView, other.DashboardInsightsView)
+	changes = diffPointer(changes, prefix+"disable_fields_map_fix", t.DisableFieldsMapFix, other.DisableFieldsMapFix)
+	return changes
+}
+
+func (t *TenantFlags) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantFlags)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetEnabled returns the Enabled field if it's non-nil, zero value otherwise.
 func (t *TenantGuardianMFAPage) GetEnabled() bool {
 	if t == nil || t.Enabled == nil {
@@ -7457,6 +17530,54 @@ func (t *TenantGuardianMFAPage) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantGuardianMFAPage.
+func (t *TenantGuardianMFAPage) Clone() *TenantGuardianMFAPage {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantGuardianMFAPage{}
+	clone.Enabled = clonePointer(t.Enabled)
+	clone.HTML = clonePointer(t.HTML)
+	return clone
+}
+
+func (t *TenantGuardianMFAPage) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantGuardianMFAPage and other hold the same values.
+func (t *TenantGuardianMFAPage) Equal(other *TenantGuardianMFAPage) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantGuardianMFAPage and other.
+func (t *TenantGuardianMFAPage) Diff(other *TenantGuardianMFAPage) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantGuardianMFAPage) diff(prefix string, other *TenantGuardianMFAPage) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantGuardianMFAPage{}
+	}
+	if other == nil {
+		other = &TenantGuardianMFAPage{}
+	}
+	changes = diffPointer(changes, prefix+"enabled", t.Enabled, other.Enabled)
+	changes = diffPointer(changes, prefix+"html", t.HTML, other.HTML)
+	return changes
+}
+
+func (t *TenantGuardianMFAPage) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantGuardianMFAPage)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetMode returns the Mode field if it's non-nil, zero value otherwise.
 func (t *TenantSessionCookie) GetMode() string {
 	if t == nil || t.Mode == nil {
@@ -7470,6 +17591,52 @@ func (t *TenantSessionCookie) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantSessionCookie.
+func (t *TenantSessionCookie) Clone() *TenantSessionCookie {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantSessionCookie{}
+	clone.Mode = clonePointer(t.Mode)
+	return clone
+}
+
+func (t *TenantSessionCookie) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantSessionCookie and other hold the same values.
+func (t *TenantSessionCookie) Equal(other *TenantSessionCookie) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantSessionCookie and other.
+func (t *TenantSessionCookie) Diff(other *TenantSessionCookie) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantSessionCookie) diff(prefix string, other *TenantSessionCookie) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantSessionCookie{}
+	}
+	if other == nil {
+		other = &TenantSessionCookie{}
+	}
+	changes = diffPointer(changes, prefix+"mode", t.Mode, other.Mode)
+	return changes
+}
+
+func (t *TenantSessionCookie) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantSessionCookie)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetColors returns the Colors field.
 func (t *TenantUniversalLogin) GetColors() *TenantUniversalLoginColors {
 	if t == nil {
@@ -7483,6 +17650,52 @@ func (t *TenantUniversalLogin) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of TenantUniversalLogin.
+func (t *TenantUniversalLogin) Clone() *TenantUniversalLogin {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantUniversalLogin{}
+	clone.Colors = t.Colors.Clone()
+	return clone
+}
+
+func (t *TenantUniversalLogin) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantUniversalLogin and other hold the same values.
+func (t *TenantUniversalLogin) Equal(other *TenantUniversalLogin) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantUniversalLogin and other.
+func (t *TenantUniversalLogin) Diff(other *TenantUniversalLogin) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantUniversalLogin) diff(prefix string, other *TenantUniversalLogin) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantUniversalLogin{}
+	}
+	if other == nil {
+		other = &TenantUniversalLogin{}
+	}
+	changes = append(changes, t.Colors.diff(prefix+"colors.", other.Colors)...)
+	return changes
+}
+
+func (t *TenantUniversalLogin) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantUniversalLogin)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
 // GetPageBackground returns the PageBackground field if it's non-nil, zero value otherwise.
 func (t *TenantUniversalLoginColors) GetPageBackground() string {
 	if t == nil || t.PageBackground == nil {
@@ -7504,12 +17717,62 @@ func (t *TenantUniversalLoginColors) GetPrimary() string {
 	if t == nil || t.Primary == nil {
 		return ""
 	}
-	return *t.Primary
+	return *t.Primary
+}
+
+// String returns a string representation of TenantUniversalLoginColors.
+func (t *TenantUniversalLoginColors) String() string {
+	return Stringify(t)
+}
+
+// Clone returns a deep copy of TenantUniversalLoginColors.
+func (t *TenantUniversalLoginColors) Clone() *TenantUniversalLoginColors {
+	if t == nil {
+		return nil
+	}
+	clone := &TenantUniversalLoginColors{}
+	clone.Primary = clonePointer(t.Primary)
+	clone.PageBackground = clonePointer(t.PageBackground)
+	clone.PageBackgroundGradient = t.PageBackgroundGradient.Clone()
+	return clone
+}
+
+func (t *TenantUniversalLoginColors) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether TenantUniversalLoginColors and other hold the same values.
+func (t *TenantUniversalLoginColors) Equal(other *TenantUniversalLoginColors) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between TenantUniversalLoginColors and other.
+func (t *TenantUniversalLoginColors) Diff(other *TenantUniversalLoginColors) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *TenantUniversalLoginColors) diff(prefix string, other *TenantUniversalLoginColors) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &TenantUniversalLoginColors{}
+	}
+	if other == nil {
+		other = &TenantUniversalLoginColors{}
+	}
+	changes = diffPointer(changes, prefix+"primary", t.Primary, other.Primary)
+	changes = diffPointer(changes, prefix+"page_background", t.PageBackground, other.PageBackground)
+	changes = append(changes, t.PageBackgroundGradient.diff(prefix+"page_background_gradient.", other.PageBackgroundGradient)...)
+	return changes
 }
 
-// String returns a string representation of TenantUniversalLoginColors.
-func (t *TenantUniversalLoginColors) String() string {
-	return Stringify(t)
+func (t *TenantUniversalLoginColors) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*TenantUniversalLoginColors)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
 }
 
 // GetClientID returns the ClientID field if it's non-nil, zero value otherwise.
@@ -7589,6 +17852,89 @@ func (t *Ticket) String() string {
 	return Stringify(t)
 }
 
+// Clone returns a deep copy of Ticket.
+func (t *Ticket) Clone() *Ticket {
+	if t == nil {
+		return nil
+	}
+	clone := &Ticket{}
+	clone.ResultURL = clonePointer(t.ResultURL)
+	clone.UserID = clonePointer(t.UserID)
+	clone.TTLSec = clonePointer(t.TTLSec)
+	clone.ClientID = clonePointer(t.ClientID)
+	clone.ConnectionID = clonePointer(t.ConnectionID)
+	clone.Email = clonePointer(t.Email)
+	clone.Ticket = clonePointer(t.Ticket)
+	clone.MarkEmailAsVerified = clonePointer(t.MarkEmailAsVerified)
+	clone.IncludeEmailInRedirect = clonePointer(t.IncludeEmailInRedirect)
+	return clone
+}
+
+func (t *Ticket) cloneAny() interface{} {
+	return t.Clone()
+}
+
+// Equal reports whether Ticket and other hold the same values.
+func (t *Ticket) Equal(other *Ticket) bool {
+	return len(t.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between Ticket and other.
+func (t *Ticket) Diff(other *Ticket) []FieldChange {
+	return t.diff("", other)
+}
+
+func (t *Ticket) diff(prefix string, other *Ticket) (changes []FieldChange) {
+	if t == nil && other == nil {
+		return nil
+	}
+	if t == nil {
+		t = &Ticket{}
+	}
+	if other == nil {
+		other = &Ticket{}
+	}
+	changes = diffPointer(changes, prefix+"result_url", t.ResultURL, other.ResultURL)
+	changes = diffPointer(changes, prefix+"user_id", t.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"ttl_sec", t.TTLSec, other.TTLSec)
+	changes = diffPointer(changes, prefix+"client_id", t.ClientID, other.ClientID)
+	changes = diffPointer(changes, prefix+"connection_id", t.ConnectionID, other.ConnectionID)
+	changes = diffPointer(changes, prefix+"email", t.Email, other.Email)
+	changes = diffPointer(changes, prefix+"ticket", t.Ticket, other.Ticket)
+	changes = diffPointer(changes, prefix+"mark_email_as_verified", t.MarkEmailAsVerified, other.MarkEmailAsVerified)
+	changes = diffPointer(changes, prefix+"includeEmailInRedirect", t.IncludeEmailInRedirect, other.IncludeEmailInRedirect)
+	return changes
+}
+
+func (t *Ticket) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*Ticket)
+	if !ok {
+		return nil, false
+	}
+	return t.diff(prefix, typed), true
+}
+
+// GetAlias returns the Alias field if it's non-nil, zero value otherwise.
+func (u *UpstreamParam) GetAlias() string {
+	if u == nil || u.Alias == nil {
+		return ""
+	}
+	return *u.Alias
+}
+
+// GetValue returns the Value field if it's non-nil, zero value otherwise.
+func (u *UpstreamParam) GetValue() string {
+	if u == nil || u.Value == nil {
+		return ""
+	}
+	return *u.Value
+}
+
+// String returns a string representation of UpstreamParam.
+func (u *UpstreamParam) String() string {
+	return Stringify(u)
+}
+
 // GetBlocked returns the Blocked field if it's non-nil, zero value otherwise.
 func (u *User) GetBlocked() bool {
 	if u == nil || u.Blocked == nil {
@@ -7810,6 +18156,114 @@ func (u *User) String() string {
 	return Stringify(u)
 }
 
+// Clone returns a deep copy of User.
+func (u *User) Clone() *User {
+	if u == nil {
+		return nil
+	}
+	clone := &User{}
+	clone.ID = clonePointer(u.ID)
+	clone.Connection = clonePointer(u.Connection)
+	clone.Email = clonePointer(u.Email)
+	clone.Name = clonePointer(u.Name)
+	clone.GivenName = clonePointer(u.GivenName)
+	clone.FamilyName = clonePointer(u.FamilyName)
+	clone.Username = clonePointer(u.Username)
+	clone.Nickname = clonePointer(u.Nickname)
+	clone.ScreenName = clonePointer(u.ScreenName)
+	clone.Description = clonePointer(u.Description)
+	clone.Location = clonePointer(u.Location)
+	clone.Password = clonePointer(u.Password)
+	clone.PhoneNumber = clonePointer(u.PhoneNumber)
+	clone.CreatedAt = clonePointer(u.CreatedAt)
+	clone.UpdatedAt = clonePointer(u.UpdatedAt)
+	clone.LastLogin = clonePointer(u.LastLogin)
+	clone.LastPasswordReset = clonePointer(u.LastPasswordReset)
+	clone.UserMetadata = clonePointerFunc(u.UserMetadata, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	clone.Identities = cloneSliceFunc(u.Identities, func(v *UserIdentity) *UserIdentity { return v.Clone() })
+	clone.EmailVerified = clonePointer(u.EmailVerified)
+	clone.VerifyEmail = clonePointer(u.VerifyEmail)
+	clone.PhoneVerified = clonePointer(u.PhoneVerified)
+	clone.AppMetadata = clonePointerFunc(u.AppMetadata, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	clone.Picture = clonePointer(u.Picture)
+	clone.URL = clonePointer(u.URL)
+	clone.Blocked = clonePointer(u.Blocked)
+	clone.LastIP = clonePointer(u.LastIP)
+	clone.LoginsCount = clonePointer(u.LoginsCount)
+	clone.Multifactor = clonePointerFunc(u.Multifactor, func(v []string) []string { return cloneSlice(v) })
+	clone.ClientID = clonePointer(u.ClientID)
+	return clone
+}
+
+func (u *User) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether User and other hold the same values.
+func (u *User) Equal(other *User) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between User and other.
+func (u *User) Diff(other *User) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *User) diff(prefix string, other *User) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &User{}
+	}
+	if other == nil {
+		other = &User{}
+	}
+	changes = diffPointer(changes, prefix+"user_id", u.ID, other.ID)
+	changes = diffPointer(changes, prefix+"connection", u.Connection, other.Connection)
+	changes = diffPointer(changes, prefix+"email", u.Email, other.Email)
+	changes = diffPointer(changes, prefix+"name", u.Name, other.Name)
+	changes = diffPointer(changes, prefix+"given_name", u.GivenName, other.GivenName)
+	changes = diffPointer(changes, prefix+"family_name", u.FamilyName, other.FamilyName)
+	changes = diffPointer(changes, prefix+"username", u.Username, other.Username)
+	changes = diffPointer(changes, prefix+"nickname", u.Nickname, other.Nickname)
+	changes = diffPointer(changes, prefix+"screen_name", u.ScreenName, other.ScreenName)
+	changes = diffPointer(changes, prefix+"description", u.Description, other.Description)
+	changes = diffPointer(changes, prefix+"location", u.Location, other.Location)
+	changes = diffPointer(changes, prefix+"password", u.Password, other.Password)
+	changes = diffPointer(changes, prefix+"phone_number", u.PhoneNumber, other.PhoneNumber)
+	changes = diffTime(changes, prefix+"created_at", u.CreatedAt, other.CreatedAt)
+	changes = diffTime(changes, prefix+"updated_at", u.UpdatedAt, other.UpdatedAt)
+	changes = diffTime(changes, prefix+"last_login", u.LastLogin, other.LastLogin)
+	changes = diffTime(changes, prefix+"last_password_reset", u.LastPasswordReset, other.LastPasswordReset)
+	changes = diffMapPointer(changes, prefix+"user_metadata", u.UserMetadata, other.UserMetadata)
+	changes = diffSlice(changes, prefix+"identities", u.Identities, other.Identities)
+	changes = diffPointer(changes, prefix+"email_verified", u.EmailVerified, other.EmailVerified)
+	changes = diffPointer(changes, prefix+"verify_email", u.VerifyEmail, other.VerifyEmail)
+	changes = diffPointer(changes, prefix+"phone_verified", u.PhoneVerified, other.PhoneVerified)
+	changes = diffMapPointer(changes, prefix+"app_metadata", u.AppMetadata, other.AppMetadata)
+	changes = diffPointer(changes, prefix+"picture", u.Picture, other.Picture)
+	changes = diffPointer(changes, prefix+"url", u.URL, other.URL)
+	changes = diffPointer(changes, prefix+"blocked", u.Blocked, other.Blocked)
+	changes = diffPointer(changes, prefix+"last_ip", u.LastIP, other.LastIP)
+	changes = diffPointer(changes, prefix+"logins_count", u.LoginsCount, other.LoginsCount)
+	changes = diffSlicePointer(changes, prefix+"multifactor", u.Multifactor, other.Multifactor)
+	changes = diffPointer(changes, prefix+"client_id", u.ClientID, other.ClientID)
+	return changes
+}
+
+func (u *User) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*User)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
 // GetIdentifier returns the Identifier field if it's non-nil, zero value otherwise.
 func (u *UserBlock) GetIdentifier() string {
 	if u == nil || u.Identifier == nil {
@@ -7831,6 +18285,149 @@ func (u *UserBlock) String() string {
 	return Stringify(u)
 }
 
+// Clone returns a deep copy of UserBlock.
+func (u *UserBlock) Clone() *UserBlock {
+	if u == nil {
+		return nil
+	}
+	clone := &UserBlock{}
+	clone.Identifier = clonePointer(u.Identifier)
+	clone.IP = clonePointer(u.IP)
+	return clone
+}
+
+func (u *UserBlock) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether UserBlock and other hold the same values.
+func (u *UserBlock) Equal(other *UserBlock) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between UserBlock and other.
+func (u *UserBlock) Diff(other *UserBlock) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *UserBlock) diff(prefix string, other *UserBlock) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &UserBlock{}
+	}
+	if other == nil {
+		other = &UserBlock{}
+	}
+	changes = diffPointer(changes, prefix+"identifier", u.Identifier, other.Identifier)
+	changes = diffPointer(changes, prefix+"ip", u.IP, other.IP)
+	return changes
+}
+
+func (u *UserBlock) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*UserBlock)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
+// GetExportedAt returns the ExportedAt field if it's non-nil, zero value otherwise.
+func (u *UserDataExport) GetExportedAt() time.Time {
+	if u == nil || u.ExportedAt == nil {
+		return time.Time{}
+	}
+	return *u.ExportedAt
+}
+
+// GetUser returns the User field.
+func (u *UserDataExport) GetUser() *User {
+	if u == nil {
+		return nil
+	}
+	return u.User
+}
+
+// GetVersion returns the Version field if it's non-nil, zero value otherwise.
+func (u *UserDataExport) GetVersion() int {
+	if u == nil || u.Version == nil {
+		return 0
+	}
+	return *u.Version
+}
+
+// String returns a string representation of UserDataExport.
+func (u *UserDataExport) String() string {
+	return Stringify(u)
+}
+
+// GetApproved returns the Approved field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateGroup) GetApproved() bool {
+	if u == nil || u.Approved == nil {
+		return false
+	}
+	return *u.Approved
+}
+
+// GetEmail returns the Email field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateGroup) GetEmail() string {
+	if u == nil || u.Email == nil {
+		return ""
+	}
+	return *u.Email
+}
+
+// GetPrimaryID returns the PrimaryID field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateGroup) GetPrimaryID() string {
+	if u == nil || u.PrimaryID == nil {
+		return ""
+	}
+	return *u.PrimaryID
+}
+
+// String returns a string representation of UserDuplicateGroup.
+func (u *UserDuplicateGroup) String() string {
+	return Stringify(u)
+}
+
+// GetAppMetadataStrategy returns the AppMetadataStrategy field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateOptions) GetAppMetadataStrategy() string {
+	if u == nil || u.AppMetadataStrategy == nil {
+		return ""
+	}
+	return *u.AppMetadataStrategy
+}
+
+// GetIncludeUnverified returns the IncludeUnverified field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateOptions) GetIncludeUnverified() bool {
+	if u == nil || u.IncludeUnverified == nil {
+		return false
+	}
+	return *u.IncludeUnverified
+}
+
+// GetPrimaryRules returns the PrimaryRules field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateOptions) GetPrimaryRules() []string {
+	if u == nil || u.PrimaryRules == nil {
+		return nil
+	}
+	return *u.PrimaryRules
+}
+
+// GetUserMetadataStrategy returns the UserMetadataStrategy field if it's non-nil, zero value otherwise.
+func (u *UserDuplicateOptions) GetUserMetadataStrategy() string {
+	if u == nil || u.UserMetadataStrategy == nil {
+		return ""
+	}
+	return *u.UserMetadataStrategy
+}
+
+// String returns a string representation of UserDuplicateOptions.
+func (u *UserDuplicateOptions) String() string {
+	return Stringify(u)
+}
+
 // GetAuthMethod returns the AuthMethod field if it's non-nil, zero value otherwise.
 func (u *UserEnrollment) GetAuthMethod() string {
 	if u == nil || u.AuthMethod == nil {
@@ -7908,6 +18505,142 @@ func (u *UserEnrollment) String() string {
 	return Stringify(u)
 }
 
+// Clone returns a deep copy of UserEnrollment.
+func (u *UserEnrollment) Clone() *UserEnrollment {
+	if u == nil {
+		return nil
+	}
+	clone := &UserEnrollment{}
+	clone.AuthMethod = clonePointer(u.AuthMethod)
+	clone.EnrolledAt = clonePointer(u.EnrolledAt)
+	clone.ID = clonePointer(u.ID)
+	clone.Identifier = clonePointer(u.Identifier)
+	clone.LastAuth = clonePointer(u.LastAuth)
+	clone.Name = clonePointer(u.Name)
+	clone.PhoneNumber = clonePointer(u.PhoneNumber)
+	clone.Status = clonePointer(u.Status)
+	clone.Type = clonePointer(u.Type)
+	return clone
+}
+
+func (u *UserEnrollment) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether UserEnrollment and other hold the same values.
+func (u *UserEnrollment) Equal(other *UserEnrollment) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between UserEnrollment and other.
+func (u *UserEnrollment) Diff(other *UserEnrollment) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *UserEnrollment) diff(prefix string, other *UserEnrollment) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &UserEnrollment{}
+	}
+	if other == nil {
+		other = &UserEnrollment{}
+	}
+	changes = diffPointer(changes, prefix+"auth_method", u.AuthMethod, other.AuthMethod)
+	changes = diffTime(changes, prefix+"enrolled_at", u.EnrolledAt, other.EnrolledAt)
+	changes = diffPointer(changes, prefix+"id", u.ID, other.ID)
+	changes = diffPointer(changes, prefix+"identifier", u.Identifier, other.Identifier)
+	changes = diffTime(changes, prefix+"last_auth", u.LastAuth, other.LastAuth)
+	changes = diffPointer(changes, prefix+"name", u.Name, other.Name)
+	changes = diffPointer(changes, prefix+"phone_number", u.PhoneNumber, other.PhoneNumber)
+	changes = diffPointer(changes, prefix+"status", u.Status, other.Status)
+	changes = diffPointer(changes, prefix+"type", u.Type, other.Type)
+	return changes
+}
+
+func (u *UserEnrollment) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*UserEnrollment)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
+// GetCompletedAt returns the CompletedAt field if it's non-nil, zero value otherwise.
+func (u *UserErasureReceipt) GetCompletedAt() time.Time {
+	if u == nil || u.CompletedAt == nil {
+		return time.Time{}
+	}
+	return *u.CompletedAt
+}
+
+// GetLeftovers returns the Leftovers field if it's non-nil, zero value otherwise.
+func (u *UserErasureReceipt) GetLeftovers() []string {
+	if u == nil || u.Leftovers == nil {
+		return nil
+	}
+	return *u.Leftovers
+}
+
+// GetStartedAt returns the StartedAt field if it's non-nil, zero value otherwise.
+func (u *UserErasureReceipt) GetStartedAt() time.Time {
+	if u == nil || u.StartedAt == nil {
+		return time.Time{}
+	}
+	return *u.StartedAt
+}
+
+// GetUserID returns the UserID field if it's non-nil, zero value otherwise.
+func (u *UserErasureReceipt) GetUserID() string {
+	if u == nil || u.UserID == nil {
+		return ""
+	}
+	return *u.UserID
+}
+
+// GetVerified returns the Verified field if it's non-nil, zero value otherwise.
+func (u *UserErasureReceipt) GetVerified() bool {
+	if u == nil || u.Verified == nil {
+		return false
+	}
+	return *u.Verified
+}
+
+// String returns a string representation of UserErasureReceipt.
+func (u *UserErasureReceipt) String() string {
+	return Stringify(u)
+}
+
+// GetError returns the Error field if it's non-nil, zero value otherwise.
+func (u *UserErasureStep) GetError() string {
+	if u == nil || u.Error == nil {
+		return ""
+	}
+	return *u.Error
+}
+
+// GetName returns the Name field if it's non-nil, zero value otherwise.
+func (u *UserErasureStep) GetName() string {
+	if u == nil || u.Name == nil {
+		return ""
+	}
+	return *u.Name
+}
+
+// GetRemoved returns the Removed field if it's non-nil, zero value otherwise.
+func (u *UserErasureStep) GetRemoved() []string {
+	if u == nil || u.Removed == nil {
+		return nil
+	}
+	return *u.Removed
+}
+
+// String returns a string representation of UserErasureStep.
+func (u *UserErasureStep) String() string {
+	return Stringify(u)
+}
+
 // GetAccessToken returns the AccessToken field if it's non-nil, zero value otherwise.
 func (u *UserIdentity) GetAccessToken() string {
 	if u == nil || u.AccessToken == nil {
@@ -7969,6 +18702,68 @@ func (u *UserIdentity) String() string {
 	return Stringify(u)
 }
 
+// Clone returns a deep copy of UserIdentity.
+func (u *UserIdentity) Clone() *UserIdentity {
+	if u == nil {
+		return nil
+	}
+	clone := &UserIdentity{}
+	clone.Connection = clonePointer(u.Connection)
+	clone.UserID = clonePointer(u.UserID)
+	clone.Provider = clonePointer(u.Provider)
+	clone.IsSocial = clonePointer(u.IsSocial)
+	clone.AccessToken = clonePointer(u.AccessToken)
+	clone.AccessTokenSecret = clonePointer(u.AccessTokenSecret)
+	clone.RefreshToken = clonePointer(u.RefreshToken)
+	clone.ProfileData = clonePointerFunc(u.ProfileData, func(v map[string]interface{}) map[string]interface{} {
+		return cloneMapFunc(v, func(v interface{}) interface{} { return cloneValue(v) })
+	})
+	return clone
+}
+
+func (u *UserIdentity) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether UserIdentity and other hold the same values.
+func (u *UserIdentity) Equal(other *UserIdentity) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between UserIdentity and other.
+func (u *UserIdentity) Diff(other *UserIdentity) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *UserIdentity) diff(prefix string, other *UserIdentity) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &UserIdentity{}
+	}
+	if other == nil {
+		other = &UserIdentity{}
+	}
+	changes = diffPointer(changes, prefix+"connection", u.Connection, other.Connection)
+	changes = diffPointer(changes, prefix+"user_id", u.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"provider", u.Provider, other.Provider)
+	changes = diffPointer(changes, prefix+"isSocial", u.IsSocial, other.IsSocial)
+	changes = diffPointer(changes, prefix+"access_token", u.AccessToken, other.AccessToken)
+	changes = diffPointer(changes, prefix+"access_token_secret", u.AccessTokenSecret, other.AccessTokenSecret)
+	changes = diffPointer(changes, prefix+"refresh_token", u.RefreshToken, other.RefreshToken)
+	changes = diffMapPointer(changes, prefix+"profileData", u.ProfileData, other.ProfileData)
+	return changes
+}
+
+func (u *UserIdentity) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*UserIdentity)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
 // GetConnectionID returns the ConnectionID field if it's non-nil, zero value otherwise.
 func (u *UserIdentityLink) GetConnectionID() string {
 	if u == nil || u.ConnectionID == nil {
@@ -8006,6 +18801,58 @@ func (u *UserIdentityLink) String() string {
 	return Stringify(u)
 }
 
+// Clone returns a deep copy of UserIdentityLink.
+func (u *UserIdentityLink) Clone() *UserIdentityLink {
+	if u == nil {
+		return nil
+	}
+	clone := &UserIdentityLink{}
+	clone.ConnectionID = clonePointer(u.ConnectionID)
+	clone.UserID = clonePointer(u.UserID)
+	clone.Provider = clonePointer(u.Provider)
+	clone.LinkWith = clonePointer(u.LinkWith)
+	return clone
+}
+
+func (u *UserIdentityLink) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether UserIdentityLink and other hold the same values.
+func (u *UserIdentityLink) Equal(other *UserIdentityLink) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between UserIdentityLink and other.
+func (u *UserIdentityLink) Diff(other *UserIdentityLink) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *UserIdentityLink) diff(prefix string, other *UserIdentityLink) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &UserIdentityLink{}
+	}
+	if other == nil {
+		other = &UserIdentityLink{}
+	}
+	changes = diffPointer(changes, prefix+"connection_id", u.ConnectionID, other.ConnectionID)
+	changes = diffPointer(changes, prefix+"user_id", u.UserID, other.UserID)
+	changes = diffPointer(changes, prefix+"provider", u.Provider, other.Provider)
+	changes = diffPointer(changes, prefix+"link_with", u.LinkWith, other.LinkWith)
+	return changes
+}
+
+func (u *UserIdentityLink) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*UserIdentityLink)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
 // String returns a string representation of UserList.
 func (u *UserList) String() string {
 	return Stringify(u)
@@ -8023,3 +18870,86 @@ func (u *UserRecoveryCode) GetRecoveryCode() string {
 func (u *UserRecoveryCode) String() string {
 	return Stringify(u)
 }
+
+// Clone returns a deep copy of UserRecoveryCode.
+func (u *UserRecoveryCode) Clone() *UserRecoveryCode {
+	if u == nil {
+		return nil
+	}
+	clone := &UserRecoveryCode{}
+	clone.RecoveryCode = clonePointer(u.RecoveryCode)
+	return clone
+}
+
+func (u *UserRecoveryCode) cloneAny() interface{} {
+	return u.Clone()
+}
+
+// Equal reports whether UserRecoveryCode and other hold the same values.
+func (u *UserRecoveryCode) Equal(other *UserRecoveryCode) bool {
+	return len(u.diff("", other)) == 0
+}
+
+// Diff returns the fields that changed between UserRecoveryCode and other.
+func (u *UserRecoveryCode) Diff(other *UserRecoveryCode) []FieldChange {
+	return u.diff("", other)
+}
+
+func (u *UserRecoveryCode) diff(prefix string, other *UserRecoveryCode) (changes []FieldChange) {
+	if u == nil && other == nil {
+		return nil
+	}
+	if u == nil {
+		u = &UserRecoveryCode{}
+	}
+	if other == nil {
+		other = &UserRecoveryCode{}
+	}
+	changes = diffPointer(changes, prefix+"recovery_code", u.RecoveryCode, other.RecoveryCode)
+	return changes
+}
+
+func (u *UserRecoveryCode) diffAny(prefix string, other interface{}) ([]FieldChange, bool) {
+	typed, ok := other.(*UserRecoveryCode)
+	if !ok {
+		return nil, false
+	}
+	return u.diff(prefix, typed), true
+}
+
+// GetExportFields returns the ExportFields field if it's non-nil, zero value otherwise.
+func (u *UserSearchOptions) GetExportFields() []string {
+	if u == nil || u.ExportFields == nil {
+		return nil
+	}
+	return *u.ExportFields
+}
+
+// GetPollInterval returns the PollInterval field if it's non-nil, zero value otherwise.
+func (u *UserSearchOptions) GetPollInterval() time.Duration {
+	if u == nil || u.PollInterval == nil {
+		return 0
+	}
+	return *u.PollInterval
+}
+
+// GetPollTimeout returns the PollTimeout field if it's non-nil, zero value otherwise.
+func (u *UserSearchOptions) GetPollTimeout() time.Duration {
+	if u == nil || u.PollTimeout == nil {
+		return 0
+	}
+	return *u.PollTimeout
+}
+
+// GetWindow returns the Window field if it's non-nil, zero value otherwise.
+func (u *UserSearchOptions) GetWindow() int {
+	if u == nil || u.Window == nil {
+		return 0
+	}
+	return *u.Window
+}
+
+// String returns a string representation of UserSearchOptions.
+func (u *UserSearchOptions) String() string {
+	return Stringify(u)
+}
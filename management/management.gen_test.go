@@ -124,6 +124,28 @@ func TestAction_String(t *testing.T) {
 	}
 }
 
+func TestAction_Clone(t *testing.T) {
+	var v *Action
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Action{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Action{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionBinding_GetAction(tt *testing.T) {
 	a := &ActionBinding{}
 	a.GetAction()
@@ -196,6 +218,28 @@ func TestActionBinding_String(t *testing.T) {
 	}
 }
 
+func TestActionBinding_Clone(t *testing.T) {
+	var v *ActionBinding
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionBinding{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionBinding{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionBindingList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionBindingList{}
@@ -232,6 +276,28 @@ func TestActionBindingReference_String(t *testing.T) {
 	}
 }
 
+func TestActionBindingReference_Clone(t *testing.T) {
+	var v *ActionBindingReference
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionBindingReference{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionBindingReference{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionDependency_GetName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionDependency{Name: &zeroValue}
@@ -270,6 +336,28 @@ func TestActionDependency_String(t *testing.T) {
 	}
 }
 
+func TestActionDependency_Clone(t *testing.T) {
+	var v *ActionDependency
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionDependency{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionDependency{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionExecution_GetCreatedAt(tt *testing.T) {
 	var zeroValue time.Time
 	a := &ActionExecution{CreatedAt: &zeroValue}
@@ -328,6 +416,28 @@ func TestActionExecution_String(t *testing.T) {
 	}
 }
 
+func TestActionExecution_Clone(t *testing.T) {
+	var v *ActionExecution
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionExecution{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionExecution{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionExecutionResult_GetActionName(tt *testing.T) {
 	var zeroValue string
 	a := &ActionExecutionResult{ActionName: &zeroValue}
@@ -366,6 +476,28 @@ func TestActionExecutionResult_String(t *testing.T) {
 	}
 }
 
+func TestActionExecutionResult_Clone(t *testing.T) {
+	var v *ActionExecutionResult
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionExecutionResult{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionExecutionResult{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionList{}
@@ -448,6 +580,28 @@ func TestActionSecret_String(t *testing.T) {
 	}
 }
 
+func TestActionSecret_Clone(t *testing.T) {
+	var v *ActionSecret
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionSecret{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionSecret{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionTrigger_GetID(tt *testing.T) {
 	var zeroValue string
 	a := &ActionTrigger{ID: &zeroValue}
@@ -486,6 +640,28 @@ func TestActionTrigger_String(t *testing.T) {
 	}
 }
 
+func TestActionTrigger_Clone(t *testing.T) {
+	var v *ActionTrigger
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionTrigger{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionTrigger{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionTriggerList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionTriggerList{}
@@ -569,6 +745,28 @@ func TestActionVersion_String(t *testing.T) {
 	}
 }
 
+func TestActionVersion_Clone(t *testing.T) {
+	var v *ActionVersion
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionVersion{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionVersion{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionVersionError_GetID(tt *testing.T) {
 	var zeroValue string
 	a := &ActionVersionError{ID: &zeroValue}
@@ -607,6 +805,28 @@ func TestActionVersionError_String(t *testing.T) {
 	}
 }
 
+func TestActionVersionError_Clone(t *testing.T) {
+	var v *ActionVersionError
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ActionVersionError{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ActionVersionError{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestActionVersionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ActionVersionList{}
@@ -783,6 +1003,28 @@ func TestAuthenticationMethod_String(t *testing.T) {
 	}
 }
 
+func TestAuthenticationMethod_Clone(t *testing.T) {
+	var v *AuthenticationMethod
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &AuthenticationMethod{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &AuthenticationMethod{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestAuthenticationMethodList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &AuthenticationMethodList{}
@@ -819,6 +1061,28 @@ func TestAuthenticationMethodReference_String(t *testing.T) {
 	}
 }
 
+func TestAuthenticationMethodReference_Clone(t *testing.T) {
+	var v *AuthenticationMethodReference
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &AuthenticationMethodReference{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &AuthenticationMethodReference{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBlacklistToken_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BlacklistToken{}
@@ -827,6 +1091,28 @@ func TestBlacklistToken_String(t *testing.T) {
 	}
 }
 
+func TestBlacklistToken_Clone(t *testing.T) {
+	var v *BlacklistToken
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BlacklistToken{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BlacklistToken{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBranding_GetColors(tt *testing.T) {
 	b := &Branding{}
 	b.GetColors()
@@ -869,6 +1155,28 @@ func TestBranding_String(t *testing.T) {
 	}
 }
 
+func TestBranding_Clone(t *testing.T) {
+	var v *Branding
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Branding{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Branding{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingColors_GetPageBackground(tt *testing.T) {
 	var zeroValue string
 	b := &BrandingColors{PageBackground: &zeroValue}
@@ -904,6 +1212,28 @@ func TestBrandingColors_String(t *testing.T) {
 	}
 }
 
+func TestBrandingColors_Clone(t *testing.T) {
+	var v *BrandingColors
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingColors{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingColors{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingFont_GetURL(tt *testing.T) {
 	var zeroValue string
 	b := &BrandingFont{URL: &zeroValue}
@@ -922,6 +1252,28 @@ func TestBrandingFont_String(t *testing.T) {
 	}
 }
 
+func TestBrandingFont_Clone(t *testing.T) {
+	var v *BrandingFont
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingFont{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingFont{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingPageBackgroundGradient_GetAngleDegree(tt *testing.T) {
 	var zeroValue int
 	b := &BrandingPageBackgroundGradient{AngleDegree: &zeroValue}
@@ -970,6 +1322,28 @@ func TestBrandingPageBackgroundGradient_String(t *testing.T) {
 	}
 }
 
+func TestBrandingPageBackgroundGradient_Clone(t *testing.T) {
+	var v *BrandingPageBackgroundGradient
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingPageBackgroundGradient{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingPageBackgroundGradient{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingTheme_GetDisplayName(tt *testing.T) {
 	var zeroValue string
 	b := &BrandingTheme{DisplayName: &zeroValue}
@@ -998,6 +1372,28 @@ func TestBrandingTheme_String(t *testing.T) {
 	}
 }
 
+func TestBrandingTheme_Clone(t *testing.T) {
+	var v *BrandingTheme
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingTheme{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingTheme{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemeBorders_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BrandingThemeBorders{}
@@ -1006,6 +1402,28 @@ func TestBrandingThemeBorders_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemeBorders_Clone(t *testing.T) {
+	var v *BrandingThemeBorders
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemeBorders{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemeBorders{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemeColors_GetBaseFocusColor(tt *testing.T) {
 	var zeroValue string
 	b := &BrandingThemeColors{BaseFocusColor: &zeroValue}
@@ -1034,6 +1452,28 @@ func TestBrandingThemeColors_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemeColors_Clone(t *testing.T) {
+	var v *BrandingThemeColors
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemeColors{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemeColors{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemeFonts_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BrandingThemeFonts{}
@@ -1042,6 +1482,28 @@ func TestBrandingThemeFonts_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemeFonts_Clone(t *testing.T) {
+	var v *BrandingThemeFonts
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemeFonts{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemeFonts{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemePageBackground_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BrandingThemePageBackground{}
@@ -1050,6 +1512,28 @@ func TestBrandingThemePageBackground_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemePageBackground_Clone(t *testing.T) {
+	var v *BrandingThemePageBackground
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemePageBackground{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemePageBackground{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemeText_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BrandingThemeText{}
@@ -1058,6 +1542,28 @@ func TestBrandingThemeText_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemeText_Clone(t *testing.T) {
+	var v *BrandingThemeText
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemeText{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemeText{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingThemeWidget_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &BrandingThemeWidget{}
@@ -1066,6 +1572,28 @@ func TestBrandingThemeWidget_String(t *testing.T) {
 	}
 }
 
+func TestBrandingThemeWidget_Clone(t *testing.T) {
+	var v *BrandingThemeWidget
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingThemeWidget{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingThemeWidget{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBrandingUniversalLogin_GetBody(tt *testing.T) {
 	var zeroValue string
 	b := &BrandingUniversalLogin{Body: &zeroValue}
@@ -1084,6 +1612,28 @@ func TestBrandingUniversalLogin_String(t *testing.T) {
 	}
 }
 
+func TestBrandingUniversalLogin_Clone(t *testing.T) {
+	var v *BrandingUniversalLogin
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BrandingUniversalLogin{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BrandingUniversalLogin{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBreachedPasswordDetection_GetAdminNotificationFrequency(tt *testing.T) {
 	var zeroValue []string
 	b := &BreachedPasswordDetection{AdminNotificationFrequency: &zeroValue}
@@ -1139,6 +1689,28 @@ func TestBreachedPasswordDetection_String(t *testing.T) {
 	}
 }
 
+func TestBreachedPasswordDetection_Clone(t *testing.T) {
+	var v *BreachedPasswordDetection
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BreachedPasswordDetection{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BreachedPasswordDetection{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBreachedPasswordDetectionPreUserRegistration_GetShields(tt *testing.T) {
 	var zeroValue []string
 	b := &BreachedPasswordDetectionPreUserRegistration{Shields: &zeroValue}
@@ -1157,6 +1729,28 @@ func TestBreachedPasswordDetectionPreUserRegistration_String(t *testing.T) {
 	}
 }
 
+func TestBreachedPasswordDetectionPreUserRegistration_Clone(t *testing.T) {
+	var v *BreachedPasswordDetectionPreUserRegistration
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BreachedPasswordDetectionPreUserRegistration{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BreachedPasswordDetectionPreUserRegistration{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBreachedPasswordDetectionStage_GetPreUserRegistration(tt *testing.T) {
 	b := &BreachedPasswordDetectionStage{}
 	b.GetPreUserRegistration()
@@ -1172,6 +1766,28 @@ func TestBreachedPasswordDetectionStage_String(t *testing.T) {
 	}
 }
 
+func TestBreachedPasswordDetectionStage_Clone(t *testing.T) {
+	var v *BreachedPasswordDetectionStage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BreachedPasswordDetectionStage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BreachedPasswordDetectionStage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestBruteForceProtection_GetAllowList(tt *testing.T) {
 	var zeroValue []string
 	b := &BruteForceProtection{AllowList: &zeroValue}
@@ -1230,107 +1846,380 @@ func TestBruteForceProtection_String(t *testing.T) {
 	}
 }
 
-func TestClient_GetAllowedClients(tt *testing.T) {
-	var zeroValue []string
-	c := &Client{AllowedClients: &zeroValue}
-	c.GetAllowedClients()
-	c = &Client{}
-	c.GetAllowedClients()
+func TestBruteForceProtection_Clone(t *testing.T) {
+	var v *BruteForceProtection
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &BruteForceProtection{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &BruteForceProtection{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestCertificateInfo_GetIssuer(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInfo{Issuer: &zeroValue}
+	c.GetIssuer()
+	c = &CertificateInfo{}
+	c.GetIssuer()
 	c = nil
-	c.GetAllowedClients()
+	c.GetIssuer()
 }
 
-func TestClient_GetAllowedLogoutURLs(tt *testing.T) {
-	var zeroValue []string
-	c := &Client{AllowedLogoutURLs: &zeroValue}
-	c.GetAllowedLogoutURLs()
-	c = &Client{}
-	c.GetAllowedLogoutURLs()
+func TestCertificateInfo_GetNotAfter(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CertificateInfo{NotAfter: &zeroValue}
+	c.GetNotAfter()
+	c = &CertificateInfo{}
+	c.GetNotAfter()
 	c = nil
-	c.GetAllowedLogoutURLs()
+	c.GetNotAfter()
 }
 
-func TestClient_GetAllowedOrigins(tt *testing.T) {
-	var zeroValue []string
-	c := &Client{AllowedOrigins: &zeroValue}
-	c.GetAllowedOrigins()
-	c = &Client{}
-	c.GetAllowedOrigins()
+func TestCertificateInfo_GetNotBefore(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CertificateInfo{NotBefore: &zeroValue}
+	c.GetNotBefore()
+	c = &CertificateInfo{}
+	c.GetNotBefore()
 	c = nil
-	c.GetAllowedOrigins()
+	c.GetNotBefore()
 }
 
-func TestClient_GetAppType(tt *testing.T) {
+func TestCertificateInfo_GetPEM(tt *testing.T) {
 	var zeroValue string
-	c := &Client{AppType: &zeroValue}
-	c.GetAppType()
-	c = &Client{}
-	c.GetAppType()
+	c := &CertificateInfo{PEM: &zeroValue}
+	c.GetPEM()
+	c = &CertificateInfo{}
+	c.GetPEM()
 	c = nil
-	c.GetAppType()
+	c.GetPEM()
 }
 
-func TestClient_GetCallbacks(tt *testing.T) {
-	var zeroValue []string
-	c := &Client{Callbacks: &zeroValue}
-	c.GetCallbacks()
-	c = &Client{}
-	c.GetCallbacks()
+func TestCertificateInfo_GetPublicKeyAlgorithm(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInfo{PublicKeyAlgorithm: &zeroValue}
+	c.GetPublicKeyAlgorithm()
+	c = &CertificateInfo{}
+	c.GetPublicKeyAlgorithm()
 	c = nil
-	c.GetCallbacks()
+	c.GetPublicKeyAlgorithm()
 }
 
-func TestClient_GetClientAliases(tt *testing.T) {
-	var zeroValue []string
-	c := &Client{ClientAliases: &zeroValue}
-	c.GetClientAliases()
-	c = &Client{}
-	c.GetClientAliases()
+func TestCertificateInfo_GetSerialNumber(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInfo{SerialNumber: &zeroValue}
+	c.GetSerialNumber()
+	c = &CertificateInfo{}
+	c.GetSerialNumber()
 	c = nil
-	c.GetClientAliases()
+	c.GetSerialNumber()
 }
 
-func TestClient_GetClientID(tt *testing.T) {
+func TestCertificateInfo_GetSignatureAlgorithm(tt *testing.T) {
 	var zeroValue string
-	c := &Client{ClientID: &zeroValue}
-	c.GetClientID()
-	c = &Client{}
-	c.GetClientID()
+	c := &CertificateInfo{SignatureAlgorithm: &zeroValue}
+	c.GetSignatureAlgorithm()
+	c = &CertificateInfo{}
+	c.GetSignatureAlgorithm()
 	c = nil
-	c.GetClientID()
+	c.GetSignatureAlgorithm()
 }
 
-func TestClient_GetClientSecret(tt *testing.T) {
+func TestCertificateInfo_GetSubject(tt *testing.T) {
 	var zeroValue string
-	c := &Client{ClientSecret: &zeroValue}
-	c.GetClientSecret()
-	c = &Client{}
-	c.GetClientSecret()
+	c := &CertificateInfo{Subject: &zeroValue}
+	c.GetSubject()
+	c = &CertificateInfo{}
+	c.GetSubject()
 	c = nil
-	c.GetClientSecret()
+	c.GetSubject()
 }
 
-func TestClient_GetCrossOriginAuth(tt *testing.T) {
-	var zeroValue bool
-	c := &Client{CrossOriginAuth: &zeroValue}
-	c.GetCrossOriginAuth()
-	c = &Client{}
-	c.GetCrossOriginAuth()
+func TestCertificateInfo_GetThumbprintSHA1(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInfo{ThumbprintSHA1: &zeroValue}
+	c.GetThumbprintSHA1()
+	c = &CertificateInfo{}
+	c.GetThumbprintSHA1()
 	c = nil
-	c.GetCrossOriginAuth()
+	c.GetThumbprintSHA1()
 }
 
-func TestClient_GetCrossOriginLocation(tt *testing.T) {
+func TestCertificateInfo_GetThumbprintSHA256(tt *testing.T) {
 	var zeroValue string
-	c := &Client{CrossOriginLocation: &zeroValue}
-	c.GetCrossOriginLocation()
-	c = &Client{}
-	c.GetCrossOriginLocation()
+	c := &CertificateInfo{ThumbprintSHA256: &zeroValue}
+	c.GetThumbprintSHA256()
+	c = &CertificateInfo{}
+	c.GetThumbprintSHA256()
 	c = nil
-	c.GetCrossOriginLocation()
+	c.GetThumbprintSHA256()
 }
 
-func TestClient_GetCustomLoginPage(tt *testing.T) {
+func TestCertificateInfo_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CertificateInfo{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCertificateInventory_GetExpiryWindow(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventory{ExpiryWindow: &zeroValue}
+	c.GetExpiryWindow()
+	c = &CertificateInventory{}
+	c.GetExpiryWindow()
+	c = nil
+	c.GetExpiryWindow()
+}
+
+func TestCertificateInventory_GetGeneratedAt(tt *testing.T) {
+	var zeroValue time.Time
+	c := &CertificateInventory{GeneratedAt: &zeroValue}
+	c.GetGeneratedAt()
+	c = &CertificateInventory{}
+	c.GetGeneratedAt()
+	c = nil
+	c.GetGeneratedAt()
+}
+
+func TestCertificateInventory_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CertificateInventory{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCertificateInventoryItem_GetCertificate(tt *testing.T) {
+	c := &CertificateInventoryItem{}
+	c.GetCertificate()
+	c = nil
+	c.GetCertificate()
+}
+
+func TestCertificateInventoryItem_GetConnectionID(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{ConnectionID: &zeroValue}
+	c.GetConnectionID()
+	c = &CertificateInventoryItem{}
+	c.GetConnectionID()
+	c = nil
+	c.GetConnectionID()
+}
+
+func TestCertificateInventoryItem_GetConnectionName(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{ConnectionName: &zeroValue}
+	c.GetConnectionName()
+	c = &CertificateInventoryItem{}
+	c.GetConnectionName()
+	c = nil
+	c.GetConnectionName()
+}
+
+func TestCertificateInventoryItem_GetConnectionStrategy(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{ConnectionStrategy: &zeroValue}
+	c.GetConnectionStrategy()
+	c = &CertificateInventoryItem{}
+	c.GetConnectionStrategy()
+	c = nil
+	c.GetConnectionStrategy()
+}
+
+func TestCertificateInventoryItem_GetError(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{Error: &zeroValue}
+	c.GetError()
+	c = &CertificateInventoryItem{}
+	c.GetError()
+	c = nil
+	c.GetError()
+}
+
+func TestCertificateInventoryItem_GetExpired(tt *testing.T) {
+	var zeroValue bool
+	c := &CertificateInventoryItem{Expired: &zeroValue}
+	c.GetExpired()
+	c = &CertificateInventoryItem{}
+	c.GetExpired()
+	c = nil
+	c.GetExpired()
+}
+
+func TestCertificateInventoryItem_GetExpiring(tt *testing.T) {
+	var zeroValue bool
+	c := &CertificateInventoryItem{Expiring: &zeroValue}
+	c.GetExpiring()
+	c = &CertificateInventoryItem{}
+	c.GetExpiring()
+	c = nil
+	c.GetExpiring()
+}
+
+func TestCertificateInventoryItem_GetField(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{Field: &zeroValue}
+	c.GetField()
+	c = &CertificateInventoryItem{}
+	c.GetField()
+	c = nil
+	c.GetField()
+}
+
+func TestCertificateInventoryItem_GetKeyState(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{KeyState: &zeroValue}
+	c.GetKeyState()
+	c = &CertificateInventoryItem{}
+	c.GetKeyState()
+	c = nil
+	c.GetKeyState()
+}
+
+func TestCertificateInventoryItem_GetKID(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{KID: &zeroValue}
+	c.GetKID()
+	c = &CertificateInventoryItem{}
+	c.GetKID()
+	c = nil
+	c.GetKID()
+}
+
+func TestCertificateInventoryItem_GetSource(tt *testing.T) {
+	var zeroValue string
+	c := &CertificateInventoryItem{Source: &zeroValue}
+	c.GetSource()
+	c = &CertificateInventoryItem{}
+	c.GetSource()
+	c = nil
+	c.GetSource()
+}
+
+func TestCertificateInventoryItem_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CertificateInventoryItem{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestClient_GetAllowedClients(tt *testing.T) {
+	var zeroValue []string
+	c := &Client{AllowedClients: &zeroValue}
+	c.GetAllowedClients()
+	c = &Client{}
+	c.GetAllowedClients()
+	c = nil
+	c.GetAllowedClients()
+}
+
+func TestClient_GetAllowedLogoutURLs(tt *testing.T) {
+	var zeroValue []string
+	c := &Client{AllowedLogoutURLs: &zeroValue}
+	c.GetAllowedLogoutURLs()
+	c = &Client{}
+	c.GetAllowedLogoutURLs()
+	c = nil
+	c.GetAllowedLogoutURLs()
+}
+
+func TestClient_GetAllowedOrigins(tt *testing.T) {
+	var zeroValue []string
+	c := &Client{AllowedOrigins: &zeroValue}
+	c.GetAllowedOrigins()
+	c = &Client{}
+	c.GetAllowedOrigins()
+	c = nil
+	c.GetAllowedOrigins()
+}
+
+func TestClient_GetAppType(tt *testing.T) {
+	var zeroValue string
+	c := &Client{AppType: &zeroValue}
+	c.GetAppType()
+	c = &Client{}
+	c.GetAppType()
+	c = nil
+	c.GetAppType()
+}
+
+func TestClient_GetCallbacks(tt *testing.T) {
+	var zeroValue []string
+	c := &Client{Callbacks: &zeroValue}
+	c.GetCallbacks()
+	c = &Client{}
+	c.GetCallbacks()
+	c = nil
+	c.GetCallbacks()
+}
+
+func TestClient_GetClientAliases(tt *testing.T) {
+	var zeroValue []string
+	c := &Client{ClientAliases: &zeroValue}
+	c.GetClientAliases()
+	c = &Client{}
+	c.GetClientAliases()
+	c = nil
+	c.GetClientAliases()
+}
+
+func TestClient_GetClientID(tt *testing.T) {
+	var zeroValue string
+	c := &Client{ClientID: &zeroValue}
+	c.GetClientID()
+	c = &Client{}
+	c.GetClientID()
+	c = nil
+	c.GetClientID()
+}
+
+func TestClient_GetClientSecret(tt *testing.T) {
+	var zeroValue string
+	c := &Client{ClientSecret: &zeroValue}
+	c.GetClientSecret()
+	c = &Client{}
+	c.GetClientSecret()
+	c = nil
+	c.GetClientSecret()
+}
+
+func TestClient_GetCrossOriginAuth(tt *testing.T) {
+	var zeroValue bool
+	c := &Client{CrossOriginAuth: &zeroValue}
+	c.GetCrossOriginAuth()
+	c = &Client{}
+	c.GetCrossOriginAuth()
+	c = nil
+	c.GetCrossOriginAuth()
+}
+
+func TestClient_GetCrossOriginLocation(tt *testing.T) {
+	var zeroValue string
+	c := &Client{CrossOriginLocation: &zeroValue}
+	c.GetCrossOriginLocation()
+	c = &Client{}
+	c.GetCrossOriginLocation()
+	c = nil
+	c.GetCrossOriginLocation()
+}
+
+func TestClient_GetCustomLoginPage(tt *testing.T) {
 	var zeroValue string
 	c := &Client{CustomLoginPage: &zeroValue}
 	c.GetCustomLoginPage()
@@ -1556,6 +2445,28 @@ func TestClient_String(t *testing.T) {
 	}
 }
 
+func TestClient_Clone(t *testing.T) {
+	var v *Client
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Client{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Client{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientGrant_GetAudience(tt *testing.T) {
 	var zeroValue string
 	c := &ClientGrant{Audience: &zeroValue}
@@ -1594,6 +2505,28 @@ func TestClientGrant_String(t *testing.T) {
 	}
 }
 
+func TestClientGrant_Clone(t *testing.T) {
+	var v *ClientGrant
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientGrant{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientGrant{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientGrantList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientGrantList{}
@@ -1650,6 +2583,28 @@ func TestClientJWTConfiguration_String(t *testing.T) {
 	}
 }
 
+func TestClientJWTConfiguration_Clone(t *testing.T) {
+	var v *ClientJWTConfiguration
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientJWTConfiguration{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientJWTConfiguration{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ClientList{}
@@ -1680,6 +2635,28 @@ func TestClientMobile_String(t *testing.T) {
 	}
 }
 
+func TestClientMobile_Clone(t *testing.T) {
+	var v *ClientMobile
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientMobile{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientMobile{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientMobileAndroid_GetAppPackageName(tt *testing.T) {
 	var zeroValue string
 	c := &ClientMobileAndroid{AppPackageName: &zeroValue}
@@ -1708,6 +2685,28 @@ func TestClientMobileAndroid_String(t *testing.T) {
 	}
 }
 
+func TestClientMobileAndroid_Clone(t *testing.T) {
+	var v *ClientMobileAndroid
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientMobileAndroid{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientMobileAndroid{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientMobileIOS_GetAppID(tt *testing.T) {
 	var zeroValue string
 	c := &ClientMobileIOS{AppID: &zeroValue}
@@ -1736,6 +2735,28 @@ func TestClientMobileIOS_String(t *testing.T) {
 	}
 }
 
+func TestClientMobileIOS_Clone(t *testing.T) {
+	var v *ClientMobileIOS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientMobileIOS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientMobileIOS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientNativeSocialLogin_GetApple(tt *testing.T) {
 	c := &ClientNativeSocialLogin{}
 	c.GetApple()
@@ -1758,6 +2779,28 @@ func TestClientNativeSocialLogin_String(t *testing.T) {
 	}
 }
 
+func TestClientNativeSocialLogin_Clone(t *testing.T) {
+	var v *ClientNativeSocialLogin
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientNativeSocialLogin{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientNativeSocialLogin{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientNativeSocialLoginSupportEnabled_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	c := &ClientNativeSocialLoginSupportEnabled{Enabled: &zeroValue}
@@ -1776,6 +2819,28 @@ func TestClientNativeSocialLoginSupportEnabled_String(t *testing.T) {
 	}
 }
 
+func TestClientNativeSocialLoginSupportEnabled_Clone(t *testing.T) {
+	var v *ClientNativeSocialLoginSupportEnabled
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientNativeSocialLoginSupportEnabled{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientNativeSocialLoginSupportEnabled{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestClientRefreshToken_GetExpirationType(tt *testing.T) {
 	var zeroValue string
 	c := &ClientRefreshToken{ExpirationType: &zeroValue}
@@ -1854,6 +2919,28 @@ func TestClientRefreshToken_String(t *testing.T) {
 	}
 }
 
+func TestClientRefreshToken_Clone(t *testing.T) {
+	var v *ClientRefreshToken
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ClientRefreshToken{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ClientRefreshToken{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnection_GetDisplayName(tt *testing.T) {
 	var zeroValue string
 	c := &Connection{DisplayName: &zeroValue}
@@ -1962,6 +3049,28 @@ func TestConnection_String(t *testing.T) {
 	}
 }
 
+func TestConnection_Clone(t *testing.T) {
+	var v *Connection
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Connection{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Connection{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionGatewayAuthentication_GetAudience(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionGatewayAuthentication{Audience: &zeroValue}
@@ -2020,6 +3129,28 @@ func TestConnectionGatewayAuthentication_String(t *testing.T) {
 	}
 }
 
+func TestConnectionGatewayAuthentication_Clone(t *testing.T) {
+	var v *ConnectionGatewayAuthentication
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionGatewayAuthentication{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionGatewayAuthentication{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ConnectionList{}
@@ -2166,6 +3297,28 @@ func TestConnectionOptions_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptions_Clone(t *testing.T) {
+	var v *ConnectionOptions
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptions{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptions{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsAD_GetBruteForceProtection(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsAD{BruteForceProtection: &zeroValue}
@@ -2274,6 +3427,28 @@ func TestConnectionOptionsAD_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsAD_Clone(t *testing.T) {
+	var v *ConnectionOptionsAD
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsAD{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsAD{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsADFS_GetADFSServer(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsADFS{ADFSServer: &zeroValue}
@@ -2392,6 +3567,28 @@ func TestConnectionOptionsADFS_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsADFS_Clone(t *testing.T) {
+	var v *ConnectionOptionsADFS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsADFS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsADFS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsApple_GetClientID(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsApple{ClientID: &zeroValue}
@@ -2490,8 +3687,80 @@ func TestConnectionOptionsApple_String(t *testing.T) {
 	}
 }
 
-func TestConnectionOptionsAzureAD_GetAdmin(tt *testing.T) {
-	var zeroValue bool
+func TestConnectionOptionsApple_Clone(t *testing.T) {
+	var v *ConnectionOptionsApple
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsApple{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsApple{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestConnectionOptionsAuthParams_GetResponseType(tt *testing.T) {
+	var zeroValue string
+	c := &ConnectionOptionsAuthParams{ResponseType: &zeroValue}
+	c.GetResponseType()
+	c = &ConnectionOptionsAuthParams{}
+	c.GetResponseType()
+	c = nil
+	c.GetResponseType()
+}
+
+func TestConnectionOptionsAuthParams_GetScope(tt *testing.T) {
+	var zeroValue string
+	c := &ConnectionOptionsAuthParams{Scope: &zeroValue}
+	c.GetScope()
+	c = &ConnectionOptionsAuthParams{}
+	c.GetScope()
+	c = nil
+	c.GetScope()
+}
+
+func TestConnectionOptionsAuthParams_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ConnectionOptionsAuthParams{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestConnectionOptionsAuthParams_Clone(t *testing.T) {
+	var v *ConnectionOptionsAuthParams
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsAuthParams{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsAuthParams{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestConnectionOptionsAzureAD_GetAdmin(tt *testing.T) {
+	var zeroValue bool
 	c := &ConnectionOptionsAzureAD{Admin: &zeroValue}
 	c.GetAdmin()
 	c = &ConnectionOptionsAzureAD{}
@@ -2748,6 +4017,28 @@ func TestConnectionOptionsAzureAD_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsAzureAD_Clone(t *testing.T) {
+	var v *ConnectionOptionsAzureAD
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsAzureAD{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsAzureAD{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsEmail_GetBruteForceProtection(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsEmail{BruteForceProtection: &zeroValue}
@@ -2820,6 +4111,28 @@ func TestConnectionOptionsEmail_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsEmail_Clone(t *testing.T) {
+	var v *ConnectionOptionsEmail
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsEmail{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsEmail{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsEmailSettings_GetBody(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsEmailSettings{Body: &zeroValue}
@@ -2868,6 +4181,28 @@ func TestConnectionOptionsEmailSettings_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsEmailSettings_Clone(t *testing.T) {
+	var v *ConnectionOptionsEmailSettings
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsEmailSettings{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsEmailSettings{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsFacebook_GetAdsManagement(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsFacebook{AdsManagement: &zeroValue}
@@ -3336,6 +4671,28 @@ func TestConnectionOptionsFacebook_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsFacebook_Clone(t *testing.T) {
+	var v *ConnectionOptionsFacebook
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsFacebook{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsFacebook{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsGitHub_GetAdminOrg(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsGitHub{AdminOrg: &zeroValue}
@@ -3584,6 +4941,28 @@ func TestConnectionOptionsGitHub_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsGitHub_Clone(t *testing.T) {
+	var v *ConnectionOptionsGitHub
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsGitHub{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsGitHub{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsGoogleApps_GetAdmin(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsGoogleApps{Admin: &zeroValue}
@@ -3742,6 +5121,28 @@ func TestConnectionOptionsGoogleApps_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsGoogleApps_Clone(t *testing.T) {
+	var v *ConnectionOptionsGoogleApps
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsGoogleApps{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsGoogleApps{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsGoogleOAuth2_GetAdsenseManagement(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsGoogleOAuth2{AdsenseManagement: &zeroValue}
@@ -4100,6 +5501,28 @@ func TestConnectionOptionsGoogleOAuth2_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsGoogleOAuth2_Clone(t *testing.T) {
+	var v *ConnectionOptionsGoogleOAuth2
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsGoogleOAuth2{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsGoogleOAuth2{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsLinkedin_GetBasicProfile(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsLinkedin{BasicProfile: &zeroValue}
@@ -4188,6 +5611,78 @@ func TestConnectionOptionsLinkedin_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsLinkedin_Clone(t *testing.T) {
+	var v *ConnectionOptionsLinkedin
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsLinkedin{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsLinkedin{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestConnectionOptionsMFA_GetActive(tt *testing.T) {
+	var zeroValue bool
+	c := &ConnectionOptionsMFA{Active: &zeroValue}
+	c.GetActive()
+	c = &ConnectionOptionsMFA{}
+	c.GetActive()
+	c = nil
+	c.GetActive()
+}
+
+func TestConnectionOptionsMFA_GetReturnEnrollSettings(tt *testing.T) {
+	var zeroValue bool
+	c := &ConnectionOptionsMFA{ReturnEnrollSettings: &zeroValue}
+	c.GetReturnEnrollSettings()
+	c = &ConnectionOptionsMFA{}
+	c.GetReturnEnrollSettings()
+	c = nil
+	c.GetReturnEnrollSettings()
+}
+
+func TestConnectionOptionsMFA_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ConnectionOptionsMFA{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestConnectionOptionsMFA_Clone(t *testing.T) {
+	var v *ConnectionOptionsMFA
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsMFA{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsMFA{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsOAuth2_GetAuthorizationURL(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsOAuth2{AuthorizationURL: &zeroValue}
@@ -4296,6 +5791,28 @@ func TestConnectionOptionsOAuth2_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsOAuth2_Clone(t *testing.T) {
+	var v *ConnectionOptionsOAuth2
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsOAuth2{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsOAuth2{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsOIDC_GetAuthorizationEndpoint(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsOIDC{AuthorizationEndpoint: &zeroValue}
@@ -4454,6 +5971,28 @@ func TestConnectionOptionsOIDC_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsOIDC_Clone(t *testing.T) {
+	var v *ConnectionOptionsOIDC
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsOIDC{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsOIDC{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsOkta_GetAuthorizationEndpoint(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsOkta{AuthorizationEndpoint: &zeroValue}
@@ -4592,6 +6131,28 @@ func TestConnectionOptionsOkta_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsOkta_Clone(t *testing.T) {
+	var v *ConnectionOptionsOkta
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsOkta{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsOkta{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsOTP_GetLength(tt *testing.T) {
 	var zeroValue int
 	c := &ConnectionOptionsOTP{Length: &zeroValue}
@@ -4620,6 +6181,28 @@ func TestConnectionOptionsOTP_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsOTP_Clone(t *testing.T) {
+	var v *ConnectionOptionsOTP
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsOTP{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsOTP{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsPingFederate_GetCert(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsPingFederate{Cert: &zeroValue}
@@ -4755,6 +6338,28 @@ func TestConnectionOptionsPingFederate_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsPingFederate_Clone(t *testing.T) {
+	var v *ConnectionOptionsPingFederate
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsPingFederate{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsPingFederate{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsSalesforce_GetClientID(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsSalesforce{ClientID: &zeroValue}
@@ -4823,6 +6428,28 @@ func TestConnectionOptionsSalesforce_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsSalesforce_Clone(t *testing.T) {
+	var v *ConnectionOptionsSalesforce
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsSalesforce{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsSalesforce{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsSAML_GetCert(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsSAML{Cert: &zeroValue}
@@ -5055,6 +6682,28 @@ func TestConnectionOptionsSAML_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsSAML_Clone(t *testing.T) {
+	var v *ConnectionOptionsSAML
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsSAML{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsSAML{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsSAMLIdpInitiated_GetClientAuthorizeQuery(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsSAMLIdpInitiated{ClientAuthorizeQuery: &zeroValue}
@@ -5123,6 +6772,28 @@ func TestConnectionOptionsSAMLIdpInitiated_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsSAMLIdpInitiated_Clone(t *testing.T) {
+	var v *ConnectionOptionsSAMLIdpInitiated
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsSAMLIdpInitiated{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsSAMLIdpInitiated{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsSAMLSigningKey_GetCert(tt *testing.T) {
 	var zeroValue string
 	c := &ConnectionOptionsSAMLSigningKey{Cert: &zeroValue}
@@ -5151,6 +6822,28 @@ func TestConnectionOptionsSAMLSigningKey_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsSAMLSigningKey_Clone(t *testing.T) {
+	var v *ConnectionOptionsSAMLSigningKey
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsSAMLSigningKey{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsSAMLSigningKey{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsSMS_GetBruteForceProtection(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsSMS{BruteForceProtection: &zeroValue}
@@ -5293,6 +6986,115 @@ func TestConnectionOptionsSMS_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsSMS_Clone(t *testing.T) {
+	var v *ConnectionOptionsSMS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsSMS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsSMS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestConnectionOptionsUsernameValidation_GetMax(tt *testing.T) {
+	var zeroValue int
+	c := &ConnectionOptionsUsernameValidation{Max: &zeroValue}
+	c.GetMax()
+	c = &ConnectionOptionsUsernameValidation{}
+	c.GetMax()
+	c = nil
+	c.GetMax()
+}
+
+func TestConnectionOptionsUsernameValidation_GetMin(tt *testing.T) {
+	var zeroValue int
+	c := &ConnectionOptionsUsernameValidation{Min: &zeroValue}
+	c.GetMin()
+	c = &ConnectionOptionsUsernameValidation{}
+	c.GetMin()
+	c = nil
+	c.GetMin()
+}
+
+func TestConnectionOptionsUsernameValidation_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ConnectionOptionsUsernameValidation{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestConnectionOptionsUsernameValidation_Clone(t *testing.T) {
+	var v *ConnectionOptionsUsernameValidation
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsUsernameValidation{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsUsernameValidation{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestConnectionOptionsValidation_GetUsername(tt *testing.T) {
+	c := &ConnectionOptionsValidation{}
+	c.GetUsername()
+	c = nil
+	c.GetUsername()
+}
+
+func TestConnectionOptionsValidation_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &ConnectionOptionsValidation{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestConnectionOptionsValidation_Clone(t *testing.T) {
+	var v *ConnectionOptionsValidation
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsValidation{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsValidation{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestConnectionOptionsWindowsLive_GetCalendars(tt *testing.T) {
 	var zeroValue bool
 	c := &ConnectionOptionsWindowsLive{Calendars: &zeroValue}
@@ -5571,6 +7373,28 @@ func TestConnectionOptionsWindowsLive_String(t *testing.T) {
 	}
 }
 
+func TestConnectionOptionsWindowsLive_Clone(t *testing.T) {
+	var v *ConnectionOptionsWindowsLive
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ConnectionOptionsWindowsLive{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ConnectionOptionsWindowsLive{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestCreateEnrollmentTicket_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &CreateEnrollmentTicket{}
@@ -5579,6 +7403,28 @@ func TestCreateEnrollmentTicket_String(t *testing.T) {
 	}
 }
 
+func TestCreateEnrollmentTicket_Clone(t *testing.T) {
+	var v *CreateEnrollmentTicket
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &CreateEnrollmentTicket{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &CreateEnrollmentTicket{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestCustomDomain_GetCNAMEAPIKey(tt *testing.T) {
 	var zeroValue string
 	c := &CustomDomain{CNAMEAPIKey: &zeroValue}
@@ -5694,6 +7540,28 @@ func TestCustomDomain_String(t *testing.T) {
 	}
 }
 
+func TestCustomDomain_Clone(t *testing.T) {
+	var v *CustomDomain
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &CustomDomain{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &CustomDomain{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestCustomDomainVerification_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &CustomDomainVerification{}
@@ -5702,45 +7570,133 @@ func TestCustomDomainVerification_String(t *testing.T) {
 	}
 }
 
-func TestDailyStat_GetCreatedAt(tt *testing.T) {
-	var zeroValue time.Time
-	d := &DailyStat{CreatedAt: &zeroValue}
-	d.GetCreatedAt()
-	d = &DailyStat{}
-	d.GetCreatedAt()
-	d = nil
-	d.GetCreatedAt()
+func TestCustomDomainVerification_Clone(t *testing.T) {
+	var v *CustomDomainVerification
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &CustomDomainVerification{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &CustomDomainVerification{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
 }
 
-func TestDailyStat_GetDate(tt *testing.T) {
-	var zeroValue time.Time
-	d := &DailyStat{Date: &zeroValue}
-	d.GetDate()
-	d = &DailyStat{}
-	d.GetDate()
-	d = nil
-	d.GetDate()
+func TestCustomScriptChange_GetChange(tt *testing.T) {
+	var zeroValue string
+	c := &CustomScriptChange{Change: &zeroValue}
+	c.GetChange()
+	c = &CustomScriptChange{}
+	c.GetChange()
+	c = nil
+	c.GetChange()
 }
 
-func TestDailyStat_GetLeakedPasswords(tt *testing.T) {
-	var zeroValue int
-	d := &DailyStat{LeakedPasswords: &zeroValue}
-	d.GetLeakedPasswords()
-	d = &DailyStat{}
-	d.GetLeakedPasswords()
-	d = nil
-	d.GetLeakedPasswords()
+func TestCustomScriptChange_GetName(tt *testing.T) {
+	var zeroValue string
+	c := &CustomScriptChange{Name: &zeroValue}
+	c.GetName()
+	c = &CustomScriptChange{}
+	c.GetName()
+	c = nil
+	c.GetName()
 }
 
-func TestDailyStat_GetLogins(tt *testing.T) {
-	var zeroValue int
-	d := &DailyStat{Logins: &zeroValue}
-	d.GetLogins()
-	d = &DailyStat{}
-	d.GetLogins()
-	d = nil
-	d.GetLogins()
-}
+func TestCustomScriptChange_GetUnifiedDiff(tt *testing.T) {
+	var zeroValue string
+	c := &CustomScriptChange{UnifiedDiff: &zeroValue}
+	c.GetUnifiedDiff()
+	c = &CustomScriptChange{}
+	c.GetUnifiedDiff()
+	c = nil
+	c.GetUnifiedDiff()
+}
+
+func TestCustomScriptChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomScriptChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestCustomScripts_GetConfiguration(tt *testing.T) {
+	var zeroValue map[string]string
+	c := &CustomScripts{Configuration: &zeroValue}
+	c.GetConfiguration()
+	c = &CustomScripts{}
+	c.GetConfiguration()
+	c = nil
+	c.GetConfiguration()
+}
+
+func TestCustomScripts_GetScripts(tt *testing.T) {
+	var zeroValue map[string]string
+	c := &CustomScripts{Scripts: &zeroValue}
+	c.GetScripts()
+	c = &CustomScripts{}
+	c.GetScripts()
+	c = nil
+	c.GetScripts()
+}
+
+func TestCustomScripts_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &CustomScripts{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestDailyStat_GetCreatedAt(tt *testing.T) {
+	var zeroValue time.Time
+	d := &DailyStat{CreatedAt: &zeroValue}
+	d.GetCreatedAt()
+	d = &DailyStat{}
+	d.GetCreatedAt()
+	d = nil
+	d.GetCreatedAt()
+}
+
+func TestDailyStat_GetDate(tt *testing.T) {
+	var zeroValue time.Time
+	d := &DailyStat{Date: &zeroValue}
+	d.GetDate()
+	d = &DailyStat{}
+	d.GetDate()
+	d = nil
+	d.GetDate()
+}
+
+func TestDailyStat_GetLeakedPasswords(tt *testing.T) {
+	var zeroValue int
+	d := &DailyStat{LeakedPasswords: &zeroValue}
+	d.GetLeakedPasswords()
+	d = &DailyStat{}
+	d.GetLeakedPasswords()
+	d = nil
+	d.GetLeakedPasswords()
+}
+
+func TestDailyStat_GetLogins(tt *testing.T) {
+	var zeroValue int
+	d := &DailyStat{Logins: &zeroValue}
+	d.GetLogins()
+	d = &DailyStat{}
+	d.GetLogins()
+	d = nil
+	d.GetLogins()
+}
 
 func TestDailyStat_GetSignups(tt *testing.T) {
 	var zeroValue int
@@ -5770,6 +7726,122 @@ func TestDailyStat_String(t *testing.T) {
 	}
 }
 
+func TestDailyStat_Clone(t *testing.T) {
+	var v *DailyStat
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &DailyStat{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &DailyStat{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestDesiredRole_GetDescription(tt *testing.T) {
+	var zeroValue string
+	d := &DesiredRole{Description: &zeroValue}
+	d.GetDescription()
+	d = &DesiredRole{}
+	d.GetDescription()
+	d = nil
+	d.GetDescription()
+}
+
+func TestDesiredRole_GetName(tt *testing.T) {
+	var zeroValue string
+	d := &DesiredRole{Name: &zeroValue}
+	d.GetName()
+	d = &DesiredRole{}
+	d.GetName()
+	d = nil
+	d.GetName()
+}
+
+func TestDesiredRole_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &DesiredRole{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestEffectivePermission_GetDescription(tt *testing.T) {
+	var zeroValue string
+	e := &EffectivePermission{Description: &zeroValue}
+	e.GetDescription()
+	e = &EffectivePermission{}
+	e.GetDescription()
+	e = nil
+	e.GetDescription()
+}
+
+func TestEffectivePermission_GetName(tt *testing.T) {
+	var zeroValue string
+	e := &EffectivePermission{Name: &zeroValue}
+	e.GetName()
+	e = &EffectivePermission{}
+	e.GetName()
+	e = nil
+	e.GetName()
+}
+
+func TestEffectivePermission_GetResourceServerIdentifier(tt *testing.T) {
+	var zeroValue string
+	e := &EffectivePermission{ResourceServerIdentifier: &zeroValue}
+	e.GetResourceServerIdentifier()
+	e = &EffectivePermission{}
+	e.GetResourceServerIdentifier()
+	e = nil
+	e.GetResourceServerIdentifier()
+}
+
+func TestEffectivePermission_GetResourceServerName(tt *testing.T) {
+	var zeroValue string
+	e := &EffectivePermission{ResourceServerName: &zeroValue}
+	e.GetResourceServerName()
+	e = &EffectivePermission{}
+	e.GetResourceServerName()
+	e = nil
+	e.GetResourceServerName()
+}
+
+func TestEffectivePermission_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EffectivePermission{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestEffectivePermissionsOptions_GetResourceServers(tt *testing.T) {
+	var zeroValue []string
+	e := &EffectivePermissionsOptions{ResourceServers: &zeroValue}
+	e.GetResourceServers()
+	e = &EffectivePermissionsOptions{}
+	e.GetResourceServers()
+	e = nil
+	e.GetResourceServers()
+}
+
+func TestEffectivePermissionsOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EffectivePermissionsOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEmail_GetCredentials(tt *testing.T) {
 	e := &Email{}
 	e.GetCredentials()
@@ -5815,6 +7887,28 @@ func TestEmail_String(t *testing.T) {
 	}
 }
 
+func TestEmail_Clone(t *testing.T) {
+	var v *Email
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Email{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Email{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailCredentials_GetAccessKeyID(tt *testing.T) {
 	var zeroValue string
 	e := &EmailCredentials{AccessKeyID: &zeroValue}
@@ -5923,6 +8017,28 @@ func TestEmailCredentials_String(t *testing.T) {
 	}
 }
 
+func TestEmailCredentials_Clone(t *testing.T) {
+	var v *EmailCredentials
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailCredentials{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailCredentials{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProvider_GetDefaultFromAddress(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProvider{DefaultFromAddress: &zeroValue}
@@ -5961,6 +8077,28 @@ func TestEmailProvider_String(t *testing.T) {
 	}
 }
 
+func TestEmailProvider_Clone(t *testing.T) {
+	var v *EmailProvider
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProvider{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProvider{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsMailgun_GetAPIKey(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsMailgun{APIKey: &zeroValue}
@@ -5999,6 +8137,28 @@ func TestEmailProviderCredentialsMailgun_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsMailgun_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsMailgun
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsMailgun{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsMailgun{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsMandrill_GetAPIKey(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsMandrill{APIKey: &zeroValue}
@@ -6017,6 +8177,28 @@ func TestEmailProviderCredentialsMandrill_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsMandrill_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsMandrill
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsMandrill{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsMandrill{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsSendGrid_GetAPIKey(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsSendGrid{APIKey: &zeroValue}
@@ -6035,6 +8217,28 @@ func TestEmailProviderCredentialsSendGrid_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsSendGrid_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsSendGrid
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsSendGrid{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsSendGrid{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsSES_GetAccessKeyID(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsSES{AccessKeyID: &zeroValue}
@@ -6073,6 +8277,28 @@ func TestEmailProviderCredentialsSES_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsSES_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsSES
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsSES{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsSES{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsSMTP_GetSMTPHost(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsSMTP{SMTPHost: &zeroValue}
@@ -6121,6 +8347,28 @@ func TestEmailProviderCredentialsSMTP_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsSMTP_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsSMTP
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsSMTP{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsSMTP{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderCredentialsSparkPost_GetAPIKey(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderCredentialsSparkPost{APIKey: &zeroValue}
@@ -6149,6 +8397,28 @@ func TestEmailProviderCredentialsSparkPost_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderCredentialsSparkPost_Clone(t *testing.T) {
+	var v *EmailProviderCredentialsSparkPost
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderCredentialsSparkPost{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderCredentialsSparkPost{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsMandrill_GetMessage(tt *testing.T) {
 	e := &EmailProviderSettingsMandrill{}
 	e.GetMessage()
@@ -6164,6 +8434,28 @@ func TestEmailProviderSettingsMandrill_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsMandrill_Clone(t *testing.T) {
+	var v *EmailProviderSettingsMandrill
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsMandrill{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsMandrill{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsMandrillMessage_GetViewContentLink(tt *testing.T) {
 	var zeroValue bool
 	e := &EmailProviderSettingsMandrillMessage{ViewContentLink: &zeroValue}
@@ -6182,6 +8474,28 @@ func TestEmailProviderSettingsMandrillMessage_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsMandrillMessage_Clone(t *testing.T) {
+	var v *EmailProviderSettingsMandrillMessage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsMandrillMessage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsMandrillMessage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsSES_GetMessage(tt *testing.T) {
 	e := &EmailProviderSettingsSES{}
 	e.GetMessage()
@@ -6197,6 +8511,28 @@ func TestEmailProviderSettingsSES_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsSES_Clone(t *testing.T) {
+	var v *EmailProviderSettingsSES
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsSES{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsSES{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsSESMessage_GetConfigurationSetName(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderSettingsSESMessage{ConfigurationSetName: &zeroValue}
@@ -6215,6 +8551,28 @@ func TestEmailProviderSettingsSESMessage_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsSESMessage_Clone(t *testing.T) {
+	var v *EmailProviderSettingsSESMessage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsSESMessage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsSESMessage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsSMTP_GetHeaders(tt *testing.T) {
 	e := &EmailProviderSettingsSMTP{}
 	e.GetHeaders()
@@ -6230,6 +8588,28 @@ func TestEmailProviderSettingsSMTP_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsSMTP_Clone(t *testing.T) {
+	var v *EmailProviderSettingsSMTP
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsSMTP{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsSMTP{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailProviderSettingsSMTPHeaders_GetXMCViewContentLink(tt *testing.T) {
 	var zeroValue string
 	e := &EmailProviderSettingsSMTPHeaders{XMCViewContentLink: &zeroValue}
@@ -6258,6 +8638,28 @@ func TestEmailProviderSettingsSMTPHeaders_String(t *testing.T) {
 	}
 }
 
+func TestEmailProviderSettingsSMTPHeaders_Clone(t *testing.T) {
+	var v *EmailProviderSettingsSMTPHeaders
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailProviderSettingsSMTPHeaders{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailProviderSettingsSMTPHeaders{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEmailTemplate_GetBody(tt *testing.T) {
 	var zeroValue string
 	e := &EmailTemplate{Body: &zeroValue}
@@ -6356,6 +8758,76 @@ func TestEmailTemplate_String(t *testing.T) {
 	}
 }
 
+func TestEmailTemplate_Clone(t *testing.T) {
+	var v *EmailTemplate
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EmailTemplate{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EmailTemplate{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestEnabledClientsChange_GetConnectionID(tt *testing.T) {
+	var zeroValue string
+	e := &EnabledClientsChange{ConnectionID: &zeroValue}
+	e.GetConnectionID()
+	e = &EnabledClientsChange{}
+	e.GetConnectionID()
+	e = nil
+	e.GetConnectionID()
+}
+
+func TestEnabledClientsChange_GetDisable(tt *testing.T) {
+	var zeroValue []string
+	e := &EnabledClientsChange{Disable: &zeroValue}
+	e.GetDisable()
+	e = &EnabledClientsChange{}
+	e.GetDisable()
+	e = nil
+	e.GetDisable()
+}
+
+func TestEnabledClientsChange_GetEnable(tt *testing.T) {
+	var zeroValue []string
+	e := &EnabledClientsChange{Enable: &zeroValue}
+	e.GetEnable()
+	e = &EnabledClientsChange{}
+	e.GetEnable()
+	e = nil
+	e.GetEnable()
+}
+
+func TestEnabledClientsChange_GetError(tt *testing.T) {
+	var zeroValue string
+	e := &EnabledClientsChange{Error: &zeroValue}
+	e.GetError()
+	e = &EnabledClientsChange{}
+	e.GetError()
+	e = nil
+	e.GetError()
+}
+
+func TestEnabledClientsChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &EnabledClientsChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestEnrollment_GetEnrolledAt(tt *testing.T) {
 	var zeroValue time.Time
 	e := &Enrollment{EnrolledAt: &zeroValue}
@@ -6434,6 +8906,28 @@ func TestEnrollment_String(t *testing.T) {
 	}
 }
 
+func TestEnrollment_Clone(t *testing.T) {
+	var v *Enrollment
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Enrollment{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Enrollment{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestEnrollmentTicket_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &EnrollmentTicket{}
@@ -6442,14 +8936,44 @@ func TestEnrollmentTicket_String(t *testing.T) {
 	}
 }
 
-func TestGrant_GetAudience(tt *testing.T) {
-	var zeroValue string
-	g := &Grant{Audience: &zeroValue}
-	g.GetAudience()
-	g = &Grant{}
-	g.GetAudience()
-	g = nil
-	g.GetAudience()
+func TestEnrollmentTicket_Clone(t *testing.T) {
+	var v *EnrollmentTicket
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &EnrollmentTicket{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &EnrollmentTicket{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestFieldChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &FieldChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestGrant_GetAudience(tt *testing.T) {
+	var zeroValue string
+	g := &Grant{Audience: &zeroValue}
+	g.GetAudience()
+	g = &Grant{}
+	g.GetAudience()
+	g = nil
+	g.GetAudience()
 }
 
 func TestGrant_GetClientID(tt *testing.T) {
@@ -6490,6 +9014,28 @@ func TestGrant_String(t *testing.T) {
 	}
 }
 
+func TestGrant_Clone(t *testing.T) {
+	var v *Grant
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Grant{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Grant{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestGrantList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &GrantList{}
@@ -6498,6 +9044,49 @@ func TestGrantList_String(t *testing.T) {
 	}
 }
 
+func TestHomeRealmDiscovery_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &HomeRealmDiscovery{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestHomeRealmMatch_GetConnection(tt *testing.T) {
+	h := &HomeRealmMatch{}
+	h.GetConnection()
+	h = nil
+	h.GetConnection()
+}
+
+func TestHomeRealmMatch_GetDomain(tt *testing.T) {
+	var zeroValue string
+	h := &HomeRealmMatch{Domain: &zeroValue}
+	h.GetDomain()
+	h = &HomeRealmMatch{}
+	h.GetDomain()
+	h = nil
+	h.GetDomain()
+}
+
+func TestHomeRealmMatch_GetOrganization(tt *testing.T) {
+	var zeroValue bool
+	h := &HomeRealmMatch{Organization: &zeroValue}
+	h.GetOrganization()
+	h = &HomeRealmMatch{}
+	h.GetOrganization()
+	h = nil
+	h.GetOrganization()
+}
+
+func TestHomeRealmMatch_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &HomeRealmMatch{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestHook_GetDependencies(tt *testing.T) {
 	var zeroValue map[string]string
 	h := &Hook{Dependencies: &zeroValue}
@@ -6566,6 +9155,28 @@ func TestHook_String(t *testing.T) {
 	}
 }
 
+func TestHook_Clone(t *testing.T) {
+	var v *Hook
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Hook{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Hook{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestHookList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &HookList{}
@@ -6739,6 +9350,28 @@ func TestJob_String(t *testing.T) {
 	}
 }
 
+func TestJob_Clone(t *testing.T) {
+	var v *Job
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Job{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Job{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestJobError_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &JobError{}
@@ -6747,6 +9380,28 @@ func TestJobError_String(t *testing.T) {
 	}
 }
 
+func TestJobError_Clone(t *testing.T) {
+	var v *JobError
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &JobError{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &JobError{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestJobSummary_GetFailed(tt *testing.T) {
 	var zeroValue int
 	j := &JobSummary{Failed: &zeroValue}
@@ -6795,6 +9450,28 @@ func TestJobSummary_String(t *testing.T) {
 	}
 }
 
+func TestJobSummary_Clone(t *testing.T) {
+	var v *JobSummary
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &JobSummary{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &JobSummary{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestJobUserErrors_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &JobUserErrors{}
@@ -6803,6 +9480,28 @@ func TestJobUserErrors_String(t *testing.T) {
 	}
 }
 
+func TestJobUserErrors_Clone(t *testing.T) {
+	var v *JobUserErrors
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &JobUserErrors{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &JobUserErrors{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &List{}
@@ -7029,6 +9728,28 @@ func TestLog_String(t *testing.T) {
 	}
 }
 
+func TestLog_Clone(t *testing.T) {
+	var v *Log
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Log{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Log{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStream_GetID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStream{ID: &zeroValue}
@@ -7077,6 +9798,28 @@ func TestLogStream_String(t *testing.T) {
 	}
 }
 
+func TestLogStream_Clone(t *testing.T) {
+	var v *LogStream
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStream{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStream{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkAmazonEventBridge_GetAccountID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkAmazonEventBridge{AccountID: &zeroValue}
@@ -7115,6 +9858,28 @@ func TestLogStreamSinkAmazonEventBridge_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkAmazonEventBridge_Clone(t *testing.T) {
+	var v *LogStreamSinkAmazonEventBridge
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkAmazonEventBridge{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkAmazonEventBridge{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkAzureEventGrid_GetPartnerTopic(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkAzureEventGrid{PartnerTopic: &zeroValue}
@@ -7163,6 +9928,28 @@ func TestLogStreamSinkAzureEventGrid_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkAzureEventGrid_Clone(t *testing.T) {
+	var v *LogStreamSinkAzureEventGrid
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkAzureEventGrid{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkAzureEventGrid{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkDatadog_GetAPIKey(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkDatadog{APIKey: &zeroValue}
@@ -7191,6 +9978,28 @@ func TestLogStreamSinkDatadog_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkDatadog_Clone(t *testing.T) {
+	var v *LogStreamSinkDatadog
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkDatadog{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkDatadog{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkHTTP_GetAuthorization(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkHTTP{Authorization: &zeroValue}
@@ -7239,6 +10048,28 @@ func TestLogStreamSinkHTTP_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkHTTP_Clone(t *testing.T) {
+	var v *LogStreamSinkHTTP
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkHTTP{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkHTTP{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkMixpanel_GetProjectID(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkMixpanel{ProjectID: &zeroValue}
@@ -7287,6 +10118,28 @@ func TestLogStreamSinkMixpanel_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkMixpanel_Clone(t *testing.T) {
+	var v *LogStreamSinkMixpanel
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkMixpanel{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkMixpanel{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkSegment_GetWriteKey(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkSegment{WriteKey: &zeroValue}
@@ -7305,6 +10158,28 @@ func TestLogStreamSinkSegment_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkSegment_Clone(t *testing.T) {
+	var v *LogStreamSinkSegment
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkSegment{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkSegment{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkSplunk_GetDomain(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkSplunk{Domain: &zeroValue}
@@ -7353,6 +10228,28 @@ func TestLogStreamSinkSplunk_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkSplunk_Clone(t *testing.T) {
+	var v *LogStreamSinkSplunk
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkSplunk{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkSplunk{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestLogStreamSinkSumo_GetSourceAddress(tt *testing.T) {
 	var zeroValue string
 	l := &LogStreamSinkSumo{SourceAddress: &zeroValue}
@@ -7371,6 +10268,28 @@ func TestLogStreamSinkSumo_String(t *testing.T) {
 	}
 }
 
+func TestLogStreamSinkSumo_Clone(t *testing.T) {
+	var v *LogStreamSinkSumo
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &LogStreamSinkSumo{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &LogStreamSinkSumo{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactor_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	m := &MultiFactor{Enabled: &zeroValue}
@@ -7409,6 +10328,28 @@ func TestMultiFactor_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactor_Clone(t *testing.T) {
+	var v *MultiFactor
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactor{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactor{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorDUO_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorDUO{}
@@ -7417,6 +10358,28 @@ func TestMultiFactorDUO_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorDUO_Clone(t *testing.T) {
+	var v *MultiFactorDUO
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorDUO{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorDUO{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorDUOSettings_GetHostname(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorDUOSettings{Hostname: &zeroValue}
@@ -7455,6 +10418,28 @@ func TestMultiFactorDUOSettings_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorDUOSettings_Clone(t *testing.T) {
+	var v *MultiFactorDUOSettings
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorDUOSettings{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorDUOSettings{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorEmail_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorEmail{}
@@ -7463,6 +10448,28 @@ func TestMultiFactorEmail_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorEmail_Clone(t *testing.T) {
+	var v *MultiFactorEmail
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorEmail{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorEmail{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorOTP_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorOTP{}
@@ -7471,6 +10478,28 @@ func TestMultiFactorOTP_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorOTP_Clone(t *testing.T) {
+	var v *MultiFactorOTP
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorOTP{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorOTP{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorPhone_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorPhone{}
@@ -7479,6 +10508,28 @@ func TestMultiFactorPhone_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorPhone_Clone(t *testing.T) {
+	var v *MultiFactorPhone
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorPhone{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorPhone{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorProvider_GetProvider(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorProvider{Provider: &zeroValue}
@@ -7497,6 +10548,28 @@ func TestMultiFactorProvider_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorProvider_Clone(t *testing.T) {
+	var v *MultiFactorProvider
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorProvider{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorProvider{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorProviderAmazonSNS_GetAccessKeyID(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorProviderAmazonSNS{AccessKeyID: &zeroValue}
@@ -7555,6 +10628,28 @@ func TestMultiFactorProviderAmazonSNS_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorProviderAmazonSNS_Clone(t *testing.T) {
+	var v *MultiFactorProviderAmazonSNS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorProviderAmazonSNS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorProviderAmazonSNS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorProviderTwilio_GetAuthToken(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorProviderTwilio{AuthToken: &zeroValue}
@@ -7603,6 +10698,28 @@ func TestMultiFactorProviderTwilio_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorProviderTwilio_Clone(t *testing.T) {
+	var v *MultiFactorProviderTwilio
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorProviderTwilio{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorProviderTwilio{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorPush_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorPush{}
@@ -7611,6 +10728,28 @@ func TestMultiFactorPush_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorPush_Clone(t *testing.T) {
+	var v *MultiFactorPush
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorPush{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorPush{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorPushCustomApp_GetAppleAppLink(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorPushCustomApp{AppleAppLink: &zeroValue}
@@ -7649,6 +10788,28 @@ func TestMultiFactorPushCustomApp_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorPushCustomApp_Clone(t *testing.T) {
+	var v *MultiFactorPushCustomApp
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorPushCustomApp{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorPushCustomApp{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorPushDirectAPNS_GetBundleID(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorPushDirectAPNS{BundleID: &zeroValue}
@@ -7697,6 +10858,28 @@ func TestMultiFactorPushDirectAPNS_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorPushDirectAPNS_Clone(t *testing.T) {
+	var v *MultiFactorPushDirectAPNS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorPushDirectAPNS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorPushDirectAPNS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorPushDirectFCM_GetServerKey(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorPushDirectFCM{ServerKey: &zeroValue}
@@ -7715,6 +10898,28 @@ func TestMultiFactorPushDirectFCM_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorPushDirectFCM_Clone(t *testing.T) {
+	var v *MultiFactorPushDirectFCM
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorPushDirectFCM{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorPushDirectFCM{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorRecoveryCode_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorRecoveryCode{}
@@ -7723,6 +10928,28 @@ func TestMultiFactorRecoveryCode_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorRecoveryCode_Clone(t *testing.T) {
+	var v *MultiFactorRecoveryCode
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorRecoveryCode{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorRecoveryCode{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorSMS_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorSMS{}
@@ -7731,6 +10958,28 @@ func TestMultiFactorSMS_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorSMS_Clone(t *testing.T) {
+	var v *MultiFactorSMS
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorSMS{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorSMS{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorSMSTemplate_GetEnrollmentMessage(tt *testing.T) {
 	var zeroValue string
 	m := &MultiFactorSMSTemplate{EnrollmentMessage: &zeroValue}
@@ -7759,6 +11008,28 @@ func TestMultiFactorSMSTemplate_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorSMSTemplate_Clone(t *testing.T) {
+	var v *MultiFactorSMSTemplate
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorSMSTemplate{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorSMSTemplate{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorWebAuthnPlatform_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorWebAuthnPlatform{}
@@ -7767,6 +11038,28 @@ func TestMultiFactorWebAuthnPlatform_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorWebAuthnPlatform_Clone(t *testing.T) {
+	var v *MultiFactorWebAuthnPlatform
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorWebAuthnPlatform{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorWebAuthnPlatform{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorWebAuthnRoaming_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &MultiFactorWebAuthnRoaming{}
@@ -7775,6 +11068,28 @@ func TestMultiFactorWebAuthnRoaming_String(t *testing.T) {
 	}
 }
 
+func TestMultiFactorWebAuthnRoaming_Clone(t *testing.T) {
+	var v *MultiFactorWebAuthnRoaming
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorWebAuthnRoaming{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorWebAuthnRoaming{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestMultiFactorWebAuthnSettings_GetOverrideRelyingParty(tt *testing.T) {
 	var zeroValue bool
 	m := &MultiFactorWebAuthnSettings{OverrideRelyingParty: &zeroValue}
@@ -7813,11 +11128,141 @@ func TestMultiFactorWebAuthnSettings_String(t *testing.T) {
 	}
 }
 
-func TestOrganization_GetBranding(tt *testing.T) {
-	o := &Organization{}
-	o.GetBranding()
-	o = nil
-	o.GetBranding()
+func TestMultiFactorWebAuthnSettings_Clone(t *testing.T) {
+	var v *MultiFactorWebAuthnSettings
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &MultiFactorWebAuthnSettings{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &MultiFactorWebAuthnSettings{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestOIDCDiscoveryDocument_GetAuthorizationEndpoint(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{AuthorizationEndpoint: &zeroValue}
+	o.GetAuthorizationEndpoint()
+	o = &OIDCDiscoveryDocument{}
+	o.GetAuthorizationEndpoint()
+	o = nil
+	o.GetAuthorizationEndpoint()
+}
+
+func TestOIDCDiscoveryDocument_GetDiscoveryURL(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{DiscoveryURL: &zeroValue}
+	o.GetDiscoveryURL()
+	o = &OIDCDiscoveryDocument{}
+	o.GetDiscoveryURL()
+	o = nil
+	o.GetDiscoveryURL()
+}
+
+func TestOIDCDiscoveryDocument_GetEndSessionEndpoint(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{EndSessionEndpoint: &zeroValue}
+	o.GetEndSessionEndpoint()
+	o = &OIDCDiscoveryDocument{}
+	o.GetEndSessionEndpoint()
+	o = nil
+	o.GetEndSessionEndpoint()
+}
+
+func TestOIDCDiscoveryDocument_GetIDTokenSigningAlgValuesSupported(tt *testing.T) {
+	var zeroValue []string
+	o := &OIDCDiscoveryDocument{IDTokenSigningAlgValuesSupported: &zeroValue}
+	o.GetIDTokenSigningAlgValuesSupported()
+	o = &OIDCDiscoveryDocument{}
+	o.GetIDTokenSigningAlgValuesSupported()
+	o = nil
+	o.GetIDTokenSigningAlgValuesSupported()
+}
+
+func TestOIDCDiscoveryDocument_GetIssuer(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{Issuer: &zeroValue}
+	o.GetIssuer()
+	o = &OIDCDiscoveryDocument{}
+	o.GetIssuer()
+	o = nil
+	o.GetIssuer()
+}
+
+func TestOIDCDiscoveryDocument_GetJWKSURI(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{JWKSURI: &zeroValue}
+	o.GetJWKSURI()
+	o = &OIDCDiscoveryDocument{}
+	o.GetJWKSURI()
+	o = nil
+	o.GetJWKSURI()
+}
+
+func TestOIDCDiscoveryDocument_GetResponseTypesSupported(tt *testing.T) {
+	var zeroValue []string
+	o := &OIDCDiscoveryDocument{ResponseTypesSupported: &zeroValue}
+	o.GetResponseTypesSupported()
+	o = &OIDCDiscoveryDocument{}
+	o.GetResponseTypesSupported()
+	o = nil
+	o.GetResponseTypesSupported()
+}
+
+func TestOIDCDiscoveryDocument_GetScopesSupported(tt *testing.T) {
+	var zeroValue []string
+	o := &OIDCDiscoveryDocument{ScopesSupported: &zeroValue}
+	o.GetScopesSupported()
+	o = &OIDCDiscoveryDocument{}
+	o.GetScopesSupported()
+	o = nil
+	o.GetScopesSupported()
+}
+
+func TestOIDCDiscoveryDocument_GetTokenEndpoint(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{TokenEndpoint: &zeroValue}
+	o.GetTokenEndpoint()
+	o = &OIDCDiscoveryDocument{}
+	o.GetTokenEndpoint()
+	o = nil
+	o.GetTokenEndpoint()
+}
+
+func TestOIDCDiscoveryDocument_GetUserInfoEndpoint(tt *testing.T) {
+	var zeroValue string
+	o := &OIDCDiscoveryDocument{UserInfoEndpoint: &zeroValue}
+	o.GetUserInfoEndpoint()
+	o = &OIDCDiscoveryDocument{}
+	o.GetUserInfoEndpoint()
+	o = nil
+	o.GetUserInfoEndpoint()
+}
+
+func TestOIDCDiscoveryDocument_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OIDCDiscoveryDocument{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganization_GetBranding(tt *testing.T) {
+	o := &Organization{}
+	o.GetBranding()
+	o = nil
+	o.GetBranding()
 }
 
 func TestOrganization_GetDisplayName(tt *testing.T) {
@@ -7868,6 +11313,28 @@ func TestOrganization_String(t *testing.T) {
 	}
 }
 
+func TestOrganization_Clone(t *testing.T) {
+	var v *Organization
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Organization{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Organization{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationBranding_GetColors(tt *testing.T) {
 	var zeroValue map[string]string
 	o := &OrganizationBranding{Colors: &zeroValue}
@@ -7896,6 +11363,28 @@ func TestOrganizationBranding_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationBranding_Clone(t *testing.T) {
+	var v *OrganizationBranding
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationBranding{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationBranding{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationConnection_GetAssignMembershipOnLogin(tt *testing.T) {
 	var zeroValue bool
 	o := &OrganizationConnection{AssignMembershipOnLogin: &zeroValue}
@@ -7931,6 +11420,28 @@ func TestOrganizationConnection_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationConnection_Clone(t *testing.T) {
+	var v *OrganizationConnection
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationConnection{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationConnection{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationConnectionDetails_GetName(tt *testing.T) {
 	var zeroValue string
 	o := &OrganizationConnectionDetails{Name: &zeroValue}
@@ -7959,6 +11470,28 @@ func TestOrganizationConnectionDetails_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationConnectionDetails_Clone(t *testing.T) {
+	var v *OrganizationConnectionDetails
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationConnectionDetails{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationConnectionDetails{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationConnectionList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationConnectionList{}
@@ -7967,6 +11500,34 @@ func TestOrganizationConnectionList_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationDesiredMember_GetRoles(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationDesiredMember{Roles: &zeroValue}
+	o.GetRoles()
+	o = &OrganizationDesiredMember{}
+	o.GetRoles()
+	o = nil
+	o.GetRoles()
+}
+
+func TestOrganizationDesiredMember_GetUserID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationDesiredMember{UserID: &zeroValue}
+	o.GetUserID()
+	o = &OrganizationDesiredMember{}
+	o.GetUserID()
+	o = nil
+	o.GetUserID()
+}
+
+func TestOrganizationDesiredMember_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationDesiredMember{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestOrganizationInvitation_GetClientID(tt *testing.T) {
 	var zeroValue string
 	o := &OrganizationInvitation{ClientID: &zeroValue}
@@ -8089,6 +11650,28 @@ func TestOrganizationInvitation_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationInvitation_Clone(t *testing.T) {
+	var v *OrganizationInvitation
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationInvitation{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationInvitation{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationInvitationInvitee_GetEmail(tt *testing.T) {
 	var zeroValue string
 	o := &OrganizationInvitationInvitee{Email: &zeroValue}
@@ -8107,6 +11690,28 @@ func TestOrganizationInvitationInvitee_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationInvitationInvitee_Clone(t *testing.T) {
+	var v *OrganizationInvitationInvitee
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationInvitationInvitee{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationInvitationInvitee{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationInvitationInviter_GetName(tt *testing.T) {
 	var zeroValue string
 	o := &OrganizationInvitationInviter{Name: &zeroValue}
@@ -8125,6 +11730,28 @@ func TestOrganizationInvitationInviter_String(t *testing.T) {
 	}
 }
 
+func TestOrganizationInvitationInviter_Clone(t *testing.T) {
+	var v *OrganizationInvitationInviter
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationInvitationInviter{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationInvitationInviter{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestOrganizationInvitationList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &OrganizationInvitationList{}
@@ -8133,167 +11760,1040 @@ func TestOrganizationInvitationList_String(t *testing.T) {
 	}
 }
 
-func TestOrganizationList_String(t *testing.T) {
+func TestOrganizationInvitationRefreshOptions_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationInvitationRefreshOptions{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationInvitationRefreshOptions{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
+}
+
+func TestOrganizationInvitationRefreshOptions_GetDelay(tt *testing.T) {
+	var zeroValue time.Duration
+	o := &OrganizationInvitationRefreshOptions{Delay: &zeroValue}
+	o.GetDelay()
+	o = &OrganizationInvitationRefreshOptions{}
+	o.GetDelay()
+	o = nil
+	o.GetDelay()
+}
+
+func TestOrganizationInvitationRefreshOptions_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationInvitationRefreshOptions{DryRun: &zeroValue}
+	o.GetDryRun()
+	o = &OrganizationInvitationRefreshOptions{}
+	o.GetDryRun()
+	o = nil
+	o.GetDryRun()
+}
+
+func TestOrganizationInvitationRefreshOptions_GetWithin(tt *testing.T) {
+	var zeroValue time.Duration
+	o := &OrganizationInvitationRefreshOptions{Within: &zeroValue}
+	o.GetWithin()
+	o = &OrganizationInvitationRefreshOptions{}
+	o.GetWithin()
+	o = nil
+	o.GetWithin()
+}
+
+func TestOrganizationInvitationRefreshOptions_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &OrganizationList{}
+	v := &OrganizationInvitationRefreshOptions{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestOrganizationMember_GetEmail(tt *testing.T) {
+func TestOrganizationInvitationReport_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationInvitationReport{DryRun: &zeroValue}
+	o.GetDryRun()
+	o = &OrganizationInvitationReport{}
+	o.GetDryRun()
+	o = nil
+	o.GetDryRun()
+}
+
+func TestOrganizationInvitationReport_GetOrganizationID(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMember{Email: &zeroValue}
+	o := &OrganizationInvitationReport{OrganizationID: &zeroValue}
+	o.GetOrganizationID()
+	o = &OrganizationInvitationReport{}
+	o.GetOrganizationID()
+	o = nil
+	o.GetOrganizationID()
+}
+
+func TestOrganizationInvitationReport_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationInvitationReport{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationInvitationResult_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationInvitationResult{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationInvitationResult{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
+}
+
+func TestOrganizationInvitationResult_GetApplied(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationInvitationResult{Applied: &zeroValue}
+	o.GetApplied()
+	o = &OrganizationInvitationResult{}
+	o.GetApplied()
+	o = nil
+	o.GetApplied()
+}
+
+func TestOrganizationInvitationResult_GetEmail(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationInvitationResult{Email: &zeroValue}
 	o.GetEmail()
-	o = &OrganizationMember{}
+	o = &OrganizationInvitationResult{}
 	o.GetEmail()
 	o = nil
 	o.GetEmail()
 }
 
-func TestOrganizationMember_GetName(tt *testing.T) {
+func TestOrganizationInvitationResult_GetError(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMember{Name: &zeroValue}
-	o.GetName()
-	o = &OrganizationMember{}
-	o.GetName()
+	o := &OrganizationInvitationResult{Error: &zeroValue}
+	o.GetError()
+	o = &OrganizationInvitationResult{}
+	o.GetError()
 	o = nil
-	o.GetName()
+	o.GetError()
 }
 
-func TestOrganizationMember_GetPicture(tt *testing.T) {
-	var zeroValue string
-	o := &OrganizationMember{Picture: &zeroValue}
-	o.GetPicture()
-	o = &OrganizationMember{}
-	o.GetPicture()
+func TestOrganizationInvitationResult_GetExpiresAt(tt *testing.T) {
+	var zeroValue time.Time
+	o := &OrganizationInvitationResult{ExpiresAt: &zeroValue}
+	o.GetExpiresAt()
+	o = &OrganizationInvitationResult{}
+	o.GetExpiresAt()
 	o = nil
-	o.GetPicture()
+	o.GetExpiresAt()
 }
 
-func TestOrganizationMember_GetUserID(tt *testing.T) {
+func TestOrganizationInvitationResult_GetInvitationID(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMember{UserID: &zeroValue}
-	o.GetUserID()
-	o = &OrganizationMember{}
-	o.GetUserID()
+	o := &OrganizationInvitationResult{InvitationID: &zeroValue}
+	o.GetInvitationID()
+	o = &OrganizationInvitationResult{}
+	o.GetInvitationID()
 	o = nil
-	o.GetUserID()
+	o.GetInvitationID()
 }
 
-func TestOrganizationMember_String(t *testing.T) {
+func TestOrganizationInvitationResult_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &OrganizationMember{}
+	v := &OrganizationInvitationResult{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestOrganizationMemberList_String(t *testing.T) {
+func TestOrganizationInvitee_GetEmail(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationInvitee{Email: &zeroValue}
+	o.GetEmail()
+	o = &OrganizationInvitee{}
+	o.GetEmail()
+	o = nil
+	o.GetEmail()
+}
+
+func TestOrganizationInvitee_GetRoles(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationInvitee{Roles: &zeroValue}
+	o.GetRoles()
+	o = &OrganizationInvitee{}
+	o.GetRoles()
+	o = nil
+	o.GetRoles()
+}
+
+func TestOrganizationInvitee_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &OrganizationMemberList{}
+	v := &OrganizationInvitee{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestOrganizationMemberRole_GetDescription(tt *testing.T) {
+func TestOrganizationInviteOptions_GetClientID(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMemberRole{Description: &zeroValue}
-	o.GetDescription()
-	o = &OrganizationMemberRole{}
-	o.GetDescription()
+	o := &OrganizationInviteOptions{ClientID: &zeroValue}
+	o.GetClientID()
+	o = &OrganizationInviteOptions{}
+	o.GetClientID()
 	o = nil
-	o.GetDescription()
+	o.GetClientID()
 }
 
-func TestOrganizationMemberRole_GetID(tt *testing.T) {
+func TestOrganizationInviteOptions_GetConnectionID(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMemberRole{ID: &zeroValue}
-	o.GetID()
-	o = &OrganizationMemberRole{}
-	o.GetID()
+	o := &OrganizationInviteOptions{ConnectionID: &zeroValue}
+	o.GetConnectionID()
+	o = &OrganizationInviteOptions{}
+	o.GetConnectionID()
 	o = nil
-	o.GetID()
+	o.GetConnectionID()
 }
 
-func TestOrganizationMemberRole_GetName(tt *testing.T) {
+func TestOrganizationInviteOptions_GetDelay(tt *testing.T) {
+	var zeroValue time.Duration
+	o := &OrganizationInviteOptions{Delay: &zeroValue}
+	o.GetDelay()
+	o = &OrganizationInviteOptions{}
+	o.GetDelay()
+	o = nil
+	o.GetDelay()
+}
+
+func TestOrganizationInviteOptions_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationInviteOptions{DryRun: &zeroValue}
+	o.GetDryRun()
+	o = &OrganizationInviteOptions{}
+	o.GetDryRun()
+	o = nil
+	o.GetDryRun()
+}
+
+func TestOrganizationInviteOptions_GetInviterName(tt *testing.T) {
 	var zeroValue string
-	o := &OrganizationMemberRole{Name: &zeroValue}
-	o.GetName()
-	o = &OrganizationMemberRole{}
-	o.GetName()
+	o := &OrganizationInviteOptions{InviterName: &zeroValue}
+	o.GetInviterName()
+	o = &OrganizationInviteOptions{}
+	o.GetInviterName()
 	o = nil
-	o.GetName()
+	o.GetInviterName()
 }
 
-func TestOrganizationMemberRole_String(t *testing.T) {
+func TestOrganizationInviteOptions_GetSendInvitationEmail(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationInviteOptions{SendInvitationEmail: &zeroValue}
+	o.GetSendInvitationEmail()
+	o = &OrganizationInviteOptions{}
+	o.GetSendInvitationEmail()
+	o = nil
+	o.GetSendInvitationEmail()
+}
+
+func TestOrganizationInviteOptions_GetTTLSec(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationInviteOptions{TTLSec: &zeroValue}
+	o.GetTTLSec()
+	o = &OrganizationInviteOptions{}
+	o.GetTTLSec()
+	o = nil
+	o.GetTTLSec()
+}
+
+func TestOrganizationInviteOptions_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &OrganizationMemberRole{}
+	v := &OrganizationInviteOptions{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestOrganizationMemberRoleList_String(t *testing.T) {
+func TestOrganizationList_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &OrganizationMemberRoleList{}
+	v := &OrganizationList{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
 }
 
-func TestPermission_GetDescription(tt *testing.T) {
+func TestOrganizationMember_GetEmail(tt *testing.T) {
 	var zeroValue string
-	p := &Permission{Description: &zeroValue}
-	p.GetDescription()
-	p = &Permission{}
-	p.GetDescription()
-	p = nil
-	p.GetDescription()
-}
-
-func TestPermission_GetName(tt *testing.T) {
+	o := &OrganizationMember{Email: &zeroValue}
+	o.GetEmail()
+	o = &OrganizationMember{}
+	o.GetEmail()
+	o = nil
+	o.GetEmail()
+}
+
+func TestOrganizationMember_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMember{Name: &zeroValue}
+	o.GetName()
+	o = &OrganizationMember{}
+	o.GetName()
+	o = nil
+	o.GetName()
+}
+
+func TestOrganizationMember_GetPicture(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMember{Picture: &zeroValue}
+	o.GetPicture()
+	o = &OrganizationMember{}
+	o.GetPicture()
+	o = nil
+	o.GetPicture()
+}
+
+func TestOrganizationMember_GetUserID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMember{UserID: &zeroValue}
+	o.GetUserID()
+	o = &OrganizationMember{}
+	o.GetUserID()
+	o = nil
+	o.GetUserID()
+}
+
+func TestOrganizationMember_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMember{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMember_Clone(t *testing.T) {
+	var v *OrganizationMember
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationMember{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationMember{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestOrganizationMemberChange_GetAction(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberChange{Action: &zeroValue}
+	o.GetAction()
+	o = &OrganizationMemberChange{}
+	o.GetAction()
+	o = nil
+	o.GetAction()
+}
+
+func TestOrganizationMemberChange_GetApplied(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationMemberChange{Applied: &zeroValue}
+	o.GetApplied()
+	o = &OrganizationMemberChange{}
+	o.GetApplied()
+	o = nil
+	o.GetApplied()
+}
+
+func TestOrganizationMemberChange_GetRoles(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationMemberChange{Roles: &zeroValue}
+	o.GetRoles()
+	o = &OrganizationMemberChange{}
+	o.GetRoles()
+	o = nil
+	o.GetRoles()
+}
+
+func TestOrganizationMemberChange_GetUserID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberChange{UserID: &zeroValue}
+	o.GetUserID()
+	o = &OrganizationMemberChange{}
+	o.GetUserID()
+	o = nil
+	o.GetUserID()
+}
+
+func TestOrganizationMemberChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberRole_GetDescription(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberRole{Description: &zeroValue}
+	o.GetDescription()
+	o = &OrganizationMemberRole{}
+	o.GetDescription()
+	o = nil
+	o.GetDescription()
+}
+
+func TestOrganizationMemberRole_GetID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberRole{ID: &zeroValue}
+	o.GetID()
+	o = &OrganizationMemberRole{}
+	o.GetID()
+	o = nil
+	o.GetID()
+}
+
+func TestOrganizationMemberRole_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberRole{Name: &zeroValue}
+	o.GetName()
+	o = &OrganizationMemberRole{}
+	o.GetName()
+	o = nil
+	o.GetName()
+}
+
+func TestOrganizationMemberRole_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberRole{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberRole_Clone(t *testing.T) {
+	var v *OrganizationMemberRole
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &OrganizationMemberRole{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &OrganizationMemberRole{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestOrganizationMemberRoleList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberRoleList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberSyncOptions_GetBatchDelay(tt *testing.T) {
+	var zeroValue time.Duration
+	o := &OrganizationMemberSyncOptions{BatchDelay: &zeroValue}
+	o.GetBatchDelay()
+	o = &OrganizationMemberSyncOptions{}
+	o.GetBatchDelay()
+	o = nil
+	o.GetBatchDelay()
+}
+
+func TestOrganizationMemberSyncOptions_GetBatchSize(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationMemberSyncOptions{BatchSize: &zeroValue}
+	o.GetBatchSize()
+	o = &OrganizationMemberSyncOptions{}
+	o.GetBatchSize()
+	o = nil
+	o.GetBatchSize()
+}
+
+func TestOrganizationMemberSyncOptions_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationMemberSyncOptions{DryRun: &zeroValue}
+	o.GetDryRun()
+	o = &OrganizationMemberSyncOptions{}
+	o.GetDryRun()
+	o = nil
+	o.GetDryRun()
+}
+
+func TestOrganizationMemberSyncOptions_GetKeepUndesired(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationMemberSyncOptions{KeepUndesired: &zeroValue}
+	o.GetKeepUndesired()
+	o = &OrganizationMemberSyncOptions{}
+	o.GetKeepUndesired()
+	o = nil
+	o.GetKeepUndesired()
+}
+
+func TestOrganizationMemberSyncOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberSyncOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationMemberSyncReport_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	o := &OrganizationMemberSyncReport{DryRun: &zeroValue}
+	o.GetDryRun()
+	o = &OrganizationMemberSyncReport{}
+	o.GetDryRun()
+	o = nil
+	o.GetDryRun()
+}
+
+func TestOrganizationMemberSyncReport_GetOrganizationID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationMemberSyncReport{OrganizationID: &zeroValue}
+	o.GetOrganizationID()
+	o = &OrganizationMemberSyncReport{}
+	o.GetOrganizationID()
+	o = nil
+	o.GetOrganizationID()
+}
+
+func TestOrganizationMemberSyncReport_GetUnchanged(tt *testing.T) {
+	var zeroValue int
+	o := &OrganizationMemberSyncReport{Unchanged: &zeroValue}
+	o.GetUnchanged()
+	o = &OrganizationMemberSyncReport{}
+	o.GetUnchanged()
+	o = nil
+	o.GetUnchanged()
+}
+
+func TestOrganizationMemberSyncReport_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationMemberSyncReport{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationProvisioning_GetConnectionIDs(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationProvisioning{ConnectionIDs: &zeroValue}
+	o.GetConnectionIDs()
+	o = &OrganizationProvisioning{}
+	o.GetConnectionIDs()
+	o = nil
+	o.GetConnectionIDs()
+}
+
+func TestOrganizationProvisioning_GetInvitationID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationProvisioning{InvitationID: &zeroValue}
+	o.GetInvitationID()
+	o = &OrganizationProvisioning{}
+	o.GetInvitationID()
+	o = nil
+	o.GetInvitationID()
+}
+
+func TestOrganizationProvisioning_GetOrganizationID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationProvisioning{OrganizationID: &zeroValue}
+	o.GetOrganizationID()
+	o = &OrganizationProvisioning{}
+	o.GetOrganizationID()
+	o = nil
+	o.GetOrganizationID()
+}
+
+func TestOrganizationProvisioning_GetRoleIDs(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationProvisioning{RoleIDs: &zeroValue}
+	o.GetRoleIDs()
+	o = &OrganizationProvisioning{}
+	o.GetRoleIDs()
+	o = nil
+	o.GetRoleIDs()
+}
+
+func TestOrganizationProvisioning_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationProvisioning{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationTemplate_GetAdminRoles(tt *testing.T) {
+	var zeroValue []string
+	o := &OrganizationTemplate{AdminRoles: &zeroValue}
+	o.GetAdminRoles()
+	o = &OrganizationTemplate{}
+	o.GetAdminRoles()
+	o = nil
+	o.GetAdminRoles()
+}
+
+func TestOrganizationTemplate_GetBranding(tt *testing.T) {
+	o := &OrganizationTemplate{}
+	o.GetBranding()
+	o = nil
+	o.GetBranding()
+}
+
+func TestOrganizationTemplate_GetClientID(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationTemplate{ClientID: &zeroValue}
+	o.GetClientID()
+	o = &OrganizationTemplate{}
+	o.GetClientID()
+	o = nil
+	o.GetClientID()
+}
+
+func TestOrganizationTemplate_GetInviterName(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationTemplate{InviterName: &zeroValue}
+	o.GetInviterName()
+	o = &OrganizationTemplate{}
+	o.GetInviterName()
+	o = nil
+	o.GetInviterName()
+}
+
+func TestOrganizationTemplate_GetMetadata(tt *testing.T) {
+	var zeroValue map[string]string
+	o := &OrganizationTemplate{Metadata: &zeroValue}
+	o.GetMetadata()
+	o = &OrganizationTemplate{}
+	o.GetMetadata()
+	o = nil
+	o.GetMetadata()
+}
+
+func TestOrganizationTemplate_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationTemplate{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestOrganizationTemplateParams_GetAdminEmail(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationTemplateParams{AdminEmail: &zeroValue}
+	o.GetAdminEmail()
+	o = &OrganizationTemplateParams{}
+	o.GetAdminEmail()
+	o = nil
+	o.GetAdminEmail()
+}
+
+func TestOrganizationTemplateParams_GetDisplayName(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationTemplateParams{DisplayName: &zeroValue}
+	o.GetDisplayName()
+	o = &OrganizationTemplateParams{}
+	o.GetDisplayName()
+	o = nil
+	o.GetDisplayName()
+}
+
+func TestOrganizationTemplateParams_GetMetadata(tt *testing.T) {
+	var zeroValue map[string]string
+	o := &OrganizationTemplateParams{Metadata: &zeroValue}
+	o.GetMetadata()
+	o = &OrganizationTemplateParams{}
+	o.GetMetadata()
+	o = nil
+	o.GetMetadata()
+}
+
+func TestOrganizationTemplateParams_GetName(tt *testing.T) {
+	var zeroValue string
+	o := &OrganizationTemplateParams{Name: &zeroValue}
+	o.GetName()
+	o = &OrganizationTemplateParams{}
+	o.GetName()
+	o = nil
+	o.GetName()
+}
+
+func TestOrganizationTemplateParams_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &OrganizationTemplateParams{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordComplexityOptions_GetMinLength(tt *testing.T) {
+	var zeroValue int
+	p := &PasswordComplexityOptions{MinLength: &zeroValue}
+	p.GetMinLength()
+	p = &PasswordComplexityOptions{}
+	p.GetMinLength()
+	p = nil
+	p.GetMinLength()
+}
+
+func TestPasswordComplexityOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordComplexityOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordDictionary_GetDictionary(tt *testing.T) {
+	var zeroValue []string
+	p := &PasswordDictionary{Dictionary: &zeroValue}
+	p.GetDictionary()
+	p = &PasswordDictionary{}
+	p.GetDictionary()
+	p = nil
+	p.GetDictionary()
+}
+
+func TestPasswordDictionary_GetEnable(tt *testing.T) {
+	var zeroValue bool
+	p := &PasswordDictionary{Enable: &zeroValue}
+	p.GetEnable()
+	p = &PasswordDictionary{}
+	p.GetEnable()
+	p = nil
+	p.GetEnable()
+}
+
+func TestPasswordDictionary_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordDictionary{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordHistory_GetEnable(tt *testing.T) {
+	var zeroValue bool
+	p := &PasswordHistory{Enable: &zeroValue}
+	p.GetEnable()
+	p = &PasswordHistory{}
+	p.GetEnable()
+	p = nil
+	p.GetEnable()
+}
+
+func TestPasswordHistory_GetSize(tt *testing.T) {
+	var zeroValue int
+	p := &PasswordHistory{Size: &zeroValue}
+	p.GetSize()
+	p = &PasswordHistory{}
+	p.GetSize()
+	p = nil
+	p.GetSize()
+}
+
+func TestPasswordHistory_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordHistory{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordNoPersonalInfo_GetEnable(tt *testing.T) {
+	var zeroValue bool
+	p := &PasswordNoPersonalInfo{Enable: &zeroValue}
+	p.GetEnable()
+	p = &PasswordNoPersonalInfo{}
+	p.GetEnable()
+	p = nil
+	p.GetEnable()
+}
+
+func TestPasswordNoPersonalInfo_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordNoPersonalInfo{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordSettings_GetComplexityOptions(tt *testing.T) {
+	p := &PasswordSettings{}
+	p.GetComplexityOptions()
+	p = nil
+	p.GetComplexityOptions()
+}
+
+func TestPasswordSettings_GetDictionary(tt *testing.T) {
+	p := &PasswordSettings{}
+	p.GetDictionary()
+	p = nil
+	p.GetDictionary()
+}
+
+func TestPasswordSettings_GetHistory(tt *testing.T) {
+	p := &PasswordSettings{}
+	p.GetHistory()
+	p = nil
+	p.GetHistory()
+}
+
+func TestPasswordSettings_GetNoPersonalInfo(tt *testing.T) {
+	p := &PasswordSettings{}
+	p.GetNoPersonalInfo()
+	p = nil
+	p.GetNoPersonalInfo()
+}
+
+func TestPasswordSettings_GetPolicy(tt *testing.T) {
+	var zeroValue string
+	p := &PasswordSettings{Policy: &zeroValue}
+	p.GetPolicy()
+	p = &PasswordSettings{}
+	p.GetPolicy()
+	p = nil
+	p.GetPolicy()
+}
+
+func TestPasswordSettings_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordSettings{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPasswordViolation_GetCode(tt *testing.T) {
+	var zeroValue string
+	p := &PasswordViolation{Code: &zeroValue}
+	p.GetCode()
+	p = &PasswordViolation{}
+	p.GetCode()
+	p = nil
+	p.GetCode()
+}
+
+func TestPasswordViolation_GetField(tt *testing.T) {
+	var zeroValue string
+	p := &PasswordViolation{Field: &zeroValue}
+	p.GetField()
+	p = &PasswordViolation{}
+	p.GetField()
+	p = nil
+	p.GetField()
+}
+
+func TestPasswordViolation_GetMessage(tt *testing.T) {
+	var zeroValue string
+	p := &PasswordViolation{Message: &zeroValue}
+	p.GetMessage()
+	p = &PasswordViolation{}
+	p.GetMessage()
+	p = nil
+	p.GetMessage()
+}
+
+func TestPasswordViolation_GetMinLength(tt *testing.T) {
+	var zeroValue int
+	p := &PasswordViolation{MinLength: &zeroValue}
+	p.GetMinLength()
+	p = &PasswordViolation{}
+	p.GetMinLength()
+	p = nil
+	p.GetMinLength()
+}
+
+func TestPasswordViolation_GetMissingCharacterTypes(tt *testing.T) {
+	var zeroValue []string
+	p := &PasswordViolation{MissingCharacterTypes: &zeroValue}
+	p.GetMissingCharacterTypes()
+	p = &PasswordViolation{}
+	p.GetMissingCharacterTypes()
+	p = nil
+	p.GetMissingCharacterTypes()
+}
+
+func TestPasswordViolation_GetRequiredCharacterTypes(tt *testing.T) {
+	var zeroValue int
+	p := &PasswordViolation{RequiredCharacterTypes: &zeroValue}
+	p.GetRequiredCharacterTypes()
+	p = &PasswordViolation{}
+	p.GetRequiredCharacterTypes()
+	p = nil
+	p.GetRequiredCharacterTypes()
+}
+
+func TestPasswordViolation_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PasswordViolation{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPermission_GetDescription(tt *testing.T) {
+	var zeroValue string
+	p := &Permission{Description: &zeroValue}
+	p.GetDescription()
+	p = &Permission{}
+	p.GetDescription()
+	p = nil
+	p.GetDescription()
+}
+
+func TestPermission_GetName(tt *testing.T) {
 	var zeroValue string
 	p := &Permission{Name: &zeroValue}
 	p.GetName()
 	p = &Permission{}
 	p.GetName()
 	p = nil
-	p.GetName()
+	p.GetName()
+}
+
+func TestPermission_GetResourceServerIdentifier(tt *testing.T) {
+	var zeroValue string
+	p := &Permission{ResourceServerIdentifier: &zeroValue}
+	p.GetResourceServerIdentifier()
+	p = &Permission{}
+	p.GetResourceServerIdentifier()
+	p = nil
+	p.GetResourceServerIdentifier()
+}
+
+func TestPermission_GetResourceServerName(tt *testing.T) {
+	var zeroValue string
+	p := &Permission{ResourceServerName: &zeroValue}
+	p.GetResourceServerName()
+	p = &Permission{}
+	p.GetResourceServerName()
+	p = nil
+	p.GetResourceServerName()
+}
+
+func TestPermission_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &Permission{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPermission_Clone(t *testing.T) {
+	var v *Permission
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Permission{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Permission{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestPermissionList_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PermissionList{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPermissionResolver_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &PermissionResolver{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestPermissionSource_GetOrganizationID(tt *testing.T) {
+	var zeroValue string
+	p := &PermissionSource{OrganizationID: &zeroValue}
+	p.GetOrganizationID()
+	p = &PermissionSource{}
+	p.GetOrganizationID()
+	p = nil
+	p.GetOrganizationID()
+}
+
+func TestPermissionSource_GetOrganizationName(tt *testing.T) {
+	var zeroValue string
+	p := &PermissionSource{OrganizationName: &zeroValue}
+	p.GetOrganizationName()
+	p = &PermissionSource{}
+	p.GetOrganizationName()
+	p = nil
+	p.GetOrganizationName()
 }
 
-func TestPermission_GetResourceServerIdentifier(tt *testing.T) {
+func TestPermissionSource_GetRoleID(tt *testing.T) {
 	var zeroValue string
-	p := &Permission{ResourceServerIdentifier: &zeroValue}
-	p.GetResourceServerIdentifier()
-	p = &Permission{}
-	p.GetResourceServerIdentifier()
+	p := &PermissionSource{RoleID: &zeroValue}
+	p.GetRoleID()
+	p = &PermissionSource{}
+	p.GetRoleID()
 	p = nil
-	p.GetResourceServerIdentifier()
+	p.GetRoleID()
 }
 
-func TestPermission_GetResourceServerName(tt *testing.T) {
+func TestPermissionSource_GetRoleName(tt *testing.T) {
 	var zeroValue string
-	p := &Permission{ResourceServerName: &zeroValue}
-	p.GetResourceServerName()
-	p = &Permission{}
-	p.GetResourceServerName()
+	p := &PermissionSource{RoleName: &zeroValue}
+	p.GetRoleName()
+	p = &PermissionSource{}
+	p.GetRoleName()
 	p = nil
-	p.GetResourceServerName()
+	p.GetRoleName()
 }
 
-func TestPermission_String(t *testing.T) {
-	var rawJSON json.RawMessage
-	v := &Permission{}
-	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
-		t.Errorf("failed to produce a valid json")
-	}
+func TestPermissionSource_GetType(tt *testing.T) {
+	var zeroValue string
+	p := &PermissionSource{Type: &zeroValue}
+	p.GetType()
+	p = &PermissionSource{}
+	p.GetType()
+	p = nil
+	p.GetType()
 }
 
-func TestPermissionList_String(t *testing.T) {
+func TestPermissionSource_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &PermissionList{}
+	v := &PermissionSource{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
@@ -8317,6 +12817,28 @@ func TestPhoneMessageTypes_String(t *testing.T) {
 	}
 }
 
+func TestPhoneMessageTypes_Clone(t *testing.T) {
+	var v *PhoneMessageTypes
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &PhoneMessageTypes{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &PhoneMessageTypes{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestPreLogin_GetMaxAttempts(tt *testing.T) {
 	var zeroValue int
 	p := &PreLogin{MaxAttempts: &zeroValue}
@@ -8345,6 +12867,28 @@ func TestPreLogin_String(t *testing.T) {
 	}
 }
 
+func TestPreLogin_Clone(t *testing.T) {
+	var v *PreLogin
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &PreLogin{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &PreLogin{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestPreUserRegistration_GetMaxAttempts(tt *testing.T) {
 	var zeroValue int
 	p := &PreUserRegistration{MaxAttempts: &zeroValue}
@@ -8373,6 +12917,28 @@ func TestPreUserRegistration_String(t *testing.T) {
 	}
 }
 
+func TestPreUserRegistration_Clone(t *testing.T) {
+	var v *PreUserRegistration
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &PreUserRegistration{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &PreUserRegistration{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestPrompt_GetIdentifierFirst(tt *testing.T) {
 	var zeroValue bool
 	p := &Prompt{IdentifierFirst: &zeroValue}
@@ -8401,6 +12967,28 @@ func TestPrompt_String(t *testing.T) {
 	}
 }
 
+func TestPrompt_Clone(t *testing.T) {
+	var v *Prompt
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Prompt{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Prompt{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestResourceServer_GetAllowOfflineAccess(tt *testing.T) {
 	var zeroValue bool
 	r := &ResourceServer{AllowOfflineAccess: &zeroValue}
@@ -8549,6 +13137,28 @@ func TestResourceServer_String(t *testing.T) {
 	}
 }
 
+func TestResourceServer_Clone(t *testing.T) {
+	var v *ResourceServer
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ResourceServer{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ResourceServer{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestResourceServerList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &ResourceServerList{}
@@ -8585,6 +13195,28 @@ func TestResourceServerScope_String(t *testing.T) {
 	}
 }
 
+func TestResourceServerScope_Clone(t *testing.T) {
+	var v *ResourceServerScope
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &ResourceServerScope{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &ResourceServerScope{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestRole_GetDescription(tt *testing.T) {
 	var zeroValue string
 	r := &Role{Description: &zeroValue}
@@ -8623,6 +13255,76 @@ func TestRole_String(t *testing.T) {
 	}
 }
 
+func TestRole_Clone(t *testing.T) {
+	var v *Role
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Role{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Role{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestRoleChange_GetAction(tt *testing.T) {
+	var zeroValue string
+	r := &RoleChange{Action: &zeroValue}
+	r.GetAction()
+	r = &RoleChange{}
+	r.GetAction()
+	r = nil
+	r.GetAction()
+}
+
+func TestRoleChange_GetApplied(tt *testing.T) {
+	var zeroValue bool
+	r := &RoleChange{Applied: &zeroValue}
+	r.GetApplied()
+	r = &RoleChange{}
+	r.GetApplied()
+	r = nil
+	r.GetApplied()
+}
+
+func TestRoleChange_GetRoleID(tt *testing.T) {
+	var zeroValue string
+	r := &RoleChange{RoleID: &zeroValue}
+	r.GetRoleID()
+	r = &RoleChange{}
+	r.GetRoleID()
+	r = nil
+	r.GetRoleID()
+}
+
+func TestRoleChange_GetRoleName(tt *testing.T) {
+	var zeroValue string
+	r := &RoleChange{RoleName: &zeroValue}
+	r.GetRoleName()
+	r = &RoleChange{}
+	r.GetRoleName()
+	r = nil
+	r.GetRoleName()
+}
+
+func TestRoleChange_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RoleChange{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestRoleList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &RoleList{}
@@ -8631,6 +13333,62 @@ func TestRoleList_String(t *testing.T) {
 	}
 }
 
+func TestRoleSyncOptions_GetDeleteUnmanaged(tt *testing.T) {
+	var zeroValue bool
+	r := &RoleSyncOptions{DeleteUnmanaged: &zeroValue}
+	r.GetDeleteUnmanaged()
+	r = &RoleSyncOptions{}
+	r.GetDeleteUnmanaged()
+	r = nil
+	r.GetDeleteUnmanaged()
+}
+
+func TestRoleSyncOptions_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	r := &RoleSyncOptions{DryRun: &zeroValue}
+	r.GetDryRun()
+	r = &RoleSyncOptions{}
+	r.GetDryRun()
+	r = nil
+	r.GetDryRun()
+}
+
+func TestRoleSyncOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RoleSyncOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestRoleSyncReport_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	r := &RoleSyncReport{DryRun: &zeroValue}
+	r.GetDryRun()
+	r = &RoleSyncReport{}
+	r.GetDryRun()
+	r = nil
+	r.GetDryRun()
+}
+
+func TestRoleSyncReport_GetUnchanged(tt *testing.T) {
+	var zeroValue int
+	r := &RoleSyncReport{Unchanged: &zeroValue}
+	r.GetUnchanged()
+	r = &RoleSyncReport{}
+	r.GetUnchanged()
+	r = nil
+	r.GetUnchanged()
+}
+
+func TestRoleSyncReport_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &RoleSyncReport{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestRule_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	r := &Rule{Enabled: &zeroValue}
@@ -8689,6 +13447,28 @@ func TestRule_String(t *testing.T) {
 	}
 }
 
+func TestRule_Clone(t *testing.T) {
+	var v *Rule
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Rule{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Rule{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestRuleConfig_GetKey(tt *testing.T) {
 	var zeroValue string
 	r := &RuleConfig{Key: &zeroValue}
@@ -8717,6 +13497,28 @@ func TestRuleConfig_String(t *testing.T) {
 	}
 }
 
+func TestRuleConfig_Clone(t *testing.T) {
+	var v *RuleConfig
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &RuleConfig{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &RuleConfig{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestRuleList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &RuleList{}
@@ -8725,6 +13527,149 @@ func TestRuleList_String(t *testing.T) {
 	}
 }
 
+func TestSAMLMetadata_GetDigestAlgorithm(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{DigestAlgorithm: &zeroValue}
+	s.GetDigestAlgorithm()
+	s = &SAMLMetadata{}
+	s.GetDigestAlgorithm()
+	s = nil
+	s.GetDigestAlgorithm()
+}
+
+func TestSAMLMetadata_GetEntityID(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{EntityID: &zeroValue}
+	s.GetEntityID()
+	s = &SAMLMetadata{}
+	s.GetEntityID()
+	s = nil
+	s.GetEntityID()
+}
+
+func TestSAMLMetadata_GetMetadataURL(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{MetadataURL: &zeroValue}
+	s.GetMetadataURL()
+	s = &SAMLMetadata{}
+	s.GetMetadataURL()
+	s = nil
+	s.GetMetadataURL()
+}
+
+func TestSAMLMetadata_GetMetadataXML(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{MetadataXML: &zeroValue}
+	s.GetMetadataXML()
+	s = &SAMLMetadata{}
+	s.GetMetadataXML()
+	s = nil
+	s.GetMetadataXML()
+}
+
+func TestSAMLMetadata_GetSignatureAlgorithm(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{SignatureAlgorithm: &zeroValue}
+	s.GetSignatureAlgorithm()
+	s = &SAMLMetadata{}
+	s.GetSignatureAlgorithm()
+	s = nil
+	s.GetSignatureAlgorithm()
+}
+
+func TestSAMLMetadata_GetSignInBinding(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{SignInBinding: &zeroValue}
+	s.GetSignInBinding()
+	s = &SAMLMetadata{}
+	s.GetSignInBinding()
+	s = nil
+	s.GetSignInBinding()
+}
+
+func TestSAMLMetadata_GetSignInEndpoint(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{SignInEndpoint: &zeroValue}
+	s.GetSignInEndpoint()
+	s = &SAMLMetadata{}
+	s.GetSignInEndpoint()
+	s = nil
+	s.GetSignInEndpoint()
+}
+
+func TestSAMLMetadata_GetSignOutBinding(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{SignOutBinding: &zeroValue}
+	s.GetSignOutBinding()
+	s = &SAMLMetadata{}
+	s.GetSignOutBinding()
+	s = nil
+	s.GetSignOutBinding()
+}
+
+func TestSAMLMetadata_GetSignOutEndpoint(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLMetadata{SignOutEndpoint: &zeroValue}
+	s.GetSignOutEndpoint()
+	s = &SAMLMetadata{}
+	s.GetSignOutEndpoint()
+	s = nil
+	s.GetSignOutEndpoint()
+}
+
+func TestSAMLMetadata_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SAMLMetadata{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSAMLServiceProvider_GetConnection(tt *testing.T) {
+	s := &SAMLServiceProvider{}
+	s.GetConnection()
+	s = nil
+	s.GetConnection()
+}
+
+func TestSAMLServiceProvider_GetDomain(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLServiceProvider{Domain: &zeroValue}
+	s.GetDomain()
+	s = &SAMLServiceProvider{}
+	s.GetDomain()
+	s = nil
+	s.GetDomain()
+}
+
+func TestSAMLServiceProvider_GetSigningCert(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLServiceProvider{SigningCert: &zeroValue}
+	s.GetSigningCert()
+	s = &SAMLServiceProvider{}
+	s.GetSigningCert()
+	s = nil
+	s.GetSigningCert()
+}
+
+func TestSAMLServiceProvider_GetTenant(tt *testing.T) {
+	var zeroValue string
+	s := &SAMLServiceProvider{Tenant: &zeroValue}
+	s.GetTenant()
+	s = &SAMLServiceProvider{}
+	s.GetTenant()
+	s = nil
+	s.GetTenant()
+}
+
+func TestSAMLServiceProvider_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SAMLServiceProvider{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestSigningKey_GetCert(tt *testing.T) {
 	var zeroValue string
 	s := &SigningKey{Cert: &zeroValue}
@@ -8842,12 +13787,150 @@ func TestSigningKey_GetThumbprint(tt *testing.T) {
 	s = &SigningKey{}
 	s.GetThumbprint()
 	s = nil
-	s.GetThumbprint()
+	s.GetThumbprint()
+}
+
+func TestSigningKey_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SigningKey{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSigningKey_Clone(t *testing.T) {
+	var v *SigningKey
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &SigningKey{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &SigningKey{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestSigningKeyRotation_GetDryRun(tt *testing.T) {
+	var zeroValue bool
+	s := &SigningKeyRotation{DryRun: &zeroValue}
+	s.GetDryRun()
+	s = &SigningKeyRotation{}
+	s.GetDryRun()
+	s = nil
+	s.GetDryRun()
+}
+
+func TestSigningKeyRotation_GetGracePeriod(tt *testing.T) {
+	var zeroValue time.Duration
+	s := &SigningKeyRotation{GracePeriod: &zeroValue}
+	s.GetGracePeriod()
+	s = &SigningKeyRotation{}
+	s.GetGracePeriod()
+	s = nil
+	s.GetGracePeriod()
+}
+
+func TestSigningKeyRotation_GetKID(tt *testing.T) {
+	var zeroValue string
+	s := &SigningKeyRotation{KID: &zeroValue}
+	s.GetKID()
+	s = &SigningKeyRotation{}
+	s.GetKID()
+	s = nil
+	s.GetKID()
+}
+
+func TestSigningKeyRotation_GetPollInterval(tt *testing.T) {
+	var zeroValue time.Duration
+	s := &SigningKeyRotation{PollInterval: &zeroValue}
+	s.GetPollInterval()
+	s = &SigningKeyRotation{}
+	s.GetPollInterval()
+	s = nil
+	s.GetPollInterval()
+}
+
+func TestSigningKeyRotation_GetPollTimeout(tt *testing.T) {
+	var zeroValue time.Duration
+	s := &SigningKeyRotation{PollTimeout: &zeroValue}
+	s.GetPollTimeout()
+	s = &SigningKeyRotation{}
+	s.GetPollTimeout()
+	s = nil
+	s.GetPollTimeout()
+}
+
+func TestSigningKeyRotation_GetPreviousKID(tt *testing.T) {
+	var zeroValue string
+	s := &SigningKeyRotation{PreviousKID: &zeroValue}
+	s.GetPreviousKID()
+	s = &SigningKeyRotation{}
+	s.GetPreviousKID()
+	s = nil
+	s.GetPreviousKID()
+}
+
+func TestSigningKeyRotation_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &SigningKeyRotation{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestSigningKeyRotationStep_GetDescription(tt *testing.T) {
+	var zeroValue string
+	s := &SigningKeyRotationStep{Description: &zeroValue}
+	s.GetDescription()
+	s = &SigningKeyRotationStep{}
+	s.GetDescription()
+	s = nil
+	s.GetDescription()
+}
+
+func TestSigningKeyRotationStep_GetDone(tt *testing.T) {
+	var zeroValue bool
+	s := &SigningKeyRotationStep{Done: &zeroValue}
+	s.GetDone()
+	s = &SigningKeyRotationStep{}
+	s.GetDone()
+	s = nil
+	s.GetDone()
+}
+
+func TestSigningKeyRotationStep_GetKID(tt *testing.T) {
+	var zeroValue string
+	s := &SigningKeyRotationStep{KID: &zeroValue}
+	s.GetKID()
+	s = &SigningKeyRotationStep{}
+	s.GetKID()
+	s = nil
+	s.GetKID()
+}
+
+func TestSigningKeyRotationStep_GetName(tt *testing.T) {
+	var zeroValue string
+	s := &SigningKeyRotationStep{Name: &zeroValue}
+	s.GetName()
+	s = &SigningKeyRotationStep{}
+	s.GetName()
+	s = nil
+	s.GetName()
 }
 
-func TestSigningKey_String(t *testing.T) {
+func TestSigningKeyRotationStep_String(t *testing.T) {
 	var rawJSON json.RawMessage
-	v := &SigningKey{}
+	v := &SigningKeyRotationStep{}
 	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
 		t.Errorf("failed to produce a valid json")
 	}
@@ -8875,6 +13958,28 @@ func TestStage_String(t *testing.T) {
 	}
 }
 
+func TestStage_Clone(t *testing.T) {
+	var v *Stage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Stage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Stage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestSuspiciousIPThrottling_GetAllowList(tt *testing.T) {
 	var zeroValue []string
 	s := &SuspiciousIPThrottling{AllowList: &zeroValue}
@@ -8920,6 +14025,28 @@ func TestSuspiciousIPThrottling_String(t *testing.T) {
 	}
 }
 
+func TestSuspiciousIPThrottling_Clone(t *testing.T) {
+	var v *SuspiciousIPThrottling
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &SuspiciousIPThrottling{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &SuspiciousIPThrottling{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenant_GetAllowedLogoutURLs(tt *testing.T) {
 	var zeroValue []string
 	t := &Tenant{AllowedLogoutURLs: &zeroValue}
@@ -9107,6 +14234,28 @@ func TestTenant_String(t *testing.T) {
 	}
 }
 
+func TestTenant_Clone(t *testing.T) {
+	var v *Tenant
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Tenant{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Tenant{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantChangePassword_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	t := &TenantChangePassword{Enabled: &zeroValue}
@@ -9135,6 +14284,28 @@ func TestTenantChangePassword_String(t *testing.T) {
 	}
 }
 
+func TestTenantChangePassword_Clone(t *testing.T) {
+	var v *TenantChangePassword
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantChangePassword{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantChangePassword{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantDeviceFlow_GetCharset(tt *testing.T) {
 	var zeroValue string
 	t := &TenantDeviceFlow{Charset: &zeroValue}
@@ -9163,6 +14334,28 @@ func TestTenantDeviceFlow_String(t *testing.T) {
 	}
 }
 
+func TestTenantDeviceFlow_Clone(t *testing.T) {
+	var v *TenantDeviceFlow
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantDeviceFlow{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantDeviceFlow{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantErrorPage_GetHTML(tt *testing.T) {
 	var zeroValue string
 	t := &TenantErrorPage{HTML: &zeroValue}
@@ -9201,6 +14394,28 @@ func TestTenantErrorPage_String(t *testing.T) {
 	}
 }
 
+func TestTenantErrorPage_Clone(t *testing.T) {
+	var v *TenantErrorPage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantErrorPage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantErrorPage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantFlags_GetAllowChangingEnableSSO(tt *testing.T) {
 	var zeroValue bool
 	t := &TenantFlags{AllowChangingEnableSSO: &zeroValue}
@@ -9459,6 +14674,28 @@ func TestTenantFlags_String(t *testing.T) {
 	}
 }
 
+func TestTenantFlags_Clone(t *testing.T) {
+	var v *TenantFlags
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantFlags{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantFlags{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantGuardianMFAPage_GetEnabled(tt *testing.T) {
 	var zeroValue bool
 	t := &TenantGuardianMFAPage{Enabled: &zeroValue}
@@ -9487,6 +14724,28 @@ func TestTenantGuardianMFAPage_String(t *testing.T) {
 	}
 }
 
+func TestTenantGuardianMFAPage_Clone(t *testing.T) {
+	var v *TenantGuardianMFAPage
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantGuardianMFAPage{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantGuardianMFAPage{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantSessionCookie_GetMode(tt *testing.T) {
 	var zeroValue string
 	t := &TenantSessionCookie{Mode: &zeroValue}
@@ -9505,6 +14764,28 @@ func TestTenantSessionCookie_String(t *testing.T) {
 	}
 }
 
+func TestTenantSessionCookie_Clone(t *testing.T) {
+	var v *TenantSessionCookie
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantSessionCookie{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantSessionCookie{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantUniversalLogin_GetColors(tt *testing.T) {
 	t := &TenantUniversalLogin{}
 	t.GetColors()
@@ -9520,6 +14801,28 @@ func TestTenantUniversalLogin_String(t *testing.T) {
 	}
 }
 
+func TestTenantUniversalLogin_Clone(t *testing.T) {
+	var v *TenantUniversalLogin
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantUniversalLogin{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantUniversalLogin{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTenantUniversalLoginColors_GetPageBackground(tt *testing.T) {
 	var zeroValue string
 	t := &TenantUniversalLoginColors{PageBackground: &zeroValue}
@@ -9555,6 +14858,28 @@ func TestTenantUniversalLoginColors_String(t *testing.T) {
 	}
 }
 
+func TestTenantUniversalLoginColors_Clone(t *testing.T) {
+	var v *TenantUniversalLoginColors
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &TenantUniversalLoginColors{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &TenantUniversalLoginColors{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestTicket_GetClientID(tt *testing.T) {
 	var zeroValue string
 	t := &Ticket{ClientID: &zeroValue}
@@ -9653,6 +14978,56 @@ func TestTicket_String(t *testing.T) {
 	}
 }
 
+func TestTicket_Clone(t *testing.T) {
+	var v *Ticket
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &Ticket{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &Ticket{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestUpstreamParam_GetAlias(tt *testing.T) {
+	var zeroValue string
+	u := &UpstreamParam{Alias: &zeroValue}
+	u.GetAlias()
+	u = &UpstreamParam{}
+	u.GetAlias()
+	u = nil
+	u.GetAlias()
+}
+
+func TestUpstreamParam_GetValue(tt *testing.T) {
+	var zeroValue string
+	u := &UpstreamParam{Value: &zeroValue}
+	u.GetValue()
+	u = &UpstreamParam{}
+	u.GetValue()
+	u = nil
+	u.GetValue()
+}
+
+func TestUpstreamParam_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UpstreamParam{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestUser_GetBlocked(tt *testing.T) {
 	var zeroValue bool
 	u := &User{Blocked: &zeroValue}
@@ -9931,6 +15306,28 @@ func TestUser_String(t *testing.T) {
 	}
 }
 
+func TestUser_Clone(t *testing.T) {
+	var v *User
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &User{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &User{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestUserBlock_GetIdentifier(tt *testing.T) {
 	var zeroValue string
 	u := &UserBlock{Identifier: &zeroValue}
@@ -9959,6 +15356,149 @@ func TestUserBlock_String(t *testing.T) {
 	}
 }
 
+func TestUserBlock_Clone(t *testing.T) {
+	var v *UserBlock
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &UserBlock{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &UserBlock{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestUserDataExport_GetExportedAt(tt *testing.T) {
+	var zeroValue time.Time
+	u := &UserDataExport{ExportedAt: &zeroValue}
+	u.GetExportedAt()
+	u = &UserDataExport{}
+	u.GetExportedAt()
+	u = nil
+	u.GetExportedAt()
+}
+
+func TestUserDataExport_GetUser(tt *testing.T) {
+	u := &UserDataExport{}
+	u.GetUser()
+	u = nil
+	u.GetUser()
+}
+
+func TestUserDataExport_GetVersion(tt *testing.T) {
+	var zeroValue int
+	u := &UserDataExport{Version: &zeroValue}
+	u.GetVersion()
+	u = &UserDataExport{}
+	u.GetVersion()
+	u = nil
+	u.GetVersion()
+}
+
+func TestUserDataExport_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserDataExport{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestUserDuplicateGroup_GetApproved(tt *testing.T) {
+	var zeroValue bool
+	u := &UserDuplicateGroup{Approved: &zeroValue}
+	u.GetApproved()
+	u = &UserDuplicateGroup{}
+	u.GetApproved()
+	u = nil
+	u.GetApproved()
+}
+
+func TestUserDuplicateGroup_GetEmail(tt *testing.T) {
+	var zeroValue string
+	u := &UserDuplicateGroup{Email: &zeroValue}
+	u.GetEmail()
+	u = &UserDuplicateGroup{}
+	u.GetEmail()
+	u = nil
+	u.GetEmail()
+}
+
+func TestUserDuplicateGroup_GetPrimaryID(tt *testing.T) {
+	var zeroValue string
+	u := &UserDuplicateGroup{PrimaryID: &zeroValue}
+	u.GetPrimaryID()
+	u = &UserDuplicateGroup{}
+	u.GetPrimaryID()
+	u = nil
+	u.GetPrimaryID()
+}
+
+func TestUserDuplicateGroup_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserDuplicateGroup{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestUserDuplicateOptions_GetAppMetadataStrategy(tt *testing.T) {
+	var zeroValue string
+	u := &UserDuplicateOptions{AppMetadataStrategy: &zeroValue}
+	u.GetAppMetadataStrategy()
+	u = &UserDuplicateOptions{}
+	u.GetAppMetadataStrategy()
+	u = nil
+	u.GetAppMetadataStrategy()
+}
+
+func TestUserDuplicateOptions_GetIncludeUnverified(tt *testing.T) {
+	var zeroValue bool
+	u := &UserDuplicateOptions{IncludeUnverified: &zeroValue}
+	u.GetIncludeUnverified()
+	u = &UserDuplicateOptions{}
+	u.GetIncludeUnverified()
+	u = nil
+	u.GetIncludeUnverified()
+}
+
+func TestUserDuplicateOptions_GetPrimaryRules(tt *testing.T) {
+	var zeroValue []string
+	u := &UserDuplicateOptions{PrimaryRules: &zeroValue}
+	u.GetPrimaryRules()
+	u = &UserDuplicateOptions{}
+	u.GetPrimaryRules()
+	u = nil
+	u.GetPrimaryRules()
+}
+
+func TestUserDuplicateOptions_GetUserMetadataStrategy(tt *testing.T) {
+	var zeroValue string
+	u := &UserDuplicateOptions{UserMetadataStrategy: &zeroValue}
+	u.GetUserMetadataStrategy()
+	u = &UserDuplicateOptions{}
+	u.GetUserMetadataStrategy()
+	u = nil
+	u.GetUserMetadataStrategy()
+}
+
+func TestUserDuplicateOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserDuplicateOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestUserEnrollment_GetAuthMethod(tt *testing.T) {
 	var zeroValue string
 	u := &UserEnrollment{AuthMethod: &zeroValue}
@@ -10057,6 +15597,124 @@ func TestUserEnrollment_String(t *testing.T) {
 	}
 }
 
+func TestUserEnrollment_Clone(t *testing.T) {
+	var v *UserEnrollment
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &UserEnrollment{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &UserEnrollment{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestUserErasureReceipt_GetCompletedAt(tt *testing.T) {
+	var zeroValue time.Time
+	u := &UserErasureReceipt{CompletedAt: &zeroValue}
+	u.GetCompletedAt()
+	u = &UserErasureReceipt{}
+	u.GetCompletedAt()
+	u = nil
+	u.GetCompletedAt()
+}
+
+func TestUserErasureReceipt_GetLeftovers(tt *testing.T) {
+	var zeroValue []string
+	u := &UserErasureReceipt{Leftovers: &zeroValue}
+	u.GetLeftovers()
+	u = &UserErasureReceipt{}
+	u.GetLeftovers()
+	u = nil
+	u.GetLeftovers()
+}
+
+func TestUserErasureReceipt_GetStartedAt(tt *testing.T) {
+	var zeroValue time.Time
+	u := &UserErasureReceipt{StartedAt: &zeroValue}
+	u.GetStartedAt()
+	u = &UserErasureReceipt{}
+	u.GetStartedAt()
+	u = nil
+	u.GetStartedAt()
+}
+
+func TestUserErasureReceipt_GetUserID(tt *testing.T) {
+	var zeroValue string
+	u := &UserErasureReceipt{UserID: &zeroValue}
+	u.GetUserID()
+	u = &UserErasureReceipt{}
+	u.GetUserID()
+	u = nil
+	u.GetUserID()
+}
+
+func TestUserErasureReceipt_GetVerified(tt *testing.T) {
+	var zeroValue bool
+	u := &UserErasureReceipt{Verified: &zeroValue}
+	u.GetVerified()
+	u = &UserErasureReceipt{}
+	u.GetVerified()
+	u = nil
+	u.GetVerified()
+}
+
+func TestUserErasureReceipt_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserErasureReceipt{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
+func TestUserErasureStep_GetError(tt *testing.T) {
+	var zeroValue string
+	u := &UserErasureStep{Error: &zeroValue}
+	u.GetError()
+	u = &UserErasureStep{}
+	u.GetError()
+	u = nil
+	u.GetError()
+}
+
+func TestUserErasureStep_GetName(tt *testing.T) {
+	var zeroValue string
+	u := &UserErasureStep{Name: &zeroValue}
+	u.GetName()
+	u = &UserErasureStep{}
+	u.GetName()
+	u = nil
+	u.GetName()
+}
+
+func TestUserErasureStep_GetRemoved(tt *testing.T) {
+	var zeroValue []string
+	u := &UserErasureStep{Removed: &zeroValue}
+	u.GetRemoved()
+	u = &UserErasureStep{}
+	u.GetRemoved()
+	u = nil
+	u.GetRemoved()
+}
+
+func TestUserErasureStep_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserErasureStep{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
+
 func TestUserIdentity_GetAccessToken(tt *testing.T) {
 	var zeroValue string
 	u := &UserIdentity{AccessToken: &zeroValue}
@@ -10135,6 +15793,28 @@ func TestUserIdentity_String(t *testing.T) {
 	}
 }
 
+func TestUserIdentity_Clone(t *testing.T) {
+	var v *UserIdentity
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &UserIdentity{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &UserIdentity{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestUserIdentityLink_GetConnectionID(tt *testing.T) {
 	var zeroValue string
 	u := &UserIdentityLink{ConnectionID: &zeroValue}
@@ -10183,6 +15863,28 @@ func TestUserIdentityLink_String(t *testing.T) {
 	}
 }
 
+func TestUserIdentityLink_Clone(t *testing.T) {
+	var v *UserIdentityLink
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &UserIdentityLink{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &UserIdentityLink{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
 func TestUserList_String(t *testing.T) {
 	var rawJSON json.RawMessage
 	v := &UserList{}
@@ -10208,3 +15910,73 @@ func TestUserRecoveryCode_String(t *testing.T) {
 		t.Errorf("failed to produce a valid json")
 	}
 }
+
+func TestUserRecoveryCode_Clone(t *testing.T) {
+	var v *UserRecoveryCode
+	if v.Clone() != nil {
+		t.Errorf("expected the clone of nil to be nil")
+	}
+	v = &UserRecoveryCode{}
+	clone := v.Clone()
+	if clone == v {
+		t.Errorf("expected the clone to be a new value")
+	}
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	v = &UserRecoveryCode{}
+	populate(v)
+	clone = v.Clone()
+	if !v.Equal(clone) {
+		t.Errorf("expected the clone to be equal, got changes %v", v.Diff(clone))
+	}
+	assertNotAliased(t, v, clone)
+}
+
+func TestUserSearchOptions_GetExportFields(tt *testing.T) {
+	var zeroValue []string
+	u := &UserSearchOptions{ExportFields: &zeroValue}
+	u.GetExportFields()
+	u = &UserSearchOptions{}
+	u.GetExportFields()
+	u = nil
+	u.GetExportFields()
+}
+
+func TestUserSearchOptions_GetPollInterval(tt *testing.T) {
+	var zeroValue time.Duration
+	u := &UserSearchOptions{PollInterval: &zeroValue}
+	u.GetPollInterval()
+	u = &UserSearchOptions{}
+	u.GetPollInterval()
+	u = nil
+	u.GetPollInterval()
+}
+
+func TestUserSearchOptions_GetPollTimeout(tt *testing.T) {
+	var zeroValue time.Duration
+	u := &UserSearchOptions{PollTimeout: &zeroValue}
+	u.GetPollTimeout()
+	u = &UserSearchOptions{}
+	u.GetPollTimeout()
+	u = nil
+	u.GetPollTimeout()
+}
+
+func TestUserSearchOptions_GetWindow(tt *testing.T) {
+	var zeroValue int
+	u := &UserSearchOptions{Window: &zeroValue}
+	u.GetWindow()
+	u = &UserSearchOptions{}
+	u.GetWindow()
+	u = nil
+	u.GetWindow()
+}
+
+func TestUserSearchOptions_String(t *testing.T) {
+	var rawJSON json.RawMessage
+	v := &UserSearchOptions{}
+	if err := json.Unmarshal([]byte(v.String()), &rawJSON); err != nil {
+		t.Errorf("failed to produce a valid json")
+	}
+}
@@ -0,0 +1,245 @@
+package management
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// FieldChange describes a single field that differs between two values of
+// the same resource type, as reported by the generated Diff methods.
+type FieldChange struct {
+	// Path to the field, made up of the JSON names of the fields leading to it
+	// separated by dots. For example "jwt_configuration.alg" or
+	// "user_metadata.color".
+	Path string `json:"path"`
+
+	// Old is the value of the field before the change or nil if it was unset.
+	Old interface{} `json:"old"`
+
+	// New is the value of the field after the change or nil if it is unset.
+	New interface{} `json:"new"`
+}
+
+// cloner is implemented by every struct that has generated Clone methods, so
+// that values stored in interface{} fields can be deep copied as well.
+type cloner interface {
+	cloneAny() interface{}
+}
+
+// differ is implemented by every struct that has generated Diff methods, so
+// that values stored in interface{} fields can be compared field by field.
+type differ interface {
+	diffAny(prefix string, other interface{}) ([]FieldChange, bool)
+}
+
+func clonePointer[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+func clonePointerFunc[T any](p *T, clone func(T) T) *T {
+	if p == nil {
+		return nil
+	}
+	v := clone(*p)
+	return &v
+}
+
+func cloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	c := make([]T, len(s))
+	copy(c, s)
+	return c
+}
+
+func cloneSliceFunc[T any](s []T, clone func(T) T) []T {
+	if s == nil {
+		return nil
+	}
+	c := make([]T, len(s))
+	for i, v := range s {
+		c[i] = clone(v)
+	}
+	return c
+}
+
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func cloneMapFunc[K comparable, V any](m map[K]V, clone func(V) V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = clone(v)
+	}
+	return c
+}
+
+// cloneValue deep copies a value held by an interface{} field. Objects and
+// arrays decoded from JSON are copied recursively, as is any struct with a
+// generated Clone method. Everything else is treated as immutable.
+func cloneValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return cloneMapFunc(v, cloneValue)
+	case []interface{}:
+		return cloneSliceFunc(v, cloneValue)
+	case map[string]string:
+		return cloneMap(v)
+	case []string:
+		return cloneSlice(v)
+	case cloner:
+		return v.cloneAny()
+	default:
+		return v
+	}
+}
+
+func diffComparable[T comparable](changes []FieldChange, path string, a, b T) []FieldChange {
+	if a != b {
+		changes = append(changes, FieldChange{Path: path, Old: a, New: b})
+	}
+	return changes
+}
+
+func diffPointer[T comparable](changes []FieldChange, path string, a, b *T) []FieldChange {
+	switch {
+	case a == nil && b == nil:
+	case a == nil:
+		changes = append(changes, FieldChange{Path: path, New: *b})
+	case b == nil:
+		changes = append(changes, FieldChange{Path: path, Old: *a})
+	case *a != *b:
+		changes = append(changes, FieldChange{Path: path, Old: *a, New: *b})
+	}
+	return changes
+}
+
+func diffTime(changes []FieldChange, path string, a, b *time.Time) []FieldChange {
+	switch {
+	case a == nil && b == nil:
+	case a == nil:
+		changes = append(changes, FieldChange{Path: path, New: *b})
+	case b == nil:
+		changes = append(changes, FieldChange{Path: path, Old: *a})
+	case !a.Equal(*b):
+		changes = append(changes, FieldChange{Path: path, Old: *a, New: *b})
+	}
+	return changes
+}
+
+// diffSlice compares slices as a whole. Nil and empty slices are considered
+// equal, as neither of them is sent to the API.
+func diffSlice[T any](changes []FieldChange, path string, a, b []T) []FieldChange {
+	if len(a) == 0 && len(b) == 0 {
+		return changes
+	}
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, FieldChange{Path: path, Old: nilIfEmpty(a), New: nilIfEmpty(b)})
+	}
+	return changes
+}
+
+func diffSlicePointer[T any](changes []FieldChange, path string, a, b *[]T) []FieldChange {
+	switch {
+	case a == nil && b == nil:
+	case a == nil:
+		changes = append(changes, FieldChange{Path: path, New: *b})
+	case b == nil:
+		changes = append(changes, FieldChange{Path: path, Old: *a})
+	case !reflect.DeepEqual(*a, *b):
+		changes = append(changes, FieldChange{Path: path, Old: *a, New: *b})
+	}
+	return changes
+}
+
+// diffMap compares maps key by key. Nil and empty maps are considered equal.
+func diffMap[V any](changes []FieldChange, path string, a, b map[string]V) []FieldChange {
+	keys := make(map[string]bool, len(a)+len(b))
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+
+	sortedKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	for _, k := range sortedKeys {
+		av, inA := a[k]
+		bv, inB := b[k]
+		switch {
+		case !inA:
+			changes = append(changes, FieldChange{Path: path + "." + k, New: bv})
+		case !inB:
+			changes = append(changes, FieldChange{Path: path + "." + k, Old: av})
+		default:
+			changes = diffValue(changes, path+"."+k, av, bv)
+		}
+	}
+
+	return changes
+}
+
+func diffMapPointer[V any](changes []FieldChange, path string, a, b *map[string]V) []FieldChange {
+	switch {
+	case a == nil && b == nil:
+	case a == nil:
+		changes = append(changes, FieldChange{Path: path, New: *b})
+	case b == nil:
+		changes = append(changes, FieldChange{Path: path, Old: *a})
+	default:
+		changes = diffMap(changes, path, *a, *b)
+	}
+	return changes
+}
+
+// diffValue compares values held by interface{} fields. Objects decoded from
+// JSON and structs with generated Diff methods are compared field by field,
+// everything else is compared as a whole.
+func diffValue(changes []FieldChange, path string, a, b interface{}) []FieldChange {
+	if am, ok := a.(map[string]interface{}); ok {
+		if bm, ok := b.(map[string]interface{}); ok {
+			return diffMap(changes, path, am, bm)
+		}
+	}
+
+	if ad, ok := a.(differ); ok {
+		if fieldChanges, ok := ad.diffAny(path+".", b); ok {
+			return append(changes, fieldChanges...)
+		}
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		changes = append(changes, FieldChange{Path: path, Old: a, New: b})
+	}
+
+	return changes
+}
+
+func nilIfEmpty[T any](s []T) interface{} {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
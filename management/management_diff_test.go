@@ -0,0 +1,375 @@
+package management
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/authok/authok-go"
+)
+
+func TestClient_Diff(t *testing.T) {
+	before := &Client{
+		Name:      authok.String("Test Client"),
+		Callbacks: &[]string{"https://example.com/callback"},
+		JWTConfiguration: &ClientJWTConfiguration{
+			Algorithm:         authok.String("HS256"),
+			LifetimeInSeconds: authok.Int(3600),
+		},
+		ClientMetadata: &map[string]interface{}{
+			"owner": "team-a",
+			"tier":  "gold",
+		},
+	}
+
+	after := before.Clone()
+	after.Callbacks = &[]string{"https://example.com/callback", "https://example.com/other"}
+	after.JWTConfiguration.Algorithm = authok.String("RS256")
+	after.JWTConfiguration.LifetimeInSeconds = nil
+	(*after.ClientMetadata)["owner"] = "team-b"
+	delete(*after.ClientMetadata, "tier")
+	after.Description = authok.String("A description")
+
+	assert.False(t, before.Equal(after))
+	assert.Equal(t, []FieldChange{
+		{Path: "description", New: "A description"},
+		{
+			Path: "callbacks",
+			Old:  []string{"https://example.com/callback"},
+			New:  []string{"https://example.com/callback", "https://example.com/other"},
+		},
+		{Path: "jwt_configuration.lifetime_in_seconds", Old: 3600},
+		{Path: "jwt_configuration.alg", Old: "HS256", New: "RS256"},
+		{Path: "client_metadata.owner", Old: "team-a", New: "team-b"},
+		{Path: "client_metadata.tier", Old: "gold"},
+	}, before.Diff(after))
+}
+
+func TestClient_CloneIsDeep(t *testing.T) {
+	original := &Client{
+		Name:           authok.String("Test Client"),
+		Callbacks:      &[]string{"https://example.com/callback"},
+		SigningKeys:    []map[string]string{{"cert": "abc"}},
+		ClientMetadata: &map[string]interface{}{"nested": map[string]interface{}{"key": "value"}},
+		JWTConfiguration: &ClientJWTConfiguration{
+			Scopes: &map[string]string{"read": "Read"},
+		},
+	}
+
+	clone := original.Clone()
+	assert.True(t, original.Equal(clone))
+
+	*clone.Name = "Changed"
+	(*clone.Callbacks)[0] = "https://changed.com"
+	clone.SigningKeys[0]["cert"] = "changed"
+	(*clone.ClientMetadata)["nested"].(map[string]interface{})["key"] = "changed"
+	(*clone.JWTConfiguration.Scopes)["read"] = "changed"
+
+	assert.Equal(t, "Test Client", original.GetName())
+	assert.Equal(t, []string{"https://example.com/callback"}, original.GetCallbacks())
+	assert.Equal(t, "abc", original.SigningKeys[0]["cert"])
+	assert.Equal(t, "value", (*original.ClientMetadata)["nested"].(map[string]interface{})["key"])
+	assert.Equal(t, "Read", original.JWTConfiguration.GetScopes()["read"])
+}
+
+func TestConnection_Diff(t *testing.T) {
+	before := &Connection{
+		Name:     authok.String("Username-Password"),
+		Strategy: authok.String("authok"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:       authok.String("fair"),
+			BruteForceProtection: authok.Bool(true),
+		},
+	}
+
+	t.Run("Compares typed options field by field", func(t *testing.T) {
+		after := before.Clone()
+		after.Options.(*ConnectionOptions).PasswordPolicy = authok.String("good")
+
+		assert.NotSame(t, before.Options, after.Options)
+		assert.Equal(t, []FieldChange{
+			{Path: "options.passwordPolicy", Old: "fair", New: "good"},
+		}, before.Diff(after))
+	})
+
+	t.Run("Compares options of different types as a whole", func(t *testing.T) {
+		after := before.Clone()
+		after.Options = &ConnectionOptionsGoogleOAuth2{}
+
+		changes := before.Diff(after)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, "options", changes[0].Path)
+	})
+}
+
+func TestUser_Diff(t *testing.T) {
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	before := &User{
+		ID:        authok.String("authok|123"),
+		CreatedAt: &createdAt,
+		UserMetadata: &map[string]interface{}{
+			"preferences": map[string]interface{}{"theme": "light"},
+		},
+	}
+	after := before.Clone()
+	after.CreatedAt = authok.Time(createdAt.In(time.FixedZone("CET", 3600)))
+	(*after.UserMetadata)["preferences"].(map[string]interface{})["theme"] = "dark"
+
+	assert.Equal(t, []FieldChange{
+		{Path: "user_metadata.preferences.theme", Old: "light", New: "dark"},
+	}, before.Diff(after))
+}
+
+func TestDiff_NilValues(t *testing.T) {
+	var nilRole *Role
+
+	assert.True(t, nilRole.Equal(&Role{}))
+	assert.True(t, (&Role{}).Equal(nil))
+	assert.Equal(t, []FieldChange{
+		{Path: "name", New: "admin"},
+	}, nilRole.Diff(&Role{Name: authok.String("admin")}))
+	assert.Equal(t, []FieldChange{
+		{Path: "name", Old: "admin"},
+	}, (&Role{Name: authok.String("admin")}).Diff(nil))
+}
+
+func TestClone_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	newUser := func() *User {
+		return &User{
+			ID:        authok.String("authok|123"),
+			Email:     authok.String("jane@example.com"),
+			CreatedAt: authok.Time(createdAt),
+			Identities: []*UserIdentity{
+				{Connection: authok.String("Username-Password-Authentication"), UserID: authok.String("123")},
+			},
+			AppMetadata: &map[string]interface{}{
+				"plan":   "free",
+				"limits": map[string]interface{}{"seats": 5.0},
+			},
+		}
+	}
+	newOrganization := func() *Organization {
+		return &Organization{
+			Name: authok.String("acme"),
+			Branding: &OrganizationBranding{
+				LogoURL: authok.String("https://acme.com/logo.png"),
+				Colors:  &map[string]string{"primary": "#000000"},
+			},
+		}
+	}
+	newResourceServer := func() *ResourceServer {
+		return &ResourceServer{
+			Identifier: authok.String("https://api"),
+			Scopes: &[]ResourceServerScope{
+				{Value: authok.String("read:items"), Description: authok.String("Read items")},
+			},
+		}
+	}
+
+	t.Run("Pointer", func(t *testing.T) {
+		original := newUser()
+		clone := original.Clone()
+		assert.True(t, original.Equal(clone))
+
+		*clone.Email = "john@example.com"
+
+		assert.Equal(t, newUser(), original)
+		assert.Equal(t, []FieldChange{
+			{Path: "email", Old: "jane@example.com", New: "john@example.com"},
+		}, original.Diff(clone))
+	})
+
+	t.Run("Time", func(t *testing.T) {
+		original := newUser()
+		clone := original.Clone()
+		assert.True(t, original.Equal(clone))
+
+		*clone.CreatedAt = createdAt.Add(time.Hour)
+
+		assert.Equal(t, newUser(), original)
+		assert.Equal(t, []FieldChange{
+			{Path: "created_at", Old: createdAt, New: createdAt.Add(time.Hour)},
+		}, original.Diff(clone))
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		original := newUser()
+		clone := original.Clone()
+		assert.True(t, original.Equal(clone))
+
+		clone.Identities[0].UserID = authok.String("456")
+
+		assert.Equal(t, newUser(), original)
+		changes := original.Diff(clone)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, "identities", changes[0].Path)
+		assert.Equal(t, original.Identities, changes[0].Old)
+		assert.Equal(t, clone.Identities, changes[0].New)
+
+		resourceServer := newResourceServer()
+		resourceServerClone := resourceServer.Clone()
+		(*resourceServerClone.Scopes)[0].Description = authok.String("Read all items")
+
+		assert.Equal(t, newResourceServer(), resourceServer)
+		assert.Equal(t, []FieldChange{
+			{Path: "scopes", Old: *resourceServer.Scopes, New: *resourceServerClone.Scopes},
+		}, resourceServer.Diff(resourceServerClone))
+	})
+
+	t.Run("Map", func(t *testing.T) {
+		original := newUser()
+		clone := original.Clone()
+		assert.True(t, original.Equal(clone))
+
+		(*clone.AppMetadata)["limits"].(map[string]interface{})["seats"] = 10.0
+		delete(*clone.AppMetadata, "plan")
+
+		assert.Equal(t, newUser(), original)
+		assert.Equal(t, []FieldChange{
+			{Path: "app_metadata.limits.seats", Old: 5.0, New: 10.0},
+			{Path: "app_metadata.plan", Old: "free"},
+		}, original.Diff(clone))
+	})
+
+	t.Run("Nested struct", func(t *testing.T) {
+		original := newOrganization()
+		clone := original.Clone()
+		assert.True(t, original.Equal(clone))
+		assert.NotSame(t, original.Branding, clone.Branding)
+
+		clone.Branding.LogoURL = nil
+		(*clone.Branding.Colors)["primary"] = "#ffffff"
+
+		assert.Equal(t, newOrganization(), original)
+		assert.Equal(t, []FieldChange{
+			{Path: "branding.logo_url", Old: "https://acme.com/logo.png"},
+			{Path: "branding.colors.primary", Old: "#000000", New: "#ffffff"},
+		}, original.Diff(clone))
+	})
+}
+
+// populate sets every exported field reachable from v, which must be a
+// pointer, to a non-zero value so that clones can be checked for aliasing.
+// Slices and maps get a single element and interface{} fields a JSON object.
+func populate(v interface{}) {
+	populateValue(reflect.ValueOf(v).Elem(), 0)
+}
+
+func populateValue(v reflect.Value, depth int) {
+	// Recursive types are populated a few levels deep only.
+	if depth > 4 {
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if isManagementClient(v.Type()) {
+			return
+		}
+		v.Set(reflect.New(v.Type().Elem()))
+		populateValue(v.Elem(), depth+1)
+	case reflect.Struct:
+		if v.Type() == reflect.TypeOf(time.Time{}) {
+			v.Set(reflect.ValueOf(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				populateValue(v.Field(i), depth+1)
+			}
+		}
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 1, 1))
+		populateValue(v.Index(0), depth+1)
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		populateValue(key, depth+1)
+		value := reflect.New(v.Type().Elem()).Elem()
+		populateValue(value, depth+1)
+		v.SetMapIndex(key, value)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(map[string]interface{}{"key": []interface{}{"value"}}))
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1)
+	}
+}
+
+// assertNotAliased checks that a clone shares no pointers, slices or maps
+// with the original, so that changing one leaves the other untouched.
+func assertNotAliased(t *testing.T, original, clone interface{}) {
+	t.Helper()
+	for _, path := range aliasedPaths(reflect.ValueOf(original), reflect.ValueOf(clone), "") {
+		t.Errorf("expected the clone not to share %s with the original", path)
+	}
+}
+
+func aliasedPaths(a, b reflect.Value, path string) []string {
+	var paths []string
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() || isManagementClient(a.Type()) {
+			return nil
+		}
+		if a.Pointer() == b.Pointer() {
+			return []string{path}
+		}
+		paths = aliasedPaths(a.Elem(), b.Elem(), path)
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return nil
+		}
+		paths = aliasedPaths(a.Elem(), b.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if field := a.Type().Field(i); field.IsExported() {
+				paths = append(paths, aliasedPaths(a.Field(i), b.Field(i), path+"."+field.Name)...)
+			}
+		}
+	case reflect.Slice:
+		if a.Len() == 0 || b.Len() == 0 {
+			return nil
+		}
+		if a.Pointer() == b.Pointer() {
+			return []string{path}
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			paths = append(paths, aliasedPaths(a.Index(i), b.Index(i), path+"[]")...)
+		}
+	case reflect.Map:
+		if a.IsNil() || b.IsNil() {
+			return nil
+		}
+		if a.Pointer() == b.Pointer() {
+			return []string{path}
+		}
+		for _, key := range a.MapKeys() {
+			if value := b.MapIndex(key); value.IsValid() {
+				paths = append(paths, aliasedPaths(a.MapIndex(key), value, path+"[]")...)
+			}
+		}
+	}
+	return paths
+}
+
+// isManagementClient reports whether t is a client embedded in a resource,
+// which clones share rather than copy.
+func isManagementClient(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name() == "Management" || strings.HasSuffix(t.Name(), "Manager")
+}
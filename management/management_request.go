@@ -157,6 +157,12 @@ func (o *requestOption) apply(r *http.Request) {
 	o.applyFn(r)
 }
 
+// withOptions returns opts followed by more in a new slice, so that requests
+// built from the same options never write to a shared backing array.
+func withOptions(opts []RequestOption, more ...RequestOption) []RequestOption {
+	return append(append(make([]RequestOption, 0, len(opts)+len(more)), opts...), more...)
+}
+
 func applyListDefaults(options []RequestOption) RequestOption {
 	return newRequestOption(func(r *http.Request) {
 		PerPage(50).apply(r)
@@ -249,6 +255,8 @@ func Take(items int) RequestOption {
 //	List(Query(`logins_count:[100 TO 200}`))
 //	List(Query(`logins_count:{100 TO *]`))
 //
+// Queries can also be built with SearchQuery, which escapes the values.
+//
 // See: https://authok.com/docs/users/search/v3/query-syntax
 func Query(s string) RequestOption {
 	return newRequestOption(func(r *http.Request) {
@@ -0,0 +1,129 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Fields that are returned when reading a resource but which are rejected by
+// the Management API when sent as part of an update, either because they are
+// read only or because they can only be set when the resource is created.
+var (
+	clientReadOnlyFields         = fieldSet("client_id", "client_secret", "signing_keys")
+	connectionReadOnlyFields     = fieldSet("id", "name", "strategy", "provisioning_ticket_url")
+	resourceServerReadOnlyFields = fieldSet("id", "identifier")
+)
+
+func fieldSet(fields ...string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[field] = true
+	}
+	return set
+}
+
+// partialUpdate is the payload of an update that only holds the fields which
+// changed. The response is decoded into result.
+type partialUpdate struct {
+	body   map[string]json.RawMessage
+	result interface{}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (p *partialUpdate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.body)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (p *partialUpdate) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, p.result)
+}
+
+// newPartialUpdate builds the body of an update from the changes between two
+// values of a resource.
+//
+// The Management API replaces nested objects as a whole, so every top level
+// field that contains a change is sent in full, as found in after. Fields that
+// were unset are sent as null and read only fields are left out. If nothing
+// is left to send, nil is returned.
+func newPartialUpdate(changes []FieldChange, after interface{}, readOnly map[string]bool) (*partialUpdate, error) {
+	fields := make(map[string]bool)
+	for _, change := range changes {
+		field := strings.SplitN(change.Path, ".", 2)[0]
+		if !readOnly[field] {
+			fields[field] = true
+		}
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(after)
+	if err != nil {
+		return nil, fmt.Errorf("encoding update payload failed: %w", err)
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("decoding update payload failed: %w", err)
+	}
+
+	body := make(map[string]json.RawMessage, len(fields))
+	for field := range fields {
+		value, ok := values[field]
+		if !ok {
+			value = json.RawMessage("null")
+		}
+		body[field] = value
+	}
+
+	return &partialUpdate{body: body, result: after}, nil
+}
+
+// UpdateChanged updates a client by sending only the fields that differ
+// between before and after, leaving out those that can't be updated such as
+// the client_id and client_secret. If nothing changed no request is made.
+//
+// The response is decoded into after.
+//
+// See: https://authok.com/docs/api/management/v1#!/Clients/patch_clients_by_id
+func (m *ClientManager) UpdateChanged(id string, before, after *Client, opts ...RequestOption) error {
+	update, err := newPartialUpdate(before.Diff(after), after, clientReadOnlyFields)
+	if err != nil || update == nil {
+		return err
+	}
+	return m.Request("PATCH", m.URI("clients", id), update, opts...)
+}
+
+// UpdateChanged updates a connection by sending only the fields that differ
+// between before and after, leaving out those that can't be updated such as
+// the name and strategy. If nothing changed no request is made.
+//
+// Changes to the options are sent along with all the other options in after,
+// as the whole options object is replaced on update.
+//
+// The response is decoded into after.
+//
+// See: https://authok.com/docs/api/management/v1#!/Connections/patch_connections_by_id
+func (m *ConnectionManager) UpdateChanged(id string, before, after *Connection, opts ...RequestOption) error {
+	update, err := newPartialUpdate(before.Diff(after), after, connectionReadOnlyFields)
+	if err != nil || update == nil {
+		return err
+	}
+	return m.Request("PATCH", m.URI("connections", id), update, opts...)
+}
+
+// UpdateChanged updates a resource server by sending only the fields that
+// differ between before and after, leaving out those that can't be updated
+// such as the identifier. If nothing changed no request is made.
+//
+// The response is decoded into after.
+//
+// See: https://authok.com/docs/api/management/v1#!/Resource_Servers/patch_resource_servers_by_id
+func (m *ResourceServerManager) UpdateChanged(id string, before, after *ResourceServer, opts ...RequestOption) error {
+	update, err := newPartialUpdate(before.Diff(after), after, resourceServerReadOnlyFields)
+	if err != nil || update == nil {
+		return err
+	}
+	return m.Request("PATCH", m.URI("resource-servers", id), update, opts...)
+}
@@ -0,0 +1,108 @@
+package management
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func TestClientManager_UpdateChanged(t *testing.T) {
+	before := &Client{
+		Name:         authok.String("Test Client"),
+		ClientID:     authok.String("client-id"),
+		ClientSecret: authok.String("client-secret"),
+		Callbacks:    &[]string{"https://example.com/callback"},
+		JWTConfiguration: &ClientJWTConfiguration{
+			Algorithm:         authok.String("RS256"),
+			LifetimeInSeconds: authok.Int(3600),
+		},
+		Description: authok.String("To be removed"),
+	}
+
+	t.Run("Sends only the changed fields", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		api.put("/clients/client-id", before)
+
+		after := before.Clone()
+		after.ClientSecret = authok.String("new-secret")
+		after.JWTConfiguration.Algorithm = authok.String("HS256")
+		after.Description = nil
+
+		err := m.Client.UpdateChanged("client-id", before, after)
+		require.NoError(t, err)
+
+		assert.Equal(t, []map[string]interface{}{{
+			"jwt_configuration": map[string]interface{}{
+				"alg":                 "HS256",
+				"lifetime_in_seconds": float64(3600),
+			},
+			"description": nil,
+		}}, api.bodies("PATCH /clients/client-id"))
+		assert.Equal(t, "client-id", after.GetClientID())
+		assert.NotContains(t, api.get("/clients/client-id"), "description")
+	})
+
+	t.Run("Skips the request when only read only fields changed", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		api.put("/clients/client-id", before)
+
+		after := before.Clone()
+		after.ClientID = authok.String("other-id")
+
+		err := m.Client.UpdateChanged("client-id", before, after)
+		require.NoError(t, err)
+		assert.Empty(t, api.requests())
+	})
+}
+
+func TestConnectionManager_UpdateChanged(t *testing.T) {
+	api, m := startFakeAPI(t)
+	before := &Connection{
+		ID:       authok.String("con_123"),
+		Name:     authok.String("Username-Password"),
+		Strategy: authok.String("authok"),
+		Options: &ConnectionOptions{
+			PasswordPolicy:       authok.String("fair"),
+			BruteForceProtection: authok.Bool(true),
+		},
+	}
+	api.put("/connections/con_123", before)
+
+	after := before.Clone()
+	after.Name = authok.String("Renamed")
+	after.Options.(*ConnectionOptions).PasswordPolicy = authok.String("good")
+
+	err := m.Connection.UpdateChanged("con_123", before, after)
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{{
+		"options": map[string]interface{}{
+			"passwordPolicy":         "good",
+			"brute_force_protection": true,
+		},
+	}}, api.bodies("PATCH /connections/con_123"))
+	assert.Equal(t, "Username-Password", api.get("/connections/con_123")["name"])
+}
+
+func TestResourceServerManager_UpdateChanged(t *testing.T) {
+	api, m := startFakeAPI(t)
+	before := &ResourceServer{
+		ID:            authok.String("rs_123"),
+		Identifier:    authok.String("https://api.example.com"),
+		TokenLifetime: authok.Int(7200),
+	}
+	api.put("/resource-servers/rs_123", before)
+
+	after := before.Clone()
+	after.Identifier = authok.String("https://other.example.com")
+	after.TokenLifetime = authok.Int(3600)
+
+	err := m.ResourceServer.UpdateChanged("rs_123", before, after)
+	require.NoError(t, err)
+
+	assert.Equal(t, []map[string]interface{}{{"token_lifetime": float64(3600)}}, api.bodies("PATCH /resource-servers/rs_123"))
+	assert.Equal(t, "rs_123", after.GetID())
+}
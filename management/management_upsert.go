@@ -0,0 +1,461 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// UpsertResult describes the outcome of an Upsert call.
+type UpsertResult string
+
+const (
+	// UpsertCreated is returned when the resource did not exist and was created.
+	UpsertCreated UpsertResult = "created"
+
+	// UpsertUpdated is returned when the resource existed and was updated.
+	UpsertUpdated UpsertResult = "updated"
+
+	// UpsertUnchanged is returned when the resource already had the desired values.
+	UpsertUnchanged UpsertResult = "unchanged"
+)
+
+// Fields that can't be sent when updating resources through Upsert, on top of
+// those already rejected by the UpdateChanged helpers.
+var (
+	roleReadOnlyFields         = fieldSet("id")
+	organizationReadOnlyFields = fieldSet("id")
+	actionReadOnlyFields       = fieldSet(
+		"id",
+		"deployed_version",
+		"status",
+		"all_changes_deployed",
+		"built_at",
+		"created_at",
+		"updated_at",
+	)
+	hookReadOnlyFields      = fieldSet("id", "triggerId")
+	ruleReadOnlyFields      = fieldSet("id")
+	logStreamReadOnlyFields = fieldSet("id", "type")
+)
+
+// upsert makes sure a resource holding the desired values exists.
+//
+// The existing resource is looked up using find, which must return nil when
+// there is none. Missing resources are created, and when the creation fails
+// with a conflict because somebody else created it in the meantime, the
+// resource is looked up again and updated instead.
+//
+// Existing resources are only updated when they don't already hold every value
+// set in desired. In that case only the top level fields that differ are
+// sent, with nested objects merged into the existing ones. Either way desired
+// is populated with the resource as stored by the API.
+//
+// The query parameters set by the request options of the Upsert methods only
+// apply to the lookups, never to the creation or the update.
+func upsert[T any](
+	desired *T,
+	find func() (*T, error),
+	create func() error,
+	update func(id string, payload interface{}) error,
+	idOf func(*T) string,
+	readOnly map[string]bool,
+) (UpsertResult, error) {
+	existing, err := find()
+	if err != nil {
+		return "", err
+	}
+
+	if existing == nil {
+		createErr := create()
+		if createErr == nil {
+			return UpsertCreated, nil
+		}
+		if mErr, ok := createErr.(Error); !ok || mErr.Status() != http.StatusConflict {
+			return "", createErr
+		}
+
+		existing, err = find()
+		if err != nil {
+			return "", err
+		}
+		if existing == nil {
+			return "", createErr
+		}
+	}
+
+	payload, err := newUpsertUpdate(desired, existing, readOnly)
+	if err != nil {
+		return "", err
+	}
+	if payload == nil {
+		*desired = *existing
+		return UpsertUnchanged, nil
+	}
+
+	if err := update(idOf(existing), payload); err != nil {
+		return "", err
+	}
+
+	return UpsertUpdated, nil
+}
+
+// newUpsertUpdate returns the update that brings existing in line with
+// desired, or nil if there is nothing to update.
+func newUpsertUpdate(desired, existing interface{}, readOnly map[string]bool) (*partialUpdate, error) {
+	want, err := toJSONObject(desired)
+	if err != nil {
+		return nil, err
+	}
+	have, err := toJSONObject(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]json.RawMessage)
+	for field, value := range want {
+		if value == nil || readOnly[field] || isJSONSubset(value, have[field]) {
+			continue
+		}
+
+		merged := value
+		if _, ok := value.(map[string]interface{}); ok {
+			merged = mergePatch(have[field], value)
+		}
+
+		b, err := json.Marshal(merged)
+		if err != nil {
+			return nil, fmt.Errorf("encoding update payload failed: %w", err)
+		}
+		body[field] = b
+	}
+
+	if len(body) == 0 {
+		return nil, nil
+	}
+
+	return &partialUpdate{body: body, result: desired}, nil
+}
+
+func toJSONObject(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encoding resource failed: %w", err)
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(b, &object); err != nil {
+		return nil, fmt.Errorf("decoding resource failed: %w", err)
+	}
+	return object, nil
+}
+
+// isJSONSubset reports whether every value set in a is also set in b. Objects
+// are compared key by key, anything else has to be equal.
+func isJSONSubset(a, b interface{}) bool {
+	am, ok := a.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(a, b)
+	}
+
+	bm, ok := b.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for key, value := range am {
+		if value == nil {
+			continue
+		}
+		if !isJSONSubset(value, bm[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// findFirst pages through a list until it finds an item that matches.
+func findFirst[T any](list func(page int) ([]*T, bool, error), match func(*T) bool) (*T, error) {
+	for page := 0; ; page++ {
+		items, hasNext, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if match(item) {
+				return item, nil
+			}
+		}
+		if !hasNext {
+			return nil, nil
+		}
+	}
+}
+
+// nilIfNotFound turns a 404 error into a nil result.
+func nilIfNotFound[T any](v *T, err error) (*T, error) {
+	if isNotFound(err) {
+		return nil, nil
+	}
+	return v, err
+}
+
+// isNotFound reports whether err is a 404 returned by the API.
+func isNotFound(err error) bool {
+	mErr, ok := err.(Error)
+	return ok && mErr.Status() == http.StatusNotFound
+}
+
+func emptyKeyError(key string) error {
+	return &managementError{400, "Bad Request", fmt.Sprintf("%s cannot be empty", key)}
+}
+
+// Upsert creates a client or updates the existing client with the same name.
+//
+// Only the fields that are set in c and differ from the existing client are
+// updated. On return c holds the client as stored by Authok.
+func (m *ClientManager) Upsert(c *Client, opts ...RequestOption) (UpsertResult, error) {
+	if c.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		c,
+		func() (*Client, error) {
+			return findFirst(
+				func(page int) ([]*Client, bool, error) {
+					l, err := m.List(withOptions(opts, Page(page))...)
+					if err != nil {
+						return nil, false, err
+					}
+					return l.Clients, l.HasNext(), nil
+				},
+				func(existing *Client) bool { return existing.GetName() == c.GetName() },
+			)
+		},
+		func() error { return m.Create(c, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("clients", id), payload, withoutQuery(opts))
+		},
+		(*Client).GetClientID,
+		clientReadOnlyFields,
+	)
+}
+
+// Upsert creates a connection or updates the existing connection with the same
+// name.
+//
+// Only the fields that are set in c and differ from the existing connection
+// are updated. Options are merged into the existing options, so options that
+// are not set in c are kept. On return c holds the connection as stored by
+// Authok.
+func (m *ConnectionManager) Upsert(c *Connection, opts ...RequestOption) (UpsertResult, error) {
+	if c.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		c,
+		func() (*Connection, error) { return nilIfNotFound(m.ReadByName(c.GetName(), opts...)) },
+		func() error { return m.Create(c, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("connections", id), payload, withoutQuery(opts))
+		},
+		(*Connection).GetID,
+		connectionReadOnlyFields,
+	)
+}
+
+// Upsert creates a resource server or updates the existing resource server
+// with the same identifier.
+//
+// Only the fields that are set in rs and differ from the existing resource
+// server are updated. On return rs holds the resource server as stored by
+// Authok.
+func (m *ResourceServerManager) Upsert(rs *ResourceServer, opts ...RequestOption) (UpsertResult, error) {
+	if rs.GetIdentifier() == "" {
+		return "", emptyKeyError("Identifier")
+	}
+	return upsert(
+		rs,
+		func() (*ResourceServer, error) { return nilIfNotFound(m.Read(rs.GetIdentifier(), opts...)) },
+		func() error { return m.Create(rs, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("resource-servers", id), payload, withoutQuery(opts))
+		},
+		(*ResourceServer).GetID,
+		resourceServerReadOnlyFields,
+	)
+}
+
+// Upsert creates a role or updates the existing role with the same name.
+//
+// On return r holds the role as stored by Authok.
+func (m *RoleManager) Upsert(r *Role, opts ...RequestOption) (UpsertResult, error) {
+	if r.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		r,
+		func() (*Role, error) { return m.findByName(r.GetName(), opts...) },
+		func() error { return m.Create(r, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("roles", id), payload, withoutQuery(opts))
+		},
+		(*Role).GetID,
+		roleReadOnlyFields,
+	)
+}
+
+// findByName returns the role with the given name, or nil if there is none.
+// The name filter of the API also matches other names, so the roles it
+// returns are checked for an exact match.
+func (m *RoleManager) findByName(name string, opts ...RequestOption) (*Role, error) {
+	return findFirst(
+		func(page int) ([]*Role, bool, error) {
+			l, err := m.List(withOptions(opts, Parameter("name_filter", name), Page(page))...)
+			if err != nil {
+				return nil, false, err
+			}
+			return l.Roles, l.HasNext(), nil
+		},
+		func(role *Role) bool { return role.GetName() == name },
+	)
+}
+
+// Upsert creates an organization or updates the existing organization with
+// the same name.
+//
+// On return o holds the organization as stored by Authok.
+func (m *OrganizationManager) Upsert(o *Organization, opts ...RequestOption) (UpsertResult, error) {
+	if o.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		o,
+		func() (*Organization, error) { return nilIfNotFound(m.ReadByName(o.GetName(), opts...)) },
+		func() error { return m.Create(o, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("organizations", id), payload, withoutQuery(opts))
+		},
+		(*Organization).GetID,
+		organizationReadOnlyFields,
+	)
+}
+
+// Upsert creates an action or updates the existing action with the same name.
+//
+// Updated actions still have to be deployed. On return a holds the action as
+// stored by Authok.
+func (m *ActionManager) Upsert(a *Action, opts ...RequestOption) (UpsertResult, error) {
+	if a.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		a,
+		func() (*Action, error) {
+			return findFirst(
+				func(page int) ([]*Action, bool, error) {
+					l, err := m.List(withOptions(opts, Parameter("actionName", a.GetName()), Page(page))...)
+					if err != nil {
+						return nil, false, err
+					}
+					return l.Actions, l.HasNext(), nil
+				},
+				func(existing *Action) bool { return existing.GetName() == a.GetName() },
+			)
+		},
+		func() error { return m.Create(a, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("actions", "actions", id), payload, withoutQuery(opts))
+		},
+		(*Action).GetID,
+		actionReadOnlyFields,
+	)
+}
+
+// Upsert creates a hook or updates the existing hook with the same name.
+//
+// On return h holds the hook as stored by Authok.
+func (m *HookManager) Upsert(h *Hook, opts ...RequestOption) (UpsertResult, error) {
+	if h.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		h,
+		func() (*Hook, error) {
+			return findFirst(
+				func(page int) ([]*Hook, bool, error) {
+					l, err := m.List(withOptions(opts, Page(page))...)
+					if err != nil {
+						return nil, false, err
+					}
+					return l.Hooks, l.HasNext(), nil
+				},
+				func(existing *Hook) bool { return existing.GetName() == h.GetName() },
+			)
+		},
+		func() error { return m.Create(h, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("hooks", id), payload, withoutQuery(opts))
+		},
+		(*Hook).GetID,
+		hookReadOnlyFields,
+	)
+}
+
+// Upsert creates a rule or updates the existing rule with the same name.
+//
+// On return r holds the rule as stored by Authok.
+func (m *RuleManager) Upsert(r *Rule, opts ...RequestOption) (UpsertResult, error) {
+	if r.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		r,
+		func() (*Rule, error) {
+			return findFirst(
+				func(page int) ([]*Rule, bool, error) {
+					l, err := m.List(withOptions(opts, Page(page))...)
+					if err != nil {
+						return nil, false, err
+					}
+					return l.Rules, l.HasNext(), nil
+				},
+				func(existing *Rule) bool { return existing.GetName() == r.GetName() },
+			)
+		},
+		func() error { return m.Create(r, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("rules", id), payload, withoutQuery(opts))
+		},
+		(*Rule).GetID,
+		ruleReadOnlyFields,
+	)
+}
+
+// Upsert creates a log stream or updates the existing log stream with the same
+// name.
+//
+// On return l holds the log stream as stored by Authok.
+func (m *LogStreamManager) Upsert(l *LogStream, opts ...RequestOption) (UpsertResult, error) {
+	if l.GetName() == "" {
+		return "", emptyKeyError("Name")
+	}
+	return upsert(
+		l,
+		func() (*LogStream, error) {
+			return findFirst(
+				func(int) ([]*LogStream, bool, error) {
+					ls, err := m.List(opts...)
+					return ls, false, err
+				},
+				func(existing *LogStream) bool { return existing.GetName() == l.GetName() },
+			)
+		},
+		func() error { return m.Create(l, withoutQuery(opts)) },
+		func(id string, payload interface{}) error {
+			return m.Request("PATCH", m.URI("log-streams", id), payload, withoutQuery(opts))
+		},
+		(*LogStream).GetID,
+		logStreamReadOnlyFields,
+	)
+}
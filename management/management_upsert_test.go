@@ -0,0 +1,161 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func TestRoleManager_Upsert(t *testing.T) {
+	t.Run("Creates a missing role", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		api.put("/roles/rol_1", map[string]interface{}{"id": "rol_1", "name": "viewer"})
+
+		role := &Role{Name: authok.String("admin"), Description: authok.String("Admins")}
+		result, err := m.Role.Upsert(role)
+		require.NoError(t, err)
+
+		assert.Equal(t, UpsertCreated, result)
+		assert.Equal(t, "id_1", role.GetID())
+		assert.Equal(t, []string{"/roles/id_1", "/roles/rol_1"}, api.paths())
+	})
+
+	t.Run("Leaves a matching role unchanged", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		api.put("/roles/rol_1", map[string]interface{}{
+			"id":          "rol_1",
+			"name":        "admin",
+			"description": "Admins",
+		})
+
+		role := &Role{Name: authok.String("admin")}
+		result, err := m.Role.Upsert(role)
+		require.NoError(t, err)
+
+		assert.Equal(t, UpsertUnchanged, result)
+		assert.Equal(t, "rol_1", role.GetID())
+		assert.Equal(t, "Admins", role.GetDescription())
+		assert.Equal(t, []string{"GET /roles"}, api.requests())
+	})
+
+	t.Run("Updates the fields that differ", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		api.put("/roles/rol_1", map[string]interface{}{
+			"id":          "rol_1",
+			"name":        "admin",
+			"description": "Old",
+		})
+
+		role := &Role{Name: authok.String("admin"), Description: authok.String("New")}
+		result, err := m.Role.Upsert(role)
+		require.NoError(t, err)
+
+		assert.Equal(t, UpsertUpdated, result)
+		assert.Equal(t, []map[string]interface{}{{"description": "New"}}, api.bodies("PATCH /roles/rol_1"))
+		assert.Equal(t, "rol_1", role.GetID())
+	})
+
+	t.Run("Requires a name", func(t *testing.T) {
+		_, m := startFakeAPI(t)
+
+		_, err := m.Role.Upsert(&Role{})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, err.(Error).Status())
+	})
+}
+
+// givenARuleCreatedConcurrently makes the creation of a rule fail with a
+// conflict, as if somebody else created the rule first.
+func givenARuleCreatedConcurrently(api *fakeAPI) {
+	api.handle("POST /rules", func(w http.ResponseWriter, r *http.Request) {
+		api.put("/rules/rul_1", map[string]interface{}{
+			"id":     "rul_1",
+			"name":   "my-rule",
+			"script": "function (user, context, callback) {}",
+		})
+		writeFakeAPIError(w, http.StatusConflict, "Already exists")
+	})
+}
+
+func TestRuleManager_Upsert(t *testing.T) {
+	t.Run("Updates the rule created concurrently", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenARuleCreatedConcurrently(api)
+
+		rule := &Rule{Name: authok.String("my-rule"), Script: authok.String("function (u, c, cb) { cb(null, u, c); }")}
+		result, err := m.Rule.Upsert(rule)
+		require.NoError(t, err)
+
+		assert.Equal(t, UpsertUpdated, result)
+		assert.Equal(t, "rul_1", rule.GetID())
+		assert.Equal(t, []string{"GET /rules", "POST /rules", "GET /rules", "PATCH /rules/rul_1"}, api.requests())
+	})
+
+	t.Run("Only looks the rule up with the query options", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenARuleCreatedConcurrently(api)
+
+		rule := &Rule{Name: authok.String("my-rule"), Script: authok.String("function (u, c, cb) { cb(null, u, c); }")}
+		_, err := m.Rule.Upsert(rule, IncludeFields("id", "name"))
+		require.NoError(t, err)
+
+		var fields []string
+		for _, query := range api.queries("GET /rules") {
+			fields = append(fields, query.Get("fields"))
+		}
+		assert.Equal(t, []string{"id,name", "id,name"}, fields)
+		assert.Empty(t, api.queries("POST /rules")[0])
+		assert.Empty(t, api.queries("PATCH /rules/rul_1")[0])
+	})
+}
+
+func TestConnectionManager_Upsert(t *testing.T) {
+	api, m := startFakeAPI(t)
+	api.put("/connections/con_1", map[string]interface{}{
+		"id":       "con_1",
+		"name":     "Username-Password",
+		"strategy": "authok",
+		"options": map[string]interface{}{
+			"passwordPolicy":         "fair",
+			"brute_force_protection": true,
+		},
+	})
+
+	connection := &Connection{
+		Name:     authok.String("Username-Password"),
+		Strategy: authok.String("authok"),
+		Options:  &ConnectionOptions{PasswordPolicy: authok.String("good")},
+	}
+	result, err := m.Connection.Upsert(connection)
+	require.NoError(t, err)
+
+	assert.Equal(t, UpsertUpdated, result)
+	assert.Equal(t, []map[string]interface{}{{
+		"options": map[string]interface{}{
+			"passwordPolicy":         "good",
+			"brute_force_protection": true,
+		},
+	}}, api.bodies("PATCH /connections/con_1"))
+	assert.Equal(t, "con_1", connection.GetID())
+}
+
+func TestResourceServerManager_Upsert(t *testing.T) {
+	api, m := startFakeAPI(t)
+	api.put("/resource-servers/rs_1", map[string]interface{}{
+		"id":             "rs_1",
+		"identifier":     "https-api",
+		"token_lifetime": 3600,
+	})
+
+	rs := &ResourceServer{Identifier: authok.String("https-api"), TokenLifetime: authok.Int(3600)}
+	result, err := m.ResourceServer.Upsert(rs)
+	require.NoError(t, err)
+
+	assert.Equal(t, UpsertUnchanged, result)
+	assert.Equal(t, "rs_1", rs.GetID())
+	assert.Equal(t, []string{"GET /resource-servers/https-api"}, api.requests())
+}
@@ -0,0 +1,565 @@
+package management
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// Formats read by ReadOrganizationInvitees.
+const (
+	OrganizationInviteesCSV    = "csv"
+	OrganizationInviteesNDJSON = "ndjson"
+)
+
+// Actions of an OrganizationInvitationResult.
+const (
+	OrganizationInvitationInvite      = "invite"
+	OrganizationInvitationSkipMember  = "skip_member"
+	OrganizationInvitationSkipPending = "skip_pending"
+	OrganizationInvitationReissue     = "reissue"
+	OrganizationInvitationDelete      = "delete"
+)
+
+// organizationInvitationDefaultTTL is how long an invitation is valid when no
+// TTLSec is given.
+const organizationInvitationDefaultTTL = 7 * 24 * time.Hour
+
+// OrganizationInvitee is someone to invite to an organization, as given to
+// OrganizationManager.InviteMembers.
+type OrganizationInvitee struct {
+	Email *string `json:"email,omitempty"`
+
+	// List of roles IDs to associate with the user once they accept.
+	Roles *[]string `json:"roles,omitempty"`
+
+	AppMetadata  *map[string]interface{} `json:"app_metadata,omitempty"`
+	UserMetadata *map[string]interface{} `json:"user_metadata,omitempty"`
+}
+
+// OrganizationInviteOptions configures OrganizationManager.InviteMembers.
+type OrganizationInviteOptions struct {
+	// The name of the inviter shown in the invitation.
+	InviterName *string `json:"inviter_name,omitempty"`
+
+	// Authok client ID. Used to resolve the application's login initiation
+	// endpoint.
+	ClientID *string `json:"client_id,omitempty"`
+
+	// The id of the connection to force invitees to authenticate with.
+	ConnectionID *string `json:"connection_id,omitempty"`
+
+	// Number of seconds for which the invitations are valid.
+	TTLSec *int `json:"ttl_sec,omitempty"`
+
+	// Whether the invitees receive an invitation email, true by default.
+	SendInvitationEmail *bool `json:"send_invitation_email,omitempty"`
+
+	// When true, the invitations are worked out and reported but not created.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// How long to pause between invitations, to stay within rate limits.
+	Delay *time.Duration `json:"delay,omitempty"`
+}
+
+// OrganizationInvitationRefreshOptions configures
+// OrganizationManager.RefreshInvitations.
+type OrganizationInvitationRefreshOptions struct {
+	// Invitations expiring within this long are refreshed along with those
+	// that have expired already.
+	Within *time.Duration `json:"within,omitempty"`
+
+	// Either OrganizationInvitationReissue, the default, or
+	// OrganizationInvitationDelete.
+	Action *string `json:"action,omitempty"`
+
+	// When true, the stale invitations are reported but left as they are.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// How long to pause between invitations, to stay within rate limits.
+	Delay *time.Duration `json:"delay,omitempty"`
+}
+
+// OrganizationInvitationResult is what OrganizationManager.InviteMembers or
+// OrganizationManager.RefreshInvitations did for a single invitee.
+type OrganizationInvitationResult struct {
+	Email *string `json:"email,omitempty"`
+
+	// One of OrganizationInvitationInvite, OrganizationInvitationSkipMember,
+	// OrganizationInvitationSkipPending, OrganizationInvitationReissue or
+	// OrganizationInvitationDelete.
+	Action *string `json:"action,omitempty"`
+
+	// The id of the invitation created, or of the pending or deleted one.
+	InvitationID *string `json:"invitation_id,omitempty"`
+
+	// When the invitation created or found expires.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// True once the action has been taken.
+	Applied *bool `json:"applied"`
+
+	// Why the action could not be taken, if it couldn't.
+	Error *string `json:"error,omitempty"`
+}
+
+// OrganizationInvitationReport reports on the invitations handled by
+// OrganizationManager.InviteMembers or OrganizationManager.RefreshInvitations.
+type OrganizationInvitationReport struct {
+	OrganizationID *string `json:"organization_id,omitempty"`
+	DryRun         *bool   `json:"dry_run,omitempty"`
+
+	Results []*OrganizationInvitationResult `json:"results"`
+}
+
+// Count returns the number of results with the given action.
+func (r *OrganizationInvitationReport) Count(action string) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.GetAction() == action {
+			count++
+		}
+	}
+	return count
+}
+
+// CreatedAtTime parses the creation time of the invitation.
+func (i *OrganizationInvitation) CreatedAtTime() (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, i.GetCreatedAt())
+}
+
+// ExpiresAtTime parses the expiration time of the invitation. When the
+// expiration time is missing, it is worked out from the creation time and
+// TTLSec.
+func (i *OrganizationInvitation) ExpiresAtTime() (time.Time, error) {
+	if i.GetExpiresAt() != "" {
+		return time.Parse(time.RFC3339Nano, i.GetExpiresAt())
+	}
+
+	createdAt, err := i.CreatedAtTime()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invitation %q has no expiration or creation time", i.GetID())
+	}
+	ttl := organizationInvitationDefaultTTL
+	if i.GetTTLSec() > 0 {
+		ttl = time.Duration(i.GetTTLSec()) * time.Second
+	}
+	return createdAt.Add(ttl), nil
+}
+
+// ReadOrganizationInvitees reads invitees in the OrganizationInviteesCSV or
+// OrganizationInviteesNDJSON format.
+//
+// CSV input starts with a header row naming the columns. The email column is
+// required; the roles column holds role ids separated by spaces or commas;
+// app_metadata and user_metadata columns hold JSON objects; and columns named
+// app_metadata.<key> or user_metadata.<key> set a single string value.
+//
+// NDJSON input has one invitee per line, as a JSON object with the email,
+// roles, app_metadata and user_metadata properties.
+func ReadOrganizationInvitees(r io.Reader, format string) ([]*OrganizationInvitee, error) {
+	var invitees []*OrganizationInvitee
+	var err error
+	switch format {
+	case OrganizationInviteesCSV:
+		invitees, err = readOrganizationInviteesCSV(r)
+	case OrganizationInviteesNDJSON:
+		invitees, err = readOrganizationInviteesNDJSON(r)
+	default:
+		return nil, fmt.Errorf("unsupported invitees format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, invitee := range invitees {
+		if invitee.GetEmail() == "" {
+			return nil, fmt.Errorf("invitee %d has no email", i+1)
+		}
+	}
+	return invitees, nil
+}
+
+func readOrganizationInviteesCSV(r io.Reader) ([]*OrganizationInvitee, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the invitees header: %w", err)
+	}
+	hasEmail := false
+	for _, column := range header {
+		switch {
+		case column == "email":
+			hasEmail = true
+		case column == "roles", column == "app_metadata", column == "user_metadata",
+			strings.HasPrefix(column, "app_metadata."), strings.HasPrefix(column, "user_metadata."):
+		default:
+			return nil, fmt.Errorf("unknown invitees column %q", column)
+		}
+	}
+	if !hasEmail {
+		return nil, fmt.Errorf("the invitees have no email column")
+	}
+
+	var invitees []*OrganizationInvitee
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return invitees, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the invitees: %w", err)
+		}
+
+		invitee := &OrganizationInvitee{}
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+			column := header[i]
+			switch {
+			case column == "email":
+				invitee.Email = authok.String(value)
+			case column == "roles":
+				roles := strings.FieldsFunc(value, func(r rune) bool {
+					return r == ',' || r == ' '
+				})
+				invitee.Roles = &roles
+			case column == "app_metadata", column == "user_metadata":
+				var metadata map[string]interface{}
+				if err := json.Unmarshal([]byte(value), &metadata); err != nil {
+					return nil, fmt.Errorf("invalid %s of invitee %d: %w", column, len(invitees)+1, err)
+				}
+				for key, value := range metadata {
+					setInviteeMetadata(invitee, column, key, value)
+				}
+			default:
+				field, key, _ := strings.Cut(column, ".")
+				setInviteeMetadata(invitee, field, key, value)
+			}
+		}
+		invitees = append(invitees, invitee)
+	}
+}
+
+func setInviteeMetadata(invitee *OrganizationInvitee, field, key string, value interface{}) {
+	metadata := &invitee.UserMetadata
+	if field == "app_metadata" {
+		metadata = &invitee.AppMetadata
+	}
+	if *metadata == nil {
+		*metadata = &map[string]interface{}{}
+	}
+	(**metadata)[key] = value
+}
+
+func readOrganizationInviteesNDJSON(r io.Reader) ([]*OrganizationInvitee, error) {
+	var invitees []*OrganizationInvitee
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var invitee OrganizationInvitee
+		if err := json.Unmarshal(scanner.Bytes(), &invitee); err != nil {
+			return nil, fmt.Errorf("invalid invitee on line %d: %w", line, err)
+		}
+		invitees = append(invitees, &invitee)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read the invitees: %w", err)
+	}
+	return invitees, nil
+}
+
+// InviteMembers invites people to an organization, such as those read by
+// ReadOrganizationInvitees.
+//
+// Invitees that are members already, or that have an invitation which has not
+// expired, are skipped. Emails are compared case-insensitively. A failed
+// invitation does not stop the others; its Error is set and an error is
+// returned along with the report once all invitees have been tried.
+func (m *OrganizationManager) InviteMembers(id string, invitees []*OrganizationInvitee, options *OrganizationInviteOptions, opts ...RequestOption) (*OrganizationInvitationReport, error) {
+	if options == nil {
+		options = &OrganizationInviteOptions{}
+	}
+
+	seen := map[string]bool{}
+	for _, invitee := range invitees {
+		email := strings.ToLower(invitee.GetEmail())
+		if email == "" {
+			return nil, fmt.Errorf("an email is required for every invitee")
+		}
+		if seen[email] {
+			return nil, fmt.Errorf("email %q is listed more than once", invitee.GetEmail())
+		}
+		seen[email] = true
+	}
+
+	members, err := m.memberEmails(id, opts)
+	if err != nil {
+		return nil, err
+	}
+	invitations, err := m.allInvitations(id, opts)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	pending := map[string]*OrganizationInvitation{}
+	for _, invitation := range invitations {
+		expiresAt, err := invitation.ExpiresAtTime()
+		if err != nil || expiresAt.After(now) {
+			pending[strings.ToLower(invitation.GetInvitee().GetEmail())] = invitation
+		}
+	}
+
+	report := &OrganizationInvitationReport{
+		OrganizationID: &id,
+		DryRun:         authok.Bool(options.GetDryRun()),
+		Results:        []*OrganizationInvitationResult{},
+	}
+	for _, invitee := range invitees {
+		email := strings.ToLower(invitee.GetEmail())
+		result := &OrganizationInvitationResult{
+			Email:   invitee.Email,
+			Action:  authok.String(OrganizationInvitationInvite),
+			Applied: authok.Bool(false),
+		}
+		switch {
+		case members[email]:
+			result.Action = authok.String(OrganizationInvitationSkipMember)
+		case pending[email] != nil:
+			result.Action = authok.String(OrganizationInvitationSkipPending)
+			result.InvitationID = pending[email].ID
+			if expiresAt, err := pending[email].ExpiresAtTime(); err == nil {
+				result.ExpiresAt = &expiresAt
+			}
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	if options.GetDryRun() {
+		return report, nil
+	}
+
+	ctx := requestContext(opts)
+	attempted, failed := 0, 0
+	for i, invitee := range invitees {
+		result := report.Results[i]
+		if result.GetAction() != OrganizationInvitationInvite {
+			continue
+		}
+		if attempted > 0 {
+			if err := sleepContext(ctx, options.GetDelay()); err != nil {
+				return report, err
+			}
+		}
+		attempted++
+
+		invitation := &OrganizationInvitation{
+			Inviter:             &OrganizationInvitationInviter{Name: options.InviterName},
+			Invitee:             &OrganizationInvitationInvitee{Email: invitee.Email},
+			ClientID:            options.ClientID,
+			ConnectionID:        options.ConnectionID,
+			TTLSec:              options.TTLSec,
+			SendInvitationEmail: options.SendInvitationEmail,
+			Roles:               invitee.GetRoles(),
+		}
+		if invitee.AppMetadata != nil {
+			invitation.AppMetadata = *invitee.AppMetadata
+		}
+		if invitee.UserMetadata != nil {
+			invitation.UserMetadata = *invitee.UserMetadata
+		}
+		if err := m.CreateInvitation(id, invitation, opts...); err != nil {
+			failed++
+			message := err.Error()
+			result.Error = &message
+			continue
+		}
+		setInvitationResult(result, invitation)
+		result.Applied = authok.Bool(true)
+	}
+	if failed > 0 {
+		return report, fmt.Errorf("failed to invite %d of %d invitees", failed, attempted)
+	}
+	return report, nil
+}
+
+// StaleInvitations lists the invitations to an organization that have expired,
+// or that expire within the given duration, soonest to expire first.
+// Invitations without a valid expiration time are left out.
+func (m *OrganizationManager) StaleInvitations(id string, within time.Duration, opts ...RequestOption) ([]*OrganizationInvitation, error) {
+	invitations, err := m.allInvitations(id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := time.Now().Add(within)
+	var stale []*OrganizationInvitation
+	expiries := map[*OrganizationInvitation]time.Time{}
+	for _, invitation := range invitations {
+		expiresAt, err := invitation.ExpiresAtTime()
+		if err != nil || expiresAt.After(cutoff) {
+			continue
+		}
+		stale = append(stale, invitation)
+		expiries[invitation] = expiresAt
+	}
+	sort.SliceStable(stale, func(i, j int) bool {
+		return expiries[stale[i]].Before(expiries[stale[j]])
+	})
+	return stale, nil
+}
+
+// ReissueInvitation replaces an invitation with a new one for the same
+// invitee, roles and metadata, and returns the new invitation. The new
+// invitation is created before the old one is deleted, so that the invitee
+// always has one.
+func (m *OrganizationManager) ReissueInvitation(id string, i *OrganizationInvitation, opts ...RequestOption) (*OrganizationInvitation, error) {
+	invitation := &OrganizationInvitation{
+		Inviter:             i.Inviter,
+		Invitee:             i.Invitee,
+		ClientID:            i.ClientID,
+		ConnectionID:        i.ConnectionID,
+		TTLSec:              i.TTLSec,
+		AppMetadata:         i.AppMetadata,
+		UserMetadata:        i.UserMetadata,
+		Roles:               i.Roles,
+		SendInvitationEmail: i.SendInvitationEmail,
+	}
+	if err := m.CreateInvitation(id, invitation, opts...); err != nil {
+		return nil, err
+	}
+	if err := m.DeleteInvitation(id, i.GetID(), opts...); err != nil {
+		if mErr, ok := err.(Error); !ok || mErr.Status() != http.StatusNotFound {
+			return invitation, fmt.Errorf("failed to delete invitation %q after reissuing it: %w", i.GetID(), err)
+		}
+	}
+	return invitation, nil
+}
+
+// RefreshInvitations reissues or deletes the invitations to an organization
+// that have expired or expire soon, as listed by StaleInvitations. A failed
+// invitation does not stop the others; its Error is set and an error is
+// returned along with the report once all invitations have been tried.
+func (m *OrganizationManager) RefreshInvitations(id string, options *OrganizationInvitationRefreshOptions, opts ...RequestOption) (*OrganizationInvitationReport, error) {
+	if options == nil {
+		options = &OrganizationInvitationRefreshOptions{}
+	}
+	action := options.GetAction()
+	if action == "" {
+		action = OrganizationInvitationReissue
+	}
+	if action != OrganizationInvitationReissue && action != OrganizationInvitationDelete {
+		return nil, fmt.Errorf("unsupported invitation refresh action %q", action)
+	}
+
+	stale, err := m.StaleInvitations(id, options.GetWithin(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrganizationInvitationReport{
+		OrganizationID: &id,
+		DryRun:         authok.Bool(options.GetDryRun()),
+		Results:        []*OrganizationInvitationResult{},
+	}
+	for _, invitation := range stale {
+		expiresAt, _ := invitation.ExpiresAtTime()
+		report.Results = append(report.Results, &OrganizationInvitationResult{
+			Email:        invitation.GetInvitee().Email,
+			Action:       authok.String(action),
+			InvitationID: invitation.ID,
+			ExpiresAt:    &expiresAt,
+			Applied:      authok.Bool(false),
+		})
+	}
+
+	if options.GetDryRun() {
+		return report, nil
+	}
+
+	ctx := requestContext(opts)
+	failed := 0
+	for i, invitation := range stale {
+		if i > 0 {
+			if err := sleepContext(ctx, options.GetDelay()); err != nil {
+				return report, err
+			}
+		}
+
+		result := report.Results[i]
+		if action == OrganizationInvitationDelete {
+			err = m.DeleteInvitation(id, invitation.GetID(), opts...)
+		} else {
+			var reissued *OrganizationInvitation
+			if reissued, err = m.ReissueInvitation(id, invitation, opts...); reissued != nil {
+				setInvitationResult(result, reissued)
+			}
+		}
+		if err != nil {
+			failed++
+			message := err.Error()
+			result.Error = &message
+			continue
+		}
+		result.Applied = authok.Bool(true)
+	}
+	if failed > 0 {
+		return report, fmt.Errorf("failed to refresh %d of %d invitations", failed, len(stale))
+	}
+	return report, nil
+}
+
+func setInvitationResult(result *OrganizationInvitationResult, invitation *OrganizationInvitation) {
+	result.InvitationID = invitation.ID
+	if expiresAt, err := invitation.ExpiresAtTime(); err == nil {
+		result.ExpiresAt = &expiresAt
+	}
+}
+
+func (m *OrganizationManager) memberEmails(id string, opts []RequestOption) (map[string]bool, error) {
+	emails := map[string]bool{}
+	for page := 0; ; page++ {
+		l, err := m.Members(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range l.Members {
+			if member.GetEmail() != "" {
+				emails[strings.ToLower(member.GetEmail())] = true
+			}
+		}
+		if !l.HasNext() {
+			return emails, nil
+		}
+	}
+}
+
+// allInvitations pages through the invitations of an organization. The
+// invitations list cannot tell whether there is a next page, so pages are
+// read until an empty one.
+func (m *OrganizationManager) allInvitations(id string, opts []RequestOption) ([]*OrganizationInvitation, error) {
+	var invitations []*OrganizationInvitation
+	for page := 0; ; page++ {
+		l, err := m.Invitations(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		if len(l.OrganizationInvitations) == 0 {
+			return invitations, nil
+		}
+		invitations = append(invitations, l.OrganizationInvitations...)
+	}
+}
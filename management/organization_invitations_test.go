@@ -0,0 +1,279 @@
+package management
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenOrganizationInvitations stores the organization org_1 with a member
+// and three invitations: one pending, one expired and one created six days
+// ago, about to expire.
+func givenOrganizationInvitations(api *fakeAPI) {
+	expiresIn := func(d time.Duration) *string {
+		return authok.String(time.Now().Add(d).UTC().Format(time.RFC3339))
+	}
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	api.put("/organizations/org_1/members/user_1", &OrganizationMember{UserID: authok.String("user_1"), Email: authok.String("member@example.com")})
+	api.put("/organizations/org_1/invitations/inv_pending", &OrganizationInvitation{
+		ID:        authok.String("inv_pending"),
+		Invitee:   &OrganizationInvitationInvitee{Email: authok.String("pending@example.com")},
+		ExpiresAt: expiresIn(72 * time.Hour),
+	})
+	api.put("/organizations/org_1/invitations/inv_expired", &OrganizationInvitation{
+		ID:        authok.String("inv_expired"),
+		Invitee:   &OrganizationInvitationInvitee{Email: authok.String("expired@example.com")},
+		ExpiresAt: expiresIn(-time.Hour),
+		Roles:     []string{"rol_viewer"},
+	})
+	api.put("/organizations/org_1/invitations/inv_expiring", &OrganizationInvitation{
+		ID:        authok.String("inv_expiring"),
+		Invitee:   &OrganizationInvitationInvitee{Email: authok.String("expiring@example.com")},
+		CreatedAt: authok.String(time.Now().Add(-6 * 24 * time.Hour).UTC().Format(time.RFC3339)),
+	})
+}
+
+func organizationInvitations(api *fakeAPI) []*OrganizationInvitation {
+	var invitations []*OrganizationInvitation
+	for _, invitation := range api.children("/organizations/org_1/invitations") {
+		invitations = append(invitations, fakeAPIResourceAs[OrganizationInvitation](api, "/organizations/org_1/invitations/"+invitation["id"].(string)))
+	}
+	return invitations
+}
+
+func TestReadOrganizationInvitees(t *testing.T) {
+	t.Run("Reads CSV", func(t *testing.T) {
+		invitees, err := ReadOrganizationInvitees(strings.NewReader(
+			"email,roles,user_metadata.plan,app_metadata\n"+
+				"ana@example.com,\"rol_admin, rol_viewer\",pro,\"{\"\"crm_id\"\":42}\"\n"+
+				"ben@example.com,,,\n",
+		), OrganizationInviteesCSV)
+		require.NoError(t, err)
+		require.Len(t, invitees, 2)
+
+		assert.Equal(t, "ana@example.com", invitees[0].GetEmail())
+		assert.Equal(t, []string{"rol_admin", "rol_viewer"}, invitees[0].GetRoles())
+		assert.Equal(t, map[string]interface{}{"plan": "pro"}, *invitees[0].UserMetadata)
+		assert.Equal(t, map[string]interface{}{"crm_id": float64(42)}, *invitees[0].AppMetadata)
+		assert.Equal(t, &OrganizationInvitee{Email: authok.String("ben@example.com")}, invitees[1])
+	})
+
+	t.Run("Reads NDJSON", func(t *testing.T) {
+		invitees, err := ReadOrganizationInvitees(strings.NewReader(
+			`{"email":"ana@example.com","roles":["rol_admin"],"user_metadata":{"plan":"pro"}}`+"\n\n"+
+				`{"email":"ben@example.com"}`+"\n",
+		), OrganizationInviteesNDJSON)
+		require.NoError(t, err)
+		require.Len(t, invitees, 2)
+		assert.Equal(t, []string{"rol_admin"}, invitees[0].GetRoles())
+		assert.Equal(t, "ben@example.com", invitees[1].GetEmail())
+	})
+
+	t.Run("Rejects invalid input", func(t *testing.T) {
+		for input, format := range map[string]string{
+			"name\nAna\n":                      OrganizationInviteesCSV,
+			"email,nickname\na@example.com":    OrganizationInviteesCSV,
+			"email,roles\n,rol_admin\n":        OrganizationInviteesCSV,
+			"{\"email\":\"a@example.com\"}\n{": OrganizationInviteesNDJSON,
+			"email\na@example.com\n":           "xlsx",
+		} {
+			_, err := ReadOrganizationInvitees(strings.NewReader(input), format)
+			assert.Error(t, err, input)
+		}
+	})
+}
+
+func TestOrganizationInvitation_ExpiresAtTime(t *testing.T) {
+	invitation := &OrganizationInvitation{ExpiresAt: authok.String("2023-03-01T10:00:00.000Z")}
+	expiresAt, err := invitation.ExpiresAtTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), expiresAt)
+
+	invitation = &OrganizationInvitation{CreatedAt: authok.String("2023-03-01T10:00:00Z"), TTLSec: authok.Int(3600)}
+	expiresAt, err = invitation.ExpiresAtTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 3, 1, 11, 0, 0, 0, time.UTC), expiresAt)
+
+	invitation = &OrganizationInvitation{CreatedAt: authok.String("2023-03-01T10:00:00Z")}
+	expiresAt, err = invitation.ExpiresAtTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 3, 8, 10, 0, 0, 0, time.UTC), expiresAt)
+
+	_, err = (&OrganizationInvitation{}).ExpiresAtTime()
+	assert.Error(t, err)
+}
+
+func TestOrganizationManager_InviteMembers(t *testing.T) {
+	invitees := func() []*OrganizationInvitee {
+		return []*OrganizationInvitee{
+			{Email: authok.String("Member@example.com")},
+			{Email: authok.String("pending@example.com")},
+			{Email: authok.String("expired@example.com")},
+			{Email: authok.String("new@example.com"), Roles: &[]string{"rol_admin"}, UserMetadata: &map[string]interface{}{"plan": "pro"}},
+		}
+	}
+
+	t.Run("Invites those not members or invited already", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		report, err := m.Organization.InviteMembers("org_1", invitees(), &OrganizationInviteOptions{
+			InviterName: authok.String("Acme"),
+			ClientID:    authok.String("app_1"),
+		})
+		require.NoError(t, err)
+
+		var results []string
+		for _, result := range report.Results {
+			results = append(results, result.GetEmail()+" "+result.GetAction()+" "+result.GetInvitationID())
+		}
+		assert.Equal(t, []string{
+			"Member@example.com skip_member ",
+			"pending@example.com skip_pending inv_pending",
+			"expired@example.com invite id_1",
+			"new@example.com invite id_2",
+		}, results)
+		assert.True(t, report.Results[3].GetApplied())
+		assert.False(t, report.Results[3].GetExpiresAt().IsZero())
+
+		invitations := organizationInvitations(api)
+		created := invitations[len(invitations)-1]
+		assert.Equal(t, "Acme", created.GetInviter().GetName())
+		assert.Equal(t, "app_1", created.GetClientID())
+		assert.Equal(t, []string{"rol_admin"}, created.Roles)
+		assert.Equal(t, map[string]interface{}{"plan": "pro"}, created.UserMetadata)
+
+		report, err = m.Organization.InviteMembers("org_1", invitees(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, 0, report.Count(OrganizationInvitationInvite))
+		assert.Equal(t, 3, report.Count(OrganizationInvitationSkipPending))
+	})
+
+	t.Run("Invites nobody on a dry run", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		report, err := m.Organization.InviteMembers("org_1", invitees(), &OrganizationInviteOptions{DryRun: authok.Bool(true)})
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Count(OrganizationInvitationInvite))
+		for _, request := range api.requests() {
+			assert.True(t, strings.HasPrefix(request, "GET "), request)
+		}
+	})
+
+	t.Run("Carries on after a failed invitation", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+		api.handle("POST /organizations/org_1/invitations", func(w http.ResponseWriter, r *http.Request) {
+			var invitation OrganizationInvitation
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&invitation))
+			if invitation.GetInvitee().GetEmail() == "expired@example.com" {
+				writeFakeAPIError(w, http.StatusBadRequest, "Invalid email")
+				return
+			}
+			api.serve(w, r)
+		})
+
+		report, err := m.Organization.InviteMembers("org_1", invitees(), nil)
+		assert.EqualError(t, err, "failed to invite 1 of 2 invitees")
+		assert.Contains(t, report.Results[2].GetError(), "Invalid email")
+		assert.False(t, report.Results[2].GetApplied())
+		assert.True(t, report.Results[3].GetApplied())
+	})
+
+	t.Run("Rejects duplicate emails", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		_, err := m.Organization.InviteMembers("org_1", append(invitees(), &OrganizationInvitee{Email: authok.String("NEW@example.com")}), nil)
+		assert.EqualError(t, err, `email "NEW@example.com" is listed more than once`)
+	})
+}
+
+func TestOrganizationManager_RefreshInvitations(t *testing.T) {
+	t.Run("Lists stale invitations soonest first", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		stale, err := m.Organization.StaleInvitations("org_1", 0)
+		require.NoError(t, err)
+		require.Len(t, stale, 1)
+		assert.Equal(t, "inv_expired", stale[0].GetID())
+
+		stale, err = m.Organization.StaleInvitations("org_1", 48*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, stale, 2)
+		assert.Equal(t, "inv_expired", stale[0].GetID())
+		assert.Equal(t, "inv_expiring", stale[1].GetID())
+	})
+
+	t.Run("Reissues stale invitations", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		report, err := m.Organization.RefreshInvitations("org_1", &OrganizationInvitationRefreshOptions{
+			Within: durationPtr(48 * time.Hour),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, report.Count(OrganizationInvitationReissue))
+		for _, result := range report.Results {
+			assert.True(t, result.GetApplied())
+			assert.True(t, strings.HasPrefix(result.GetInvitationID(), "id_"))
+			assert.True(t, result.GetExpiresAt().After(time.Now()))
+		}
+
+		invitations := organizationInvitations(api)
+		var ids []string
+		for _, invitation := range invitations {
+			ids = append(ids, invitation.GetID())
+		}
+		assert.Equal(t, []string{"inv_pending", "id_1", "id_2"}, ids)
+		assert.Equal(t, "expired@example.com", invitations[1].GetInvitee().GetEmail())
+		assert.Equal(t, []string{"rol_viewer"}, invitations[1].Roles)
+
+		stale, err := m.Organization.StaleInvitations("org_1", 48*time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, stale)
+	})
+
+	t.Run("Deletes stale invitations", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		report, err := m.Organization.RefreshInvitations("org_1", &OrganizationInvitationRefreshOptions{
+			Action: authok.String(OrganizationInvitationDelete),
+		})
+		require.NoError(t, err)
+		require.Len(t, report.Results, 1)
+		assert.Equal(t, "inv_expired", report.Results[0].GetInvitationID())
+		assert.Len(t, organizationInvitations(api), 2)
+	})
+
+	t.Run("Changes nothing on a dry run", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		report, err := m.Organization.RefreshInvitations("org_1", &OrganizationInvitationRefreshOptions{
+			Within: durationPtr(48 * time.Hour),
+			DryRun: authok.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Len(t, report.Results, 2)
+		assert.Len(t, organizationInvitations(api), 3)
+	})
+
+	t.Run("Rejects unknown actions", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationInvitations(api)
+
+		_, err := m.Organization.RefreshInvitations("org_1", &OrganizationInvitationRefreshOptions{Action: authok.String("resend")})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,309 @@
+package management
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// Actions of an OrganizationMemberChange.
+const (
+	OrganizationMemberAdd         = "add"
+	OrganizationMemberRemove      = "remove"
+	OrganizationMemberAssignRoles = "assign_roles"
+	OrganizationMemberRemoveRoles = "remove_roles"
+)
+
+// organizationMembersBatchSize is the default and largest number of members
+// added or removed per request.
+const organizationMembersBatchSize = 10
+
+// OrganizationDesiredMember is a member an organization should have, as given
+// to OrganizationManager.SyncMembers.
+type OrganizationDesiredMember struct {
+	UserID *string `json:"user_id,omitempty"`
+
+	// The ids of the roles the member should have in the organization. When
+	// nil, the roles of the member are left as they are.
+	Roles *[]string `json:"roles,omitempty"`
+}
+
+// OrganizationMemberSyncOptions configures OrganizationManager.SyncMembers.
+type OrganizationMemberSyncOptions struct {
+	// When true, the changes are worked out and reported but not applied.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// When true, members that are not desired are kept.
+	KeepUndesired *bool `json:"keep_undesired,omitempty"`
+
+	// How many members are added or removed per request, and how many role
+	// changes are made, before pausing for BatchDelay. Defaults to 10, which
+	// is also the maximum.
+	BatchSize *int `json:"batch_size,omitempty"`
+
+	// How long to pause between batches, to stay within rate limits.
+	BatchDelay *time.Duration `json:"batch_delay,omitempty"`
+}
+
+// OrganizationMemberChange is a change made to the members of an
+// organization by OrganizationManager.SyncMembers.
+type OrganizationMemberChange struct {
+	UserID *string `json:"user_id,omitempty"`
+
+	// One of OrganizationMemberAdd, OrganizationMemberRemove,
+	// OrganizationMemberAssignRoles or OrganizationMemberRemoveRoles.
+	Action *string `json:"action,omitempty"`
+
+	// The ids of the roles assigned or removed.
+	Roles *[]string `json:"roles,omitempty"`
+
+	// True once the change has been made.
+	Applied *bool `json:"applied"`
+}
+
+// OrganizationMemberSyncReport reports on the changes made by
+// OrganizationManager.SyncMembers.
+type OrganizationMemberSyncReport struct {
+	OrganizationID *string `json:"organization_id,omitempty"`
+	DryRun         *bool   `json:"dry_run,omitempty"`
+
+	Changes []*OrganizationMemberChange `json:"changes"`
+
+	// The number of desired members that needed no change.
+	Unchanged *int `json:"unchanged,omitempty"`
+}
+
+// Count returns the number of changes with the given action.
+func (r *OrganizationMemberSyncReport) Count(action string) int {
+	count := 0
+	for _, change := range r.Changes {
+		if change.GetAction() == action {
+			count++
+		}
+	}
+	return count
+}
+
+// SyncMembers reconciles the members of an organization, and their roles in
+// it, with the desired members, such as those kept in a CRM.
+//
+// Members that are not desired are removed, unless KeepUndesired is set, and
+// the roles of members are only changed when the desired member lists them.
+// Members are added, then removed, then have their roles changed in batches,
+// pausing for BatchDelay between every two batches. The changes are reported
+// whether or not they could all be applied. On a dry run nothing is changed.
+//
+// Running SyncMembers again after a failure picks up where it left off.
+func (m *OrganizationManager) SyncMembers(id string, desired []*OrganizationDesiredMember, options *OrganizationMemberSyncOptions, opts ...RequestOption) (*OrganizationMemberSyncReport, error) {
+	if options == nil {
+		options = &OrganizationMemberSyncOptions{}
+	}
+	batchSize := options.GetBatchSize()
+	if batchSize <= 0 || batchSize > organizationMembersBatchSize {
+		batchSize = organizationMembersBatchSize
+	}
+
+	desiredByID := map[string]*OrganizationDesiredMember{}
+	for _, member := range desired {
+		userID := member.GetUserID()
+		if userID == "" {
+			return nil, fmt.Errorf("a user id is required for every desired member")
+		}
+		if desiredByID[userID] != nil {
+			return nil, fmt.Errorf("user %q is listed more than once", userID)
+		}
+		desiredByID[userID] = member
+	}
+
+	current, err := m.memberIDs(id, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &OrganizationMemberSyncReport{
+		OrganizationID: &id,
+		DryRun:         authok.Bool(options.GetDryRun()),
+		Changes:        []*OrganizationMemberChange{},
+	}
+	newChange := func(userID, action string, roles []string) *OrganizationMemberChange {
+		change := &OrganizationMemberChange{
+			UserID:  authok.String(userID),
+			Action:  authok.String(action),
+			Applied: authok.Bool(false),
+		}
+		if roles != nil {
+			change.Roles = &roles
+		}
+		report.Changes = append(report.Changes, change)
+		return change
+	}
+
+	var adds, removes, roleChanges []*OrganizationMemberChange
+	unchanged := 0
+	for _, member := range desired {
+		userID := member.GetUserID()
+		var have []string
+		if current[userID] {
+			if member.Roles == nil {
+				unchanged++
+				continue
+			}
+			if have, err = m.memberRoleIDs(id, userID, opts); err != nil {
+				return nil, err
+			}
+		} else {
+			adds = append(adds, newChange(userID, OrganizationMemberAdd, nil))
+		}
+
+		assign, remove := diffStrings(have, member.GetRoles())
+		if len(assign) > 0 {
+			roleChanges = append(roleChanges, newChange(userID, OrganizationMemberAssignRoles, assign))
+		}
+		if len(remove) > 0 {
+			roleChanges = append(roleChanges, newChange(userID, OrganizationMemberRemoveRoles, remove))
+		}
+		if current[userID] && len(assign) == 0 && len(remove) == 0 {
+			unchanged++
+		}
+	}
+	if !options.GetKeepUndesired() {
+		var undesired []string
+		for userID := range current {
+			if desiredByID[userID] == nil {
+				undesired = append(undesired, userID)
+			}
+		}
+		sort.Strings(undesired)
+		for _, userID := range undesired {
+			removes = append(removes, newChange(userID, OrganizationMemberRemove, nil))
+		}
+	}
+	report.Unchanged = &unchanged
+
+	if options.GetDryRun() {
+		return report, nil
+	}
+
+	ctx := requestContext(opts)
+	batches := append(batchChanges(adds, batchSize), batchChanges(removes, batchSize)...)
+	batches = append(batches, batchChanges(roleChanges, batchSize)...)
+	for i, batch := range batches {
+		if i > 0 {
+			if err := sleepContext(ctx, options.GetBatchDelay()); err != nil {
+				return report, err
+			}
+		}
+		if err := m.applyMemberChanges(id, batch, opts); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// applyMemberChanges applies a batch of changes of the same kind. Members are
+// added or removed in a single request, while roles are changed one member at
+// a time.
+func (m *OrganizationManager) applyMemberChanges(id string, batch []*OrganizationMemberChange, opts []RequestOption) error {
+	var userIDs []string
+	for _, change := range batch {
+		userIDs = append(userIDs, change.GetUserID())
+	}
+
+	var err error
+	switch batch[0].GetAction() {
+	case OrganizationMemberAdd:
+		err = m.AddMembers(id, userIDs, opts...)
+	case OrganizationMemberRemove:
+		err = m.DeleteMember(id, userIDs, opts...)
+	default:
+		for _, change := range batch {
+			if change.GetAction() == OrganizationMemberAssignRoles {
+				err = m.AssignMemberRoles(id, change.GetUserID(), change.GetRoles(), opts...)
+			} else {
+				err = m.DeleteMemberRoles(id, change.GetUserID(), change.GetRoles(), opts...)
+			}
+			if err != nil {
+				return err
+			}
+			change.Applied = authok.Bool(true)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, change := range batch {
+		change.Applied = authok.Bool(true)
+	}
+	return nil
+}
+
+// batchChanges splits changes into batches of at most size changes.
+func batchChanges(changes []*OrganizationMemberChange, size int) [][]*OrganizationMemberChange {
+	var batches [][]*OrganizationMemberChange
+	for start := 0; start < len(changes); start += size {
+		end := start + size
+		if end > len(changes) {
+			end = len(changes)
+		}
+		batches = append(batches, changes[start:end])
+	}
+	return batches
+}
+
+func (m *OrganizationManager) memberIDs(id string, opts []RequestOption) (map[string]bool, error) {
+	ids := map[string]bool{}
+	for page := 0; ; page++ {
+		l, err := m.Members(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range l.Members {
+			ids[member.GetUserID()] = true
+		}
+		if !l.HasNext() {
+			return ids, nil
+		}
+	}
+}
+
+func (m *OrganizationManager) memberRoleIDs(id, userID string, opts []RequestOption) ([]string, error) {
+	var ids []string
+	for page := 0; ; page++ {
+		l, err := m.MemberRoles(id, userID, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range l.Roles {
+			ids = append(ids, role.GetID())
+		}
+		if !l.HasNext() {
+			return ids, nil
+		}
+	}
+}
+
+// diffStrings returns the strings in want missing from have, and those in
+// have missing from want, in the order they are given.
+func diffStrings(have, want []string) (added, removed []string) {
+	inHave := map[string]bool{}
+	for _, s := range have {
+		inHave[s] = true
+	}
+	inWant := map[string]bool{}
+	for _, s := range want {
+		if !inHave[s] && !inWant[s] {
+			added = append(added, s)
+		}
+		inWant[s] = true
+	}
+	for _, s := range have {
+		if !inWant[s] {
+			removed = append(removed, s)
+		}
+	}
+	return added, removed
+}
@@ -0,0 +1,172 @@
+package management
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenOrganizationMembers stores the organization org_1, with user_1 as an
+// admin, user_2 as a viewer and user_3 without roles. Members are listed 2
+// per page.
+func givenOrganizationMembers(api *fakeAPI) {
+	api.pageSize = 2
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	for userID, roles := range map[string][]string{
+		"user_1": {"rol_admin"},
+		"user_2": {"rol_viewer"},
+		"user_3": nil,
+	} {
+		api.put("/organizations/org_1/members/"+userID, map[string]interface{}{"user_id": userID})
+		for _, role := range roles {
+			api.put("/organizations/org_1/members/"+userID+"/roles/"+role, map[string]interface{}{"id": role})
+		}
+	}
+}
+
+// organizationMembers returns the roles of the members of org_1 by user id.
+func organizationMembers(api *fakeAPI) map[string][]string {
+	members := map[string][]string{}
+	for _, member := range api.children("/organizations/org_1/members") {
+		userID := member["user_id"].(string)
+		members[userID] = nil
+		for _, role := range api.children("/organizations/org_1/members/" + userID + "/roles") {
+			members[userID] = append(members[userID], role["id"].(string))
+		}
+	}
+	return members
+}
+
+func testDesiredMembers() []*OrganizationDesiredMember {
+	return []*OrganizationDesiredMember{
+		{UserID: authok.String("user_1"), Roles: &[]string{"rol_viewer"}},
+		{UserID: authok.String("user_2")},
+		{UserID: authok.String("user_4"), Roles: &[]string{"rol_admin"}},
+	}
+}
+
+func TestOrganizationManager_SyncMembers(t *testing.T) {
+	t.Run("Reconciles members and roles", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+
+		report, err := m.Organization.SyncMembers("org_1", testDesiredMembers(), nil)
+		require.NoError(t, err)
+
+		var changes []string
+		for _, change := range report.Changes {
+			assert.True(t, change.GetApplied())
+			changes = append(changes, change.GetUserID()+" "+change.GetAction()+" "+strings.Join(change.GetRoles(), ","))
+		}
+		assert.Equal(t, []string{
+			"user_1 assign_roles rol_viewer",
+			"user_1 remove_roles rol_admin",
+			"user_4 add ",
+			"user_4 assign_roles rol_admin",
+			"user_3 remove ",
+		}, changes)
+		assert.Equal(t, 1, report.GetUnchanged())
+		assert.Equal(t, 1, report.Count(OrganizationMemberAdd))
+
+		assert.Equal(t, map[string][]string{
+			"user_1": {"rol_viewer"},
+			"user_2": {"rol_viewer"},
+			"user_4": {"rol_admin"},
+		}, organizationMembers(api))
+
+		report, err = m.Organization.SyncMembers("org_1", testDesiredMembers(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes)
+		assert.Equal(t, 3, report.GetUnchanged())
+	})
+
+	t.Run("Changes nothing on a dry run", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+
+		report, err := m.Organization.SyncMembers("org_1", testDesiredMembers(), &OrganizationMemberSyncOptions{
+			DryRun:        authok.Bool(true),
+			KeepUndesired: authok.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.True(t, report.GetDryRun())
+		assert.Len(t, report.Changes, 4)
+		assert.Equal(t, 0, report.Count(OrganizationMemberRemove))
+		for _, change := range report.Changes {
+			assert.False(t, change.GetApplied())
+		}
+		for _, request := range api.requests() {
+			assert.True(t, strings.HasPrefix(request, "GET "), request)
+		}
+	})
+
+	t.Run("Adds members in batches", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+
+		var desired []*OrganizationDesiredMember
+		for _, id := range []string{"user_1", "user_2", "user_3", "user_4", "user_5", "user_6"} {
+			desired = append(desired, &OrganizationDesiredMember{UserID: authok.String(id)})
+		}
+		report, err := m.Organization.SyncMembers("org_1", desired, &OrganizationMemberSyncOptions{BatchSize: authok.Int(2)})
+		require.NoError(t, err)
+		assert.Equal(t, 3, report.Count(OrganizationMemberAdd))
+
+		assert.Equal(t, []map[string]interface{}{
+			{"members": []interface{}{"user_4", "user_5"}},
+			{"members": []interface{}{"user_6"}},
+		}, api.bodies("POST /organizations/org_1/members"))
+		assert.Len(t, organizationMembers(api), 6)
+	})
+
+	t.Run("Pauses between every batch", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+		var writes []time.Time
+		for _, pattern := range []string{"POST /organizations/org_1/**", "DELETE /organizations/org_1/**"} {
+			api.handle(pattern, func(w http.ResponseWriter, r *http.Request) {
+				writes = append(writes, time.Now())
+				api.serve(w, r)
+			})
+		}
+
+		delay := 20 * time.Millisecond
+		_, err := m.Organization.SyncMembers("org_1", testDesiredMembers(), &OrganizationMemberSyncOptions{
+			BatchSize:  authok.Int(2),
+			BatchDelay: &delay,
+		})
+		require.NoError(t, err)
+
+		// One batch adds user_4, one removes user_3 and two change the roles
+		// of user_1 and user_4.
+		require.Len(t, writes, 5)
+		assert.GreaterOrEqual(t, writes[len(writes)-1].Sub(writes[0]), 3*delay)
+	})
+
+	t.Run("Reports the changes made before a failure", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+		api.fail("DELETE /organizations/org_1/members", http.StatusInternalServerError, 0)
+
+		report, err := m.Organization.SyncMembers("org_1", testDesiredMembers(), nil)
+		require.Error(t, err)
+		for _, change := range report.Changes {
+			assert.Equal(t, change.GetAction() == OrganizationMemberAdd, change.GetApplied(), change.GetUserID()+" "+change.GetAction())
+		}
+	})
+
+	t.Run("Rejects duplicate members", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenOrganizationMembers(api)
+
+		desired := append(testDesiredMembers(), &OrganizationDesiredMember{UserID: authok.String("user_1")})
+		_, err := m.Organization.SyncMembers("org_1", desired, nil)
+		assert.EqualError(t, err, `user "user_1" is listed more than once`)
+	})
+}
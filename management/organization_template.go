@@ -0,0 +1,228 @@
+package management
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// OrganizationTemplate holds the steps taken every time an organization is
+// onboarded, to be run by OrganizationManager.Provision.
+type OrganizationTemplate struct {
+	// Branding given to every organization.
+	Branding *OrganizationBranding `json:"branding,omitempty"`
+
+	// Metadata given to every organization, merged with the metadata given
+	// when provisioning.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// The connections enabled on every organization, with whether users
+	// logging in with them become members.
+	Connections []*OrganizationConnection `json:"connections,omitempty"`
+
+	// The default roles. Roles are created unless a role with the same name
+	// exists already, in which case that role is used as it is.
+	Roles []*Role `json:"roles,omitempty"`
+
+	// The names of the roles, among Roles, given to the admin.
+	AdminRoles *[]string `json:"admin_roles,omitempty"`
+
+	// The name of the inviter shown in the admin's invitation.
+	InviterName *string `json:"inviter_name,omitempty"`
+
+	// Authok client ID used to resolve the application's login initiation
+	// endpoint in the admin's invitation.
+	ClientID *string `json:"client_id,omitempty"`
+}
+
+// OrganizationTemplateParams holds the values specific to an organization
+// provisioned from an OrganizationTemplate.
+type OrganizationTemplateParams struct {
+	// Name of the organization.
+	Name *string `json:"name,omitempty"`
+
+	// DisplayName of the organization.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Metadata of the organization, taking precedence over the template's.
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// The email of the admin to invite. No one is invited when empty.
+	AdminEmail *string `json:"admin_email,omitempty"`
+}
+
+// OrganizationProvisioning holds the ids of everything created by
+// OrganizationManager.Provision.
+type OrganizationProvisioning struct {
+	OrganizationID *string `json:"organization_id,omitempty"`
+
+	// The ids of the connections enabled on the organization.
+	ConnectionIDs *[]string `json:"connection_ids,omitempty"`
+
+	// The ids of the roles created. Roles that existed already are not
+	// included.
+	RoleIDs *[]string `json:"role_ids,omitempty"`
+
+	// The id of the admin's invitation.
+	InvitationID *string `json:"invitation_id,omitempty"`
+}
+
+// Validate checks the template's admin roles are among its roles.
+func (t *OrganizationTemplate) Validate() error {
+	roles := map[string]bool{}
+	for _, role := range t.Roles {
+		if role.GetName() == "" {
+			return fmt.Errorf("every role of the template needs a name")
+		}
+		roles[role.GetName()] = true
+	}
+	for _, name := range t.GetAdminRoles() {
+		if !roles[name] {
+			return fmt.Errorf("admin role %q is not among the roles of the template", name)
+		}
+	}
+	for _, c := range t.Connections {
+		if c.GetConnectionID() == "" {
+			return fmt.Errorf("every connection of the template needs a connection id")
+		}
+	}
+	return nil
+}
+
+// Provision onboards an organization from a template: it creates the
+// organization with the template's branding and metadata, enables the
+// template's connections, makes sure the default roles exist and invites the
+// admin with the admin roles.
+//
+// If a step fails, the organization and the roles created are deleted again
+// and the error is returned. When that rollback fails too, the ids of what
+// could not be deleted are returned along with the error.
+func (m *OrganizationManager) Provision(template *OrganizationTemplate, params *OrganizationTemplateParams, opts ...RequestOption) (*OrganizationProvisioning, error) {
+	if params.GetName() == "" {
+		return nil, emptyKeyError("Name")
+	}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &OrganizationProvisioning{}
+	if err := m.provision(p, template, params, opts); err != nil {
+		return m.rollbackProvisioning(p, err, opts)
+	}
+	return p, nil
+}
+
+func (m *OrganizationManager) provision(p *OrganizationProvisioning, template *OrganizationTemplate, params *OrganizationTemplateParams, opts []RequestOption) error {
+	o := &Organization{
+		Name:        params.Name,
+		DisplayName: params.DisplayName,
+		Branding:    template.Branding.Clone(),
+	}
+	if template.Metadata != nil || params.Metadata != nil {
+		metadata := map[string]string{}
+		for key, value := range template.GetMetadata() {
+			metadata[key] = value
+		}
+		for key, value := range params.GetMetadata() {
+			metadata[key] = value
+		}
+		o.Metadata = &metadata
+	}
+	if err := m.Create(o, opts...); err != nil {
+		return fmt.Errorf("failed to create organization %q: %w", params.GetName(), err)
+	}
+	p.OrganizationID = o.ID
+
+	for _, c := range template.Connections {
+		err := m.AddConnection(o.GetID(), &OrganizationConnection{
+			ConnectionID:            c.ConnectionID,
+			AssignMembershipOnLogin: c.AssignMembershipOnLogin,
+		}, opts...)
+		if err != nil {
+			return fmt.Errorf("failed to enable connection %q: %w", c.GetConnectionID(), err)
+		}
+		p.ConnectionIDs = appendID(p.ConnectionIDs, c.GetConnectionID())
+	}
+
+	roleIDs := map[string]string{}
+	for _, r := range template.Roles {
+		existing, err := m.Role.findByName(r.GetName(), opts...)
+		if err != nil {
+			return fmt.Errorf("failed to look up role %q: %w", r.GetName(), err)
+		}
+		if existing != nil {
+			roleIDs[r.GetName()] = existing.GetID()
+			continue
+		}
+
+		role := r.Clone()
+		if err := m.Role.Create(role, opts...); err != nil {
+			return fmt.Errorf("failed to create role %q: %w", r.GetName(), err)
+		}
+		p.RoleIDs = appendID(p.RoleIDs, role.GetID())
+		roleIDs[r.GetName()] = role.GetID()
+	}
+
+	if params.GetAdminEmail() == "" {
+		return nil
+	}
+	invitation := &OrganizationInvitation{
+		Inviter:  &OrganizationInvitationInviter{Name: template.InviterName},
+		Invitee:  &OrganizationInvitationInvitee{Email: params.AdminEmail},
+		ClientID: template.ClientID,
+	}
+	for _, name := range template.GetAdminRoles() {
+		invitation.Roles = append(invitation.Roles, roleIDs[name])
+	}
+	if err := m.CreateInvitation(o.GetID(), invitation, opts...); err != nil {
+		return fmt.Errorf("failed to invite admin %q: %w", params.GetAdminEmail(), err)
+	}
+	p.InvitationID = invitation.ID
+
+	return nil
+}
+
+// rollbackProvisioning deletes the organization and the roles created by a failed
+// provisioning. Deleting the organization also removes its connections and
+// invitations.
+func (m *OrganizationManager) rollbackProvisioning(p *OrganizationProvisioning, cause error, opts []RequestOption) (*OrganizationProvisioning, error) {
+	var failures []string
+	deleted := func(err error) bool {
+		if err == nil {
+			return true
+		}
+		if mErr, ok := err.(Error); ok && mErr.Status() == http.StatusNotFound {
+			return true
+		}
+		failures = append(failures, err.Error())
+		return false
+	}
+
+	if p.OrganizationID != nil && deleted(m.Delete(p.GetOrganizationID(), opts...)) {
+		p.OrganizationID, p.ConnectionIDs, p.InvitationID = nil, nil, nil
+	}
+
+	var remaining []string
+	for _, id := range p.GetRoleIDs() {
+		if !deleted(m.Role.Delete(id, opts...)) {
+			remaining = append(remaining, id)
+		}
+	}
+	p.RoleIDs = nil
+	if remaining != nil {
+		p.RoleIDs = &remaining
+	}
+
+	if failures != nil {
+		return p, fmt.Errorf("%w; rolling back failed too: %s", cause, strings.Join(failures, "; "))
+	}
+	return nil, cause
+}
+
+func appendID(ids *[]string, id string) *[]string {
+	if ids == nil {
+		ids = &[]string{}
+	}
+	*ids = append(*ids, id)
+	return ids
+}
@@ -0,0 +1,107 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenAnExistingRole stores the Viewer role, which templates reuse instead
+// of creating it again.
+func givenAnExistingRole(api *fakeAPI) {
+	api.put("/roles/rol_existing", map[string]interface{}{"id": "rol_existing", "name": "Viewer", "description": "Read only"})
+}
+
+func testOrganizationTemplate() *OrganizationTemplate {
+	return &OrganizationTemplate{
+		Branding: &OrganizationBranding{LogoURL: authok.String("https://example.com/logo.png")},
+		Metadata: &map[string]string{"plan": "standard", "region": "eu"},
+		Connections: []*OrganizationConnection{
+			{ConnectionID: authok.String("con_password"), AssignMembershipOnLogin: authok.Bool(false)},
+			{ConnectionID: authok.String("con_google"), AssignMembershipOnLogin: authok.Bool(true)},
+		},
+		Roles: []*Role{
+			{Name: authok.String("Admin"), Description: authok.String("Manages the organization")},
+			{Name: authok.String("Viewer"), Description: authok.String("Views the organization")},
+		},
+		AdminRoles:  &[]string{"Admin", "Viewer"},
+		InviterName: authok.String("Acme onboarding"),
+	}
+}
+
+func TestOrganizationManager_Provision(t *testing.T) {
+	params := &OrganizationTemplateParams{
+		Name:       authok.String("globex"),
+		Metadata:   &map[string]string{"plan": "enterprise"},
+		AdminEmail: authok.String("admin@globex.example.com"),
+	}
+
+	t.Run("Runs every step of the template", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAnExistingRole(api)
+
+		p, err := m.Organization.Provision(testOrganizationTemplate(), params)
+		require.NoError(t, err)
+		assert.Equal(t, &OrganizationProvisioning{
+			OrganizationID: authok.String("id_1"),
+			ConnectionIDs:  &[]string{"con_password", "con_google"},
+			RoleIDs:        &[]string{"id_2"},
+			InvitationID:   authok.String("id_3"),
+		}, p)
+
+		organization := api.get("/organizations/id_1")
+		assert.Equal(t, "globex", organization["name"])
+		assert.Equal(t, map[string]interface{}{"plan": "enterprise", "region": "eu"}, organization["metadata"])
+		assert.Equal(t, true, api.get("/organizations/id_1/enabled_connections/con_google")["assign_membership_on_login"])
+
+		invitation := api.get("/organizations/id_1/invitations/id_3")
+		assert.Equal(t, map[string]interface{}{"email": "admin@globex.example.com"}, invitation["invitee"])
+		assert.Equal(t, []interface{}{"id_2", "rol_existing"}, invitation["roles"])
+
+		assert.Equal(t, "Read only", api.get("/roles/rol_existing")["description"])
+		assert.NotContains(t, api.requests(), "PATCH /roles/rol_existing")
+	})
+
+	t.Run("Rolls back when a step fails", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAnExistingRole(api)
+		api.fail("POST /organizations/id_1/invitations", http.StatusInternalServerError, 0)
+
+		p, err := m.Organization.Provision(testOrganizationTemplate(), params)
+		assert.Nil(t, p)
+		assert.ErrorContains(t, err, `failed to invite admin "admin@globex.example.com"`)
+
+		assert.Equal(t, []string{"/roles/rol_existing"}, api.paths())
+		assert.Equal(t, map[string]interface{}{"id": "rol_existing", "name": "Viewer", "description": "Read only"}, api.get("/roles/rol_existing"))
+		assert.Contains(t, api.requests(), "DELETE /roles/id_2")
+		assert.NotContains(t, api.requests(), "PATCH /roles/rol_existing")
+		assert.NotContains(t, api.requests(), "DELETE /roles/rol_existing")
+	})
+
+	t.Run("Returns what could not be rolled back", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAnExistingRole(api)
+		api.fail("POST /organizations/id_1/enabled_connections", http.StatusInternalServerError, 0)
+		api.fail("DELETE /organizations/**", http.StatusInternalServerError, 0)
+
+		p, err := m.Organization.Provision(testOrganizationTemplate(), params)
+		assert.ErrorContains(t, err, "rolling back failed too")
+		assert.Equal(t, &OrganizationProvisioning{OrganizationID: authok.String("id_1")}, p)
+	})
+
+	t.Run("Rejects invalid templates", func(t *testing.T) {
+		_, m := startFakeAPI(t)
+
+		template := testOrganizationTemplate()
+		template.AdminRoles = &[]string{"Owner"}
+		_, err := m.Organization.Provision(template, params)
+		assert.EqualError(t, err, `admin role "Owner" is not among the roles of the template`)
+
+		_, err = m.Organization.Provision(testOrganizationTemplate(), &OrganizationTemplateParams{})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,326 @@
+package management
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/authok/authok-go"
+)
+
+// Actions of a RoleChange.
+const (
+	RoleCreate               = "create"
+	RoleUpdate               = "update"
+	RoleAssociatePermissions = "associate_permissions"
+	RoleRemovePermissions    = "remove_permissions"
+	RoleDelete               = "delete"
+)
+
+// DesiredRole is a role as it should be, as given to RoleManager.Sync.
+type DesiredRole struct {
+	// The name of the role, which identifies it.
+	Name *string `json:"name,omitempty"`
+
+	// The description of the role. When nil, the description of an existing
+	// role is left as it is.
+	Description *string `json:"description,omitempty"`
+
+	// The names of the permissions the role should grant, by identifier of
+	// the resource server defining them.
+	Permissions map[string][]string `json:"permissions,omitempty"`
+}
+
+// RoleSyncOptions configures RoleManager.Sync.
+type RoleSyncOptions struct {
+	// When true, the changes are worked out and reported but not applied.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// When true, the roles that are not desired are deleted.
+	DeleteUnmanaged *bool `json:"delete_unmanaged,omitempty"`
+}
+
+// RoleChange is a change made to the roles by RoleManager.Sync.
+type RoleChange struct {
+	// The id of the role, unknown for roles to be created.
+	RoleID   *string `json:"role_id,omitempty"`
+	RoleName *string `json:"role_name,omitempty"`
+
+	// One of RoleCreate, RoleUpdate, RoleAssociatePermissions,
+	// RoleRemovePermissions or RoleDelete.
+	Action *string `json:"action,omitempty"`
+
+	// The permissions associated or removed.
+	Permissions []*Permission `json:"permissions,omitempty"`
+
+	// True once the change has been made.
+	Applied *bool `json:"applied"`
+}
+
+// RoleSyncReport reports on the changes made by RoleManager.Sync.
+type RoleSyncReport struct {
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	Changes []*RoleChange `json:"changes"`
+
+	// The number of desired roles that needed no change.
+	Unchanged *int `json:"unchanged,omitempty"`
+}
+
+// Count returns the number of changes with the given action.
+func (r *RoleSyncReport) Count(action string) int {
+	count := 0
+	for _, change := range r.Changes {
+		if change.GetAction() == action {
+			count++
+		}
+	}
+	return count
+}
+
+// Sync reconciles the roles, and the permissions they grant, with the desired
+// roles, such as those defined in code.
+//
+// Missing roles are created and permissions are associated and removed until
+// each role grants exactly the desired ones, undoing changes made elsewhere.
+// Roles that are not desired are only deleted when DeleteUnmanaged is set.
+// Before anything is changed, every desired permission is checked to be among
+// the scopes of its resource server.
+//
+// The changes are reported whether or not they could all be applied. On a dry
+// run nothing is changed. Running Sync again after a failure picks up where it
+// left off. The query parameters set by opts only apply to the lists, never to
+// the changes.
+func (m *RoleManager) Sync(desired []*DesiredRole, options *RoleSyncOptions, opts ...RequestOption) (*RoleSyncReport, error) {
+	if options == nil {
+		options = &RoleSyncOptions{}
+	}
+
+	desiredByName := map[string]*DesiredRole{}
+	for _, role := range desired {
+		if role.GetName() == "" {
+			return nil, fmt.Errorf("a name is required for every desired role")
+		}
+		if desiredByName[role.GetName()] != nil {
+			return nil, fmt.Errorf("role %q is listed more than once", role.GetName())
+		}
+		desiredByName[role.GetName()] = role
+	}
+	if err := m.checkPermissionsExist(desired, opts); err != nil {
+		return nil, err
+	}
+
+	existing, err := listAll(func(page int) ([]*Role, bool, error) {
+		l, err := m.List(withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Roles, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	existingByName := map[string]*Role{}
+	for _, role := range existing {
+		existingByName[role.GetName()] = role
+	}
+
+	report := &RoleSyncReport{
+		DryRun:  authok.Bool(options.GetDryRun()),
+		Changes: []*RoleChange{},
+	}
+	var steps []func() error
+	addChange := func(role *Role, action string, permissions []*Permission, apply func() error) {
+		change := &RoleChange{
+			RoleID:      role.ID,
+			RoleName:    role.Name,
+			Action:      authok.String(action),
+			Permissions: permissions,
+			Applied:     authok.Bool(false),
+		}
+		report.Changes = append(report.Changes, change)
+		steps = append(steps, func() error {
+			if err := apply(); err != nil {
+				return fmt.Errorf("failed to %s role %q: %w", strings.ReplaceAll(action, "_", " "), role.GetName(), err)
+			}
+			change.RoleID = role.ID
+			change.Applied = authok.Bool(true)
+			return nil
+		})
+	}
+
+	unchanged := 0
+	for _, d := range desired {
+		role := existingByName[d.GetName()]
+		var have []*Permission
+		changed := false
+		if role == nil {
+			role = &Role{Name: d.Name, Description: d.Description}
+			addChange(role, RoleCreate, nil, func() error {
+				return m.Create(role, withoutQuery(opts))
+			})
+			changed = true
+		} else {
+			if d.Description != nil && d.GetDescription() != role.GetDescription() {
+				update := &Role{Description: d.Description}
+				addChange(role, RoleUpdate, nil, func() error {
+					return m.Update(role.GetID(), update, withoutQuery(opts))
+				})
+				changed = true
+			}
+			if have, err = m.rolePermissions(role.GetID(), opts); err != nil {
+				return nil, err
+			}
+		}
+
+		associate, remove := diffPermissions(have, d.Permissions)
+		if len(associate) > 0 {
+			addChange(role, RoleAssociatePermissions, associate, func() error {
+				return m.AssociatePermissions(role.GetID(), associate, withoutQuery(opts))
+			})
+			changed = true
+		}
+		if len(remove) > 0 {
+			addChange(role, RoleRemovePermissions, remove, func() error {
+				return m.RemovePermissions(role.GetID(), remove, withoutQuery(opts))
+			})
+			changed = true
+		}
+		if !changed {
+			unchanged++
+		}
+	}
+	if options.GetDeleteUnmanaged() {
+		sort.Slice(existing, func(i, j int) bool {
+			return existing[i].GetName() < existing[j].GetName()
+		})
+		for _, role := range existing {
+			if desiredByName[role.GetName()] != nil {
+				continue
+			}
+			role := role
+			addChange(role, RoleDelete, nil, func() error {
+				return m.Delete(role.GetID(), withoutQuery(opts))
+			})
+		}
+	}
+	report.Unchanged = &unchanged
+
+	if options.GetDryRun() {
+		return report, nil
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// checkPermissionsExist checks that the desired permissions are among the
+// scopes of their resource servers.
+func (m *RoleManager) checkPermissionsExist(desired []*DesiredRole, opts []RequestOption) error {
+	resourceServers, err := listAll(func(page int) ([]*ResourceServer, bool, error) {
+		l, err := m.ResourceServer.List(withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.ResourceServers, l.HasNext(), nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list resource servers: %w", err)
+	}
+	scopes := map[string]map[string]bool{}
+	for _, resourceServer := range resourceServers {
+		values := map[string]bool{}
+		for _, scope := range resourceServer.GetScopes() {
+			values[scope.GetValue()] = true
+		}
+		scopes[resourceServer.GetIdentifier()] = values
+	}
+
+	var problems []string
+	for _, role := range desired {
+		identifiers := make([]string, 0, len(role.Permissions))
+		for identifier := range role.Permissions {
+			identifiers = append(identifiers, identifier)
+		}
+		sort.Strings(identifiers)
+		for _, identifier := range identifiers {
+			values, ok := scopes[identifier]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("role %q: resource server %q does not exist", role.GetName(), identifier))
+				continue
+			}
+			for _, name := range role.Permissions[identifier] {
+				if !values[name] {
+					problems = append(problems, fmt.Sprintf("role %q: permission %q is not a scope of resource server %q", role.GetName(), name, identifier))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid desired roles: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (m *RoleManager) rolePermissions(id string, opts []RequestOption) ([]*Permission, error) {
+	permissions, err := listAll(func(page int) ([]*Permission, bool, error) {
+		l, err := m.Permissions(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Permissions, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the permissions of role %q: %w", id, err)
+	}
+	return permissions, nil
+}
+
+// diffPermissions returns the permissions in want missing from have, and
+// those in have missing from want. Both are sorted by resource server and
+// name.
+func diffPermissions(have []*Permission, want map[string][]string) (associate, remove []*Permission) {
+	key := func(identifier, name string) string {
+		return identifier + "\x00" + name
+	}
+
+	inHave := map[string]bool{}
+	for _, permission := range have {
+		inHave[key(permission.GetResourceServerIdentifier(), permission.GetName())] = true
+	}
+	inWant := map[string]bool{}
+	for identifier, names := range want {
+		for _, name := range names {
+			k := key(identifier, name)
+			if !inHave[k] && !inWant[k] {
+				associate = append(associate, &Permission{
+					ResourceServerIdentifier: authok.String(identifier),
+					Name:                     authok.String(name),
+				})
+			}
+			inWant[k] = true
+		}
+	}
+	for _, permission := range have {
+		if !inWant[key(permission.GetResourceServerIdentifier(), permission.GetName())] {
+			remove = append(remove, &Permission{
+				ResourceServerIdentifier: permission.ResourceServerIdentifier,
+				Name:                     permission.Name,
+			})
+		}
+	}
+
+	for _, permissions := range [][]*Permission{associate, remove} {
+		permissions := permissions
+		sort.Slice(permissions, func(i, j int) bool {
+			if permissions[i].GetResourceServerIdentifier() != permissions[j].GetResourceServerIdentifier() {
+				return permissions[i].GetResourceServerIdentifier() < permissions[j].GetResourceServerIdentifier()
+			}
+			return permissions[i].GetName() < permissions[j].GetName()
+		})
+	}
+	return associate, remove
+}
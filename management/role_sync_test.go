@@ -0,0 +1,153 @@
+package management
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenRolesToSync stores the resource server https://api and the roles
+// reader, which grants read:items and delete:items, and legacy.
+func givenRolesToSync(api *fakeAPI) {
+	api.put("/resource-servers/rs_api", map[string]interface{}{
+		"id":         "rs_api",
+		"identifier": "https://api",
+		"scopes": []interface{}{
+			map[string]interface{}{"value": "read:items"},
+			map[string]interface{}{"value": "write:items"},
+			map[string]interface{}{"value": "delete:items"},
+		},
+	})
+	api.put("/roles/rol_reader", map[string]interface{}{"id": "rol_reader", "name": "reader"})
+	api.put("/roles/rol_legacy", map[string]interface{}{"id": "rol_legacy", "name": "legacy"})
+	for _, name := range []string{"read:items", "delete:items"} {
+		api.add("/roles/rol_reader/permissions", fakeAPICollections["permissions"], map[string]interface{}{
+			"resource_server_identifier": "https://api",
+			"permission_name":            name,
+		})
+	}
+}
+
+func testDesiredRoles() []*DesiredRole {
+	return []*DesiredRole{
+		{
+			Name:        authok.String("reader"),
+			Description: authok.String("Reads items"),
+			Permissions: map[string][]string{"https://api": {"read:items"}},
+		},
+		{
+			Name:        authok.String("editor"),
+			Permissions: map[string][]string{"https://api": {"write:items", "read:items"}},
+		},
+	}
+}
+
+func TestRoleManager_Sync(t *testing.T) {
+	describe := func(report *RoleSyncReport) []string {
+		var changes []string
+		for _, change := range report.Changes {
+			var names []string
+			for _, permission := range change.Permissions {
+				names = append(names, permission.GetName())
+			}
+			changes = append(changes, change.GetAction()+" "+change.GetRoleName()+" "+strings.Join(names, ","))
+		}
+		return changes
+	}
+	writes := func(api *fakeAPI) []string {
+		var writes []string
+		for _, request := range api.requests() {
+			if !strings.HasPrefix(request, "GET ") {
+				writes = append(writes, request)
+			}
+		}
+		return writes
+	}
+
+	t.Run("Reconciles roles and their permissions", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenRolesToSync(api)
+
+		report, err := m.Role.Sync(testDesiredRoles(), nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"update reader ",
+			"remove_permissions reader delete:items",
+			"create editor ",
+			"associate_permissions editor read:items,write:items",
+		}, describe(report))
+		for _, change := range report.Changes {
+			assert.True(t, change.GetApplied())
+		}
+		assert.Equal(t, "id_1", report.Changes[3].GetRoleID())
+		assert.Equal(t, "Reads items", api.get("/roles/rol_reader")["description"])
+		assert.Len(t, api.children("/roles/rol_reader/permissions"), 1)
+		assert.Len(t, api.children("/roles/id_1/permissions"), 2)
+		assert.NotNil(t, api.get("/roles/rol_legacy"))
+
+		api.forget()
+		report, err = m.Role.Sync(testDesiredRoles(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, report.Changes)
+		assert.Equal(t, 2, report.GetUnchanged())
+		assert.Empty(t, writes(api))
+	})
+
+	t.Run("Deletes unmanaged roles when asked to", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenRolesToSync(api)
+
+		report, err := m.Role.Sync(testDesiredRoles(), &RoleSyncOptions{
+			DryRun:          authok.Bool(true),
+			DeleteUnmanaged: authok.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, report.Count(RoleDelete))
+		assert.Empty(t, writes(api))
+
+		_, err = m.Role.Sync(testDesiredRoles(), &RoleSyncOptions{DeleteUnmanaged: authok.Bool(true)})
+		require.NoError(t, err)
+		assert.Nil(t, api.get("/roles/rol_legacy"))
+		assert.Contains(t, writes(api), "DELETE /roles/rol_legacy")
+	})
+
+	t.Run("Only lists with the query options", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenRolesToSync(api)
+
+		_, err := m.Role.Sync(testDesiredRoles(), &RoleSyncOptions{DeleteUnmanaged: authok.Bool(true)}, IncludeFields("id", "name"))
+		require.NoError(t, err)
+
+		require.Len(t, writes(api), 5)
+		for _, method := range []string{"PATCH", "POST", "DELETE"} {
+			for _, query := range api.queries(method + " /**") {
+				assert.Empty(t, query, method)
+			}
+		}
+		for _, query := range api.queries("GET /roles") {
+			assert.Equal(t, "id,name", query.Get("fields"))
+		}
+	})
+
+	t.Run("Checks permissions exist before changing anything", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenRolesToSync(api)
+
+		desired := testDesiredRoles()
+		desired[0].Permissions["https://api"] = append(desired[0].Permissions["https://api"], "read:secrets")
+		desired[1].Permissions["https://billing"] = []string{"read:invoices"}
+
+		_, err := m.Role.Sync(desired, nil)
+		assert.EqualError(t, err, `invalid desired roles: `+
+			`role "reader": permission "read:secrets" is not a scope of resource server "https://api"; `+
+			`role "editor": resource server "https://billing" does not exist`)
+		assert.Empty(t, writes(api))
+
+		_, err = m.Role.Sync(append(testDesiredRoles(), &DesiredRole{Name: authok.String("reader")}), nil)
+		assert.EqualError(t, err, `role "reader" is listed more than once`)
+	})
+}
@@ -0,0 +1,298 @@
+package management
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SearchField is a searchable attribute of users or log events.
+type SearchField string
+
+// Searchable attributes of users.
+//
+// See: https://authok.com/docs/users/search/v3/query-syntax
+const (
+	UserFieldUserID             SearchField = "user_id"
+	UserFieldEmail              SearchField = "email"
+	UserFieldEmailVerified      SearchField = "email_verified"
+	UserFieldPhoneNumber        SearchField = "phone_number"
+	UserFieldPhoneVerified      SearchField = "phone_verified"
+	UserFieldName               SearchField = "name"
+	UserFieldGivenName          SearchField = "given_name"
+	UserFieldFamilyName         SearchField = "family_name"
+	UserFieldNickname           SearchField = "nickname"
+	UserFieldUsername           SearchField = "username"
+	UserFieldPicture            SearchField = "picture"
+	UserFieldBlocked            SearchField = "blocked"
+	UserFieldCreatedAt          SearchField = "created_at"
+	UserFieldUpdatedAt          SearchField = "updated_at"
+	UserFieldLastLogin          SearchField = "last_login"
+	UserFieldLastIP             SearchField = "last_ip"
+	UserFieldLoginsCount        SearchField = "logins_count"
+	UserFieldIdentityConnection SearchField = "identities.connection"
+	UserFieldIdentityProvider   SearchField = "identities.provider"
+	UserFieldIdentityIsSocial   SearchField = "identities.isSocial"
+	UserFieldIdentityUserID     SearchField = "identities.user_id"
+	UserFieldOrganizationID     SearchField = "organization_id"
+	UserFieldMultifactor        SearchField = "multifactor"
+	UserFieldLastPasswordReset  SearchField = "last_password_reset"
+	UserFieldAppMetadata        SearchField = "app_metadata"
+	UserFieldUserMetadata       SearchField = "user_metadata"
+)
+
+// Searchable attributes of log events.
+//
+// See: https://authok.com/docs/logs/log-search-query-syntax
+const (
+	LogFieldType           SearchField = "type"
+	LogFieldDate           SearchField = "date"
+	LogFieldDescription    SearchField = "description"
+	LogFieldClientID       SearchField = "client_id"
+	LogFieldClientName     SearchField = "client_name"
+	LogFieldConnection     SearchField = "connection"
+	LogFieldConnectionID   SearchField = "connection_id"
+	LogFieldIP             SearchField = "ip"
+	LogFieldUserID         SearchField = "user_id"
+	LogFieldUserName       SearchField = "user_name"
+	LogFieldStrategy       SearchField = "strategy"
+	LogFieldStrategyType   SearchField = "strategy_type"
+	LogFieldHostname       SearchField = "hostname"
+	LogFieldAudience       SearchField = "audience"
+	LogFieldScope          SearchField = "scope"
+	LogFieldOrganizationID SearchField = "organization_id"
+	LogFieldIsMobile       SearchField = "isMobile"
+)
+
+// AppMetadataField returns the field holding a value nested in the
+// app_metadata of users, such as AppMetadataField("plan", "tier") for
+// app_metadata.plan.tier. Keys holding < or >, which cannot be escaped, are
+// rejected.
+func AppMetadataField(path ...string) (SearchField, error) {
+	return metadataField(UserFieldAppMetadata, path)
+}
+
+// UserMetadataField returns the field holding a value nested in the
+// user_metadata of users. Keys holding < or > are rejected.
+func UserMetadataField(path ...string) (SearchField, error) {
+	return metadataField(UserFieldUserMetadata, path)
+}
+
+func metadataField(root SearchField, path []string) (SearchField, error) {
+	field := string(root)
+	for _, key := range path {
+		if strings.ContainsAny(key, searchUnescapableCharacters) {
+			return "", fmt.Errorf("%s key %q cannot be searched: it holds < or >", root, key)
+		}
+		field += "." + escapeSearchTerm(key)
+	}
+	return SearchField(field), nil
+}
+
+// SearchQuery is a query in the Lucene syntax used to search users and log
+// events. Queries are built from the methods of SearchField and combined with
+// AllOf, AnyOf and Not, which take care of escaping:
+//
+//	plan, err := AppMetadataField("plan")
+//	if err != nil {
+//		return err
+//	}
+//	q := AllOf(
+//		UserFieldEmail.Matches("*@example.com"),
+//		UserFieldLoginsCount.Range(100, 200, true, false),
+//		Not(plan.Equals("free")),
+//	)
+//	m.User.Search(q.Option())
+//
+// See: https://authok.com/docs/users/search/v3/query-syntax
+type SearchQuery string
+
+// Option returns the RequestOption searching for the query, to be passed to
+// UserManager.Search or LogManager.Search.
+func (q SearchQuery) Option() RequestOption {
+	return Query(string(q))
+}
+
+// Equals matches the field holding exactly the value. Strings, booleans,
+// numbers and time.Time values are supported. Strings holding < or >, which
+// cannot be escaped, are quoted.
+func (f SearchField) Equals(value interface{}) SearchQuery {
+	return SearchQuery(string(f) + ":" + searchValue(value, false))
+}
+
+// Phrase matches the field holding the words of the phrase in order.
+func (f SearchField) Phrase(phrase string) SearchQuery {
+	return SearchQuery(string(f) + ":" + quoteSearchTerm(phrase))
+}
+
+// Matches matches the field against a pattern, in which * stands for any
+// characters and ? for a single one. Other characters are escaped, except for
+// < and > which cannot be: patterns holding them are searched as a phrase, in
+// which * and ? stand for themselves.
+func (f SearchField) Matches(pattern string) SearchQuery {
+	if strings.ContainsAny(pattern, searchUnescapableCharacters) {
+		return f.Phrase(pattern)
+	}
+	var b strings.Builder
+	for _, r := range pattern {
+		if r != '*' && r != '?' && strings.ContainsRune(searchSpecialCharacters, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return SearchQuery(string(f) + ":" + b.String())
+}
+
+// Exists matches records holding a value for the field.
+func (f SearchField) Exists() SearchQuery {
+	return SearchQuery("_exists_:" + string(f))
+}
+
+// Range matches the field holding a value between from and to, each of which
+// is included in the range or not as given. A nil bound leaves that end of
+// the range open. Bounds can be numbers, strings or time.Time values, the
+// latter being suited to date fields such as UserFieldCreatedAt.
+func (f SearchField) Range(from, to interface{}, includeFrom, includeTo bool) SearchQuery {
+	open, close := "{", "}"
+	if includeFrom || from == nil {
+		open = "["
+	}
+	if includeTo || to == nil {
+		close = "]"
+	}
+	return SearchQuery(fmt.Sprintf("%s:%s%s TO %s%s", f, open, searchBound(from), searchBound(to), close))
+}
+
+// Between matches the field holding a value between from and to, both
+// included.
+func (f SearchField) Between(from, to interface{}) SearchQuery {
+	return f.Range(from, to, true, true)
+}
+
+// AtLeast matches the field holding a value greater than or equal to value.
+func (f SearchField) AtLeast(value interface{}) SearchQuery {
+	return f.Range(value, nil, true, false)
+}
+
+// AtMost matches the field holding a value less than or equal to value.
+func (f SearchField) AtMost(value interface{}) SearchQuery {
+	return f.Range(nil, value, false, true)
+}
+
+// After matches the date field holding a time after t.
+func (f SearchField) After(t time.Time) SearchQuery {
+	return f.Range(t, nil, false, false)
+}
+
+// Before matches the date field holding a time before t.
+func (f SearchField) Before(t time.Time) SearchQuery {
+	return f.Range(nil, t, false, false)
+}
+
+// AllOf matches records matching all the queries. Empty queries are ignored.
+func AllOf(queries ...SearchQuery) SearchQuery {
+	return joinSearchQueries(" AND ", queries)
+}
+
+// AnyOf matches records matching any of the queries. Empty queries are
+// ignored.
+func AnyOf(queries ...SearchQuery) SearchQuery {
+	return joinSearchQueries(" OR ", queries)
+}
+
+// Not matches records not matching the query.
+func Not(q SearchQuery) SearchQuery {
+	if q == "" {
+		return ""
+	}
+	return SearchQuery("NOT " + groupSearchQuery(q))
+}
+
+func joinSearchQueries(operator string, queries []SearchQuery) SearchQuery {
+	var parts []string
+	var last SearchQuery
+	for _, q := range queries {
+		if q != "" {
+			parts = append(parts, groupSearchQuery(q))
+			last = q
+		}
+	}
+	if len(parts) == 1 {
+		return last
+	}
+	return SearchQuery(strings.Join(parts, operator))
+}
+
+// groupSearchQuery puts queries combining others with AND or OR in
+// parentheses, so that they can be combined in turn. NOT binds tighter than
+// both, so negated queries are left as they are.
+func groupSearchQuery(q SearchQuery) string {
+	s := string(q)
+	depth := 0
+	quoted, escaped := false, false
+	for i, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case depth == 0 && (strings.HasPrefix(s[i:], " AND ") || strings.HasPrefix(s[i:], " OR ")):
+			return "(" + s + ")"
+		}
+	}
+	return s
+}
+
+// searchSpecialCharacters are the characters escaped in search terms.
+const searchSpecialCharacters = `+-&|!(){}[]^"~*?:\/= `
+
+// searchUnescapableCharacters are the characters starting range queries, such
+// as age:>10, which cannot be escaped. Terms holding them are quoted instead.
+const searchUnescapableCharacters = `<>`
+
+func escapeSearchTerm(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(searchSpecialCharacters, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func quoteSearchTerm(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func searchValue(value interface{}, quoteStrings bool) string {
+	switch v := value.(type) {
+	case string:
+		if quoteStrings || strings.ContainsAny(v, searchUnescapableCharacters) {
+			return quoteSearchTerm(v)
+		}
+		return escapeSearchTerm(v)
+	case time.Time:
+		return quoteSearchTerm(v.UTC().Format("2006-01-02T15:04:05.000Z"))
+	case *time.Time:
+		return searchValue(*v, quoteStrings)
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v)
+	default:
+		return escapeSearchTerm(fmt.Sprint(v))
+	}
+}
+
+func searchBound(value interface{}) string {
+	if value == nil {
+		return "*"
+	}
+	return searchValue(value, true)
+}
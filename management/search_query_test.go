@@ -0,0 +1,76 @@
+package management
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchQuery(t *testing.T) {
+	createdAt := time.Date(2023, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	field := func(f SearchField, err error) SearchField {
+		require.NoError(t, err)
+		return f
+	}
+
+	for expected, query := range map[string]SearchQuery{
+		`email:ana@example.com`:                          UserFieldEmail.Equals("ana@example.com"),
+		`email_verified:true`:                            UserFieldEmailVerified.Equals(true),
+		`logins_count:-5`:                                UserFieldLoginsCount.Equals(-5),
+		`name:Ana\ \(admin\)`:                            UserFieldName.Equals("Ana (admin)"),
+		`name:"Ana \"the\" Admin \\o/"`:                  UserFieldName.Phrase(`Ana "the" Admin \o/`),
+		`email:*@example.com`:                            UserFieldEmail.Matches("*@example.com"),
+		`user_id:auth0\|?\+x*`:                           UserFieldUserID.Matches("auth0|?+x*"),
+		`_exists_:last_login`:                            UserFieldLastLogin.Exists(),
+		`logins_count:[100 TO 200}`:                      UserFieldLoginsCount.Range(100, 200, true, false),
+		`logins_count:{100 TO *]`:                        UserFieldLoginsCount.Range(100, nil, false, false),
+		`logins_count:[* TO 10]`:                         UserFieldLoginsCount.AtMost(10),
+		`name:["a" TO "m"]`:                              UserFieldName.Between("a", "m"),
+		`created_at:{"2023-01-02T02:04:05.000Z" TO *]`:   UserFieldCreatedAt.After(createdAt),
+		`date:[* TO "2023-01-02T02:04:05.000Z"}`:         LogFieldDate.Before(createdAt),
+		`app_metadata.plan.tier:gold`:                    field(AppMetadataField("plan", "tier")).Equals("gold"),
+		`user_metadata.favorite\ color:blue`:             field(UserMetadataField("favorite color")).Equals("blue"),
+		`email_verified:true AND logins_count:[10 TO *]`: AllOf(UserFieldEmailVerified.Equals(true), UserFieldLoginsCount.AtLeast(10)),
+		`type:s OR type:f`:                               AnyOf(LogFieldType.Equals("s"), "", LogFieldType.Equals("f")),
+		`type:s`:                                         AnyOf("", LogFieldType.Equals("s")),
+		``:                                               AllOf(),
+		`NOT blocked:true`:                               Not(UserFieldBlocked.Equals(true)),
+		`(a:1 OR b:2) AND NOT (c:3 AND d:4)`:             AllOf(AnyOf("a:1", "b:2"), Not(AllOf("c:3", "d:4"))),
+		`(a:1 OR b:2) AND ((a:1) OR (b:2))`:              AllOf(AnyOf("a:1", "b:2"), AnyOf("(a:1)", "(b:2)")),
+		`(a:1 OR b:2) OR NOT c:3`:                        AnyOf("(a:1 OR b:2)", Not("c:3")),
+		`name:"Tom AND Jerry" AND a:1`:                   AllOf(UserFieldName.Phrase("Tom AND Jerry"), "a:1"),
+		`app_metadata.plan:tier\=gold`:                   field(AppMetadataField("plan")).Equals("tier=gold"),
+		`name:"<script>"`:                                UserFieldName.Equals("<script>"),
+		`logins_count:">10"`:                             UserFieldLoginsCount.Equals(">10"),
+		`name:"a>b"`:                                     UserFieldName.Matches("a>b"),
+		`name:"<*>"`:                                     UserFieldName.Matches("<*>"),
+		`user_id:auth0\=*`:                               UserFieldUserID.Matches("auth0=*"),
+		`identities.connection:Username\-Password\-Authentication`: UserFieldIdentityConnection.Equals("Username-Password-Authentication"),
+	} {
+		assert.Equal(t, expected, string(query))
+	}
+}
+
+func TestMetadataField_RejectsUnescapableKeys(t *testing.T) {
+	_, err := AppMetadataField("plan", "<tier>")
+	assert.EqualError(t, err, `app_metadata key "<tier>" cannot be searched: it holds < or >`)
+
+	_, err = UserMetadataField("a>b")
+	assert.EqualError(t, err, `user_metadata key "a>b" cannot be searched: it holds < or >`)
+}
+
+func TestSearchQuery_Option(t *testing.T) {
+	api, m := startFakeAPI(t)
+
+	plan, err := AppMetadataField("plan")
+	require.NoError(t, err)
+	q := AllOf(UserFieldEmail.Matches("*@example.com"), Not(plan.Equals("free")))
+	_, err = m.User.Search(q.Option())
+	require.NoError(t, err)
+
+	require.Len(t, api.queries("GET /users"), 1)
+	assert.Equal(t, `email:*@example.com AND NOT app_metadata.plan:free`, api.queries("GET /users")[0].Get("q"))
+	assert.Equal(t, "v3", api.queries("GET /users")[0].Get("search_engine"))
+}
@@ -0,0 +1,355 @@
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// Steps of a signing key rotation.
+const (
+	SigningKeyRotationStepRotate = "rotate"
+	SigningKeyRotationStepWait   = "wait"
+	SigningKeyRotationStepRevoke = "revoke"
+)
+
+// SigningKeyRotation describes a rotation of the signing keys of a tenant.
+//
+// A rotation makes the next key the current one, waits for the new key to
+// reach every party verifying tokens, and only then revokes the previous key.
+// Every step is skipped when already done, so an interrupted rotation is
+// resumed by running it again with the same SigningKeyRotation. As the key ids
+// are recorded while planning, it can be stored as JSON between runs.
+type SigningKeyRotation struct {
+	// The key id of the key that becomes the current key. Defaults to the
+	// next key.
+	KID *string `json:"kid,omitempty"`
+
+	// The key id of the key that is revoked once the new key has propagated.
+	// Defaults to the key that is current when the rotation is planned.
+	PreviousKID *string `json:"previous_kid,omitempty"`
+
+	// The JWKS endpoints of downstream consumers, such as API gateways or
+	// caching proxies, which are polled until they all serve the new key.
+	JWKSURLs []string `json:"jwks_urls,omitempty"`
+
+	// How long to wait after the new key became current before revoking the
+	// previous key. Either a grace period or JWKS URLs are required.
+	GracePeriod *time.Duration `json:"grace_period,omitempty"`
+
+	// How often the JWKS URLs are polled. Defaults to 10 seconds.
+	PollInterval *time.Duration `json:"poll_interval,omitempty"`
+
+	// How long to poll the JWKS URLs for. Defaults to 15 minutes.
+	PollTimeout *time.Duration `json:"poll_timeout,omitempty"`
+
+	// When true, the plan is printed to Output and nothing is changed.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// The client used to poll the JWKS URLs. Defaults to http.DefaultClient.
+	HTTPClient *http.Client `json:"-"`
+
+	// Where the plan is printed to on a dry run. Defaults to os.Stdout.
+	Output io.Writer `json:"-"`
+}
+
+// SigningKeyRotationStep is a step of a SigningKeyRotation.
+type SigningKeyRotationStep struct {
+	// One of SigningKeyRotationStepRotate, SigningKeyRotationStepWait or
+	// SigningKeyRotationStepRevoke.
+	Name *string `json:"name,omitempty"`
+
+	// The key the step acts on.
+	KID *string `json:"kid,omitempty"`
+
+	// What the step does, in plain words.
+	Description *string `json:"description,omitempty"`
+
+	// True if the step has been done.
+	Done *bool `json:"done"`
+}
+
+const (
+	signingKeyRotationPollInterval = 10 * time.Second
+	signingKeyRotationPollTimeout  = 15 * time.Minute
+)
+
+func (r *SigningKeyRotation) validate() error {
+	if len(r.JWKSURLs) == 0 && r.GetGracePeriod() <= 0 {
+		return fmt.Errorf("either JWKS URLs or a grace period are required to know when the new signing key has propagated")
+	}
+	return nil
+}
+
+// PlanRotation works out the steps of a signing key rotation from the current
+// state of the signing keys, and records the key ids involved in r.
+func (m *SigningKeyManager) PlanRotation(r *SigningKeyRotation, opts ...RequestOption) ([]*SigningKeyRotationStep, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	keys, err := m.List(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return r.plan(keys)
+}
+
+func (r *SigningKeyRotation) plan(keys []*SigningKey) ([]*SigningKeyRotationStep, error) {
+	byKID := map[string]*SigningKey{}
+	var current, next, previous *SigningKey
+	for _, key := range keys {
+		byKID[key.GetKID()] = key
+		switch signingKeyState(key) {
+		case "current":
+			current = key
+		case "next":
+			next = key
+		case "previous":
+			previous = key
+		}
+	}
+
+	if r.GetKID() == "" {
+		if next == nil {
+			return nil, fmt.Errorf("there is no next signing key to rotate to")
+		}
+		r.KID = next.KID
+	}
+	target, ok := byKID[r.GetKID()]
+	switch {
+	case !ok:
+		return nil, fmt.Errorf("signing key %q not found", r.GetKID())
+	case target.GetRevoked():
+		return nil, fmt.Errorf("signing key %q has been revoked", r.GetKID())
+	case !target.GetCurrent() && !target.GetNext():
+		return nil, fmt.Errorf("signing key %q is neither the next nor the current key", r.GetKID())
+	}
+
+	if r.GetPreviousKID() == "" {
+		if target.GetNext() && current != nil {
+			r.PreviousKID = current.KID
+		} else if target.GetCurrent() && previous != nil {
+			r.PreviousKID = previous.KID
+		}
+	}
+	if r.GetPreviousKID() == r.GetKID() {
+		return nil, fmt.Errorf("signing key %q cannot replace itself", r.GetKID())
+	}
+
+	revoked := true
+	if key, ok := byKID[r.GetPreviousKID()]; ok {
+		revoked = key.GetRevoked()
+	}
+
+	steps := []*SigningKeyRotationStep{
+		{
+			Name:        authok.String(SigningKeyRotationStepRotate),
+			KID:         r.KID,
+			Description: authok.String(fmt.Sprintf("rotate the signing keys, making %s the current key", r.GetKID())),
+			Done:        authok.Bool(target.GetCurrent()),
+		},
+		{
+			Name:        authok.String(SigningKeyRotationStepWait),
+			KID:         r.KID,
+			Description: authok.String(r.waitDescription()),
+			Done:        authok.Bool(revoked),
+		},
+	}
+	if r.GetPreviousKID() != "" {
+		steps = append(steps, &SigningKeyRotationStep{
+			Name:        authok.String(SigningKeyRotationStepRevoke),
+			KID:         r.PreviousKID,
+			Description: authok.String(fmt.Sprintf("revoke the previous key %s", r.GetPreviousKID())),
+			Done:        authok.Bool(revoked),
+		})
+	}
+	return steps, nil
+}
+
+func (r *SigningKeyRotation) waitDescription() string {
+	var waits []string
+	if grace := r.GetGracePeriod(); grace > 0 {
+		waits = append(waits, fmt.Sprintf("%s after %s became current", grace, r.GetKID()))
+	}
+	if len(r.JWKSURLs) > 0 {
+		waits = append(waits, fmt.Sprintf("until %s is served by %s", r.GetKID(), strings.Join(r.JWKSURLs, ", ")))
+	}
+	return "wait " + strings.Join(waits, " and ")
+}
+
+// RunRotation rotates the signing keys of the tenant safely: it checks that
+// there is a key to rotate to, rotates, waits until the new key has
+// propagated and then revokes the previous key.
+//
+// Steps already done are skipped, so an interrupted rotation can be resumed
+// by calling RunRotation again with the same SigningKeyRotation. On a dry run
+// the plan is printed and nothing is changed. The steps are returned along
+// with whether they have been done.
+func (m *SigningKeyManager) RunRotation(r *SigningKeyRotation, opts ...RequestOption) ([]*SigningKeyRotationStep, error) {
+	steps, err := m.PlanRotation(r, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.GetDryRun() {
+		return steps, r.printPlan(steps)
+	}
+
+	ctx := requestContext(opts)
+	for _, step := range steps {
+		if step.GetDone() {
+			continue
+		}
+		switch step.GetName() {
+		case SigningKeyRotationStepRotate:
+			if _, err := m.Rotate(opts...); err != nil {
+				return steps, err
+			}
+			key, err := m.Read(r.GetKID(), opts...)
+			if err != nil {
+				return steps, err
+			}
+			if !key.GetCurrent() {
+				return steps, fmt.Errorf("signing key %q did not become the current key", r.GetKID())
+			}
+		case SigningKeyRotationStepWait:
+			key, err := m.Read(r.GetKID(), opts...)
+			if err != nil {
+				return steps, err
+			}
+			if err := r.wait(ctx, key); err != nil {
+				return steps, err
+			}
+		case SigningKeyRotationStepRevoke:
+			if _, err := m.Revoke(r.GetPreviousKID(), opts...); err != nil {
+				return steps, err
+			}
+		}
+		step.Done = authok.Bool(true)
+	}
+	return steps, nil
+}
+
+func (r *SigningKeyRotation) printPlan(steps []*SigningKeyRotationStep) error {
+	output := r.Output
+	if output == nil {
+		output = os.Stdout
+	}
+	if _, err := fmt.Fprintln(output, "Signing key rotation plan:"); err != nil {
+		return err
+	}
+	for i, step := range steps {
+		state := "pending"
+		if step.GetDone() {
+			state = "done"
+		}
+		if _, err := fmt.Fprintf(output, "  %d. [%s] %s\n", i+1, state, step.GetDescription()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wait blocks until the grace period after key became current has elapsed,
+// and every JWKS URL serves the key.
+func (r *SigningKeyRotation) wait(ctx context.Context, key *SigningKey) error {
+	if grace := r.GetGracePeriod(); grace > 0 {
+		since := time.Now()
+		if key.CurrentSince != nil {
+			since = *key.CurrentSince
+		}
+		if err := sleepContext(ctx, time.Until(since.Add(grace))); err != nil {
+			return err
+		}
+	}
+
+	if len(r.JWKSURLs) == 0 {
+		return nil
+	}
+
+	interval := signingKeyRotationPollInterval
+	if r.PollInterval != nil {
+		interval = r.GetPollInterval()
+	}
+	timeout := signingKeyRotationPollTimeout
+	if r.PollTimeout != nil {
+		timeout = r.GetPollTimeout()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	pending := r.JWKSURLs
+	for {
+		var stillPending []string
+		for _, jwksURL := range pending {
+			if !r.jwksServes(ctx, jwksURL, key.GetKID()) {
+				stillPending = append(stillPending, jwksURL)
+			}
+		}
+		pending = stillPending
+		if len(pending) == 0 {
+			return nil
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return fmt.Errorf("failed waiting for %s to serve signing key %q: %w", strings.Join(pending, ", "), key.GetKID(), err)
+		}
+	}
+}
+
+// jwksServes reports whether the JWKS at the given URL contains the key.
+// Failures to fetch the JWKS are taken as the key not being served yet.
+func (r *SigningKeyRotation) jwksServes(ctx context.Context, jwksURL, kid string) bool {
+	httpClient := r.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURL, nil)
+	if err != nil {
+		return false
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return false
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return false
+	}
+
+	var jwks struct {
+		Keys []struct {
+			KID string `json:"kid"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&jwks); err != nil {
+		return false
+	}
+	for _, key := range jwks.Keys {
+		if key.KID == kid {
+			return true
+		}
+	}
+	return false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
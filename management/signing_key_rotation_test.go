@@ -0,0 +1,153 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+// givenSigningKeys stores a revoked previous key old, the current key a and
+// the next key b.
+func givenSigningKeys(api *fakeAPI) {
+	api.put("/keys/signing/old", &SigningKey{KID: authok.String("old"), Previous: authok.Bool(true), Revoked: authok.Bool(true)})
+	api.put("/keys/signing/a", &SigningKey{KID: authok.String("a"), Current: authok.Bool(true)})
+	api.put("/keys/signing/b", &SigningKey{KID: authok.String("b"), Next: authok.Bool(true)})
+}
+
+func signingKey(api *fakeAPI, kid string) *SigningKey {
+	return fakeAPIResourceAs[SigningKey](api, "/keys/signing/"+kid)
+}
+
+func TestSigningKeyManager_RunRotation(t *testing.T) {
+	t.Run("Rotates, waits for the JWKS consumers and revokes", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+
+		var polls int32
+		jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			kids := []string{"a"}
+			if atomic.AddInt32(&polls, 1) > 2 {
+				kids = append(kids, "b")
+			}
+			var keys []map[string]string
+			for _, kid := range kids {
+				keys = append(keys, map[string]string{"kid": kid})
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+		}))
+		t.Cleanup(jwks.Close)
+
+		r := &SigningKeyRotation{
+			JWKSURLs:     []string{jwks.URL},
+			PollInterval: durationPtr(time.Millisecond),
+		}
+		steps, err := m.SigningKey.RunRotation(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, "b", r.GetKID())
+		assert.Equal(t, "a", r.GetPreviousKID())
+		require.Len(t, steps, 3)
+		for _, step := range steps {
+			assert.True(t, step.GetDone(), step.GetName())
+		}
+		assert.EqualValues(t, 3, atomic.LoadInt32(&polls))
+		assert.True(t, signingKey(api, "b").GetCurrent())
+		assert.True(t, signingKey(api, "a").GetRevoked())
+		assert.Len(t, api.queries("POST /keys/signing/rotate"), 1)
+	})
+
+	t.Run("Resumes an interrupted rotation", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+
+		r := &SigningKeyRotation{GracePeriod: durationPtr(time.Millisecond)}
+		_, err := m.SigningKey.PlanRotation(r)
+		require.NoError(t, err)
+		_, err = m.SigningKey.Rotate()
+		require.NoError(t, err)
+
+		steps, err := m.SigningKey.RunRotation(r)
+		require.NoError(t, err)
+		assert.Len(t, steps, 3)
+		assert.Len(t, api.queries("POST /keys/signing/rotate"), 1)
+		assert.True(t, signingKey(api, "a").GetRevoked())
+		assert.False(t, signingKey(api, "id_1").GetRevoked())
+
+		api.forget()
+		_, err = m.SigningKey.RunRotation(r)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"GET /keys/signing"}, api.requests())
+	})
+
+	t.Run("Prints the plan on a dry run", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+
+		var output bytes.Buffer
+		r := &SigningKeyRotation{
+			GracePeriod: durationPtr(time.Hour),
+			DryRun:      authok.Bool(true),
+			Output:      &output,
+		}
+		steps, err := m.SigningKey.RunRotation(r)
+		require.NoError(t, err)
+		assert.Len(t, steps, 3)
+		assert.Equal(t, []string{"GET /keys/signing"}, api.requests())
+		assert.Equal(t, `Signing key rotation plan:
+  1. [pending] rotate the signing keys, making b the current key
+  2. [pending] wait 1h0m0s after b became current
+  3. [pending] revoke the previous key a
+`, output.String())
+	})
+
+	t.Run("Requires a next key", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+		api.remove("/keys/signing/b")
+
+		_, err := m.SigningKey.RunRotation(&SigningKeyRotation{GracePeriod: durationPtr(time.Hour)})
+		assert.EqualError(t, err, "there is no next signing key to rotate to")
+	})
+
+	t.Run("Requires a way to know the key has propagated", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+
+		_, err := m.SigningKey.RunRotation(&SigningKeyRotation{})
+		assert.Error(t, err)
+		assert.Empty(t, api.requests())
+	})
+
+	t.Run("Gives up when a JWKS consumer never serves the key", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenSigningKeys(api)
+
+		jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"keys":[{"kid":"a"}]}`))
+		}))
+		t.Cleanup(jwks.Close)
+
+		steps, err := m.SigningKey.RunRotation(&SigningKeyRotation{
+			JWKSURLs:     []string{jwks.URL},
+			PollInterval: durationPtr(time.Millisecond),
+			PollTimeout:  durationPtr(20 * time.Millisecond),
+		})
+		assert.Error(t, err)
+		assert.True(t, steps[0].GetDone())
+		assert.False(t, steps[1].GetDone())
+		assert.False(t, signingKey(api, "a").GetRevoked())
+	})
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
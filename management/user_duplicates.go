@@ -0,0 +1,279 @@
+package management
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/authok/authok-go"
+)
+
+// Rules used to pick the primary account of a UserDuplicateGroup.
+const (
+	// UserPrimaryVerified prefers accounts with a verified email.
+	UserPrimaryVerified = "verified"
+
+	// UserPrimaryMostLogins prefers the account logged into most.
+	UserPrimaryMostLogins = "most_logins"
+
+	// UserPrimaryOldest prefers the account created first.
+	UserPrimaryOldest = "oldest"
+)
+
+// Strategies used to merge the metadata of duplicate accounts into the
+// primary account.
+const (
+	// MetadataMergeKeepPrimary adds the keys missing from the primary
+	// account's metadata, keeping the values it has.
+	MetadataMergeKeepPrimary = "keep_primary"
+
+	// MetadataMergePreferSecondary overwrites the values of the primary
+	// account's metadata with those of the other accounts.
+	MetadataMergePreferSecondary = "prefer_secondary"
+
+	// MetadataMergePrimaryOnly leaves the primary account's metadata as it
+	// is, dropping that of the other accounts.
+	MetadataMergePrimaryOnly = "primary_only"
+)
+
+// defaultUserPrimaryRules are the rules used when none are given.
+var defaultUserPrimaryRules = []string{UserPrimaryVerified, UserPrimaryMostLogins, UserPrimaryOldest}
+
+// UserDuplicateOptions configures how duplicate accounts are found and
+// linked.
+type UserDuplicateOptions struct {
+	// The rules picking the primary account, tried in order until one tells
+	// the accounts apart. Defaults to UserPrimaryVerified,
+	// UserPrimaryMostLogins, then UserPrimaryOldest.
+	PrimaryRules *[]string `json:"primary_rules,omitempty"`
+
+	// When true, accounts whose email is not verified are grouped too. They
+	// are left out by default, as linking them would hand the primary account
+	// to whoever registered them.
+	IncludeUnverified *bool `json:"include_unverified,omitempty"`
+
+	// How user_metadata and app_metadata are merged before linking. Both
+	// default to MetadataMergeKeepPrimary.
+	UserMetadataStrategy *string `json:"user_metadata_strategy,omitempty"`
+	AppMetadataStrategy  *string `json:"app_metadata_strategy,omitempty"`
+}
+
+// UserDuplicateGroup holds accounts sharing an email, as found by
+// FindDuplicateUsers.
+type UserDuplicateGroup struct {
+	// The shared email, in lower case.
+	Email *string `json:"email,omitempty"`
+
+	// The accounts, the suggested primary account first.
+	Users []*User `json:"users"`
+
+	// The id of the suggested primary account. It can be changed to that of
+	// another account of the group before linking.
+	PrimaryID *string `json:"primary_id,omitempty"`
+
+	// Whether the group may be linked, to be set once it has been reviewed.
+	Approved *bool `json:"approved,omitempty"`
+}
+
+// FindDuplicateUsers groups the accounts sharing an email, such as those of a
+// users export read with ReadUserExport, and suggests a primary account for
+// each group. Emails are compared case-insensitively and groups are sorted by
+// email.
+func FindDuplicateUsers(users []*User, options *UserDuplicateOptions) []*UserDuplicateGroup {
+	if options == nil {
+		options = &UserDuplicateOptions{}
+	}
+	rules := defaultUserPrimaryRules
+	if options.PrimaryRules != nil {
+		rules = options.GetPrimaryRules()
+	}
+
+	byEmail := map[string][]*User{}
+	seen := map[string]bool{}
+	for _, user := range users {
+		email := strings.ToLower(user.GetEmail())
+		if email == "" || seen[user.GetID()] || (!user.GetEmailVerified() && !options.GetIncludeUnverified()) {
+			continue
+		}
+		seen[user.GetID()] = true
+		byEmail[email] = append(byEmail[email], user)
+	}
+
+	var groups []*UserDuplicateGroup
+	for email, users := range byEmail {
+		if len(users) < 2 {
+			continue
+		}
+		sort.SliceStable(users, func(i, j int) bool {
+			return preferUser(users[i], users[j], rules)
+		})
+		groups = append(groups, &UserDuplicateGroup{
+			Email:     authok.String(email),
+			Users:     users,
+			PrimaryID: users[0].ID,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].GetEmail() < groups[j].GetEmail()
+	})
+	return groups
+}
+
+// preferUser reports whether a is preferred over b as primary account.
+func preferUser(a, b *User, rules []string) bool {
+	for _, rule := range rules {
+		switch rule {
+		case UserPrimaryVerified:
+			if a.GetEmailVerified() != b.GetEmailVerified() {
+				return a.GetEmailVerified()
+			}
+		case UserPrimaryMostLogins:
+			if a.GetLoginsCount() != b.GetLoginsCount() {
+				return a.GetLoginsCount() > b.GetLoginsCount()
+			}
+		case UserPrimaryOldest:
+			if !a.GetCreatedAt().Equal(b.GetCreatedAt()) {
+				return a.GetCreatedAt().Before(b.GetCreatedAt())
+			}
+		}
+	}
+	return a.GetID() < b.GetID()
+}
+
+// FindDuplicatesByEmail looks up the accounts of each email with ListByEmail
+// and groups them as FindDuplicateUsers does.
+func (m *UserManager) FindDuplicatesByEmail(emails []string, options *UserDuplicateOptions, opts ...RequestOption) ([]*UserDuplicateGroup, error) {
+	var users []*User
+	for _, email := range emails {
+		l, err := m.ListByEmail(email, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the users with email %q: %w", email, err)
+		}
+		users = append(users, l...)
+	}
+	return FindDuplicateUsers(users, options), nil
+}
+
+// LinkDuplicates links the accounts of an approved group into its primary
+// account.
+//
+// The metadata of the other accounts is first merged into that of the
+// primary account according to the options' strategies, as linking drops it.
+// The primary account is returned as it is once linked.
+func (m *UserManager) LinkDuplicates(group *UserDuplicateGroup, options *UserDuplicateOptions, opts ...RequestOption) (*User, error) {
+	if !group.GetApproved() {
+		return nil, fmt.Errorf("the accounts of %q have not been approved for linking", group.GetEmail())
+	}
+	if options == nil {
+		options = &UserDuplicateOptions{}
+	}
+
+	var primary *User
+	var secondaries []*User
+	for _, user := range group.Users {
+		if user.GetID() == group.GetPrimaryID() {
+			primary = user
+		} else {
+			secondaries = append(secondaries, user)
+		}
+	}
+	if primary == nil {
+		return nil, fmt.Errorf("primary account %q is not among the accounts of %q", group.GetPrimaryID(), group.GetEmail())
+	}
+
+	for _, field := range []struct {
+		name     string
+		strategy string
+		patch    func(id string, patch MetadataPatch, opts ...RequestOption) (*User, error)
+	}{
+		{userMetadataField, options.GetUserMetadataStrategy(), m.PatchMetadata},
+		{appMetadataField, options.GetAppMetadataStrategy(), m.PatchAppMetadata},
+	} {
+		patch, err := mergeDuplicateMetadata(field.name, field.strategy, primary, secondaries)
+		if err != nil {
+			return nil, err
+		}
+		if len(patch) == 0 {
+			continue
+		}
+		if _, err := field.patch(primary.GetID(), patch, opts...); err != nil {
+			return nil, fmt.Errorf("failed to merge the %s of %q: %w", field.name, group.GetEmail(), err)
+		}
+	}
+
+	for _, secondary := range secondaries {
+		link := &UserIdentityLink{}
+		if len(secondary.Identities) > 0 {
+			link.Provider = secondary.Identities[0].Provider
+			link.UserID = secondary.Identities[0].UserID
+		} else if provider, userID, ok := strings.Cut(secondary.GetID(), "|"); ok {
+			link.Provider = authok.String(provider)
+			link.UserID = authok.String(userID)
+		} else {
+			return nil, fmt.Errorf("cannot tell the identity of user %q", secondary.GetID())
+		}
+		if _, err := m.Link(primary.GetID(), link, opts...); err != nil {
+			return nil, fmt.Errorf("failed to link user %q into %q: %w", secondary.GetID(), primary.GetID(), err)
+		}
+	}
+
+	return m.Read(primary.GetID(), opts...)
+}
+
+// mergeDuplicateMetadata returns the patch merging the metadata of the
+// secondary accounts into that of the primary account. Secondary accounts
+// listed first take precedence over those listed after them.
+func mergeDuplicateMetadata(field, strategy string, primary *User, secondaries []*User) (MetadataPatch, error) {
+	var merged interface{} = map[string]interface{}{}
+	switch strategy {
+	case "", MetadataMergeKeepPrimary:
+		for i := len(secondaries) - 1; i >= 0; i-- {
+			merged = mergePatch(merged, metadataOf(secondaries[i], field))
+		}
+		merged = mergePatch(merged, metadataOf(primary, field))
+	case MetadataMergePreferSecondary:
+		merged = mergePatch(merged, metadataOf(primary, field))
+		for i := len(secondaries) - 1; i >= 0; i-- {
+			merged = mergePatch(merged, metadataOf(secondaries[i], field))
+		}
+	case MetadataMergePrimaryOnly:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported metadata merge strategy %q", strategy)
+	}
+	return MetadataPatch(merged.(map[string]interface{})), nil
+}
+
+// ReadUserExport reads the users of a users export, as created by
+// JobManager.ExportUsers in the JSON format: one user per line, optionally
+// gzip compressed.
+func ReadUserExport(r io.Reader) ([]*User, error) {
+	buffered := bufio.NewReader(r)
+	if magic, err := buffered.Peek(2); err == nil && bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the users export: %w", err)
+		}
+		defer gz.Close()
+		buffered = bufio.NewReader(gz)
+	}
+
+	var users []*User
+	decoder := json.NewDecoder(buffered)
+	for {
+		var user User
+		err := decoder.Decode(&user)
+		if err == io.EOF {
+			return users, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid user %d in the users export: %w", len(users)+1, err)
+		}
+		users = append(users, &user)
+	}
+}
@@ -0,0 +1,204 @@
+package management
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+func testDuplicateUsers() []*User {
+	day := func(d int) *time.Time {
+		return authok.Time(time.Date(2023, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	logins := func(n int64) *int64 {
+		return &n
+	}
+	return []*User{
+		{
+			ID:            authok.String("auth0|1"),
+			Email:         authok.String("ana@example.com"),
+			EmailVerified: authok.Bool(true),
+			CreatedAt:     day(1),
+			LoginsCount:   logins(3),
+			Identities:    []*UserIdentity{{Provider: authok.String("auth0"), UserID: authok.String("1")}},
+			UserMetadata:  &map[string]interface{}{"theme": "dark", "locale": "en"},
+		},
+		{
+			ID:            authok.String("google-oauth2|123"),
+			Email:         authok.String("Ana@Example.com"),
+			EmailVerified: authok.Bool(true),
+			CreatedAt:     day(2),
+			LoginsCount:   logins(40),
+			Identities:    []*UserIdentity{{Provider: authok.String("google-oauth2"), UserID: authok.String("123")}},
+			UserMetadata:  &map[string]interface{}{"theme": "light", "newsletter": true},
+			AppMetadata:   &map[string]interface{}{"plan": "pro"},
+		},
+		{
+			ID:          authok.String("auth0|2"),
+			Email:       authok.String("ana@example.com"),
+			CreatedAt:   day(3),
+			LoginsCount: logins(100),
+		},
+		{
+			ID:            authok.String("auth0|3"),
+			Email:         authok.String("ben@example.com"),
+			EmailVerified: authok.Bool(true),
+		},
+	}
+}
+
+func TestFindDuplicateUsers(t *testing.T) {
+	ids := func(group *UserDuplicateGroup) []string {
+		var ids []string
+		for _, user := range group.Users {
+			ids = append(ids, user.GetID())
+		}
+		return ids
+	}
+
+	t.Run("Groups verified accounts by email", func(t *testing.T) {
+		groups := FindDuplicateUsers(testDuplicateUsers(), nil)
+		require.Len(t, groups, 1)
+		assert.Equal(t, "ana@example.com", groups[0].GetEmail())
+		assert.Equal(t, "google-oauth2|123", groups[0].GetPrimaryID())
+		assert.Equal(t, []string{"google-oauth2|123", "auth0|1"}, ids(groups[0]))
+		assert.False(t, groups[0].GetApproved())
+	})
+
+	t.Run("Applies the rules in order", func(t *testing.T) {
+		groups := FindDuplicateUsers(testDuplicateUsers(), &UserDuplicateOptions{
+			PrimaryRules: &[]string{UserPrimaryOldest},
+		})
+		require.Len(t, groups, 1)
+		assert.Equal(t, "auth0|1", groups[0].GetPrimaryID())
+	})
+
+	t.Run("Groups unverified accounts when asked to", func(t *testing.T) {
+		options := &UserDuplicateOptions{IncludeUnverified: authok.Bool(true)}
+		groups := FindDuplicateUsers(testDuplicateUsers(), options)
+		require.Len(t, groups, 1)
+		assert.Equal(t, []string{"google-oauth2|123", "auth0|1", "auth0|2"}, ids(groups[0]))
+
+		options.PrimaryRules = &[]string{UserPrimaryMostLogins}
+		groups = FindDuplicateUsers(testDuplicateUsers(), options)
+		assert.Equal(t, "auth0|2", groups[0].GetPrimaryID())
+	})
+}
+
+func TestReadUserExport(t *testing.T) {
+	export := `{"user_id":"auth0|1","email":"ana@example.com","email_verified":true}
+{"user_id":"google-oauth2|123","email":"ana@example.com","email_verified":"true"}
+`
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err := gz.Write([]byte(export))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	for name, r := range map[string]*bytes.Reader{
+		"plain":      bytes.NewReader([]byte(export)),
+		"compressed": bytes.NewReader(compressed.Bytes()),
+	} {
+		users, err := ReadUserExport(r)
+		require.NoError(t, err, name)
+		require.Len(t, users, 2, name)
+		assert.Equal(t, "google-oauth2|123", users[1].GetID(), name)
+		assert.True(t, users[1].GetEmailVerified(), name)
+	}
+
+	_, err = ReadUserExport(strings.NewReader(`{"user_id":`))
+	assert.Error(t, err)
+}
+
+// givenDuplicateUsers stores the users of testDuplicateUsers. Linking an
+// account answers with no identities.
+func givenDuplicateUsers(t *testing.T, api *fakeAPI) {
+	for _, user := range testDuplicateUsers() {
+		b, err := json.Marshal(user)
+		require.NoError(t, err)
+		var resource map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &resource))
+		api.put("/users/"+user.GetID(), resource)
+	}
+	api.handle("POST /users/*/identities", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeAPIJSON(w, http.StatusCreated, []interface{}{})
+	})
+}
+
+func TestUserManager_LinkDuplicates(t *testing.T) {
+	t.Run("Merges metadata then links", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenDuplicateUsers(t, api)
+
+		groups, err := m.User.FindDuplicatesByEmail([]string{"ana@example.com"}, &UserDuplicateOptions{
+			PrimaryRules: &[]string{UserPrimaryOldest},
+		})
+		require.NoError(t, err)
+		require.Len(t, groups, 1)
+
+		groups[0].Approved = authok.Bool(true)
+		primary, err := m.User.LinkDuplicates(groups[0], &UserDuplicateOptions{
+			AppMetadataStrategy: authok.String(MetadataMergePrimaryOnly),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "auth0|1", primary.GetID())
+
+		assert.Equal(t, []map[string]interface{}{
+			{"user_metadata": map[string]interface{}{"newsletter": true}},
+		}, api.bodies("PATCH /users/*"))
+		assert.Equal(t, []map[string]interface{}{
+			{"provider": "google-oauth2", "user_id": "123"},
+		}, api.bodies("POST /users/*/identities"))
+		assert.Equal(t, map[string]interface{}{"theme": "dark", "locale": "en", "newsletter": true}, *primary.UserMetadata)
+	})
+
+	t.Run("Lets the other accounts' metadata win", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenDuplicateUsers(t, api)
+
+		users := testDuplicateUsers()
+		group := &UserDuplicateGroup{
+			Email:     authok.String("ana@example.com"),
+			Users:     users[:2],
+			PrimaryID: authok.String("auth0|1"),
+			Approved:  authok.Bool(true),
+		}
+		_, err := m.User.LinkDuplicates(group, &UserDuplicateOptions{
+			UserMetadataStrategy: authok.String(MetadataMergePreferSecondary),
+			AppMetadataStrategy:  authok.String(MetadataMergePrimaryOnly),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]interface{}{
+			{"user_metadata": map[string]interface{}{"theme": "light", "newsletter": true}},
+		}, api.bodies("PATCH /users/*"))
+	})
+
+	t.Run("Refuses groups not approved", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenDuplicateUsers(t, api)
+
+		group := FindDuplicateUsers(testDuplicateUsers(), nil)[0]
+		_, err := m.User.LinkDuplicates(group, nil)
+		assert.EqualError(t, err, `the accounts of "ana@example.com" have not been approved for linking`)
+		assert.Empty(t, api.bodies("POST /users/*/identities"))
+
+		group.Approved = authok.Bool(true)
+		group.PrimaryID = authok.String("auth0|3")
+		_, err = m.User.LinkDuplicates(group, nil)
+		assert.Error(t, err)
+
+		group.PrimaryID = authok.String("auth0|1")
+		_, err = m.User.LinkDuplicates(group, &UserDuplicateOptions{UserMetadataStrategy: authok.String("newest")})
+		assert.Error(t, err)
+		assert.Empty(t, api.bodies("POST /users/*/identities"))
+	})
+}
@@ -0,0 +1,341 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// Steps of a UserErasureReceipt, in the order they are taken.
+const (
+	UserErasureUnlinkIdentities = "unlink_identities"
+	UserErasureRevokeGrants     = "revoke_grants"
+	UserErasureLeaveOrgs        = "leave_organizations"
+	UserErasureRemoveRoles      = "remove_roles"
+	UserErasureRemoveMFA        = "remove_mfa"
+	UserErasureDeleteUser       = "delete_user"
+)
+
+// UserErasureReceipt records what UserManager.EraseUser removed, for audits.
+// It holds ids only, no personal data.
+type UserErasureReceipt struct {
+	UserID *string `json:"user_id,omitempty"`
+
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// The steps taken, up to and including the first one that failed.
+	Steps []*UserErasureStep `json:"steps"`
+
+	// True when re-querying found nothing left of the user.
+	Verified *bool `json:"verified"`
+
+	// What re-querying found left of the user, such as "grant gr_1".
+	Leftovers *[]string `json:"leftovers,omitempty"`
+}
+
+// UserErasureStep is a step taken by UserManager.EraseUser.
+type UserErasureStep struct {
+	// One of the UserErasure step constants.
+	Name *string `json:"name,omitempty"`
+
+	// The ids of what was removed.
+	Removed *[]string `json:"removed,omitempty"`
+
+	// Why the step failed, if it did.
+	Error *string `json:"error,omitempty"`
+}
+
+// WriteJSON writes the receipt as an indented JSON document.
+func (r *UserErasureReceipt) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// userErasure holds what is known about a user being erased.
+type userErasure struct {
+	user          *User
+	linked        []*UserIdentity
+	linkedUserIDs []string
+	grants        []*Grant
+	organizations []*Organization
+	roles         []*Role
+	enrollments   []*UserEnrollment
+	methods       []*AuthenticationMethod
+
+	// The authentication methods still there right before the user was
+	// deleted.
+	methodsLeft []*AuthenticationMethod
+}
+
+// EraseUser removes a user along with the state Authok keeps about it
+// elsewhere, in this order: linked secondary identities are unlinked and the
+// users they become are deleted, grants are revoked, organization memberships
+// and role assignments are removed, MFA enrollments and authentication
+// methods are deleted, and finally the user itself is deleted.
+//
+// Afterwards everything is queried again to verify nothing is left: the
+// members of each organization and the users of each role the user belonged
+// to are listed, and the authentication methods, which can only be listed
+// while the user exists, are checked right before it is deleted. The
+// receipt is returned even when a step fails, in which case the following
+// steps are not taken and the user is not deleted, so that EraseUser can be
+// run again once the cause is fixed.
+func (m *UserManager) EraseUser(id string, opts ...RequestOption) (*UserErasureReceipt, error) {
+	receipt := &UserErasureReceipt{
+		UserID:    &id,
+		StartedAt: authok.Time(time.Now().UTC()),
+		Steps:     []*UserErasureStep{},
+		Verified:  authok.Bool(false),
+	}
+
+	e, err := m.userErasure(id, opts)
+	if err != nil {
+		return receipt, fmt.Errorf("failed to erase user %q: %w", id, err)
+	}
+
+	steps := []struct {
+		name string
+		run  func() ([]string, error)
+	}{
+		{UserErasureUnlinkIdentities, func() (removed []string, err error) {
+			for i, identity := range e.linked {
+				if _, err := m.Unlink(id, identity.GetProvider(), identity.GetUserID(), opts...); err != nil && !isNotFound(err) {
+					return removed, err
+				}
+				linkedUserID := e.linkedUserIDs[i]
+				if err := m.Delete(linkedUserID, opts...); err != nil && !isNotFound(err) {
+					return removed, err
+				}
+				removed = append(removed, linkedUserID)
+			}
+			return removed, nil
+		}},
+		{UserErasureRevokeGrants, func() (removed []string, err error) {
+			for _, grant := range e.grants {
+				if err := m.Grant.Delete(grant.GetID(), opts...); err != nil && !isNotFound(err) {
+					return removed, err
+				}
+				removed = append(removed, grant.GetID())
+			}
+			return removed, nil
+		}},
+		{UserErasureLeaveOrgs, func() (removed []string, err error) {
+			for _, organization := range e.organizations {
+				if err := m.Organization.DeleteMember(organization.GetID(), []string{id}, opts...); err != nil && !isNotFound(err) {
+					return removed, err
+				}
+				removed = append(removed, organization.GetID())
+			}
+			return removed, nil
+		}},
+		{UserErasureRemoveRoles, func() (removed []string, err error) {
+			if len(e.roles) == 0 {
+				return nil, nil
+			}
+			if err := m.RemoveRoles(id, e.roles, opts...); err != nil {
+				return nil, err
+			}
+			for _, role := range e.roles {
+				removed = append(removed, role.GetID())
+			}
+			return removed, nil
+		}},
+		{UserErasureRemoveMFA, func() (removed []string, err error) {
+			for _, enrollment := range e.enrollments {
+				if err := m.Guardian.Enrollment.Delete(enrollment.GetID(), opts...); err != nil && !isNotFound(err) {
+					return removed, err
+				}
+				removed = append(removed, enrollment.GetID())
+			}
+			if len(e.methods) == 0 {
+				return removed, nil
+			}
+			if err := m.DeleteAllAuthenticationMethods(id, opts...); err != nil {
+				return removed, err
+			}
+			for _, method := range e.methods {
+				removed = append(removed, method.GetID())
+			}
+			return removed, nil
+		}},
+		{UserErasureDeleteUser, func() (_ []string, err error) {
+			if e.methodsLeft, err = m.userAuthenticationMethods(id, opts); err != nil {
+				return nil, err
+			}
+			if err := m.Delete(id, opts...); err != nil {
+				return nil, err
+			}
+			return []string{id}, nil
+		}},
+	}
+	for _, step := range steps {
+		removed, err := step.run()
+		s := &UserErasureStep{Name: authok.String(step.name)}
+		if removed != nil {
+			s.Removed = &removed
+		}
+		receipt.Steps = append(receipt.Steps, s)
+		if err != nil {
+			message := err.Error()
+			s.Error = &message
+			return receipt, fmt.Errorf("failed to erase user %q: %s: %w", id, step.name, err)
+		}
+	}
+
+	leftovers, err := m.erasureLeftovers(id, e, opts)
+	if err != nil {
+		return receipt, fmt.Errorf("failed to verify the erasure of user %q: %w", id, err)
+	}
+	receipt.CompletedAt = authok.Time(time.Now().UTC())
+	if len(leftovers) > 0 {
+		receipt.Leftovers = &leftovers
+		return receipt, fmt.Errorf("user %q was not completely erased: %d leftovers", id, len(leftovers))
+	}
+	receipt.Verified = authok.Bool(true)
+
+	return receipt, nil
+}
+
+// userErasure reads the state kept about a user before erasing it.
+func (m *UserManager) userErasure(id string, opts []RequestOption) (e *userErasure, err error) {
+	e = &userErasure{}
+	if e.user, err = m.Read(id, opts...); err != nil {
+		return nil, err
+	}
+	if len(e.user.Identities) > 1 {
+		e.linked = e.user.Identities[1:]
+	}
+	for _, identity := range e.linked {
+		e.linkedUserIDs = append(e.linkedUserIDs, identity.GetProvider()+"|"+identity.GetUserID())
+	}
+
+	if e.grants, err = m.userGrants(id, opts); err != nil {
+		return nil, err
+	}
+	if e.organizations, err = m.userOrganizations(id, opts); err != nil {
+		return nil, err
+	}
+	if e.roles, err = m.userRoles(id, opts); err != nil {
+		return nil, err
+	}
+	if e.enrollments, err = m.Enrollments(id, opts...); err != nil {
+		return nil, err
+	}
+	if e.methods, err = m.userAuthenticationMethods(id, opts); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// erasureLeftovers queries what could be left of an erased user.
+func (m *UserManager) erasureLeftovers(id string, e *userErasure, opts []RequestOption) ([]string, error) {
+	leftovers := []string{}
+	for _, userID := range append([]string{id}, e.linkedUserIDs...) {
+		if _, err := m.Read(userID, opts...); err == nil {
+			leftovers = append(leftovers, "user "+userID)
+		} else if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	grants, err := m.userGrants(id, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants {
+		leftovers = append(leftovers, "grant "+grant.GetID())
+	}
+
+	for _, method := range e.methodsLeft {
+		leftovers = append(leftovers, "authentication method "+method.GetID())
+	}
+
+	// The user is gone, so its memberships and role assignments are looked
+	// for in the organizations and roles it had.
+	for _, organization := range e.organizations {
+		member, err := nilIfNotFound(findFirst(
+			func(page int) ([]*OrganizationMember, bool, error) {
+				l, err := m.Organization.Members(organization.GetID(), withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				members := make([]*OrganizationMember, len(l.Members))
+				for i := range l.Members {
+					members[i] = &l.Members[i]
+				}
+				return members, l.HasNext(), nil
+			},
+			func(member *OrganizationMember) bool { return member.GetUserID() == id },
+		))
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			leftovers = append(leftovers, "organization membership "+organization.GetID())
+		}
+	}
+
+	for _, role := range e.roles {
+		user, err := nilIfNotFound(findFirst(
+			func(page int) ([]*User, bool, error) {
+				l, err := m.Role.Users(role.GetID(), withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				return l.Users, l.HasNext(), nil
+			},
+			func(user *User) bool { return user.GetID() == id },
+		))
+		if err != nil {
+			return nil, err
+		}
+		if user != nil {
+			leftovers = append(leftovers, "role assignment "+role.GetID())
+		}
+	}
+
+	for _, enrollment := range e.enrollments {
+		if _, err := m.Guardian.Enrollment.Get(enrollment.GetID(), opts...); err == nil {
+			leftovers = append(leftovers, "enrollment "+enrollment.GetID())
+		} else if !isNotFound(err) {
+			return nil, err
+		}
+	}
+
+	return leftovers, nil
+}
+
+func (m *UserManager) userOrganizations(id string, opts []RequestOption) ([]*Organization, error) {
+	return listAll(func(page int) ([]*Organization, bool, error) {
+		l, err := m.Organizations(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Organizations, l.HasNext(), nil
+	})
+}
+
+func (m *UserManager) userRoles(id string, opts []RequestOption) ([]*Role, error) {
+	return listAll(func(page int) ([]*Role, bool, error) {
+		l, err := m.Roles(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Roles, l.HasNext(), nil
+	})
+}
+
+func (m *UserManager) userAuthenticationMethods(id string, opts []RequestOption) ([]*AuthenticationMethod, error) {
+	return listAll(func(page int) ([]*AuthenticationMethod, bool, error) {
+		l, err := m.ListAuthenticationMethods(id, withOptions(opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Authenticators, l.HasNext(), nil
+	})
+}
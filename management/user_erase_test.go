@@ -0,0 +1,151 @@
+package management
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// givenAUserToErase stores the user auth0|1, who has a linked Google
+// identity, a grant, an organization membership, a role, an MFA enrollment
+// and an authentication method.
+func givenAUserToErase(api *fakeAPI) {
+	api.put("/users/auth0|1", map[string]interface{}{
+		"user_id": "auth0|1",
+		"identities": []interface{}{
+			map[string]interface{}{"provider": "auth0", "user_id": "1"},
+			map[string]interface{}{"provider": "google-oauth2", "user_id": "123"},
+		},
+	})
+	api.put("/users/google-oauth2|123", map[string]interface{}{"user_id": "google-oauth2|123"})
+	api.handle("DELETE /users/auth0|1/identities/**", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeAPIJSON(w, http.StatusOK, []interface{}{})
+	})
+	api.put("/grants/gr_1", map[string]interface{}{"id": "gr_1", "user_id": "auth0|1"})
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	api.put("/organizations/org_1/members/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/roles/rol_1", map[string]interface{}{"id": "rol_1", "name": "Admin"})
+	api.put("/roles/rol_1/users/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/guardian/enrollments/dev_1", map[string]interface{}{"id": "dev_1", "user_id": "auth0|1"})
+	api.put("/users/auth0|1/authentication-methods/totp|1", map[string]interface{}{"id": "totp|1", "type": "totp"})
+}
+
+// ignoreDeletes makes the deletions matching pattern succeed without
+// removing anything.
+func ignoreDeletes(api *fakeAPI, pattern string) {
+	api.handle("DELETE "+pattern, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func TestUserManager_EraseUser(t *testing.T) {
+	t.Run("Erases the user and everything related", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToErase(api)
+
+		receipt, err := m.User.EraseUser("auth0|1")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"/organizations/org_1", "/roles/rol_1"}, api.paths())
+		assert.True(t, receipt.GetVerified())
+		assert.False(t, receipt.GetCompletedAt().Before(receipt.GetStartedAt()))
+
+		var steps []string
+		for _, step := range receipt.Steps {
+			assert.Nil(t, step.Error)
+			steps = append(steps, step.GetName()+" "+strings.Join(step.GetRemoved(), ","))
+		}
+		assert.Equal(t, []string{
+			"unlink_identities google-oauth2|123",
+			"revoke_grants gr_1",
+			"leave_organizations org_1",
+			"remove_roles rol_1",
+			"remove_mfa dev_1,totp|1",
+			"delete_user auth0|1",
+		}, steps)
+
+		var b bytes.Buffer
+		require.NoError(t, receipt.WriteJSON(&b))
+		var written UserErasureReceipt
+		require.NoError(t, json.Unmarshal(b.Bytes(), &written))
+		assert.Equal(t, receipt.GetUserID(), written.GetUserID())
+		assert.Len(t, written.Steps, 6)
+
+		assert.Contains(t, api.requests(), "GET /organizations/org_1/members")
+		assert.Contains(t, api.requests(), "GET /roles/rol_1/users")
+	})
+
+	t.Run("Stops before deleting the user when a step fails", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToErase(api)
+		api.fail("DELETE /users/auth0|1/roles", http.StatusInternalServerError, 1)
+
+		receipt, err := m.User.EraseUser("auth0|1")
+		assert.ErrorContains(t, err, "remove_roles")
+		assert.NotNil(t, api.get("/users/auth0|1"))
+		assert.Len(t, receipt.Steps, 4)
+		assert.Contains(t, receipt.Steps[3].GetError(), "Internal Server Error")
+		assert.False(t, receipt.GetVerified())
+
+		receipt, err = m.User.EraseUser("auth0|1")
+		require.NoError(t, err)
+		assert.True(t, receipt.GetVerified())
+		assert.Equal(t, []string{"/organizations/org_1", "/roles/rol_1"}, api.paths())
+	})
+
+	t.Run("Reports what is left", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToErase(api)
+		ignoreDeletes(api, "/grants/gr_1")
+
+		receipt, err := m.User.EraseUser("auth0|1")
+		assert.EqualError(t, err, `user "auth0|1" was not completely erased: 1 leftovers`)
+		assert.False(t, receipt.GetVerified())
+		assert.Equal(t, []string{"grant gr_1"}, receipt.GetLeftovers())
+	})
+
+	t.Run("Reports memberships and roles of a user that is still there", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToErase(api)
+		ignoreDeletes(api, "/users/auth0|1")
+		ignoreDeletes(api, "/organizations/org_1/members")
+		ignoreDeletes(api, "/users/auth0|1/roles")
+
+		receipt, err := m.User.EraseUser("auth0|1")
+		assert.EqualError(t, err, `user "auth0|1" was not completely erased: 3 leftovers`)
+		assert.Equal(t, []string{
+			"user auth0|1",
+			"organization membership org_1",
+			"role assignment rol_1",
+		}, receipt.GetLeftovers())
+	})
+
+	t.Run("Reports memberships, roles and authentication methods that survive the user", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToErase(api)
+		ignoreDeletes(api, "/organizations/org_1/members")
+		ignoreDeletes(api, "/users/auth0|1/roles")
+		ignoreDeletes(api, "/users/auth0|1/authentication-methods")
+
+		receipt, err := m.User.EraseUser("auth0|1")
+		assert.EqualError(t, err, `user "auth0|1" was not completely erased: 3 leftovers`)
+		assert.False(t, receipt.GetVerified())
+		assert.Nil(t, api.get("/users/auth0|1"))
+		assert.Equal(t, []string{
+			"authentication method totp|1",
+			"organization membership org_1",
+			"role assignment rol_1",
+		}, receipt.GetLeftovers())
+	})
+
+	t.Run("Fails for unknown users", func(t *testing.T) {
+		_, m := startFakeAPI(t)
+
+		_, err := m.User.EraseUser("auth0|1")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,233 @@
+package management
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// UserDataExportVersion is the version of the UserDataExport format. It is
+// bumped whenever the format changes in a way readers need to know about.
+const UserDataExportVersion = 1
+
+// UserDataRedacted replaces the secrets left out of a UserDataExport.
+const UserDataRedacted = "[REDACTED]"
+
+// userDataExportLogsPerPage is how many log events are read per request.
+const userDataExportLogsPerPage = 100
+
+// UserDataExport holds everything Authok stores about a user, as collected by
+// UserManager.ExportUserData to answer subject-access requests.
+type UserDataExport struct {
+	// The version of the format, UserDataExportVersion when exported.
+	Version *int `json:"version,omitempty"`
+
+	ExportedAt *time.Time `json:"exported_at,omitempty"`
+
+	// The user, with the tokens of its identities redacted.
+	User *User `json:"user,omitempty"`
+
+	Roles         []*Role         `json:"roles"`
+	Permissions   []*Permission   `json:"permissions"`
+	Organizations []*Organization `json:"organizations"`
+
+	// The Guardian enrollments of the user.
+	Enrollments []*UserEnrollment `json:"enrollments"`
+
+	// The authentication methods of the user, with TOTP secrets redacted.
+	AuthenticationMethods []*AuthenticationMethod `json:"authentication_methods"`
+
+	// The grants given by the user to applications.
+	Grants []*Grant `json:"grants"`
+
+	// The log events about the user still retained by Authok.
+	Logs []*Log `json:"logs"`
+}
+
+// ExportUserData collects everything Authok stores about a user into a
+// single UserDataExport: the user, its roles, permissions, organizations,
+// enrollments, authentication methods, grants and log events.
+//
+// Secrets are redacted: the tokens of the user's identities and the secrets
+// of TOTP authentication methods are replaced by UserDataRedacted.
+func (m *UserManager) ExportUserData(id string, opts ...RequestOption) (*UserDataExport, error) {
+	u, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export user %q: %w", id, err)
+	}
+	e := &UserDataExport{
+		Version:    authok.Int(UserDataExportVersion),
+		ExportedAt: authok.Time(time.Now().UTC()),
+		User:       u,
+	}
+
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"roles", func() (err error) {
+			e.Roles, err = listAll(func(page int) ([]*Role, bool, error) {
+				l, err := m.Roles(id, withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				return l.Roles, l.HasNext(), nil
+			})
+			return err
+		}},
+		{"permissions", func() (err error) {
+			e.Permissions, err = listAll(func(page int) ([]*Permission, bool, error) {
+				l, err := m.Permissions(id, withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				return l.Permissions, l.HasNext(), nil
+			})
+			return err
+		}},
+		{"organizations", func() (err error) {
+			e.Organizations, err = listAll(func(page int) ([]*Organization, bool, error) {
+				l, err := m.Organizations(id, withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				return l.Organizations, l.HasNext(), nil
+			})
+			return err
+		}},
+		{"enrollments", func() (err error) {
+			e.Enrollments, err = m.Enrollments(id, opts...)
+			if e.Enrollments == nil {
+				e.Enrollments = []*UserEnrollment{}
+			}
+			return err
+		}},
+		{"authentication methods", func() (err error) {
+			e.AuthenticationMethods, err = listAll(func(page int) ([]*AuthenticationMethod, bool, error) {
+				l, err := m.ListAuthenticationMethods(id, withOptions(opts, Page(page))...)
+				if err != nil {
+					return nil, false, err
+				}
+				return l.Authenticators, l.HasNext(), nil
+			})
+			return err
+		}},
+		{"grants", func() (err error) {
+			e.Grants, err = m.userGrants(id, opts)
+			return err
+		}},
+		{"logs", func() (err error) {
+			query := LogFieldUserID.Phrase(id).Option()
+			e.Logs, err = listAll(func(page int) ([]*Log, bool, error) {
+				l, err := m.Log.List(withOptions(opts, query, PerPage(userDataExportLogsPerPage), Page(page))...)
+				return l, len(l) == userDataExportLogsPerPage, err
+			})
+			return err
+		}},
+	}
+	for _, step := range steps {
+		if err := step.run(); err != nil {
+			return nil, fmt.Errorf("failed to export the %s of user %q: %w", step.name, id, err)
+		}
+	}
+
+	e.redact()
+	return e, nil
+}
+
+// listAll pages through a list and returns all of its items.
+func listAll[T any](list func(page int) ([]*T, bool, error)) ([]*T, error) {
+	all := []*T{}
+	for page := 0; ; page++ {
+		items, hasNext, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if !hasNext {
+			return all, nil
+		}
+	}
+}
+
+// userGrants returns the grants the user has given to applications.
+func (m *UserManager) userGrants(id string, opts []RequestOption) ([]*Grant, error) {
+	return listAll(func(page int) ([]*Grant, bool, error) {
+		l, err := m.Grant.List(withOptions(opts, Parameter("user_id", id), Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Grants, l.HasNext(), nil
+	})
+}
+
+func (e *UserDataExport) redact() {
+	redact := func(s **string) {
+		if *s != nil {
+			*s = authok.String(UserDataRedacted)
+		}
+	}
+	if e.User != nil {
+		redact(&e.User.Password)
+		for _, identity := range e.User.Identities {
+			redact(&identity.AccessToken)
+			redact(&identity.AccessTokenSecret)
+			redact(&identity.RefreshToken)
+		}
+	}
+	for _, method := range e.AuthenticationMethods {
+		redact(&method.TOTPSecret)
+	}
+}
+
+// WriteJSON writes the export as a single indented JSON document.
+func (e *UserDataExport) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(e)
+}
+
+// WriteZip writes the export as a zip archive holding a JSON file per part of
+// the export, along with a manifest.json file holding the version, the export
+// time and the id of the user.
+func (e *UserDataExport) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+	files := []struct {
+		name string
+		v    interface{}
+	}{
+		{"manifest.json", map[string]interface{}{
+			"version":     e.GetVersion(),
+			"exported_at": e.GetExportedAt(),
+			"user_id":     e.GetUser().GetID(),
+		}},
+		{"user.json", e.User},
+		{"roles.json", e.Roles},
+		{"permissions.json", e.Permissions},
+		{"organizations.json", e.Organizations},
+		{"enrollments.json", e.Enrollments},
+		{"authentication_methods.json", e.AuthenticationMethods},
+		{"grants.json", e.Grants},
+		{"logs.json", e.Logs},
+	}
+	for _, file := range files {
+		f, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Deflate,
+			Modified: e.GetExportedAt(),
+		})
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(f)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.v); err != nil {
+			return fmt.Errorf("failed to write %s: %w", file.name, err)
+		}
+	}
+	return archive.Close()
+}
@@ -0,0 +1,120 @@
+package management
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// givenAUserToExport stores the user auth0|1 in the fake Management API,
+// together with their roles, permissions, organizations, enrollments,
+// authentication methods, grants and logs.
+func givenAUserToExport(api *fakeAPI) {
+	api.put("/users/auth0|1", map[string]interface{}{
+		"user_id": "auth0|1",
+		"email":   "ana@example.com",
+		"identities": []interface{}{map[string]interface{}{
+			"provider":      "google-oauth2",
+			"user_id":       "123",
+			"access_token":  "ya29.secret",
+			"refresh_token": "1//secret",
+		}},
+	})
+	api.put("/roles/rol_1", map[string]interface{}{"id": "rol_1", "name": "Admin"})
+	api.put("/roles/rol_1/users/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/users/auth0|1/permissions/read:reports", map[string]interface{}{"permission_name": "read:reports"})
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	api.put("/organizations/org_1/members/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/guardian/enrollments/dev_1", map[string]interface{}{"id": "dev_1", "status": "confirmed", "user_id": "auth0|1"})
+	api.put("/users/auth0|1/authentication-methods/totp|1", map[string]interface{}{"id": "totp|1", "type": "totp", "totp_secret": "JBSWY3DPEHPK3PXP"})
+	api.put("/grants/gr_1", map[string]interface{}{"id": "gr_1", "user_id": "auth0|1", "audience": "https://api.example.com"})
+	api.put("/grants/gr_2", map[string]interface{}{"id": "gr_2", "user_id": "auth0|2", "audience": "https://api.example.com"})
+	api.put("/logs/log_1", map[string]interface{}{"_id": "log_1", "log_id": "log_1", "type": "s", "user_id": "auth0|1"})
+}
+
+func TestUserManager_ExportUserData(t *testing.T) {
+	t.Run("Collects and redacts the user's data", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToExport(api)
+
+		e, err := m.User.ExportUserData("auth0|1")
+		require.NoError(t, err)
+		assert.Equal(t, UserDataExportVersion, e.GetVersion())
+		assert.False(t, e.GetExportedAt().IsZero())
+		assert.Equal(t, "ana@example.com", e.GetUser().GetEmail())
+		assert.Equal(t, UserDataRedacted, e.User.Identities[0].GetAccessToken())
+		assert.Equal(t, UserDataRedacted, e.User.Identities[0].GetRefreshToken())
+		assert.Nil(t, e.User.Identities[0].AccessTokenSecret)
+		assert.Equal(t, UserDataRedacted, e.AuthenticationMethods[0].GetTOTPSecret())
+
+		assert.Equal(t, "rol_1", e.Roles[0].GetID())
+		assert.Equal(t, "read:reports", e.Permissions[0].GetName())
+		assert.Equal(t, "org_1", e.Organizations[0].GetID())
+		assert.Equal(t, "dev_1", e.Enrollments[0].GetID())
+		require.Len(t, e.Grants, 1)
+		assert.Equal(t, "gr_1", e.Grants[0].GetID())
+		assert.Equal(t, "log_1", e.Logs[0].GetID())
+		assert.Equal(t, `user_id:"auth0|1"`, api.queries("GET /logs")[0].Get("q"))
+
+		var b bytes.Buffer
+		require.NoError(t, e.WriteJSON(&b))
+		for _, secret := range []string{"ya29.secret", "1//secret", "JBSWY3DPEHPK3PXP"} {
+			assert.NotContains(t, b.String(), secret)
+		}
+	})
+
+	t.Run("Writes a zip of JSON files", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToExport(api)
+
+		e, err := m.User.ExportUserData("auth0|1")
+		require.NoError(t, err)
+
+		var b bytes.Buffer
+		require.NoError(t, e.WriteZip(&b))
+		archive, err := zip.NewReader(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		require.NoError(t, err)
+
+		files := map[string]*zip.File{}
+		var names []string
+		for _, f := range archive.File {
+			files[f.Name] = f
+			names = append(names, f.Name)
+		}
+		sort.Strings(names)
+		assert.Equal(t, []string{
+			"authentication_methods.json",
+			"enrollments.json",
+			"grants.json",
+			"logs.json",
+			"manifest.json",
+			"organizations.json",
+			"permissions.json",
+			"roles.json",
+			"user.json",
+		}, names)
+
+		r, err := files["manifest.json"].Open()
+		require.NoError(t, err)
+		defer r.Close()
+		var manifest map[string]interface{}
+		require.NoError(t, json.NewDecoder(r).Decode(&manifest))
+		assert.Equal(t, float64(UserDataExportVersion), manifest["version"])
+		assert.Equal(t, "auth0|1", manifest["user_id"])
+	})
+
+	t.Run("Fails when a part cannot be read", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserToExport(api)
+		api.fail("GET /grants", http.StatusForbidden, 0)
+
+		_, err := m.User.ExportUserData("auth0|1")
+		assert.ErrorContains(t, err, `failed to export the grants of user "auth0|1"`)
+	})
+}
@@ -0,0 +1,288 @@
+package management
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// MetadataPatch is a JSON merge patch document (RFC 7396) that can be applied
+// to the user_metadata or app_metadata of a User.
+//
+// Nested objects are merged recursively and a nil value removes the key it is
+// assigned to. Patches can be written as literals or built up using Set and
+// Delete:
+//
+//	patch := NewMetadataPatch().
+//		Set("preferences.theme", "dark").
+//		Delete("preferences.legacy_flag")
+//
+// See: https://www.rfc-editor.org/rfc/rfc7396
+type MetadataPatch map[string]interface{}
+
+// NewMetadataPatch returns an empty MetadataPatch.
+func NewMetadataPatch() MetadataPatch {
+	return MetadataPatch{}
+}
+
+// Set assigns v to the key found at the dot separated path, creating any
+// intermediate objects as needed.
+func (p MetadataPatch) Set(path string, v interface{}) MetadataPatch {
+	p.assign(strings.Split(path, "."), v)
+	return p
+}
+
+// Delete removes the key found at the dot separated path.
+func (p MetadataPatch) Delete(path string) MetadataPatch {
+	p.assign(strings.Split(path, "."), nil)
+	return p
+}
+
+func (p MetadataPatch) assign(keys []string, v interface{}) {
+	m := map[string]interface{}(p)
+	for _, key := range keys[:len(keys)-1] {
+		next, ok := m[key].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			m[key] = next
+		}
+		m = next
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+// Apply returns the result of merging the patch into doc. The doc is left
+// untouched.
+func (p MetadataPatch) Apply(doc map[string]interface{}) (map[string]interface{}, error) {
+	patch, err := p.normalize()
+	if err != nil {
+		return nil, err
+	}
+	result, _ := mergePatch(doc, patch).(map[string]interface{})
+	return result, nil
+}
+
+// normalize returns the patch as it would be decoded from JSON, so it can be
+// compared against metadata that was read from the API.
+func (p MetadataPatch) normalize() (map[string]interface{}, error) {
+	b, err := json.Marshal(map[string]interface{}(p))
+	if err != nil {
+		return nil, fmt.Errorf("encoding metadata patch failed: %w", err)
+	}
+	var patch map[string]interface{}
+	if err := json.Unmarshal(b, &patch); err != nil {
+		return nil, fmt.Errorf("decoding metadata patch failed: %w", err)
+	}
+	return patch, nil
+}
+
+// diff returns the smallest update that brings current in line with the
+// patch.
+//
+// The Management API only merges metadata at the top level, so every top
+// level key touched by the patch is sent in full, or as null when it has to be
+// removed. Keys that would end up unchanged are left out.
+func (p MetadataPatch) diff(current map[string]interface{}) (map[string]interface{}, error) {
+	patch, err := p.normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]interface{})
+	for key, value := range patch {
+		old, exists := current[key]
+		if value == nil {
+			if exists {
+				body[key] = nil
+			}
+			continue
+		}
+
+		merged := mergePatch(old, value)
+		if exists && reflect.DeepEqual(old, merged) {
+			continue
+		}
+		body[key] = merged
+	}
+
+	return body, nil
+}
+
+// mergePatch implements the MergePatch algorithm described in RFC 7396.
+func mergePatch(target, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	t := make(map[string]interface{})
+	if m, ok := target.(map[string]interface{}); ok {
+		for k, v := range m {
+			t[k] = v
+		}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+
+	return t
+}
+
+const (
+	userMetadataField = "user_metadata"
+	appMetadataField  = "app_metadata"
+)
+
+// PatchMetadata applies a merge patch to the user_metadata of a user.
+//
+// The user is read first in order to compute the smallest possible request
+// body: only the top level keys that actually change are sent, and keys set
+// to nil in the patch are removed. If nothing changes, no update is made.
+//
+// The options are used to read the user. Only their context and headers apply
+// to the update, so options such as IncludeFields do not affect it.
+//
+// Concurrent writes are not guarded against: the last write wins. See
+// PatchMetadataWithRetry.
+//
+// The updated user is returned.
+func (m *UserManager) PatchMetadata(id string, patch MetadataPatch, opts ...RequestOption) (*User, error) {
+	return m.patchMetadata(id, userMetadataField, patch, 0, opts)
+}
+
+// PatchAppMetadata applies a merge patch to the app_metadata of a user.
+//
+// See PatchMetadata for details on how the request body is computed.
+func (m *UserManager) PatchAppMetadata(id string, patch MetadataPatch, opts ...RequestOption) (*User, error) {
+	return m.patchMetadata(id, appMetadataField, patch, 0, opts)
+}
+
+// PatchMetadataWithRetry behaves like PatchMetadata, but guards against
+// concurrent writers. Once the update is computed, the user is read again
+// right before writing and if any of the top level keys that would be sent
+// changed in the meantime, the update is recomputed from the fresh copy and
+// compared again. After maxAttempts changed reads an Error with a 409 status
+// code is returned and nothing is written.
+//
+// The Management API has no conditional updates, so the comparison is made as
+// close to the write as a client can make it.
+func (m *UserManager) PatchMetadataWithRetry(id string, patch MetadataPatch, maxAttempts int, opts ...RequestOption) (*User, error) {
+	return m.patchMetadata(id, userMetadataField, patch, maxAttempts, opts)
+}
+
+// PatchAppMetadataWithRetry behaves like PatchAppMetadata, but guards against
+// concurrent writers in the same way as PatchMetadataWithRetry.
+func (m *UserManager) PatchAppMetadataWithRetry(id string, patch MetadataPatch, maxAttempts int, opts ...RequestOption) (*User, error) {
+	return m.patchMetadata(id, appMetadataField, patch, maxAttempts, opts)
+}
+
+func (m *UserManager) patchMetadata(
+	id string,
+	field string,
+	patch MetadataPatch,
+	maxAttempts int,
+	opts []RequestOption,
+) (*User, error) {
+	u, err := m.Read(id, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var body map[string]interface{}
+	for attempts := 1; ; attempts++ {
+		if body, err = patch.diff(metadataOf(u, field)); err != nil {
+			return nil, err
+		}
+		if len(body) == 0 {
+			return u, nil
+		}
+		if maxAttempts <= 0 {
+			break
+		}
+
+		fresh, err := m.Read(id, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if sameValues(metadataOf(u, field), metadataOf(fresh, field), body) {
+			break
+		}
+		if attempts >= maxAttempts {
+			return nil, &managementError{
+				StatusCode: http.StatusConflict,
+				Err:        http.StatusText(http.StatusConflict),
+				Message:    fmt.Sprintf("%s of user %q was modified concurrently", field, id),
+			}
+		}
+		u = fresh
+	}
+
+	written := make(map[string]interface{}, len(body))
+	for key, value := range body {
+		written[key] = value
+	}
+
+	update := &User{}
+	if field == appMetadataField {
+		update.AppMetadata = &body
+	} else {
+		update.UserMetadata = &body
+	}
+
+	if err := m.Update(id, update, withoutQuery(opts)); err != nil {
+		return nil, err
+	}
+
+	// The response is decoded into the map that held the request body, so
+	// the keys that were removed still linger as nil values.
+	updated := metadataOf(update, field)
+	for key, value := range written {
+		if value == nil && updated[key] == nil {
+			delete(updated, key)
+		}
+	}
+	return update, nil
+}
+
+// withoutQuery applies the options to a request, except for the query
+// parameters they set.
+func withoutQuery(opts []RequestOption) RequestOption {
+	return newRequestOption(func(r *http.Request) {
+		query := r.URL.RawQuery
+		for _, option := range opts {
+			option.apply(r)
+		}
+		r.URL.RawQuery = query
+	})
+}
+
+func metadataOf(u *User, field string) map[string]interface{} {
+	metadata := u.UserMetadata
+	if field == appMetadataField {
+		metadata = u.AppMetadata
+	}
+	if metadata == nil {
+		return map[string]interface{}{}
+	}
+	return *metadata
+}
+
+// sameValues reports whether a and b hold the same values for the top level
+// keys, where a key can be absent from both.
+func sameValues(a, b map[string]interface{}, keys map[string]interface{}) bool {
+	for key := range keys {
+		va, inA := a[key]
+		vb, inB := b[key]
+		if inA != inB || !reflect.DeepEqual(va, vb) {
+			return false
+		}
+	}
+	return true
+}
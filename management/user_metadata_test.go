@@ -0,0 +1,263 @@
+package management
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataPatch_Builder(t *testing.T) {
+	patch := NewMetadataPatch().
+		Set("preferences.theme", "dark").
+		Set("preferences.language", "en").
+		Delete("legacy").
+		Delete("preferences.beta")
+
+	assert.Equal(t, MetadataPatch{
+		"preferences": map[string]interface{}{
+			"theme":    "dark",
+			"language": "en",
+			"beta":     nil,
+		},
+		"legacy": nil,
+	}, patch)
+}
+
+func TestMetadataPatch_Apply(t *testing.T) {
+	doc := map[string]interface{}{
+		"title": "Goodbye!",
+		"author": map[string]interface{}{
+			"givenName":  "John",
+			"familyName": "Doe",
+		},
+		"tags":    []interface{}{"example", "sample"},
+		"content": "This will be unchanged",
+	}
+
+	patch := MetadataPatch{
+		"title":       "Hello!",
+		"phoneNumber": "+01-123-456-7890",
+		"author": map[string]interface{}{
+			"familyName": nil,
+		},
+		"tags": []string{"example"},
+	}
+
+	result, err := patch.Apply(doc)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{
+		"title":       "Hello!",
+		"author":      map[string]interface{}{"givenName": "John"},
+		"tags":        []interface{}{"example"},
+		"content":     "This will be unchanged",
+		"phoneNumber": "+01-123-456-7890",
+	}, result)
+	assert.Equal(t, "Doe", doc["author"].(map[string]interface{})["familyName"], "doc must not be modified")
+}
+
+func TestMetadataPatch_Diff(t *testing.T) {
+	current := map[string]interface{}{
+		"plan":  "free",
+		"count": float64(3),
+		"preferences": map[string]interface{}{
+			"theme":    "light",
+			"language": "en",
+		},
+	}
+
+	var testCases = []struct {
+		name     string
+		patch    MetadataPatch
+		expected map[string]interface{}
+	}{
+		{
+			name:     "unchanged values are left out",
+			patch:    MetadataPatch{"plan": "free", "count": 3},
+			expected: map[string]interface{}{},
+		},
+		{
+			name:  "nested changes send the whole top level key",
+			patch: NewMetadataPatch().Set("preferences.theme", "dark"),
+			expected: map[string]interface{}{
+				"preferences": map[string]interface{}{
+					"theme":    "dark",
+					"language": "en",
+				},
+			},
+		},
+		{
+			name:     "deletes are sent as null",
+			patch:    NewMetadataPatch().Delete("plan").Delete("missing"),
+			expected: map[string]interface{}{"plan": nil},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			body, err := testCase.patch.diff(current)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, body)
+		})
+	}
+}
+
+// givenAUserWithMetadata stores a user with the given user_metadata in the
+// fake Management API.
+func givenAUserWithMetadata(api *fakeAPI, metadata map[string]interface{}) {
+	api.put("/users/authok|123", map[string]interface{}{"user_id": "authok|123", "user_metadata": metadata})
+}
+
+// onUserRead calls fn with the number of times the user was read and its
+// user_metadata before answering each read, so that tests can make
+// concurrent changes.
+func onUserRead(api *fakeAPI, fn func(reads int, metadata map[string]interface{})) {
+	reads := 0
+	api.handle("GET /users/authok|123", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		fn(reads, api.get("/users/authok|123")["user_metadata"].(map[string]interface{}))
+		api.serve(w, r)
+	})
+}
+
+func metadataPatches(api *fakeAPI) []map[string]interface{} {
+	var patches []map[string]interface{}
+	for _, body := range api.bodies("PATCH /users/authok|123") {
+		patches = append(patches, body["user_metadata"].(map[string]interface{}))
+	}
+	return patches
+}
+
+func TestUserManager_PatchMetadata(t *testing.T) {
+	t.Run("Sends only the changed top level keys", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{
+			"plan": "free",
+			"preferences": map[string]interface{}{
+				"theme":    "light",
+				"language": "en",
+			},
+		})
+
+		patch := NewMetadataPatch().
+			Set("plan", "free").
+			Set("preferences.theme", "dark").
+			Delete("preferences.language")
+
+		u, err := m.User.PatchMetadata("authok|123", patch)
+		require.NoError(t, err)
+
+		require.Len(t, metadataPatches(api), 1)
+		assert.Equal(t, map[string]interface{}{
+			"preferences": map[string]interface{}{"theme": "dark"},
+		}, metadataPatches(api)[0])
+		assert.Equal(t, map[string]interface{}{
+			"plan":        "free",
+			"preferences": map[string]interface{}{"theme": "dark"},
+		}, *u.UserMetadata)
+	})
+
+	t.Run("Removes top level keys", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"plan": "free", "legacy": true})
+
+		u, err := m.User.PatchMetadata("authok|123", NewMetadataPatch().Delete("legacy"))
+		require.NoError(t, err)
+
+		require.Len(t, metadataPatches(api), 1)
+		assert.Equal(t, map[string]interface{}{"legacy": nil}, metadataPatches(api)[0])
+		assert.Equal(t, map[string]interface{}{"plan": "free"}, *u.UserMetadata)
+	})
+
+	t.Run("Skips the update when nothing changes", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"plan": "free"})
+
+		u, err := m.User.PatchMetadata("authok|123", MetadataPatch{"plan": "free", "gone": nil})
+		require.NoError(t, err)
+
+		assert.Empty(t, metadataPatches(api))
+		assert.Equal(t, "authok|123", u.GetID())
+	})
+}
+
+func TestUserManager_PatchMetadataWithRetry(t *testing.T) {
+	t.Run("Compares the user before writing", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"plan": "free"})
+
+		u, err := m.User.PatchMetadataWithRetry("authok|123", MetadataPatch{"plan": "pro"}, 3)
+		require.NoError(t, err)
+
+		assert.Len(t, metadataPatches(api), 1)
+		assert.Len(t, api.queries("GET /users/authok|123"), 2)
+		assert.Equal(t, map[string]interface{}{"plan": "pro"}, *u.UserMetadata)
+	})
+
+	t.Run("Recomputes the patch after a concurrent write", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{
+			"preferences": map[string]interface{}{"theme": "light"},
+		})
+		onUserRead(api, func(reads int, metadata map[string]interface{}) {
+			if reads == 2 {
+				metadata["preferences"] = map[string]interface{}{"theme": "light", "language": "fr"}
+			}
+		})
+
+		u, err := m.User.PatchMetadataWithRetry("authok|123", NewMetadataPatch().Set("preferences.theme", "dark"), 3)
+		require.NoError(t, err)
+
+		assert.Len(t, api.queries("GET /users/authok|123"), 3)
+		require.Len(t, metadataPatches(api), 1)
+		assert.Equal(t, map[string]interface{}{
+			"preferences": map[string]interface{}{"theme": "dark", "language": "fr"},
+		}, metadataPatches(api)[0])
+		assert.Equal(t, metadataPatches(api)[0], *u.UserMetadata)
+	})
+
+	t.Run("Ignores concurrent writes to other keys", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"plan": "free"})
+		onUserRead(api, func(reads int, metadata map[string]interface{}) {
+			metadata["logins"] = float64(reads)
+		})
+
+		_, err := m.User.PatchMetadataWithRetry("authok|123", MetadataPatch{"plan": "pro"}, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, []map[string]interface{}{{"plan": "pro"}}, metadataPatches(api))
+	})
+
+	t.Run("Gives up after the maximum number of attempts", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"counter": float64(0)})
+		onUserRead(api, func(reads int, metadata map[string]interface{}) {
+			metadata["counter"] = float64(reads)
+		})
+
+		_, err := m.User.PatchMetadataWithRetry("authok|123", MetadataPatch{"counter": -1}, 2)
+
+		require.Error(t, err)
+		assert.Implements(t, (*Error)(nil), err)
+		assert.Equal(t, http.StatusConflict, err.(Error).Status())
+		assert.Len(t, api.queries("GET /users/authok|123"), 3)
+		assert.Empty(t, metadataPatches(api))
+	})
+
+	t.Run("Only reads with the query options", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenAUserWithMetadata(api, map[string]interface{}{"plan": "free"})
+
+		_, err := m.User.PatchMetadataWithRetry("authok|123", MetadataPatch{"plan": "pro"}, 3, IncludeFields("user_metadata"))
+		require.NoError(t, err)
+
+		for _, query := range api.queries("GET /users/authok|123") {
+			assert.Equal(t, "user_metadata", query.Get("fields"))
+		}
+		require.Len(t, api.queries("PATCH /users/authok|123"), 1)
+		assert.Empty(t, api.queries("PATCH /users/authok|123")[0])
+	})
+}
@@ -0,0 +1,286 @@
+package management
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/authok/authok-go"
+)
+
+// Types of PermissionSource.
+const (
+	// PermissionSourceDirect is a permission assigned to the user.
+	PermissionSourceDirect = "direct"
+
+	// PermissionSourceRole is a permission of a role assigned to the user.
+	PermissionSourceRole = "role"
+
+	// PermissionSourceOrganizationRole is a permission of a role assigned to
+	// the user as a member of an organization.
+	PermissionSourceOrganizationRole = "organization_role"
+)
+
+// EffectivePermission is a permission a user has, along with where it comes
+// from.
+type EffectivePermission struct {
+	// The resource server that the permission is attached to.
+	ResourceServerIdentifier *string `json:"resource_server_identifier,omitempty"`
+
+	// The name of the resource server.
+	ResourceServerName *string `json:"resource_server_name,omitempty"`
+
+	// The name of the permission.
+	Name *string `json:"permission_name,omitempty"`
+
+	// The description of the permission.
+	Description *string `json:"description,omitempty"`
+
+	// Where the user gets the permission from.
+	Sources []*PermissionSource `json:"sources"`
+}
+
+// PermissionSource is where a user gets an EffectivePermission from.
+type PermissionSource struct {
+	// One of PermissionSourceDirect, PermissionSourceRole or
+	// PermissionSourceOrganizationRole.
+	Type *string `json:"type,omitempty"`
+
+	// The role granting the permission, unless it is assigned directly.
+	RoleID   *string `json:"role_id,omitempty"`
+	RoleName *string `json:"role_name,omitempty"`
+
+	// The organization the role is assigned in, for organization roles.
+	OrganizationID   *string `json:"organization_id,omitempty"`
+	OrganizationName *string `json:"organization_name,omitempty"`
+}
+
+// Describe describes the source in plain words, such as "direct", "role
+// admin" or "organization acme role admin".
+func (s *PermissionSource) Describe() string {
+	switch s.GetType() {
+	case PermissionSourceRole:
+		return "role " + s.GetRoleName()
+	case PermissionSourceOrganizationRole:
+		return "organization " + s.GetOrganizationName() + " role " + s.GetRoleName()
+	default:
+		return s.GetType()
+	}
+}
+
+// EffectivePermissionsOptions configures which effective permissions are
+// resolved.
+type EffectivePermissionsOptions struct {
+	// When set, only the permissions of these resource servers, given by
+	// identifier, are resolved.
+	ResourceServers *[]string `json:"resource_servers,omitempty"`
+}
+
+// EffectivePermissions resolves the permissions a user has: those assigned
+// directly, those of the roles assigned to the user, and those of the roles
+// assigned to the user in each organization it is a member of. Permissions
+// are sorted by resource server and name.
+//
+// To resolve the permissions of many users, such as for an audit report, use
+// a PermissionResolver, which fetches the permissions of each role once.
+func (m *UserManager) EffectivePermissions(id string, options *EffectivePermissionsOptions, opts ...RequestOption) ([]*EffectivePermission, error) {
+	return m.PermissionResolver(opts...).Resolve(id, options)
+}
+
+// PermissionResolver resolves effective permissions, caching the permissions
+// of roles across users. It is safe for concurrent use.
+//
+// As changes made to roles after they are cached are not seen, a
+// PermissionResolver is meant to be used for a single report.
+type PermissionResolver struct {
+	m    *UserManager
+	opts []RequestOption
+
+	mu    sync.Mutex
+	roles map[string][]*Permission
+}
+
+// PermissionResolver returns a PermissionResolver making requests with the
+// given options.
+func (m *UserManager) PermissionResolver(opts ...RequestOption) *PermissionResolver {
+	return &PermissionResolver{
+		m:     m,
+		opts:  opts,
+		roles: map[string][]*Permission{},
+	}
+}
+
+// Resolve resolves the permissions of a user as UserManager.EffectivePermissions
+// does.
+func (r *PermissionResolver) Resolve(id string, options *EffectivePermissionsOptions) ([]*EffectivePermission, error) {
+	if options == nil {
+		options = &EffectivePermissionsOptions{}
+	}
+	resolved := &effectivePermissions{byKey: map[string]*EffectivePermission{}}
+	if options.ResourceServers != nil {
+		resolved.resourceServers = map[string]bool{}
+		for _, identifier := range options.GetResourceServers() {
+			resolved.resourceServers[identifier] = true
+		}
+	}
+
+	direct, err := listAll(func(page int) ([]*Permission, bool, error) {
+		l, err := r.m.Permissions(id, withOptions(r.opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Permissions, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the permissions of user %q: %w", id, err)
+	}
+	resolved.add(direct, &PermissionSource{Type: authok.String(PermissionSourceDirect)})
+
+	roles, err := listAll(func(page int) ([]*Role, bool, error) {
+		l, err := r.m.Roles(id, withOptions(r.opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Roles, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the roles of user %q: %w", id, err)
+	}
+	for _, role := range roles {
+		permissions, err := r.rolePermissions(role.GetID())
+		if err != nil {
+			return nil, err
+		}
+		resolved.add(permissions, &PermissionSource{
+			Type:     authok.String(PermissionSourceRole),
+			RoleID:   role.ID,
+			RoleName: role.Name,
+		})
+	}
+
+	organizations, err := listAll(func(page int) ([]*Organization, bool, error) {
+		l, err := r.m.Organizations(id, withOptions(r.opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Organizations, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the organizations of user %q: %w", id, err)
+	}
+	for _, organization := range organizations {
+		roles, err := listAll(func(page int) ([]*OrganizationMemberRole, bool, error) {
+			l, err := r.m.Organization.MemberRoles(organization.GetID(), id, withOptions(r.opts, Page(page))...)
+			if err != nil {
+				return nil, false, err
+			}
+			roles := make([]*OrganizationMemberRole, len(l.Roles))
+			for i := range l.Roles {
+				roles[i] = &l.Roles[i]
+			}
+			return roles, l.HasNext(), nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the roles of user %q in organization %q: %w", id, organization.GetID(), err)
+		}
+		for _, role := range roles {
+			permissions, err := r.rolePermissions(role.GetID())
+			if err != nil {
+				return nil, err
+			}
+			resolved.add(permissions, &PermissionSource{
+				Type:             authok.String(PermissionSourceOrganizationRole),
+				RoleID:           role.ID,
+				RoleName:         role.Name,
+				OrganizationID:   organization.ID,
+				OrganizationName: organization.Name,
+			})
+		}
+	}
+
+	return resolved.sorted(), nil
+}
+
+// ResolveAll resolves the permissions of each of the users, by user id.
+func (r *PermissionResolver) ResolveAll(ids []string, options *EffectivePermissionsOptions) (map[string][]*EffectivePermission, error) {
+	permissions := make(map[string][]*EffectivePermission, len(ids))
+	for _, id := range ids {
+		resolved, err := r.Resolve(id, options)
+		if err != nil {
+			return nil, err
+		}
+		permissions[id] = resolved
+	}
+	return permissions, nil
+}
+
+// rolePermissions returns the permissions of a role, fetching them on first
+// use.
+func (r *PermissionResolver) rolePermissions(id string) ([]*Permission, error) {
+	r.mu.Lock()
+	permissions, ok := r.roles[id]
+	r.mu.Unlock()
+	if ok {
+		return permissions, nil
+	}
+
+	permissions, err := listAll(func(page int) ([]*Permission, bool, error) {
+		l, err := r.m.Role.Permissions(id, withOptions(r.opts, Page(page))...)
+		if err != nil {
+			return nil, false, err
+		}
+		return l.Permissions, l.HasNext(), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the permissions of role %q: %w", id, err)
+	}
+
+	r.mu.Lock()
+	r.roles[id] = permissions
+	r.mu.Unlock()
+	return permissions, nil
+}
+
+// effectivePermissions merges the permissions of a user by resource server
+// and name.
+type effectivePermissions struct {
+	resourceServers map[string]bool
+	byKey           map[string]*EffectivePermission
+}
+
+func (e *effectivePermissions) add(permissions []*Permission, source *PermissionSource) {
+	for _, permission := range permissions {
+		identifier := permission.GetResourceServerIdentifier()
+		if e.resourceServers != nil && !e.resourceServers[identifier] {
+			continue
+		}
+		key := identifier + "\x00" + permission.GetName()
+		effective, ok := e.byKey[key]
+		if !ok {
+			effective = &EffectivePermission{
+				ResourceServerIdentifier: permission.ResourceServerIdentifier,
+				ResourceServerName:       permission.ResourceServerName,
+				Name:                     permission.Name,
+				Description:              permission.Description,
+				Sources:                  []*PermissionSource{},
+			}
+			e.byKey[key] = effective
+		}
+		source := *source
+		effective.Sources = append(effective.Sources, &source)
+	}
+}
+
+func (e *effectivePermissions) sorted() []*EffectivePermission {
+	permissions := make([]*EffectivePermission, 0, len(e.byKey))
+	for _, permission := range e.byKey {
+		permissions = append(permissions, permission)
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].GetResourceServerIdentifier() != permissions[j].GetResourceServerIdentifier() {
+			return permissions[i].GetResourceServerIdentifier() < permissions[j].GetResourceServerIdentifier()
+		}
+		return permissions[i].GetName() < permissions[j].GetName()
+	})
+	return permissions
+}
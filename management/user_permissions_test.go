@@ -0,0 +1,132 @@
+package management
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// givenUsersWithPermissions stores the users auth0|1, who has a direct
+// permission, the role rol_1 and the role rol_2 in organization org_1, and
+// auth0|2, who has the role rol_1.
+func givenUsersWithPermissions(api *fakeAPI) {
+	permission := func(resourceServer, name string) map[string]interface{} {
+		return map[string]interface{}{"resource_server_identifier": resourceServer, "permission_name": name}
+	}
+
+	api.put("/users/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/users/auth0|2", map[string]interface{}{"user_id": "auth0|2"})
+	api.put("/users/auth0|1/permissions/read:items", permission("https://api", "read:items"))
+
+	api.put("/roles/rol_1", map[string]interface{}{"id": "rol_1", "name": "reader"})
+	api.put("/roles/rol_1/users/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/roles/rol_1/users/auth0|2", map[string]interface{}{"user_id": "auth0|2"})
+	api.put("/roles/rol_1/permissions/read:items", permission("https://api", "read:items"))
+	api.put("/roles/rol_1/permissions/read:invoices", permission("https://billing", "read:invoices"))
+
+	api.put("/roles/rol_2", map[string]interface{}{"id": "rol_2", "name": "admin"})
+	writeItems := permission("https://api", "write:items")
+	writeItems["description"] = "Write items"
+	api.put("/roles/rol_2/permissions/write:items", writeItems)
+
+	api.put("/organizations/org_1", map[string]interface{}{"id": "org_1", "name": "acme"})
+	api.put("/organizations/org_1/members/auth0|1", map[string]interface{}{"user_id": "auth0|1"})
+	api.put("/organizations/org_1/members/auth0|1/roles/rol_2", map[string]interface{}{"id": "rol_2", "name": "admin"})
+}
+
+func describePermissions(permissions []*EffectivePermission) []string {
+	var described []string
+	for _, permission := range permissions {
+		var sources []string
+		for _, source := range permission.Sources {
+			sources = append(sources, source.Describe())
+		}
+		described = append(described, permission.GetResourceServerIdentifier()+" "+permission.GetName()+": "+strings.Join(sources, ", "))
+	}
+	return described
+}
+
+func TestUserManager_EffectivePermissions(t *testing.T) {
+	t.Run("Merges direct, role and organization role permissions", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenUsersWithPermissions(api)
+
+		permissions, err := m.User.EffectivePermissions("auth0|1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"https://api read:items: direct, role reader",
+			"https://api write:items: organization acme role admin",
+			"https://billing read:invoices: role reader",
+		}, describePermissions(permissions))
+		assert.Equal(t, "Write items", permissions[1].GetDescription())
+		assert.Equal(t, "org_1", permissions[1].Sources[0].GetOrganizationID())
+		assert.Equal(t, "rol_2", permissions[1].Sources[0].GetRoleID())
+		assert.NotSame(t, permissions[0].Sources[1], permissions[2].Sources[0])
+	})
+
+	t.Run("Filters by resource server", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenUsersWithPermissions(api)
+
+		permissions, err := m.User.EffectivePermissions("auth0|1", &EffectivePermissionsOptions{
+			ResourceServers: &[]string{"https://billing"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://billing read:invoices: role reader"}, describePermissions(permissions))
+	})
+
+	t.Run("Fails for unknown users", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenUsersWithPermissions(api)
+
+		_, err := m.User.EffectivePermissions("auth0|3", nil)
+		assert.ErrorContains(t, err, `failed to list the permissions of user "auth0|3"`)
+	})
+}
+
+func TestPermissionResolver_ResolveAll(t *testing.T) {
+	api, m := startFakeAPI(t)
+	givenUsersWithPermissions(api)
+
+	permissions, err := m.User.PermissionResolver().ResolveAll([]string{"auth0|1", "auth0|2"}, nil)
+	require.NoError(t, err)
+	assert.Len(t, permissions["auth0|1"], 3)
+	assert.Equal(t, []string{
+		"https://api read:items: role reader",
+		"https://billing read:invoices: role reader",
+	}, describePermissions(permissions["auth0|2"]))
+	assert.Len(t, api.queries("GET /roles/rol_1/permissions"), 1)
+}
+
+func TestPermissionResolver_ResolveConcurrently(t *testing.T) {
+	api, m := startFakeAPI(t)
+	givenUsersWithPermissions(api)
+
+	opts := make([]RequestOption, 1, 4)
+	opts[0] = Parameter("include_totals", "true")
+	r := m.User.PermissionResolver(opts...)
+
+	var wg sync.WaitGroup
+	permissions := make([][]*EffectivePermission, 8)
+	errs := make([]error, len(permissions))
+	for i := range permissions {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			permissions[i], errs[i] = r.Resolve("auth0|1", nil)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range permissions {
+		require.NoError(t, errs[i])
+		assert.Equal(t, []string{
+			"https://api read:items: direct, role reader",
+			"https://api write:items: organization acme role admin",
+			"https://billing read:invoices: role reader",
+		}, describePermissions(permissions[i]))
+	}
+}
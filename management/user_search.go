@@ -0,0 +1,260 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/authok/authok-go"
+)
+
+// UserSearchWindow is how many results of a user search can be paged
+// through. Searches matching more users are split by SearchAll.
+const UserSearchWindow = 1000
+
+const (
+	userSearchPerPage      = 100
+	userSearchPollInterval = 5 * time.Second
+	userSearchPollTimeout  = 30 * time.Minute
+)
+
+// UserSearchOptions configures how UserManager.SearchAll searches.
+type UserSearchOptions struct {
+	// How many results a search can be paged through. Defaults to
+	// UserSearchWindow.
+	Window *int `json:"window,omitempty"`
+
+	// The fields included in the users export, when falling back to one.
+	// Those checked by the filter must be included. Defaults to the fields
+	// exported by default.
+	ExportFields *[]string `json:"export_fields,omitempty"`
+
+	// How often the export job is polled. Defaults to 5 seconds.
+	PollInterval *time.Duration `json:"poll_interval,omitempty"`
+
+	// How long to wait for the export job. Defaults to 30 minutes.
+	PollTimeout *time.Duration `json:"poll_timeout,omitempty"`
+
+	// The client used to download the users export. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client `json:"-"`
+}
+
+// errUserSearchUnsplittable is returned when more users than fit in the
+// search window match within a single millisecond of created_at.
+var errUserSearchUnsplittable = errors.New("too many users created at once to split the search")
+
+// SearchAll calls yield with every user matching the query, each exactly once.
+//
+// Unlike Search, which can only page through the first UserSearchWindow
+// results, SearchAll splits queries matching more users into created_at
+// ranges small enough to be paged through. Should too many users have been
+// created within the same millisecond, such as by a bulk import, it falls
+// back to exporting all users with JobManager.ExportUsers and matching them
+// locally with filter instead of the query. The filter must therefore match
+// exactly the users the query matches: SearchAll cannot check that, and
+// yields whatever users the filter accepts. Without a filter, SearchAll fails
+// instead.
+//
+// Users created while searching may be left out. If yield returns an error,
+// SearchAll stops and returns it.
+func (m *UserManager) SearchAll(query SearchQuery, filter func(*User) bool, yield func(*User) error, options *UserSearchOptions, opts ...RequestOption) error {
+	if options == nil {
+		options = &UserSearchOptions{}
+	}
+	s := &userSearch{
+		m:       m,
+		options: options,
+		window:  UserSearchWindow,
+		seen:    map[string]bool{},
+		yield:   yield,
+		opts:    opts,
+	}
+	if options.Window != nil {
+		s.window = options.GetWindow()
+	}
+	s.perPage = userSearchPerPage
+	if s.window < s.perPage {
+		s.perPage = s.window
+	}
+
+	err := s.search(query)
+	if errors.Is(err, errUserSearchUnsplittable) {
+		if filter == nil {
+			return fmt.Errorf("failed to search users matching %q: %w", query, err)
+		}
+		err = s.export(filter)
+	}
+	return err
+}
+
+type userSearch struct {
+	m       *UserManager
+	options *UserSearchOptions
+	window  int
+	perPage int
+	seen    map[string]bool
+	yield   func(*User) error
+	opts    []RequestOption
+}
+
+func (s *userSearch) search(query SearchQuery) error {
+	first, err := s.page(query, 0)
+	if err != nil {
+		return err
+	}
+	if first.Total <= s.window {
+		return s.yieldPages(query, first)
+	}
+
+	oldest, err := s.page(query, 0, PerPage(1), Parameter("sort", "created_at:1"))
+	if err != nil {
+		return err
+	}
+	newest, err := s.page(query, 0, PerPage(1), Parameter("sort", "created_at:-1"))
+	if err != nil {
+		return err
+	}
+	if len(oldest.Users) == 0 || len(newest.Users) == 0 {
+		return s.yieldPages(query, first)
+	}
+	from := oldest.Users[0].GetCreatedAt().Truncate(time.Millisecond)
+	to := newest.Users[0].GetCreatedAt().Truncate(time.Millisecond).Add(time.Millisecond)
+	return s.searchRange(query, from, to)
+}
+
+// searchRange searches the users created from from, included, to to,
+// excluded, halving the range until its users fit in the search window.
+func (s *userSearch) searchRange(query SearchQuery, from, to time.Time) error {
+	ranged := AllOf(query, UserFieldCreatedAt.Range(from, to, true, false))
+	first, err := s.page(ranged, 0)
+	if err != nil {
+		return err
+	}
+	if first.Total <= s.window {
+		return s.yieldPages(ranged, first)
+	}
+	if to.Sub(from) <= time.Millisecond {
+		return errUserSearchUnsplittable
+	}
+
+	middle := from.Add(to.Sub(from) / 2).Truncate(time.Millisecond)
+	if !middle.After(from) {
+		middle = from.Add(time.Millisecond)
+	}
+	if err := s.searchRange(query, from, middle); err != nil {
+		return err
+	}
+	return s.searchRange(query, middle, to)
+}
+
+func (s *userSearch) page(query SearchQuery, page int, opts ...RequestOption) (*UserList, error) {
+	more := append([]RequestOption{PerPage(s.perPage)}, opts...)
+	more = append(more, query.Option(), Page(page))
+	l, err := s.m.Search(withOptions(s.opts, more...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search users matching %q: %w", query, err)
+	}
+	return l, nil
+}
+
+// yieldPages yields the users of the first page of the query, then those of
+// the following pages.
+func (s *userSearch) yieldPages(query SearchQuery, l *UserList) error {
+	for page := 1; ; page++ {
+		for _, user := range l.Users {
+			if err := s.yieldUser(user); err != nil {
+				return err
+			}
+		}
+		if !l.HasNext() || len(l.Users) == 0 {
+			return nil
+		}
+		var err error
+		if l, err = s.page(query, page); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *userSearch) yieldUser(user *User) error {
+	if s.seen[user.GetID()] {
+		return nil
+	}
+	s.seen[user.GetID()] = true
+	return s.yield(user)
+}
+
+// export yields the users of a users export matching the filter.
+func (s *userSearch) export(filter func(*User) bool) error {
+	job := &Job{Format: authok.String("json")}
+	if s.options.ExportFields != nil {
+		for _, field := range s.options.GetExportFields() {
+			job.Fields = append(job.Fields, map[string]interface{}{"name": field})
+		}
+	}
+	if err := s.m.Job.ExportUsers(job, s.opts...); err != nil {
+		return fmt.Errorf("failed to export users: %w", err)
+	}
+
+	interval := userSearchPollInterval
+	if s.options.PollInterval != nil {
+		interval = s.options.GetPollInterval()
+	}
+	timeout := userSearchPollTimeout
+	if s.options.PollTimeout != nil {
+		timeout = s.options.GetPollTimeout()
+	}
+	ctx, cancel := context.WithTimeout(requestContext(s.opts), timeout)
+	defer cancel()
+
+	for job.GetStatus() != "completed" {
+		if job.GetStatus() == "failed" {
+			return fmt.Errorf("users export job %q failed", job.GetID())
+		}
+		if err := sleepContext(ctx, interval); err != nil {
+			return fmt.Errorf("failed waiting for users export job %q: %w", job.GetID(), err)
+		}
+		var err error
+		if job, err = s.m.Job.Read(job.GetID(), withOptions(s.opts, Context(ctx))...); err != nil {
+			return fmt.Errorf("failed to read users export job: %w", err)
+		}
+	}
+
+	users, err := s.download(ctx, job.GetLocation())
+	if err != nil {
+		return fmt.Errorf("failed to download users export job %q: %w", job.GetID(), err)
+	}
+	for _, user := range users {
+		if !filter(user) {
+			continue
+		}
+		if err := s.yieldUser(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *userSearch) download(ctx context.Context, location string) ([]*User, error) {
+	httpClient := s.options.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s", response.Status)
+	}
+	return ReadUserExport(response.Body)
+}
@@ -0,0 +1,204 @@
+package management
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+)
+
+var userSearchTestRange = regexp.MustCompile(`created_at:\["([^"]+)" TO "([^"]+)"}`)
+
+// givenUsersCreatedAt stores a user created at each of the given times. Every
+// third user has not verified their email.
+func givenUsersCreatedAt(api *fakeAPI, createdAt ...time.Time) {
+	for i, created := range createdAt {
+		id := fmt.Sprintf("auth0|%d", i)
+		api.put("/users/"+id, map[string]interface{}{
+			"user_id":        id,
+			"email_verified": i%3 != 2,
+			"created_at":     created.Format(time.RFC3339Nano),
+		})
+	}
+}
+
+func fakeAPIUsers(api *fakeAPI) []*User {
+	var users []*User
+	for _, resource := range api.children("/users") {
+		users = append(users, fakeAPIResourceAs[User](api, "/users/"+resource["user_id"].(string)))
+	}
+	return users
+}
+
+// limitUserSearches makes user searches refuse to page past window users.
+// Searches only understand the query email_verified:true, created_at ranges
+// and sorting by created_at.
+func limitUserSearches(api *fakeAPI, window int) {
+	api.handle("GET /users", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		page, _ := strconv.Atoi(query.Get("page"))
+		perPage, _ := strconv.Atoi(query.Get("page_size"))
+		if (page+1)*perPage > window {
+			writeFakeAPIError(w, http.StatusBadRequest, "You can only page through the first 1000 records")
+			return
+		}
+
+		var matching []*User
+		for _, user := range fakeAPIUsers(api) {
+			if strings.Contains(query.Get("q"), "email_verified:true") && !user.GetEmailVerified() {
+				continue
+			}
+			if m := userSearchTestRange.FindStringSubmatch(query.Get("q")); m != nil {
+				from, _ := time.Parse(time.RFC3339, m[1])
+				to, _ := time.Parse(time.RFC3339, m[2])
+				if user.GetCreatedAt().Before(from) || !user.GetCreatedAt().Before(to) {
+					continue
+				}
+			}
+			matching = append(matching, user)
+		}
+		switch query.Get("sort") {
+		case "created_at:1":
+			sort.SliceStable(matching, func(i, j int) bool { return matching[i].GetCreatedAt().Before(matching[j].GetCreatedAt()) })
+		case "created_at:-1":
+			sort.SliceStable(matching, func(i, j int) bool { return matching[i].GetCreatedAt().After(matching[j].GetCreatedAt()) })
+		}
+
+		l := &UserList{List: List{Start: page * perPage, Limit: perPage, Total: len(matching)}, Users: []*User{}}
+		for i := page * perPage; i < len(matching) && i < (page+1)*perPage; i++ {
+			l.Users = append(l.Users, matching[i])
+		}
+		l.Length = len(l.Users)
+		writeFakeAPIJSON(w, http.StatusOK, l)
+	})
+}
+
+// serveUsersExports answers users exports with a job that completes on its
+// first read, exporting every user.
+func serveUsersExports(api *fakeAPI) {
+	api.handle("POST /jobs/users-exports", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeAPIJSON(w, http.StatusCreated, map[string]interface{}{"id": "job_1", "status": "pending"})
+	})
+	api.handle("GET /jobs/job_1", func(w http.ResponseWriter, r *http.Request) {
+		writeFakeAPIJSON(w, http.StatusOK, map[string]interface{}{
+			"id":       "job_1",
+			"status":   "completed",
+			"location": "http://" + r.Host + "/export.json.gz",
+		})
+	})
+	api.handle("GET /export.json.gz", func(w http.ResponseWriter, r *http.Request) {
+		gz := gzip.NewWriter(w)
+		encoder := json.NewEncoder(gz)
+		for _, user := range fakeAPIUsers(api) {
+			_ = encoder.Encode(user)
+		}
+		_ = gz.Close()
+	})
+}
+
+func TestUserManager_SearchAll(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	query := UserFieldEmailVerified.Equals(true)
+	verified := func(user *User) bool { return user.GetEmailVerified() }
+	interval := time.Millisecond
+	options := &UserSearchOptions{Window: authok.Int(4), PollInterval: &interval}
+
+	collect := func(m *Management, filter func(*User) bool) ([]string, error) {
+		var ids []string
+		err := m.User.SearchAll(query, filter, func(user *User) error {
+			ids = append(ids, user.GetID())
+			return nil
+		}, options)
+		sort.Strings(ids)
+		return ids, err
+	}
+	expected := func(api *fakeAPI) []string {
+		var ids []string
+		for _, user := range fakeAPIUsers(api) {
+			if user.GetEmailVerified() {
+				ids = append(ids, user.GetID())
+			}
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	t.Run("Pages through results within the window", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenUsersCreatedAt(api, start, start.Add(time.Hour), start.Add(2*time.Hour))
+		limitUserSearches(api, 4)
+
+		ids, err := collect(m, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"auth0|0", "auth0|1"}, ids)
+		assert.Len(t, api.queries("GET /users"), 1)
+	})
+
+	t.Run("Splits searches past the window on created_at", func(t *testing.T) {
+		var createdAt []time.Time
+		for i := 0; i < 30; i++ {
+			createdAt = append(createdAt, start.Add(time.Duration(i*i)*time.Minute))
+		}
+		api, m := startFakeAPI(t)
+		givenUsersCreatedAt(api, createdAt...)
+		limitUserSearches(api, 4)
+		serveUsersExports(api)
+
+		ids, err := collect(m, nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected(api), ids)
+		assert.Len(t, ids, 20)
+		assert.Empty(t, api.queries("POST /jobs/users-exports"))
+	})
+
+	t.Run("Falls back to an export", func(t *testing.T) {
+		var createdAt []time.Time
+		for i := 0; i < 12; i++ {
+			createdAt = append(createdAt, start.Add(time.Duration(i/10)*time.Hour))
+		}
+		api, m := startFakeAPI(t)
+		givenUsersCreatedAt(api, createdAt...)
+		limitUserSearches(api, 4)
+		serveUsersExports(api)
+
+		_, err := collect(m, nil)
+		assert.ErrorContains(t, err, "too many users created at once")
+		assert.Empty(t, api.queries("POST /jobs/users-exports"))
+
+		ids, err := collect(m, verified)
+		require.NoError(t, err)
+		assert.Equal(t, expected(api), ids)
+		assert.Len(t, api.queries("POST /jobs/users-exports"), 1)
+
+		opts := make([]RequestOption, 1, 2)
+		opts[0] = Parameter("fields", "user_id,email_verified,created_at")
+		err = m.User.SearchAll(query, verified, func(user *User) error { return nil }, options, opts...)
+		require.NoError(t, err)
+		assert.Nil(t, opts[:2][1], "the options of the caller must be left as they are")
+	})
+
+	t.Run("Stops when yield fails", func(t *testing.T) {
+		api, m := startFakeAPI(t)
+		givenUsersCreatedAt(api, start, start.Add(time.Hour))
+		limitUserSearches(api, 4)
+
+		calls := 0
+		err := m.User.SearchAll(query, nil, func(user *User) error {
+			calls++
+			return fmt.Errorf("stop")
+		}, options)
+		assert.EqualError(t, err, "stop")
+		assert.Equal(t, 1, calls)
+	})
+}
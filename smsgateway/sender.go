@@ -0,0 +1,61 @@
+package smsgateway
+
+import (
+	"context"
+	"sync"
+)
+
+// Sender delivers the messages received by a Handler, for example through an
+// SMS provider.
+type Sender interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+// SenderFunc adapts a function to a Sender.
+type SenderFunc func(ctx context.Context, message *Message) error
+
+// Send calls f.
+func (f SenderFunc) Send(ctx context.Context, message *Message) error {
+	return f(ctx, message)
+}
+
+// Recorder is a Sender keeping the messages in memory instead of delivering
+// them, for use in tests. The zero value is ready to use.
+type Recorder struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// Send records the message.
+func (r *Recorder) Send(_ context.Context, message *Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+// Messages returns the messages recorded so far, oldest first.
+func (r *Recorder) Messages() []*Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Message(nil), r.messages...)
+}
+
+// Last returns the last message sent to a recipient, or nil if there is none.
+func (r *Recorder) Last(recipient string) *Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := len(r.messages) - 1; i >= 0; i-- {
+		if r.messages[i].Recipient == recipient {
+			return r.messages[i]
+		}
+	}
+	return nil
+}
+
+// Reset forgets the messages recorded so far.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = nil
+}
@@ -0,0 +1,33 @@
+package smsgateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	recorder := &Recorder{}
+	assert.Nil(t, recorder.Last("+15555550100"))
+
+	var wg sync.WaitGroup
+	for _, recipient := range []string{"+15555550100", "+15555550101", "+15555550100"} {
+		wg.Add(1)
+		go func(recipient string) {
+			defer wg.Done()
+			require.NoError(t, recorder.Send(context.Background(), &Message{Recipient: recipient}))
+		}(recipient)
+	}
+	wg.Wait()
+
+	assert.Len(t, recorder.Messages(), 3)
+	assert.NotNil(t, recorder.Last("+15555550101"))
+	assert.Nil(t, recorder.Last("+15555550102"))
+
+	messages := recorder.Messages()
+	messages[0] = nil
+	assert.NotNil(t, recorder.Messages()[0])
+}
@@ -0,0 +1,267 @@
+// Package smsgateway implements the contract of a custom SMS gateway for
+// passwordless SMS connections, so that connections configured with a
+// gateway URL can be served by a real gateway or by a local fake in tests.
+//
+// Authok posts every message to the gateway URL as JSON, with a JWT in the
+// Authorization header signed using the gateway authentication settings of
+// the connection. Handler verifies the JWT, decodes the message and hands it
+// to a Sender.
+//
+//	recorder := &smsgateway.Recorder{}
+//	handler, err := smsgateway.NewHandler(options.GatewayAuthentication, recorder)
+//	if err != nil {
+//	    // handle err
+//	}
+//	http.Handle("/sms", handler)
+package smsgateway
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/authok/authok-go/management"
+)
+
+// Types of messages sent to a gateway.
+const (
+	MessageTypeSMS   = "sms"
+	MessageTypeVoice = "voice"
+)
+
+// Message is a message sent to a gateway.
+type Message struct {
+	// The phone number to send the message to.
+	Recipient string `json:"recipient"`
+
+	// The text of the message, with the code filled in.
+	Body string `json:"body"`
+
+	// The phone number or name to send the message from, as set in the From
+	// option of the connection.
+	Sender string `json:"sender,omitempty"`
+
+	// Either MessageTypeSMS or MessageTypeVoice.
+	MessageType string `json:"message_type,omitempty"`
+
+	// The language of the message.
+	Language string `json:"language,omitempty"`
+
+	// The one-time code.
+	Code string `json:"code,omitempty"`
+
+	// Information about the request that triggered the message, sent when
+	// the ForwardRequestInfo option of the connection is enabled.
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// Handler is an http.Handler implementing the SMS gateway contract.
+type Handler struct {
+	// The audience the JWT must be issued for.
+	Audience string
+
+	// The subject the JWT must have, if any.
+	Subject string
+
+	// The secret the JWT is signed with.
+	Secret []byte
+
+	// Where messages are handed to.
+	Sender Sender
+
+	// How much clock skew to allow for when checking the expiry of the JWT.
+	// Defaults to a minute.
+	Leeway time.Duration
+
+	// Returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewHandler returns a Handler verifying JWTs according to the gateway
+// authentication settings of an SMS connection, and handing messages to
+// sender.
+func NewHandler(auth *management.ConnectionGatewayAuthentication, sender Sender) (*Handler, error) {
+	if auth == nil || auth.GetSecret() == "" {
+		return nil, errors.New("smsgateway: a gateway authentication secret is required")
+	}
+	if auth.GetAudience() == "" {
+		return nil, errors.New("smsgateway: a gateway authentication audience is required")
+	}
+	if sender == nil {
+		return nil, errors.New("smsgateway: a sender is required")
+	}
+
+	secret := []byte(auth.GetSecret())
+	if auth.GetSecretBase64Encoded() {
+		decoded, err := decodeBase64(auth.GetSecret())
+		if err != nil {
+			return nil, fmt.Errorf("smsgateway: failed to decode the secret: %w", err)
+		}
+		secret = decoded
+	}
+
+	return &Handler{
+		Audience: auth.GetAudience(),
+		Subject:  auth.GetSubject(),
+		Secret:   secret,
+		Sender:   sender,
+	}, nil
+}
+
+// ServeHTTP handles a message sent to the gateway.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	token := strings.TrimSpace(r.Header.Get("Authorization"))
+	if len(token) < 7 || !strings.EqualFold(token[:7], "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "missing bearer token")
+		return
+	}
+	if err := h.VerifyToken(strings.TrimSpace(token[7:])); err != nil {
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	var message Message
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20)).Decode(&message); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid message: "+err.Error())
+		return
+	}
+	if message.Recipient == "" {
+		writeError(w, http.StatusBadRequest, "invalid message: missing recipient")
+		return
+	}
+
+	if err := h.Sender.Send(r.Context(), &message); err != nil {
+		writeError(w, http.StatusBadGateway, "failed to send the message: "+err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+type claims struct {
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *int64   `json:"exp"`
+	NotBefore *int64   `json:"nbf"`
+}
+
+// audience is the aud claim of a JWT, which is either a string or an array of
+// strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+	var multiple []string
+	if err := json.Unmarshal(b, &multiple); err != nil {
+		return err
+	}
+	*a = multiple
+	return nil
+}
+
+// VerifyToken checks that a JWT is signed with the secret using HS256, is
+// issued for the audience and subject and has not expired.
+func (h *Handler) VerifyToken(token string) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errors.New("malformed token")
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return fmt.Errorf("malformed token header: %w", err)
+	}
+	if header.Algorithm != "HS256" {
+		return fmt.Errorf("unexpected signing algorithm %q", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("malformed token signature: %w", err)
+	}
+	mac := hmac.New(sha256.New, h.Secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return errors.New("invalid token signature")
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return fmt.Errorf("malformed token claims: %w", err)
+	}
+
+	now := time.Now()
+	if h.Now != nil {
+		now = h.Now()
+	}
+	leeway := h.Leeway
+	if leeway == 0 {
+		leeway = time.Minute
+	}
+	if c.ExpiresAt == nil {
+		return errors.New("token has no expiry")
+	}
+	if now.Add(-leeway).After(time.Unix(*c.ExpiresAt, 0)) {
+		return errors.New("token has expired")
+	}
+	if c.NotBefore != nil && now.Add(leeway).Before(time.Unix(*c.NotBefore, 0)) {
+		return errors.New("token is not valid yet")
+	}
+
+	validAudience := false
+	for _, aud := range c.Audience {
+		if aud == h.Audience {
+			validAudience = true
+		}
+	}
+	if !validAudience {
+		return fmt.Errorf("token is not issued for audience %q", h.Audience)
+	}
+	if h.Subject != "" && c.Subject != h.Subject {
+		return fmt.Errorf("token is not issued for subject %q", h.Subject)
+	}
+
+	return nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// decodeBase64 decodes a secret in either standard or URL safe base64, with
+// or without padding.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
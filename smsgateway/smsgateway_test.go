@@ -0,0 +1,204 @@
+package smsgateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/authok/authok-go"
+	"github.com/authok/authok-go/management"
+)
+
+func signTestToken(t *testing.T, secret []byte, alg string, claims map[string]interface{}) string {
+	t.Helper()
+
+	encode := func(v interface{}) string {
+		b, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	unsigned := encode(map[string]string{"alg": alg, "typ": "JWT"}) + "." + encode(claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func validTestClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"aud": "urn:my-gateway",
+		"sub": "urn:Authok:sms",
+		"iat": time.Now().Unix(),
+		"exp": time.Now().Add(time.Minute).Unix(),
+	}
+}
+
+func newTestGateway(t *testing.T, sender Sender) (*httptest.Server, []byte) {
+	t.Helper()
+
+	secret := []byte("a very secret secret")
+	handler, err := NewHandler(&management.ConnectionGatewayAuthentication{
+		Method:              authok.String("bearer"),
+		Subject:             authok.String("urn:Authok:sms"),
+		Audience:            authok.String("urn:my-gateway"),
+		Secret:              authok.String(base64.StdEncoding.EncodeToString(secret)),
+		SecretBase64Encoded: authok.Bool(true),
+	}, sender)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return server, secret
+}
+
+func postTestMessage(t *testing.T, url, token, body string) (int, string) {
+	t.Helper()
+
+	request, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	var e struct {
+		Error string `json:"error"`
+	}
+	_ = json.NewDecoder(response.Body).Decode(&e)
+	return response.StatusCode, e.Error
+}
+
+const testMessage = `{
+	"recipient": "+15555550100",
+	"body": "Your code is 123456",
+	"sender": "Acme",
+	"message_type": "sms",
+	"language": "en",
+	"code": "123456",
+	"context": {"client_id": "app_1"}
+}`
+
+func TestHandler(t *testing.T) {
+	t.Run("Hands valid messages to the sender", func(t *testing.T) {
+		recorder := &Recorder{}
+		server, secret := newTestGateway(t, recorder)
+
+		status, _ := postTestMessage(t, server.URL, signTestToken(t, secret, "HS256", validTestClaims()), testMessage)
+		assert.Equal(t, http.StatusOK, status)
+
+		message := recorder.Last("+15555550100")
+		require.NotNil(t, message)
+		assert.Equal(t, &Message{
+			Recipient:   "+15555550100",
+			Body:        "Your code is 123456",
+			Sender:      "Acme",
+			MessageType: MessageTypeSMS,
+			Language:    "en",
+			Code:        "123456",
+			Context:     map[string]interface{}{"client_id": "app_1"},
+		}, message)
+		assert.Len(t, recorder.Messages(), 1)
+
+		recorder.Reset()
+		assert.Empty(t, recorder.Messages())
+	})
+
+	t.Run("Rejects invalid tokens", func(t *testing.T) {
+		recorder := &Recorder{}
+		server, secret := newTestGateway(t, recorder)
+
+		expired := validTestClaims()
+		expired["exp"] = time.Now().Add(-time.Hour).Unix()
+		otherAudience := validTestClaims()
+		otherAudience["aud"] = []string{"urn:other"}
+		otherSubject := validTestClaims()
+		otherSubject["sub"] = "urn:other"
+
+		for name, token := range map[string]string{
+			"missing bearer token":                              "",
+			"malformed token":                                   "not-a-jwt",
+			"invalid token signature":                           signTestToken(t, []byte("wrong secret"), "HS256", validTestClaims()),
+			`unexpected signing algorithm "HS384"`:              signTestToken(t, secret, "HS384", validTestClaims()),
+			"token has expired":                                 signTestToken(t, secret, "HS256", expired),
+			`token is not issued for audience "urn:my-gateway"`: signTestToken(t, secret, "HS256", otherAudience),
+			`token is not issued for subject "urn:Authok:sms"`:  signTestToken(t, secret, "HS256", otherSubject),
+		} {
+			status, message := postTestMessage(t, server.URL, token, testMessage)
+			assert.Equal(t, http.StatusUnauthorized, status, name)
+			assert.Equal(t, name, message)
+		}
+		assert.Empty(t, recorder.Messages())
+	})
+
+	t.Run("Accepts an audience among many", func(t *testing.T) {
+		server, secret := newTestGateway(t, &Recorder{})
+
+		claims := validTestClaims()
+		claims["aud"] = []string{"urn:other", "urn:my-gateway"}
+		status, _ := postTestMessage(t, server.URL, signTestToken(t, secret, "HS256", claims), testMessage)
+		assert.Equal(t, http.StatusOK, status)
+	})
+
+	t.Run("Rejects invalid messages", func(t *testing.T) {
+		server, secret := newTestGateway(t, &Recorder{})
+		token := signTestToken(t, secret, "HS256", validTestClaims())
+
+		status, _ := postTestMessage(t, server.URL, token, `{"body": "no recipient"}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+		status, _ = postTestMessage(t, server.URL, token, `not json`)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Reports sender failures", func(t *testing.T) {
+		server, secret := newTestGateway(t, SenderFunc(func(ctx context.Context, message *Message) error {
+			return errors.New("provider unavailable")
+		}))
+
+		status, message := postTestMessage(t, server.URL, signTestToken(t, secret, "HS256", validTestClaims()), testMessage)
+		assert.Equal(t, http.StatusBadGateway, status)
+		assert.Equal(t, "failed to send the message: provider unavailable", message)
+	})
+
+	t.Run("Only accepts POST", func(t *testing.T) {
+		server, _ := newTestGateway(t, &Recorder{})
+
+		response, err := http.Get(server.URL)
+		require.NoError(t, err)
+		defer response.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, response.StatusCode)
+	})
+}
+
+func TestNewHandler(t *testing.T) {
+	_, err := NewHandler(nil, &Recorder{})
+	assert.Error(t, err)
+
+	_, err = NewHandler(&management.ConnectionGatewayAuthentication{Secret: authok.String("secret")}, &Recorder{})
+	assert.Error(t, err)
+
+	_, err = NewHandler(&management.ConnectionGatewayAuthentication{
+		Secret:   authok.String("secret"),
+		Audience: authok.String("urn:my-gateway"),
+	}, nil)
+	assert.Error(t, err)
+
+	handler, err := NewHandler(&management.ConnectionGatewayAuthentication{
+		Secret:   authok.String("secret"),
+		Audience: authok.String("urn:my-gateway"),
+	}, &Recorder{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret"), handler.Secret)
+}